@@ -0,0 +1,180 @@
+package backend
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/mootslive/mono/backend/db"
+	mootslivepbv1 "github.com/mootslive/mono/proto/mootslive/v1"
+	"github.com/segmentio/ksuid"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	auditSignup                   = "signup"
+	auditLogin                    = "login"
+	auditTokenIssued              = "token_issued"
+	auditSessionsRevoked          = "sessions_revoked"
+	auditProviderLinked           = "provider_linked"
+	auditProviderUnlinked         = "provider_unlinked"
+	auditAccountDeletionRequested = "account_deletion_requested"
+	auditAccountDeletionCancelled = "account_deletion_cancelled"
+	auditAccountDeleted           = "account_deleted"
+	auditUserDisabled             = "user_disabled"
+	auditUserEnabled              = "user_enabled"
+	auditRescanForced             = "rescan_forced"
+	auditRecapGenerated           = "recap_generated"
+
+	providerTwitter = "twitter"
+	providerSpotify = "spotify"
+
+	// maxAuditUserAgentLength is how much of a client's user agent is kept,
+	// as clients are free to send as long a one as they like.
+	maxAuditUserAgentLength = 512
+
+	defaultAuditEventsPageSize = 50
+	maxAuditEventsPageSize     = 200
+)
+
+var auditEventKinds = map[string]mootslivepbv1.AuditEventKind{
+	auditSignup:                   mootslivepbv1.AuditEventKind_AUDIT_EVENT_KIND_SIGNUP,
+	auditLogin:                    mootslivepbv1.AuditEventKind_AUDIT_EVENT_KIND_LOGIN,
+	auditTokenIssued:              mootslivepbv1.AuditEventKind_AUDIT_EVENT_KIND_TOKEN_ISSUED,
+	auditSessionsRevoked:          mootslivepbv1.AuditEventKind_AUDIT_EVENT_KIND_SESSIONS_REVOKED,
+	auditProviderLinked:           mootslivepbv1.AuditEventKind_AUDIT_EVENT_KIND_PROVIDER_LINKED,
+	auditProviderUnlinked:         mootslivepbv1.AuditEventKind_AUDIT_EVENT_KIND_PROVIDER_UNLINKED,
+	auditAccountDeletionRequested: mootslivepbv1.AuditEventKind_AUDIT_EVENT_KIND_ACCOUNT_DELETION_REQUESTED,
+	auditAccountDeletionCancelled: mootslivepbv1.AuditEventKind_AUDIT_EVENT_KIND_ACCOUNT_DELETION_CANCELLED,
+	auditAccountDeleted:           mootslivepbv1.AuditEventKind_AUDIT_EVENT_KIND_ACCOUNT_DELETED,
+	auditUserDisabled:             mootslivepbv1.AuditEventKind_AUDIT_EVENT_KIND_USER_DISABLED,
+	auditUserEnabled:              mootslivepbv1.AuditEventKind_AUDIT_EVENT_KIND_USER_ENABLED,
+	auditRescanForced:             mootslivepbv1.AuditEventKind_AUDIT_EVENT_KIND_RESCAN_FORCED,
+	auditRecapGenerated:           mootslivepbv1.AuditEventKind_AUDIT_EVENT_KIND_RECAP_GENERATED,
+}
+
+var auditProviders = map[string]mootslivepbv1.Provider{
+	providerTwitter: mootslivepbv1.Provider_PROVIDER_TWITTER,
+	providerSpotify: mootslivepbv1.Provider_PROVIDER_SPOTIFY,
+}
+
+func auditEventKindFromProto(
+	kind mootslivepbv1.AuditEventKind,
+) (string, error) {
+	for k, v := range auditEventKinds {
+		if v == kind {
+			return k, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported audit event kind %s", kind)
+}
+
+// auditClient is the client that made the request that caused an audit
+// event. It's empty for events that weren't caused by a request.
+type auditClient struct {
+	ipAddress string
+	userAgent string
+}
+
+// auditClientFromReq describes the client that made a request. The IP
+// address is that of the connection, as we don't sit behind a proxy we could
+// trust to tell us otherwise.
+func auditClientFromReq(req connect.AnyRequest) auditClient {
+	ipAddress := req.Peer().Addr
+	if host, _, err := net.SplitHostPort(ipAddress); err == nil {
+		ipAddress = host
+	}
+	userAgent := req.Header().Get("User-Agent")
+	if len(userAgent) > maxAuditUserAgentLength {
+		userAgent = strings.ToValidUTF8(userAgent[:maxAuditUserAgentLength], "")
+	}
+	return auditClient{
+		ipAddress: ipAddress,
+		userAgent: userAgent,
+	}
+}
+
+// auditEvent is a security relevant event to be recorded. Nothing secret,
+// such as tokens, should ever be put in one.
+type auditEvent struct {
+	kind string
+	// actorUserID is empty for events caused by mootslive itself.
+	actorUserID    string
+	targetUserID   string
+	provider       string
+	providerUserID string
+	client         auditClient
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{Valid: s != "", String: s}
+}
+
+// recordAuditEvent appends an event to the audit log, along with the ID of
+// the trace it happened within. It should be called within the same
+// transaction as the change the event describes, where there is one.
+func recordAuditEvent(
+	ctx context.Context, queries db.Querier, event auditEvent,
+) error {
+	traceID := ""
+	if spanCtx := oteltrace.SpanContextFromContext(ctx); spanCtx.HasTraceID() {
+		traceID = spanCtx.TraceID().String()
+	}
+	err := queries.CreateAuditEvent(ctx, db.CreateAuditEventParams{
+		ID:             ksuid.New().String(),
+		Kind:           event.kind,
+		ActorUserID:    nullString(event.actorUserID),
+		TargetUserID:   nullString(event.targetUserID),
+		Provider:       event.provider,
+		ProviderUserID: event.providerUserID,
+		IpAddress:      event.client.ipAddress,
+		UserAgent:      event.client.userAgent,
+		TraceID:        traceID,
+		CreatedAt:      time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("recording %s audit event: %w", event.kind, err)
+	}
+	return nil
+}
+
+func auditEventToProto(event db.AuditEvent) *mootslivepbv1.AuditEvent {
+	return &mootslivepbv1.AuditEvent{
+		Id:             event.ID,
+		Kind:           auditEventKinds[event.Kind],
+		ActorUserId:    event.ActorUserID.String,
+		TargetUserId:   event.TargetUserID.String,
+		Provider:       auditProviders[event.Provider],
+		ProviderUserId: event.ProviderUserID,
+		IpAddress:      event.IpAddress,
+		UserAgent:      event.UserAgent,
+		TraceId:        event.TraceID,
+		CreatedAt:      timestamppb.New(event.CreatedAt),
+	}
+}
+
+// securityEventToProto converts an event for the user it affected. Admins
+// acting on the user aren't identified, nor is the client they used.
+func securityEventToProto(
+	event db.AuditEvent, userID string,
+) *mootslivepbv1.SecurityEvent {
+	res := &mootslivepbv1.SecurityEvent{
+		Id:             event.ID,
+		Kind:           auditEventKinds[event.Kind],
+		Provider:       auditProviders[event.Provider],
+		ProviderUserId: event.ProviderUserID,
+		CreatedAt:      timestamppb.New(event.CreatedAt),
+	}
+	if event.ActorUserID.Valid && event.ActorUserID.String != userID {
+		res.ByAdmin = true
+	} else {
+		res.IpAddress = event.IpAddress
+		res.UserAgent = event.UserAgent
+	}
+	return res
+}
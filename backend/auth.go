@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/mootslive/mono/backend/trace"
+	"net/http"
 	"strings"
 	"time"
 
@@ -99,6 +100,11 @@ type handleReqOpts struct {
 	// noAuth enforces that the user should not be authenticated to access this
 	// endpoint
 	noAuth bool
+	// optionalAuth allows the endpoint to be accessed without being
+	// authenticated, in which case the returned authCtx has no user.
+	optionalAuth bool
+	// admin enforces that the user must be an admin to access this endpoint.
+	admin bool
 }
 
 type authCtx struct {
@@ -107,11 +113,24 @@ type authCtx struct {
 
 func (ae *authEngine) handleReq(
 	ctx context.Context, req connect.AnyRequest, opt handleReqOpts,
+) (*authCtx, error) {
+	return ae.handleHeaders(ctx, req.Header(), opt)
+}
+
+// handleHTTPReq is handleReq for endpoints served over plain HTTP rather than
+// connect.
+func (ae *authEngine) handleHTTPReq(
+	ctx context.Context, r *http.Request, opt handleReqOpts,
+) (*authCtx, error) {
+	return ae.handleHeaders(ctx, r.Header, opt)
+}
+
+func (ae *authEngine) handleHeaders(
+	ctx context.Context, headers http.Header, opt handleReqOpts,
 ) (*authCtx, error) {
 	ctx, span := trace.Start(ctx, "backend/authEngine.handleReq")
 	defer span.End()
 
-	headers := req.Header()
 	authHeader := headers.Get("Authorization")
 	if opt.noAuth {
 		if authHeader != "" {
@@ -120,6 +139,8 @@ func (ae *authEngine) handleReq(
 			)
 		}
 		return nil, nil
+	} else if opt.optionalAuth && authHeader == "" {
+		return &authCtx{}, nil
 	} else if authHeader == "" {
 		return nil, fmt.Errorf("no authorization header provided")
 	}
@@ -144,6 +165,19 @@ func (ae *authEngine) handleReq(
 	if err != nil {
 		return nil, fmt.Errorf("fetching user: %w", err)
 	}
+	if user.DisabledAt.Valid {
+		return nil, fmt.Errorf("user is disabled")
+	}
+	// Tokens only record the second they were issued in, so those issued
+	// within the same second as the revocation are rejected too, as they may
+	// have been issued before it.
+	if user.SessionsRevokedAt.Valid && (claims.IssuedAt == nil ||
+		!claims.IssuedAt.After(user.SessionsRevokedAt.Time.Truncate(time.Second))) {
+		return nil, fmt.Errorf("token has been revoked")
+	}
+	if opt.admin && !user.IsAdmin {
+		return nil, fmt.Errorf("user is not an admin")
+	}
 
 	return &authCtx{
 		user: &user,
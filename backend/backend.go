@@ -6,6 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"github.com/mootslive/mono/backend/trace"
+	"os"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/jackc/pgx/v4"
@@ -18,16 +21,49 @@ import (
 	"golang.org/x/oauth2"
 )
 
+const (
+	spotifyScanSucceeded = "succeeded"
+	spotifyScanFailed    = "failed"
+
+	// spotifyScanRetention is how long scans are kept for.
+	spotifyScanRetention = time.Hour * 24 * 7
+	// maxSpotifyScanErrorLength is how much of the error of a failed scan is
+	// kept.
+	maxSpotifyScanErrorLength = 1024
+)
+
 type SpotifyPoller struct {
 	queries db.TXQuerier
 	log     *slog.Logger
+	// replica identifies this replica in the scans it records.
+	replica string
+	// progressAt is when Run last finished scanning an account or looking
+	// for accounts to scan, as nanoseconds since the Unix epoch.
+	progressAt atomic.Int64
 }
 
 func NewSpotifyPoller(log *slog.Logger, queries db.TXQuerier) *SpotifyPoller {
-	return &SpotifyPoller{
+	replica, err := os.Hostname()
+	if err != nil {
+		replica = "unknown"
+	}
+	sp := &SpotifyPoller{
 		log:     log,
 		queries: queries,
+		replica: replica,
 	}
+	sp.recordProgress()
+	return sp
+}
+
+func (sp *SpotifyPoller) recordProgress() {
+	sp.progressAt.Store(time.Now().UnixNano())
+}
+
+// ProgressAt returns when the poller last made progress, or when it was
+// created if it hasn't yet.
+func (sp *SpotifyPoller) ProgressAt() time.Time {
+	return time.Unix(0, sp.progressAt.Load())
 }
 
 func (sp *SpotifyPoller) Run(ctx context.Context) error {
@@ -39,11 +75,27 @@ func (sp *SpotifyPoller) Run(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("fetching accounts: %w", err)
 		}
+		sp.recordProgress()
 
 		for _, account := range accounts {
+			// Failures are recorded against the account, so one account
+			// failing shouldn't hold up the others.
 			if err := sp.ScanAccount(ctx, account.SpotifyUserID); err != nil {
-				return fmt.Errorf("scanning account: %w", err)
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				sp.log.Error("failed to scan account", err,
+					slog.String("spotify_user_id", account.SpotifyUserID),
+				)
 			}
+			sp.recordProgress()
+		}
+
+		err = sp.queries.DeleteSpotifyScansBefore(
+			ctx, time.Now().Add(-spotifyScanRetention),
+		)
+		if err != nil {
+			sp.log.Error("failed to delete old scans", err)
 		}
 
 		select {
@@ -59,14 +111,45 @@ func (sp *SpotifyPoller) Run(ctx context.Context) error {
 
 const (
 	sourceSpotify = "spotify"
+	// sourceManual listens are recorded by users themselves.
+	sourceManual = "manual"
 )
 
+// ScanAccount records any listens on a Spotify account since it was last
+// scanned, keeping a record of the scan and how it went.
 func (sp *SpotifyPoller) ScanAccount(
 	ctx context.Context, spotifyUserID string,
 ) error {
 	ctx, span := trace.Start(ctx, "backend/SpotifyPoller.ScanAccount")
 	defer span.End()
 
+	scan := db.CreateSpotifyScanParams{
+		ID:            ksuid.New().String(),
+		SpotifyUserID: spotifyUserID,
+		Replica:       sp.replica,
+		StartedAt:     time.Now(),
+	}
+	if err := sp.queries.CreateSpotifyScan(ctx, scan); err != nil {
+		return fmt.Errorf("recording scan: %w", err)
+	}
+
+	listensFound, scanErr := sp.scanAccount(ctx, spotifyUserID)
+	if err := sp.finishScan(ctx, scan, listensFound, scanErr); err != nil {
+		sp.log.Error("failed to record scan result", err,
+			slog.String("spotify_user_id", spotifyUserID),
+		)
+	}
+	return scanErr
+}
+
+// finishScan records the result of a scan against both the scan and its
+// account.
+func (sp *SpotifyPoller) finishScan(
+	ctx context.Context,
+	scan db.CreateSpotifyScanParams,
+	listensFound int,
+	scanErr error,
+) error {
 	commit, rollback, tx, err := sp.queries.BeginTx(ctx)
 	if err != nil {
 		return fmt.Errorf("opening tx: %w", err)
@@ -79,9 +162,65 @@ func (sp *SpotifyPoller) ScanAccount(
 		}
 	}()
 
+	finishedAt := sql.NullTime{Valid: true, Time: time.Now()}
+	finish := db.FinishSpotifyScanParams{
+		ID:           scan.ID,
+		FinishedAt:   finishedAt,
+		Result:       sql.NullString{Valid: true, String: spotifyScanSucceeded},
+		ListensFound: int32(listensFound),
+	}
+	if scanErr != nil {
+		finish.Result.String = spotifyScanFailed
+		finish.Error = scanErr.Error()
+		if len(finish.Error) > maxSpotifyScanErrorLength {
+			finish.Error = strings.ToValidUTF8(
+				finish.Error[:maxSpotifyScanErrorLength], "",
+			)
+		}
+		err = tx.RecordSpotifyAccountScanFailed(ctx, db.RecordSpotifyAccountScanFailedParams{
+			SpotifyUserID: scan.SpotifyUserID,
+			LastScannedAt: finishedAt,
+			LastScanError: finish.Error,
+		})
+	} else {
+		err = tx.RecordSpotifyAccountScanSucceeded(ctx, db.RecordSpotifyAccountScanSucceededParams{
+			SpotifyUserID: scan.SpotifyUserID,
+			LastScannedAt: finishedAt,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("updating account: %w", err)
+	}
+	if err := tx.FinishSpotifyScan(ctx, finish); err != nil {
+		return fmt.Errorf("finishing scan: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// scanAccount records any new listens on an account, returning how many it
+// found.
+func (sp *SpotifyPoller) scanAccount(
+	ctx context.Context, spotifyUserID string,
+) (int, error) {
+	commit, rollback, tx, err := sp.queries.BeginTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		if err := rollback(context.Background()); err != nil {
+			if !errors.Is(err, pgx.ErrTxClosed) {
+				sp.log.Error("failed to rollback", err)
+			}
+		}
+	}()
+
 	account, err := tx.SelectSpotifyAccountForUpdate(ctx, spotifyUserID)
 	if err != nil {
-		return fmt.Errorf("locking account: %w", err)
+		return 0, fmt.Errorf("locking account: %w", err)
 	}
 
 	var afterEpochMs int64 = 0
@@ -95,31 +234,57 @@ func (sp *SpotifyPoller) ScanAccount(
 		AfterEpochMs: afterEpochMs,
 	})
 	if err != nil {
-		return fmt.Errorf("fetching recently played: %w", err)
+		return 0, fmt.Errorf("fetching recently played: %w", err)
+	}
+
+	var policy *privacyPolicy
+	if len(played) > 0 {
+		if err := lockRollups(ctx, tx, account.UserID); err != nil {
+			return 0, err
+		}
+		policy, err = loadPrivacyPolicy(ctx, tx, account.UserID, account.UserID)
+		if err != nil {
+			return 0, err
+		}
 	}
 
 	var listenedAt *time.Time
+	recorded := make([]db.Listen, 0, len(played))
 	for _, track := range played {
 		// TODO: Batch insert these :)
 		track := track
 		sp.log.Debug("recording listen", "user_id", account.UserID, "track_name", track.Track.Name, "listened_at", track.PlayedAt)
-		err := tx.CreateListen(ctx, db.CreateListenParams{
+		listen := db.CreateListenParams{
 			ID:         ksuid.New().String(),
 			UserID:     account.UserID,
 			CreatedAt:  time.Now(),
 			Source:     sourceSpotify,
 			Isrc:       track.Track.ExternalIDs.ISRC,
 			ListenedAt: track.PlayedAt,
-		})
-		if err != nil {
-			return fmt.Errorf("recording listen: %w", err)
 		}
+		if err := recordListen(ctx, tx, policy, listen); err != nil {
+			return 0, fmt.Errorf("recording listen: %w", err)
+		}
+		recorded = append(recorded, db.Listen{
+			ID:         listen.ID,
+			UserID:     listen.UserID,
+			CreatedAt:  listen.CreatedAt,
+			ListenedAt: listen.ListenedAt,
+			Isrc:       listen.Isrc,
+			Source:     listen.Source,
+		})
 		if listenedAt == nil {
 			listenedAt = &track.PlayedAt
 		}
 	}
 
 	if listenedAt != nil {
+		// Delivered once the transaction commits, so watchers will be able
+		// to see the listens when they go looking for them.
+		if err := publishEvent(ctx, tx, eventKindListens, account.UserID); err != nil {
+			return 0, err
+		}
+
 		err := tx.UpdateSpotifyAccountListenedAt(ctx, db.UpdateSpotifyAccountListenedAtParams{
 			SpotifyUserID: account.SpotifyUserID,
 			LastListenedAt: sql.NullTime{
@@ -128,12 +293,26 @@ func (sp *SpotifyPoller) ScanAccount(
 			},
 		})
 		if err != nil {
-			return fmt.Errorf("updating listened at: %w", err)
+			return 0, fmt.Errorf("updating listened at: %w", err)
 		}
 	}
 
 	if err := commit(ctx); err != nil {
-		return err
+		return 0, err
+	}
+
+	// Missing metadata only affects how listens are displayed, so it isn't
+	// worth failing the scan over.
+	if err := recordTracks(ctx, sp.queries, client, played); err != nil {
+		sp.log.Error("failed to record track metadata", err,
+			slog.String("user_id", account.UserID),
+		)
+	}
+
+	if err := notifySameSong(ctx, sp.queries, recorded); err != nil {
+		sp.log.Error("failed to notify of same song", err,
+			slog.String("user_id", account.UserID),
+		)
 	}
 
 	sp.log.Info("recorded listens for user",
@@ -141,7 +320,7 @@ func (sp *SpotifyPoller) ScanAccount(
 		slog.Int("count", len(played)),
 	)
 
-	return nil
+	return len(played), nil
 }
 
 func clientForSpotifyAccount(
@@ -0,0 +1,63 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/jackc/pgx/v4"
+	"github.com/mootslive/mono/backend/db"
+)
+
+// listBlockedUserIDs returns the users a user has blocked, along with those
+// who have blocked them. Either way, the two are hidden from each other.
+func listBlockedUserIDs(
+	ctx context.Context, queries db.Querier, userID string,
+) (map[string]bool, error) {
+	ids, err := queries.ListBlockedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching blocked users: %w", err)
+	}
+	blocked := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		blocked[id] = true
+	}
+	return blocked, nil
+}
+
+// listMutedUserIDs returns the users a user has muted.
+func listMutedUserIDs(
+	ctx context.Context, queries db.Querier, userID string,
+) (map[string]bool, error) {
+	ids, err := queries.ListMutedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching muted users: %w", err)
+	}
+	muted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		muted[id] = true
+	}
+	return muted, nil
+}
+
+// getOtherUser fetches a user that the caller is acting upon, such as by
+// blocking or muting them.
+func getOtherUser(
+	ctx context.Context, queries db.Querier, callerID, userID string, action string,
+) (db.User, error) {
+	if userID == callerID {
+		return db.User{}, connect.NewError(
+			connect.CodeInvalidArgument, fmt.Errorf("cannot %s yourself", action),
+		)
+	}
+	user, err := queries.GetUser(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) || user.DeletionRequestedAt.Valid {
+		return db.User{}, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("user not found"),
+		)
+	} else if err != nil {
+		return db.User{}, fmt.Errorf("fetching user: %w", err)
+	}
+	return user, nil
+}
@@ -0,0 +1,495 @@
+package backend
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/mootslive/mono/backend/db"
+	"github.com/mootslive/mono/backend/trace"
+	mootslivepbv1 "github.com/mootslive/mono/proto/mootslive/v1"
+	"github.com/segmentio/ksuid"
+	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	chartScopeGlobal = "global"
+	chartScopeMoots  = "moots"
+
+	chartPeriodDaily   = "daily"
+	chartPeriodWeekly  = "weekly"
+	chartPeriodMonthly = "monthly"
+
+	chartKindTrack  = "track"
+	chartKindArtist = "artist"
+
+	chartMovementUp      = "up"
+	chartMovementDown    = "down"
+	chartMovementNone    = "none"
+	chartMovementNew     = "new"
+	chartMovementReEntry = "re_entry"
+
+	// chartSize is how many tracks and artists are kept in each chart.
+	chartSize = 50
+	// chartGracePeriod is how long after a period ends its charts are
+	// computed, so that listens the poller hasn't picked up yet are counted.
+	chartGracePeriod = time.Hour
+	// chartBatchSize is how many moots charts of a period are computed in
+	// each pass.
+	chartBatchSize = 100
+)
+
+// chartBackfillPeriods is how many of the latest periods to have ended are
+// checked for missing charts, so that charts missed while the generator
+// wasn't running are filled in, without going back forever.
+var chartBackfillPeriods = map[string]int{
+	chartPeriodDaily:   7,
+	chartPeriodWeekly:  4,
+	chartPeriodMonthly: 2,
+}
+
+var chartScopes = map[string]mootslivepbv1.ChartScope{
+	chartScopeGlobal: mootslivepbv1.ChartScope_CHART_SCOPE_GLOBAL,
+	chartScopeMoots:  mootslivepbv1.ChartScope_CHART_SCOPE_MOOTS,
+}
+
+var chartPeriods = map[string]mootslivepbv1.ChartPeriod{
+	chartPeriodDaily:   mootslivepbv1.ChartPeriod_CHART_PERIOD_DAILY,
+	chartPeriodWeekly:  mootslivepbv1.ChartPeriod_CHART_PERIOD_WEEKLY,
+	chartPeriodMonthly: mootslivepbv1.ChartPeriod_CHART_PERIOD_MONTHLY,
+}
+
+var chartMovements = map[string]mootslivepbv1.ChartMovement{
+	chartMovementUp:      mootslivepbv1.ChartMovement_CHART_MOVEMENT_UP,
+	chartMovementDown:    mootslivepbv1.ChartMovement_CHART_MOVEMENT_DOWN,
+	chartMovementNone:    mootslivepbv1.ChartMovement_CHART_MOVEMENT_NONE,
+	chartMovementNew:     mootslivepbv1.ChartMovement_CHART_MOVEMENT_NEW,
+	chartMovementReEntry: mootslivepbv1.ChartMovement_CHART_MOVEMENT_RE_ENTRY,
+}
+
+func chartScopeFromProto(scope mootslivepbv1.ChartScope) (string, bool) {
+	for k, pb := range chartScopes {
+		if pb == scope {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+func chartPeriodFromProto(period mootslivepbv1.ChartPeriod) (string, bool) {
+	for k, pb := range chartPeriods {
+		if pb == period {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// chartPeriodStart returns the first UTC day of the period containing t.
+func chartPeriodStart(period string, t time.Time) time.Time {
+	day := rollupDay(t)
+	switch period {
+	case chartPeriodWeekly:
+		// Weeks start on Monday.
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case chartPeriodMonthly:
+		return day.AddDate(0, 0, 1-day.Day())
+	default:
+		return day
+	}
+}
+
+// chartPeriodEnd returns the day after the last of the period starting on
+// start.
+func chartPeriodEnd(period string, start time.Time) time.Time {
+	switch period {
+	case chartPeriodWeekly:
+		return start.AddDate(0, 0, 7)
+	case chartPeriodMonthly:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// chartOwner returns the user a chart belongs to, which global charts have
+// none of.
+func chartOwner(scope, userID string) sql.NullString {
+	if scope == chartScopeGlobal {
+		return sql.NullString{}
+	}
+	return sql.NullString{Valid: true, String: userID}
+}
+
+// chartMovement returns how an entry has moved given its rank in the previous
+// chart, which is zero if it wasn't in it, and whether it has ever been in the
+// chart before.
+func chartMovement(rank, previousRank int32, charted bool) string {
+	switch {
+	case previousRank == 0 && charted:
+		return chartMovementReEntry
+	case previousRank == 0:
+		return chartMovementNew
+	case rank < previousRank:
+		return chartMovementUp
+	case rank > previousRank:
+		return chartMovementDown
+	default:
+		return chartMovementNone
+	}
+}
+
+// chartHistory is what's needed of earlier charts to work out how the
+// entries of a new one have moved.
+type chartHistory struct {
+	// previousRanks are the ranks of each item in the chart of the previous
+	// period, keyed by kind and then item ID.
+	previousRanks map[string]map[string]int32
+	// charted are the items that have been in any earlier chart, keyed by
+	// kind.
+	charted map[string]map[string]bool
+}
+
+func loadChartHistory(
+	ctx context.Context,
+	queries db.Querier,
+	scope, userID, period string,
+	start time.Time,
+) (chartHistory, error) {
+	h := chartHistory{
+		previousRanks: map[string]map[string]int32{
+			chartKindTrack:  {},
+			chartKindArtist: {},
+		},
+		charted: map[string]map[string]bool{
+			chartKindTrack:  {},
+			chartKindArtist: {},
+		},
+	}
+
+	// Without a chart for the previous period, everything counts as new or
+	// a re-entry.
+	previous, err := queries.GetChart(ctx, db.GetChartParams{
+		Scope:    scope,
+		UserID:   chartOwner(scope, userID),
+		Period:   period,
+		StartDay: chartPeriodStart(period, start.AddDate(0, 0, -1)),
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return chartHistory{}, fmt.Errorf("fetching previous chart: %w", err)
+	} else if err == nil {
+		entries, err := queries.ListChartEntries(ctx, previous.ID)
+		if err != nil {
+			return chartHistory{}, fmt.Errorf("fetching previous chart entries: %w", err)
+		}
+		for _, entry := range entries {
+			h.previousRanks[entry.Kind][entry.ItemID] = entry.Rank
+		}
+	}
+
+	for kind := range h.charted {
+		ids, err := queries.ListChartedItemIDs(ctx, db.ListChartedItemIDsParams{
+			Scope:     scope,
+			UserID:    chartOwner(scope, userID),
+			Period:    period,
+			BeforeDay: start,
+			Kind:      kind,
+		})
+		if err != nil {
+			return chartHistory{}, fmt.Errorf("fetching charted items: %w", err)
+		}
+		for _, id := range ids {
+			h.charted[kind][id] = true
+		}
+	}
+	return h, nil
+}
+
+func (h chartHistory) entry(
+	chartID, kind string, rank int32, itemID, itemName string, plays int64, listeners int32,
+) db.CreateChartEntryParams {
+	previousRank := h.previousRanks[kind][itemID]
+	return db.CreateChartEntryParams{
+		ChartID:      chartID,
+		Kind:         kind,
+		Rank:         rank,
+		ItemID:       itemID,
+		ItemName:     itemName,
+		Plays:        plays,
+		Listeners:    listeners,
+		PreviousRank: previousRank,
+		Movement:     chartMovement(rank, previousRank, h.charted[kind][itemID]),
+	}
+}
+
+// ChartGenerator computes the charts of each period once it's over, both
+// globally and for the moots of each user.
+type ChartGenerator struct {
+	queries db.TXQuerier
+	log     *slog.Logger
+}
+
+func NewChartGenerator(log *slog.Logger, queries db.TXQuerier) *ChartGenerator {
+	return &ChartGenerator{
+		log:     log,
+		queries: queries,
+	}
+}
+
+func (cg *ChartGenerator) Run(ctx context.Context) error {
+	cg.log.Info("starting chart generator")
+
+	// The users due the moots chart of each period are paged through by ID,
+	// so that users whose charts keep failing are skipped over rather than
+	// filling every batch. They're retried once a pass reaches the end.
+	afterIDs := map[string]string{}
+	for {
+		more := false
+		nextAfterIDs := map[string]string{}
+		for _, period := range []string{
+			chartPeriodDaily, chartPeriodWeekly, chartPeriodMonthly,
+		} {
+			for _, start := range chartBackfillStarts(period, time.Now()) {
+				key := period + "/" + start.Format("2006-01-02")
+				afterID, err := cg.generateDueCharts(ctx, period, start, afterIDs[key])
+				if err != nil {
+					return err
+				}
+				if afterID != "" {
+					nextAfterIDs[key] = afterID
+					more = true
+				}
+			}
+		}
+		afterIDs = nextAfterIDs
+
+		// Carry on with the next batch soon if there may be more to do.
+		wait := time.Minute * 10
+		if more {
+			wait = time.Second * 10
+		}
+		select {
+		case <-time.After(wait):
+			continue
+		case <-ctx.Done():
+			cg.log.Info("context cancelled, stopping chart generator")
+			return ctx.Err()
+		}
+	}
+}
+
+// chartBackfillStarts returns the starts of the latest periods to have ended
+// at least a grace period before now, oldest first, so that each chart is
+// computed after the one it's compared against.
+func chartBackfillStarts(period string, now time.Time) []time.Time {
+	n := chartBackfillPeriods[period]
+	starts := make([]time.Time, n)
+	start := chartPeriodStart(period, now.Add(-chartGracePeriod))
+	for i := n - 1; i >= 0; i-- {
+		start = chartPeriodStart(period, start.AddDate(0, 0, -1))
+		starts[i] = start
+	}
+	return starts
+}
+
+// generateDueCharts computes the global chart of a period if it's missing,
+// and the next batch of moots charts of users after afterID that are. It
+// returns the ID to carry on after if there may be more users due.
+func (cg *ChartGenerator) generateDueCharts(
+	ctx context.Context, period string, start time.Time, afterID string,
+) (string, error) {
+	_, err := cg.queries.GetChart(ctx, db.GetChartParams{
+		Scope:    chartScopeGlobal,
+		Period:   period,
+		StartDay: start,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		if err := cg.GenerateChart(ctx, chartScopeGlobal, "", period, start); err != nil {
+			cg.log.Error("failed to generate global chart", err,
+				slog.String("period", period),
+				slog.Time("start_day", start),
+			)
+		}
+	} else if err != nil {
+		return "", fmt.Errorf("fetching global chart: %w", err)
+	}
+
+	userIDs, err := cg.queries.ListUsersDueMootsChart(ctx, db.ListUsersDueMootsChartParams{
+		Period:   period,
+		StartDay: start,
+		AfterID:  afterID,
+		RowLimit: chartBatchSize,
+	})
+	if err != nil {
+		return "", fmt.Errorf("fetching users due moots chart: %w", err)
+	}
+	for _, userID := range userIDs {
+		// A failure for one user shouldn't hold up everyone else's
+		// chart, they'll be retried on a later pass.
+		err := cg.GenerateChart(ctx, chartScopeMoots, userID, period, start)
+		if err != nil {
+			cg.log.Error("failed to generate moots chart", err,
+				slog.String("user_id", userID),
+				slog.String("period", period),
+				slog.Time("start_day", start),
+			)
+		}
+	}
+	if len(userIDs) < chartBatchSize {
+		return "", nil
+	}
+	return userIDs[len(userIDs)-1], nil
+}
+
+// GenerateChart computes and stores the chart of a period. userID is the user
+// whose moots are counted for a moots chart, and is ignored for a global one.
+// Only users whose listens are public are counted, in either scope.
+func (cg *ChartGenerator) GenerateChart(
+	ctx context.Context, scope, userID, period string, start time.Time,
+) error {
+	ctx, span := trace.Start(ctx, "backend/ChartGenerator.GenerateChart")
+	defer span.End()
+
+	end := chartPeriodEnd(period, start)
+	// A nil slice of users counts everyone.
+	var userIDs []string
+	if scope == chartScopeMoots {
+		mootIDs, err := cg.queries.ListMootIDsForUser(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("fetching moots: %w", err)
+		}
+		userIDs = append([]string{}, mootIDs...)
+	}
+
+	tracks, err := cg.queries.ListChartTracks(ctx, db.ListChartTracksParams{
+		StartDay: start,
+		EndDay:   end,
+		UserIds:  userIDs,
+		RowLimit: chartSize,
+	})
+	if err != nil {
+		return fmt.Errorf("fetching chart tracks: %w", err)
+	}
+	artists, err := cg.queries.ListChartArtists(ctx, db.ListChartArtistsParams{
+		StartDay: start,
+		EndDay:   end,
+		UserIds:  userIDs,
+		RowLimit: chartSize,
+	})
+	if err != nil {
+		return fmt.Errorf("fetching chart artists: %w", err)
+	}
+	history, err := loadChartHistory(ctx, cg.queries, scope, userID, period, start)
+	if err != nil {
+		return err
+	}
+
+	commit, rollback, tx, err := cg.queries.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		if err := rollback(context.Background()); err != nil {
+			if !errors.Is(err, pgx.ErrTxClosed) {
+				cg.log.Error("failed to rollback", err)
+			}
+		}
+	}()
+
+	chartID := ksuid.New().String()
+	err = tx.CreateChart(ctx, db.CreateChartParams{
+		ID:         chartID,
+		Scope:      scope,
+		UserID:     chartOwner(scope, userID),
+		Period:     period,
+		StartDay:   start,
+		EndDay:     end,
+		ComputedAt: time.Now(),
+	})
+	if isUniqueViolation(err) {
+		// Another replica got there first.
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("creating chart: %w", err)
+	}
+	for i, row := range tracks {
+		entry := history.entry(
+			chartID, chartKindTrack, int32(i+1), row.Isrc, "", row.Plays, row.Listeners,
+		)
+		if err := tx.CreateChartEntry(ctx, entry); err != nil {
+			return fmt.Errorf("creating chart entry: %w", err)
+		}
+	}
+	for i, row := range artists {
+		entry := history.entry(
+			chartID, chartKindArtist, int32(i+1), row.ArtistID, row.ArtistName, row.Plays, row.Listeners,
+		)
+		if err := tx.CreateChartEntry(ctx, entry); err != nil {
+			return fmt.Errorf("creating chart entry: %w", err)
+		}
+	}
+
+	if err := commit(ctx); err != nil {
+		return fmt.Errorf("committing tx: %w", err)
+	}
+	return nil
+}
+
+// chartToProto returns a stored chart along with its entries.
+func chartToProto(
+	ctx context.Context, queries db.Querier, chart db.Chart,
+) (*mootslivepbv1.GetChartsResponse, error) {
+	entries, err := queries.ListChartEntries(ctx, chart.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching chart entries: %w", err)
+	}
+	isrcs := []string{}
+	for _, entry := range entries {
+		if entry.Kind == chartKindTrack {
+			isrcs = append(isrcs, entry.ItemID)
+		}
+	}
+	tracks, err := listTracks(ctx, queries, isrcs)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &mootslivepbv1.GetChartsResponse{
+		Scope:      chartScopes[chart.Scope],
+		Period:     chartPeriods[chart.Period],
+		Range:      timeRangeToProto(chart.StartDay, chart.EndDay),
+		Tracks:     []*mootslivepbv1.ChartTrack{},
+		Artists:    []*mootslivepbv1.ChartArtist{},
+		ComputedAt: timestamppb.New(chart.ComputedAt),
+	}
+	for _, entry := range entries {
+		switch entry.Kind {
+		case chartKindTrack:
+			res.Tracks = append(res.Tracks, &mootslivepbv1.ChartTrack{
+				Rank:         entry.Rank,
+				Track:        trackOrISRCToProto(entry.ItemID, tracks),
+				Plays:        entry.Plays,
+				Listeners:    entry.Listeners,
+				PreviousRank: entry.PreviousRank,
+				Movement:     chartMovements[entry.Movement],
+			})
+		case chartKindArtist:
+			res.Artists = append(res.Artists, &mootslivepbv1.ChartArtist{
+				Rank: entry.Rank,
+				Artist: &mootslivepbv1.Artist{
+					Id:   entry.ItemID,
+					Name: entry.ItemName,
+				},
+				Plays:        entry.Plays,
+				Listeners:    entry.Listeners,
+				PreviousRank: entry.PreviousRank,
+				Movement:     chartMovements[entry.Movement],
+			})
+		}
+	}
+	return res, nil
+}
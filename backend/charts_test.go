@@ -0,0 +1,186 @@
+package backend
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChartPeriodStart(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	tests := []struct {
+		name   string
+		period string
+		t      time.Time
+		want   time.Time
+	}{
+		{
+			name:   "daily",
+			period: chartPeriodDaily,
+			t:      time.Date(2023, time.March, 8, 17, 30, 0, 0, time.UTC),
+			want:   time.Date(2023, time.March, 8, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "daily is a UTC day",
+			period: chartPeriodDaily,
+			t:      time.Date(2023, time.March, 9, 2, 0, 0, 0, tokyo),
+			want:   time.Date(2023, time.March, 8, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "weekly on a monday",
+			period: chartPeriodWeekly,
+			t:      time.Date(2023, time.March, 6, 12, 0, 0, 0, time.UTC),
+			want:   time.Date(2023, time.March, 6, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "weekly on a sunday",
+			period: chartPeriodWeekly,
+			t:      time.Date(2023, time.March, 12, 23, 59, 0, 0, time.UTC),
+			want:   time.Date(2023, time.March, 6, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "weekly starting in the previous month",
+			period: chartPeriodWeekly,
+			t:      time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+			want:   time.Date(2023, time.February, 27, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "weekly starting in the previous year",
+			period: chartPeriodWeekly,
+			t:      time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+			want:   time.Date(2022, time.December, 26, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "monthly",
+			period: chartPeriodMonthly,
+			t:      time.Date(2023, time.March, 31, 23, 0, 0, 0, time.UTC),
+			want:   time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "monthly on the first",
+			period: chartPeriodMonthly,
+			t:      time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+			want:   time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "monthly is a UTC month",
+			period: chartPeriodMonthly,
+			t:      time.Date(2023, time.March, 1, 5, 0, 0, 0, tokyo),
+			want:   time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := chartPeriodStart(tt.period, tt.t)
+			if !got.Equal(tt.want) {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestChartBackfillStarts(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name   string
+		period string
+		now    time.Time
+		want   []time.Time
+	}{
+		{
+			name:   "daily within the grace period",
+			period: chartPeriodDaily,
+			now:    time.Date(2023, time.March, 8, 0, 30, 0, 0, time.UTC),
+			want: []time.Time{
+				date(2023, time.February, 28), date(2023, time.March, 1),
+				date(2023, time.March, 2), date(2023, time.March, 3),
+				date(2023, time.March, 4), date(2023, time.March, 5),
+				date(2023, time.March, 6),
+			},
+		},
+		{
+			name:   "weekly",
+			period: chartPeriodWeekly,
+			now:    time.Date(2023, time.March, 8, 12, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				date(2023, time.February, 6), date(2023, time.February, 13),
+				date(2023, time.February, 20), date(2023, time.February, 27),
+			},
+		},
+		{
+			name:   "monthly across a year",
+			period: chartPeriodMonthly,
+			now:    time.Date(2023, time.February, 1, 2, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				date(2022, time.December, 1), date(2023, time.January, 1),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := chartBackfillStarts(tt.period, tt.now)
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %d starts, got %d", len(tt.want), len(got))
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("expected start %d to be %s, got %s", i, tt.want[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestChartMovement(t *testing.T) {
+	tests := []struct {
+		name         string
+		rank         int32
+		previousRank int32
+		charted      bool
+		want         string
+	}{
+		{
+			name: "never charted before",
+			rank: 3,
+			want: chartMovementNew,
+		},
+		{
+			name:    "charted before but not in the previous chart",
+			rank:    3,
+			charted: true,
+			want:    chartMovementReEntry,
+		},
+		{
+			name:         "moved up",
+			rank:         2,
+			previousRank: 5,
+			charted:      true,
+			want:         chartMovementUp,
+		},
+		{
+			name:         "moved down",
+			rank:         5,
+			previousRank: 2,
+			charted:      true,
+			want:         chartMovementDown,
+		},
+		{
+			name:         "stayed put",
+			rank:         4,
+			previousRank: 4,
+			charted:      true,
+			want:         chartMovementNone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := chartMovement(tt.rank, tt.previousRank, tt.charted)
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
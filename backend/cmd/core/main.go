@@ -8,11 +8,16 @@ import (
 	"io"
 	"net/http"
 	"os"
+	// Users' time zones are loaded by name, which the image we deploy to
+	// may not have the database for.
+	_ "time/tzdata"
 
 	"github.com/bufbuild/connect-go"
+	grpchealth "github.com/bufbuild/connect-grpchealth-go"
 	grpcreflect "github.com/bufbuild/connect-grpcreflect-go"
 	otelconnect "github.com/bufbuild/connect-opentelemetry-go"
 	"github.com/mootslive/mono/backend"
+	backendtrace "github.com/mootslive/mono/backend/trace"
 	"github.com/mootslive/mono/proto/mootslive/v1/mootslivepbv1connect"
 	"github.com/rs/cors"
 	"go.opentelemetry.io/otel"
@@ -26,15 +31,16 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
-func setupTraceExporting() error {
+func setupTraceExporting() (*backendtrace.MonitoredExporter, error) {
 	jaegerExporter, err := jaeger.New(
 		jaeger.WithCollectorEndpoint(),
 	)
 	if err != nil {
-		return fmt.Errorf("creating jaeger exporter: %w", err)
+		return nil, fmt.Errorf("creating jaeger exporter: %w", err)
 	}
+	exporter := backendtrace.NewMonitoredExporter(jaegerExporter)
 	tp := trace.NewTracerProvider(
-		trace.WithBatcher(jaegerExporter),
+		trace.WithBatcher(exporter),
 		trace.WithResource(resource.NewWithAttributes(
 			semconv.SchemaURL,
 			semconv.ServiceNamespaceKey.String("mootslive"),
@@ -42,7 +48,7 @@ func setupTraceExporting() error {
 		)),
 	)
 	otel.SetTracerProvider(tp)
-	return nil
+	return exporter, nil
 }
 
 func run(out io.Writer) error {
@@ -53,7 +59,8 @@ func run(out io.Writer) error {
 	log := slog.New(logOpts.NewJSONHandler(out))
 	log.Info("starting mootslive backend")
 
-	if err := setupTraceExporting(); err != nil {
+	exporter, err := setupTraceExporting()
+	if err != nil {
 		return fmt.Errorf("setting up trace exporting: %w", err)
 	}
 
@@ -75,16 +82,65 @@ func run(out io.Writer) error {
 		queries,
 	)
 
-	adminService := &backend.AdminServerHandler{}
-	userService := backend.NewUserServiceHandler(queries, log, authEngine)
+	events := backend.NewEventBus(log, pool)
+
+	poller := backend.NewSpotifyPoller(log, queries)
+	healthChecker := backend.NewHealthChecker(log, pool, queries, poller, exporter)
+
+	adminService := backend.NewAdminServiceHandler(
+		queries, log, authEngine, poller, healthChecker,
+	)
+	userService := backend.NewUserServiceHandler(queries, log, authEngine, events)
 
 	eg, gctx := errgroup.WithContext(ctx)
 	eg.Go(func() error {
-		if err := backend.NewSpotifyPoller(log, queries).Run(gctx); err != nil {
+		if err := events.Run(gctx); err != nil {
+			return fmt.Errorf("receiving events: %w", err)
+		}
+		return nil
+	})
+	eg.Go(func() error {
+		if err := poller.Run(gctx); err != nil {
 			return fmt.Errorf("polling: %w", err)
 		}
 		return nil
 	})
+	eg.Go(func() error {
+		if err := backend.NewAccountDeleter(log, queries).Run(gctx); err != nil {
+			return fmt.Errorf("deleting accounts: %w", err)
+		}
+		return nil
+	})
+	eg.Go(func() error {
+		if err := backend.NewWeeklyRecapTweeter(log, queries).Run(gctx); err != nil {
+			return fmt.Errorf("tweeting weekly recaps: %w", err)
+		}
+		return nil
+	})
+	eg.Go(func() error {
+		if err := backend.NewTwitterFollowSyncer(log, queries).Run(gctx); err != nil {
+			return fmt.Errorf("syncing twitter follows: %w", err)
+		}
+		return nil
+	})
+	eg.Go(func() error {
+		if err := backend.NewTwitterProfileRefresher(log, queries).Run(gctx); err != nil {
+			return fmt.Errorf("refreshing twitter profiles: %w", err)
+		}
+		return nil
+	})
+	eg.Go(func() error {
+		if err := backend.NewYearlyRecapGenerator(log, queries).Run(gctx); err != nil {
+			return fmt.Errorf("generating yearly recaps: %w", err)
+		}
+		return nil
+	})
+	eg.Go(func() error {
+		if err := backend.NewChartGenerator(log, queries).Run(gctx); err != nil {
+			return fmt.Errorf("generating charts: %w", err)
+		}
+		return nil
+	})
 	eg.Go(func() error {
 		loggingInterceptor := NewLoggingUnaryInteceptor(log)
 		telemetryInterceptor := otelconnect.NewInterceptor()
@@ -93,6 +149,9 @@ func run(out io.Writer) error {
 		reflector := grpcreflect.NewStaticReflector(
 			mootslivepbv1connect.AdminServiceName,
 			mootslivepbv1connect.UserServiceName,
+			// grpchealth registers the health service's descriptor, but
+			// doesn't export its name.
+			"grpc.health.v1.Health",
 		)
 		mux.Handle(grpcreflect.NewHandlerV1(
 			reflector,
@@ -111,6 +170,15 @@ func run(out io.Writer) error {
 			userService,
 			connect.WithInterceptors(telemetryInterceptor, loggingInterceptor),
 		))
+		mux.Handle(
+			"/export/listens",
+			backend.NewListenExportHandler(queries, log, authEngine),
+		)
+
+		// Health checks are polled constantly, so aren't logged.
+		mux.Handle(grpchealth.NewHandler(healthChecker))
+		mux.Handle("/healthz", healthChecker.LivenessHandler())
+		mux.Handle("/readyz", healthChecker.ReadinessHandler())
 
 		return http.ListenAndServe(
 			"localhost:9000",
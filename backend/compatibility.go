@@ -0,0 +1,361 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/mootslive/mono/backend/db"
+	"github.com/mootslive/mono/backend/trace"
+)
+
+const (
+	// compatibilitySharedCount is how many shared tracks and artists are
+	// kept to explain a compatibility score.
+	compatibilitySharedCount = 10
+
+	// The weights of each component of a compatibility score, which sum
+	// to one.
+	compatibilitySharedTracksWeight  = 0.35
+	compatibilitySharedArtistsWeight = 0.35
+	compatibilityPlayOverlapWeight   = 0.3
+)
+
+// overlap returns how many keys of a and b are shared, and how many are held
+// by either.
+func overlap(a, b map[string]int64) (shared, union int32) {
+	for k := range a {
+		if _, ok := b[k]; ok {
+			shared++
+		}
+	}
+	return shared, int32(len(a)+len(b)) - shared
+}
+
+// playOverlap returns the sum over the keys of a and b of the fewest plays
+// either holds of it, and of the most.
+func playOverlap(a, b map[string]int64) (minSum, maxSum int64) {
+	for k, av := range a {
+		bv := b[k]
+		if av < bv {
+			minSum += av
+			maxSum += bv
+		} else {
+			minSum += bv
+			maxSum += av
+		}
+	}
+	for k, bv := range b {
+		if _, ok := a[k]; !ok {
+			maxSum += bv
+		}
+	}
+	return minSum, maxSum
+}
+
+// ratio returns n over d, or zero when d is.
+func ratio(n, d int64) float64 {
+	if d == 0 {
+		return 0
+	}
+	return float64(n) / float64(d)
+}
+
+// jaccard returns the size of the intersection of the keys of a and b over
+// the size of their union.
+func jaccard(a, b map[string]int64) float64 {
+	shared, union := overlap(a, b)
+	return ratio(int64(shared), int64(union))
+}
+
+// weightedJaccard is jaccard, weighted by the play counts held in a and b.
+func weightedJaccard(a, b map[string]int64) float64 {
+	return ratio(playOverlap(a, b))
+}
+
+// topShared returns the keys present in both a and b, ordered by the fewest
+// plays either user has of them, so that something both users play a lot
+// ranks above something only one of them does.
+func topShared(a, b map[string]int64, n int) []string {
+	type shared struct {
+		key      string
+		minPlays int64
+		total    int64
+	}
+	all := []shared{}
+	for k, av := range a {
+		bv, ok := b[k]
+		if !ok {
+			continue
+		}
+		s := shared{key: k, minPlays: av, total: av + bv}
+		if bv < av {
+			s.minPlays = bv
+		}
+		all = append(all, s)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].minPlays != all[j].minPlays {
+			return all[i].minPlays > all[j].minPlays
+		}
+		if all[i].total != all[j].total {
+			return all[i].total > all[j].total
+		}
+		return all[i].key < all[j].key
+	})
+	if len(all) > n {
+		all = all[:n]
+	}
+	keys := make([]string, 0, len(all))
+	for _, s := range all {
+		keys = append(keys, s.key)
+	}
+	return keys
+}
+
+// artistPlays attributes the plays of each track to its artists.
+func artistPlays(
+	trackPlays map[string]int64, tracks map[string]db.Track,
+) map[string]int64 {
+	plays := map[string]int64{}
+	for isrc, n := range trackPlays {
+		for _, artistID := range tracks[isrc].ArtistIds {
+			plays[artistID] += n
+		}
+	}
+	return plays
+}
+
+// artistNames returns the names of the artists of the given tracks, keyed by
+// artist ID.
+func artistNames(tracks map[string]db.Track) map[string]string {
+	names := map[string]string{}
+	for _, track := range tracks {
+		for i, id := range track.ArtistIds {
+			names[id] = track.ArtistNames[i]
+		}
+	}
+	return names
+}
+
+// compatibilityCounts are the sizes a compatibility score is computed from.
+// They're stored with the score, so it can be kept up to date as listens
+// are counted by adjusting them, without the plays of either user.
+type compatibilityCounts struct {
+	sharedTracks  int32
+	unionTracks   int32
+	sharedArtists int32
+	unionArtists  int32
+	// minPlays and maxPlays are summed over every track either user played.
+	minPlays int64
+	maxPlays int64
+}
+
+// compatibilityScore returns the score, between 0 and 100, of the given
+// counts.
+func compatibilityScore(c compatibilityCounts) float64 {
+	// Overlaps between two users' listening are typically small, so the
+	// square root is taken of each component to spread scores out across
+	// the range.
+	score := compatibilitySharedTracksWeight*math.Sqrt(ratio(int64(c.sharedTracks), int64(c.unionTracks))) +
+		compatibilitySharedArtistsWeight*math.Sqrt(ratio(int64(c.sharedArtists), int64(c.unionArtists))) +
+		compatibilityPlayOverlapWeight*math.Sqrt(ratio(c.minPlays, c.maxPlays))
+	return math.Round(score*1000) / 10
+}
+
+// compatibility is how similar the listening of two users is.
+type compatibility struct {
+	compatibilityCounts
+	// score is between 0 and 100.
+	score             float64
+	sharedISRCs       []string
+	sharedArtistIDs   []string
+	sharedArtistNames []string
+}
+
+func computeCompatibility(
+	aTracks, bTracks, aArtists, bArtists map[string]int64,
+) compatibility {
+	c := compatibility{
+		sharedISRCs:     topShared(aTracks, bTracks, compatibilitySharedCount),
+		sharedArtistIDs: topShared(aArtists, bArtists, compatibilitySharedCount),
+	}
+	c.sharedTracks, c.unionTracks = overlap(aTracks, bTracks)
+	c.sharedArtists, c.unionArtists = overlap(aArtists, bArtists)
+	c.minPlays, c.maxPlays = playOverlap(aTracks, bTracks)
+	c.score = compatibilityScore(c.compatibilityCounts)
+	return c
+}
+
+func listTrackPlays(
+	ctx context.Context, queries db.Querier, userID string, startDay time.Time,
+) (map[string]int64, error) {
+	rows, err := queries.ListTrackPlaysForUser(ctx, db.ListTrackPlaysForUserParams{
+		UserID:   userID,
+		StartDay: startDay,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching track plays: %w", err)
+	}
+	plays := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		plays[row.Isrc] = row.Plays
+	}
+	return plays, nil
+}
+
+func listArtistPlays(
+	ctx context.Context, queries db.Querier, userID string, startDay time.Time,
+) (map[string]int64, error) {
+	rows, err := queries.ListArtistPlaysForUser(ctx, db.ListArtistPlaysForUserParams{
+		UserID:   userID,
+		StartDay: startDay,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching artist plays: %w", err)
+	}
+	plays := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		plays[row.ArtistID] = row.Plays
+	}
+	return plays, nil
+}
+
+// getCompatibility returns the compatibility of two users over the window
+// starting on startDay, from the cache if it's fresh enough.
+func getCompatibility(
+	ctx context.Context,
+	queries db.TXQuerier,
+	userID, otherUserID string,
+	startDay time.Time,
+	window string,
+) (db.CompatibilityScore, error) {
+	ctx, span := trace.Start(ctx, "backend/getCompatibility")
+	defer span.End()
+
+	// Compatibility is symmetric, so each pair is only stored once.
+	if otherUserID < userID {
+		userID, otherUserID = otherUserID, userID
+	}
+
+	cached, err := queries.GetCompatibilityScore(ctx, db.GetCompatibilityScoreParams{
+		UserID:      userID,
+		OtherUserID: otherUserID,
+		TimeWindow:  window,
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return db.CompatibilityScore{}, fmt.Errorf("fetching cached score: %w", err)
+	}
+	// The counts of a cached score are adjusted as either user's listens are
+	// counted, so it only needs recomputing once a day when its window moves
+	// on, or when either user's rollups have been rebuilt. The shared tracks
+	// and artists are only refreshed by those recomputations.
+	if err == nil && !cached.Stale && cached.WindowStart.Equal(startDay) {
+		cached.Score = compatibilityScore(compatibilityCounts{
+			sharedTracks:  cached.SharedTracks,
+			unionTracks:   cached.UnionTracks,
+			sharedArtists: cached.SharedArtists,
+			unionArtists:  cached.UnionArtists,
+			minPlays:      cached.MinPlays,
+			maxPlays:      cached.MaxPlays,
+		})
+		return cached, nil
+	}
+
+	commit, rollback, tx, err := queries.BeginTx(ctx)
+	if err != nil {
+		return db.CompatibilityScore{}, fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		// Any failure that led to a rollback has already been returned.
+		_ = rollback(context.Background())
+	}()
+
+	// Holding the rollups lock of both users means no listen of theirs can
+	// be counted between reading their plays and storing the score, which
+	// would otherwise be lost. They're taken in order of user ID so that two
+	// recomputations can't deadlock.
+	if err := lockRollups(ctx, tx, userID); err != nil {
+		return db.CompatibilityScore{}, err
+	}
+	if err := lockRollups(ctx, tx, otherUserID); err != nil {
+		return db.CompatibilityScore{}, err
+	}
+	aTracks, err := listTrackPlays(ctx, tx, userID, startDay)
+	if err != nil {
+		return db.CompatibilityScore{}, err
+	}
+	bTracks, err := listTrackPlays(ctx, tx, otherUserID, startDay)
+	if err != nil {
+		return db.CompatibilityScore{}, err
+	}
+	aArtists, err := listArtistPlays(ctx, tx, userID, startDay)
+	if err != nil {
+		return db.CompatibilityScore{}, err
+	}
+	bArtists, err := listArtistPlays(ctx, tx, otherUserID, startDay)
+	if err != nil {
+		return db.CompatibilityScore{}, err
+	}
+
+	c := computeCompatibility(aTracks, bTracks, aArtists, bArtists)
+	c.sharedArtistNames = make([]string, 0, len(c.sharedArtistIDs))
+	if len(c.sharedArtistIDs) > 0 {
+		names, err := tx.ListArtistNames(ctx, c.sharedArtistIDs)
+		if err != nil {
+			return db.CompatibilityScore{}, fmt.Errorf("fetching artist names: %w", err)
+		}
+		byID := make(map[string]string, len(names))
+		for _, row := range names {
+			byID[row.ArtistID] = row.ArtistName
+		}
+		for _, id := range c.sharedArtistIDs {
+			c.sharedArtistNames = append(c.sharedArtistNames, byID[id])
+		}
+	}
+	score := db.UpsertCompatibilityScoreParams{
+		UserID:            userID,
+		OtherUserID:       otherUserID,
+		TimeWindow:        window,
+		Score:             c.score,
+		SharedIsrcs:       c.sharedISRCs,
+		SharedArtistIds:   c.sharedArtistIDs,
+		SharedArtistNames: c.sharedArtistNames,
+		ComputedAt:        time.Now(),
+		WindowStart:       startDay,
+		SharedTracks:      c.sharedTracks,
+		UnionTracks:       c.unionTracks,
+		SharedArtists:     c.sharedArtists,
+		UnionArtists:      c.unionArtists,
+		MinPlays:          c.minPlays,
+		MaxPlays:          c.maxPlays,
+	}
+	if err := tx.UpsertCompatibilityScore(ctx, score); err != nil {
+		return db.CompatibilityScore{}, fmt.Errorf("caching score: %w", err)
+	}
+	if err := commit(ctx); err != nil {
+		return db.CompatibilityScore{}, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return db.CompatibilityScore{
+		UserID:            score.UserID,
+		OtherUserID:       score.OtherUserID,
+		TimeWindow:        score.TimeWindow,
+		Score:             score.Score,
+		SharedIsrcs:       score.SharedIsrcs,
+		SharedArtistIds:   score.SharedArtistIds,
+		SharedArtistNames: score.SharedArtistNames,
+		ComputedAt:        score.ComputedAt,
+		WindowStart:       score.WindowStart,
+		SharedTracks:      score.SharedTracks,
+		UnionTracks:       score.UnionTracks,
+		SharedArtists:     score.SharedArtists,
+		UnionArtists:      score.UnionArtists,
+		MinPlays:          score.MinPlays,
+		MaxPlays:          score.MaxPlays,
+	}, nil
+}
@@ -0,0 +1,226 @@
+package backend
+
+import "testing"
+
+func TestJaccard(t *testing.T) {
+	tests := []struct {
+		name         string
+		a, b         map[string]int64
+		want         float64
+		wantWeighted float64
+	}{
+		{
+			name: "both empty",
+		},
+		{
+			name:         "one empty",
+			a:            map[string]int64{"x": 3},
+			want:         0,
+			wantWeighted: 0,
+		},
+		{
+			name:         "identical",
+			a:            map[string]int64{"x": 3, "y": 1},
+			b:            map[string]int64{"x": 3, "y": 1},
+			want:         1,
+			wantWeighted: 1,
+		},
+		{
+			name:         "disjoint",
+			a:            map[string]int64{"x": 3},
+			b:            map[string]int64{"y": 3},
+			want:         0,
+			wantWeighted: 0,
+		},
+		{
+			name:         "same keys with different plays",
+			a:            map[string]int64{"x": 1, "y": 4},
+			b:            map[string]int64{"x": 3, "y": 4},
+			want:         1,
+			wantWeighted: 5.0 / 7.0,
+		},
+		{
+			name:         "partial overlap",
+			a:            map[string]int64{"x": 4, "y": 1},
+			b:            map[string]int64{"x": 2, "z": 2},
+			want:         1.0 / 3.0,
+			wantWeighted: 2.0 / 7.0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jaccard(tt.a, tt.b); got != tt.want {
+				t.Errorf("expected jaccard %v, got %v", tt.want, got)
+			}
+			if got := jaccard(tt.b, tt.a); got != tt.want {
+				t.Errorf("expected reversed jaccard %v, got %v", tt.want, got)
+			}
+			if got := weightedJaccard(tt.a, tt.b); got != tt.wantWeighted {
+				t.Errorf("expected weighted jaccard %v, got %v", tt.wantWeighted, got)
+			}
+			if got := weightedJaccard(tt.b, tt.a); got != tt.wantWeighted {
+				t.Errorf("expected reversed weighted jaccard %v, got %v", tt.wantWeighted, got)
+			}
+		})
+	}
+}
+
+func TestTopShared(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b map[string]int64
+		n    int
+		want []string
+	}{
+		{
+			name: "nothing shared",
+			a:    map[string]int64{"x": 3},
+			b:    map[string]int64{"y": 3},
+			n:    10,
+			want: []string{},
+		},
+		{
+			name: "ordered by fewest plays of either user",
+			a:    map[string]int64{"x": 10, "y": 3, "z": 2},
+			b:    map[string]int64{"x": 1, "y": 3, "z": 2},
+			n:    10,
+			want: []string{"y", "z", "x"},
+		},
+		{
+			name: "ties broken by total plays",
+			a:    map[string]int64{"x": 2, "y": 5},
+			b:    map[string]int64{"x": 2, "y": 2},
+			n:    10,
+			want: []string{"y", "x"},
+		},
+		{
+			name: "ties broken by key",
+			a:    map[string]int64{"y": 2, "x": 2},
+			b:    map[string]int64{"y": 2, "x": 2},
+			n:    10,
+			want: []string{"x", "y"},
+		},
+		{
+			name: "unshared keys are left out",
+			a:    map[string]int64{"x": 1, "only-a": 50},
+			b:    map[string]int64{"x": 1, "only-b": 50},
+			n:    10,
+			want: []string{"x"},
+		},
+		{
+			name: "limited to n",
+			a:    map[string]int64{"x": 3, "y": 2, "z": 1},
+			b:    map[string]int64{"x": 3, "y": 2, "z": 1},
+			n:    2,
+			want: []string{"x", "y"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := topShared(tt.a, tt.b, tt.n)
+			if !equalStrings(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestComputeCompatibility(t *testing.T) {
+	tests := []struct {
+		name                string
+		aTracks, bTracks    map[string]int64
+		aArtists, bArtists  map[string]int64
+		want                compatibilityCounts
+		wantScore           float64
+		wantSharedISRCs     []string
+		wantSharedArtistIDs []string
+	}{
+		{
+			name:                "no listening",
+			wantSharedISRCs:     []string{},
+			wantSharedArtistIDs: []string{},
+		},
+		{
+			name:     "identical listening",
+			aTracks:  map[string]int64{"t1": 2, "t2": 1},
+			bTracks:  map[string]int64{"t1": 2, "t2": 1},
+			aArtists: map[string]int64{"a1": 3},
+			bArtists: map[string]int64{"a1": 3},
+			want: compatibilityCounts{
+				sharedTracks: 2, unionTracks: 2,
+				sharedArtists: 1, unionArtists: 1,
+				minPlays: 3, maxPlays: 3,
+			},
+			wantScore:           100,
+			wantSharedISRCs:     []string{"t1", "t2"},
+			wantSharedArtistIDs: []string{"a1"},
+		},
+		{
+			name:     "nothing in common",
+			aTracks:  map[string]int64{"t1": 2},
+			bTracks:  map[string]int64{"t2": 2},
+			aArtists: map[string]int64{"a1": 2},
+			bArtists: map[string]int64{"a2": 2},
+			want: compatibilityCounts{
+				sharedTracks: 0, unionTracks: 2,
+				sharedArtists: 0, unionArtists: 2,
+				minPlays: 0, maxPlays: 4,
+			},
+			wantScore:           0,
+			wantSharedISRCs:     []string{},
+			wantSharedArtistIDs: []string{},
+		},
+		{
+			name:     "some in common",
+			aTracks:  map[string]int64{"t1": 4, "t2": 1},
+			bTracks:  map[string]int64{"t1": 2, "t3": 2},
+			aArtists: map[string]int64{"a1": 5},
+			bArtists: map[string]int64{"a1": 2, "a2": 2},
+			want: compatibilityCounts{
+				sharedTracks: 1, unionTracks: 3,
+				sharedArtists: 1, unionArtists: 2,
+				minPlays: 2, maxPlays: 7,
+			},
+			// 0.35*sqrt(1/3) + 0.35*sqrt(1/2) + 0.3*sqrt(2/7)
+			wantScore:           61,
+			wantSharedISRCs:     []string{"t1"},
+			wantSharedArtistIDs: []string{"a1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := computeCompatibility(tt.aTracks, tt.bTracks, tt.aArtists, tt.bArtists)
+			if got.compatibilityCounts != tt.want {
+				t.Errorf("expected counts %+v, got %+v", tt.want, got.compatibilityCounts)
+			}
+			if got.score != tt.wantScore {
+				t.Errorf("expected score %v, got %v", tt.wantScore, got.score)
+			}
+			// The score of a cached row is recomputed from its counts alone,
+			// so the two must agree.
+			if score := compatibilityScore(got.compatibilityCounts); score != got.score {
+				t.Errorf("expected score from counts %v, got %v", got.score, score)
+			}
+			if !equalStrings(got.sharedISRCs, tt.wantSharedISRCs) {
+				t.Errorf("expected shared tracks %v, got %v", tt.wantSharedISRCs, got.sharedISRCs)
+			}
+			if !equalStrings(got.sharedArtistIDs, tt.wantSharedArtistIDs) {
+				t.Errorf("expected shared artists %v, got %v", tt.wantSharedArtistIDs, got.sharedArtistIDs)
+			}
+		})
+	}
+}
+
+// equalStrings returns whether a and b hold the same strings in the same
+// order.
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -42,12 +42,393 @@ func (q *Queries) CreateListen(ctx context.Context, arg CreateListenParams) erro
 	return err
 }
 
-const listListensForUser = `-- name: ListListensForUser :many
-SELECT id, user_id, created_at, listened_at, isrc, source FROM listens WHERE user_id = $1
+const deleteListen = `-- name: DeleteListen :exec
+DELETE FROM listens WHERE id = $1 AND user_id = $2
 `
 
-func (q *Queries) ListListensForUser(ctx context.Context, userID string) ([]Listen, error) {
-	rows, err := q.db.Query(ctx, listListensForUser, userID)
+type DeleteListenParams struct {
+	ID     string
+	UserID string
+}
+
+func (q *Queries) DeleteListen(ctx context.Context, arg DeleteListenParams) error {
+	_, err := q.db.Exec(ctx, deleteListen, arg.ID, arg.UserID)
+	return err
+}
+
+const deleteListensForUserBetween = `-- name: DeleteListensForUserBetween :one
+WITH deleted AS (
+    DELETE FROM listens
+    WHERE user_id = $1
+    AND listened_at >= $2
+    AND listened_at < $3
+    RETURNING id, user_id, isrc, listened_at
+), edits AS (
+    INSERT INTO listen_edits (
+        id,
+        user_id,
+        listen_id,
+        action,
+        old_isrc,
+        old_listened_at,
+        created_at
+    )
+    SELECT
+        id,
+        user_id,
+        id,
+        $4,
+        isrc,
+        listened_at,
+        $5
+    FROM deleted
+)
+SELECT COUNT(*) FROM deleted
+`
+
+type DeleteListensForUserBetweenParams struct {
+	UserID    string
+	StartTime time.Time
+	EndTime   time.Time
+	Action    string
+	CreatedAt time.Time
+}
+
+func (q *Queries) DeleteListensForUserBetween(ctx context.Context, arg DeleteListensForUserBetweenParams) (int64, error) {
+	row := q.db.QueryRow(ctx, deleteListensForUserBetween,
+		arg.UserID,
+		arg.StartTime,
+		arg.EndTime,
+		arg.Action,
+		arg.CreatedAt,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getListen = `-- name: GetListen :one
+SELECT id, user_id, created_at, listened_at, isrc, source, hidden FROM listens WHERE id = $1
+`
+
+func (q *Queries) GetListen(ctx context.Context, id string) (Listen, error) {
+	row := q.db.QueryRow(ctx, getListen, id)
+	var i Listen
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.ListenedAt,
+		&i.Isrc,
+		&i.Source,
+		&i.Hidden,
+	)
+	return i, err
+}
+
+const getListenForUser = `-- name: GetListenForUser :one
+SELECT id, user_id, created_at, listened_at, isrc, source, hidden FROM listens WHERE id = $1 AND user_id = $2
+`
+
+type GetListenForUserParams struct {
+	ID     string
+	UserID string
+}
+
+func (q *Queries) GetListenForUser(ctx context.Context, arg GetListenForUserParams) (Listen, error) {
+	row := q.db.QueryRow(ctx, getListenForUser, arg.ID, arg.UserID)
+	var i Listen
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.ListenedAt,
+		&i.Isrc,
+		&i.Source,
+		&i.Hidden,
+	)
+	return i, err
+}
+
+const listLatestListens = `-- name: ListLatestListens :many
+SELECT DISTINCT ON (user_id) id, user_id, created_at, listened_at, isrc, source, hidden FROM listens
+WHERE user_id = ANY($1::CHAR(27)[])
+ORDER BY user_id, listened_at DESC, id DESC
+`
+
+func (q *Queries) ListLatestListens(ctx context.Context, userIds []string) ([]Listen, error) {
+	rows, err := q.db.Query(ctx, listLatestListens, userIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Listen
+	for rows.Next() {
+		var i Listen
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.ListenedAt,
+			&i.Isrc,
+			&i.Source,
+			&i.Hidden,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listListensCreatedSince = `-- name: ListListensCreatedSince :many
+SELECT id, user_id, created_at, listened_at, isrc, source, hidden FROM listens
+WHERE user_id = ANY($1::CHAR(27)[])
+AND (created_at, id) > ($2::TIMESTAMPTZ, $3::CHAR(27))
+ORDER BY created_at, id
+LIMIT $4
+`
+
+type ListListensCreatedSinceParams struct {
+	UserIds      []string
+	CreatedSince time.Time
+	AfterID      string
+	RowLimit     int32
+}
+
+func (q *Queries) ListListensCreatedSince(ctx context.Context, arg ListListensCreatedSinceParams) ([]Listen, error) {
+	rows, err := q.db.Query(ctx, listListensCreatedSince,
+		arg.UserIds,
+		arg.CreatedSince,
+		arg.AfterID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Listen
+	for rows.Next() {
+		var i Listen
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.ListenedAt,
+			&i.Isrc,
+			&i.Source,
+			&i.Hidden,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listListensForUserAfterID = `-- name: ListListensForUserAfterID :many
+SELECT id, user_id, created_at, listened_at, isrc, source, hidden FROM listens
+WHERE user_id = $1 AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListListensForUserAfterIDParams struct {
+	UserID string
+	ID     string
+	Limit  int32
+}
+
+func (q *Queries) ListListensForUserAfterID(ctx context.Context, arg ListListensForUserAfterIDParams) ([]Listen, error) {
+	rows, err := q.db.Query(ctx, listListensForUserAfterID, arg.UserID, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Listen
+	for rows.Next() {
+		var i Listen
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.ListenedAt,
+			&i.Isrc,
+			&i.Source,
+			&i.Hidden,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listListensPage = `-- name: ListListensPage :many
+SELECT listens.id, listens.user_id, listens.created_at, listens.listened_at, listens.isrc, listens.source, listens.hidden FROM listens
+WHERE listens.user_id = $1
+AND listens.listened_at >= $2::TIMESTAMPTZ
+AND listens.listened_at < $3::TIMESTAMPTZ
+AND ($4::TEXT = '' OR listens.source = $4)
+AND ($5::TEXT = '' OR listens.isrc = $5)
+AND (
+    $6::TEXT = ''
+    OR listens.isrc IN (
+        SELECT tracks.isrc FROM tracks
+        WHERE tracks.artist_ids @> ARRAY[$6]::VARCHAR[]
+    )
+)
+AND (listens.listened_at, listens.id) < (
+    $7::TIMESTAMPTZ, $8::CHAR(27)
+)
+ORDER BY listens.listened_at DESC, listens.id DESC
+LIMIT $9
+`
+
+type ListListensPageParams struct {
+	UserID           string
+	StartTime        time.Time
+	EndTime          time.Time
+	Source           string
+	Isrc             string
+	ArtistID         string
+	BeforeListenedAt time.Time
+	BeforeID         string
+	RowLimit         int32
+}
+
+func (q *Queries) ListListensPage(ctx context.Context, arg ListListensPageParams) ([]Listen, error) {
+	rows, err := q.db.Query(ctx, listListensPage,
+		arg.UserID,
+		arg.StartTime,
+		arg.EndTime,
+		arg.Source,
+		arg.Isrc,
+		arg.ArtistID,
+		arg.BeforeListenedAt,
+		arg.BeforeID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Listen
+	for rows.Next() {
+		var i Listen
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.ListenedAt,
+			&i.Isrc,
+			&i.Source,
+			&i.Hidden,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listListensPageAscending = `-- name: ListListensPageAscending :many
+SELECT listens.id, listens.user_id, listens.created_at, listens.listened_at, listens.isrc, listens.source, listens.hidden FROM listens
+WHERE listens.user_id = $1
+AND listens.listened_at >= $2::TIMESTAMPTZ
+AND listens.listened_at < $3::TIMESTAMPTZ
+AND ($4::TEXT = '' OR listens.source = $4)
+AND ($5::TEXT = '' OR listens.isrc = $5)
+AND (
+    $6::TEXT = ''
+    OR listens.isrc IN (
+        SELECT tracks.isrc FROM tracks
+        WHERE tracks.artist_ids @> ARRAY[$6]::VARCHAR[]
+    )
+)
+AND (listens.listened_at, listens.id) > (
+    $7::TIMESTAMPTZ, $8::CHAR(27)
+)
+ORDER BY listens.listened_at, listens.id
+LIMIT $9
+`
+
+type ListListensPageAscendingParams struct {
+	UserID          string
+	StartTime       time.Time
+	EndTime         time.Time
+	Source          string
+	Isrc            string
+	ArtistID        string
+	AfterListenedAt time.Time
+	AfterID         string
+	RowLimit        int32
+}
+
+func (q *Queries) ListListensPageAscending(ctx context.Context, arg ListListensPageAscendingParams) ([]Listen, error) {
+	rows, err := q.db.Query(ctx, listListensPageAscending,
+		arg.UserID,
+		arg.StartTime,
+		arg.EndTime,
+		arg.Source,
+		arg.Isrc,
+		arg.ArtistID,
+		arg.AfterListenedAt,
+		arg.AfterID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Listen
+	for rows.Next() {
+		var i Listen
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.ListenedAt,
+			&i.Isrc,
+			&i.Source,
+			&i.Hidden,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRecentListensForUser = `-- name: ListRecentListensForUser :many
+SELECT id, user_id, created_at, listened_at, isrc, source, hidden FROM listens
+WHERE user_id = $1
+ORDER BY listened_at DESC, id DESC
+LIMIT $2
+`
+
+type ListRecentListensForUserParams struct {
+	UserID string
+	Limit  int32
+}
+
+func (q *Queries) ListRecentListensForUser(ctx context.Context, arg ListRecentListensForUserParams) ([]Listen, error) {
+	rows, err := q.db.Query(ctx, listRecentListensForUser, arg.UserID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -62,6 +443,7 @@ func (q *Queries) ListListensForUser(ctx context.Context, userID string) ([]List
 			&i.ListenedAt,
 			&i.Isrc,
 			&i.Source,
+			&i.Hidden,
 		); err != nil {
 			return nil, err
 		}
@@ -72,3 +454,128 @@ func (q *Queries) ListListensForUser(ctx context.Context, userID string) ([]List
 	}
 	return items, nil
 }
+
+const listRecentListensOfTrackByMoots = `-- name: ListRecentListensOfTrackByMoots :many
+SELECT listens.id, listens.user_id, listens.created_at, listens.listened_at, listens.isrc, listens.source, listens.hidden FROM listens
+INNER JOIN moots ON moots.moot_id = listens.user_id
+WHERE moots.user_id = $1
+AND listens.isrc = $2
+AND listens.listened_at >= $3
+`
+
+type ListRecentListensOfTrackByMootsParams struct {
+	UserID        string
+	Isrc          string
+	ListenedSince time.Time
+}
+
+func (q *Queries) ListRecentListensOfTrackByMoots(ctx context.Context, arg ListRecentListensOfTrackByMootsParams) ([]Listen, error) {
+	rows, err := q.db.Query(ctx, listRecentListensOfTrackByMoots, arg.UserID, arg.Isrc, arg.ListenedSince)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Listen
+	for rows.Next() {
+		var i Listen
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.ListenedAt,
+			&i.Isrc,
+			&i.Source,
+			&i.Hidden,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTopISRCsForUser = `-- name: ListTopISRCsForUser :many
+SELECT isrc, COUNT(*) AS plays FROM listens
+WHERE user_id = $1
+AND listened_at >= $2
+AND listened_at < $3
+GROUP BY isrc
+ORDER BY plays DESC, isrc
+LIMIT $4
+`
+
+type ListTopISRCsForUserParams struct {
+	UserID    string
+	StartTime time.Time
+	EndTime   time.Time
+	RowLimit  int32
+}
+
+type ListTopISRCsForUserRow struct {
+	Isrc  string
+	Plays int64
+}
+
+func (q *Queries) ListTopISRCsForUser(ctx context.Context, arg ListTopISRCsForUserParams) ([]ListTopISRCsForUserRow, error) {
+	rows, err := q.db.Query(ctx, listTopISRCsForUser,
+		arg.UserID,
+		arg.StartTime,
+		arg.EndTime,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTopISRCsForUserRow
+	for rows.Next() {
+		var i ListTopISRCsForUserRow
+		if err := rows.Scan(&i.Isrc, &i.Plays); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setListenHidden = `-- name: SetListenHidden :exec
+UPDATE listens SET hidden = $1 WHERE id = $2 AND user_id = $3
+`
+
+type SetListenHiddenParams struct {
+	Hidden bool
+	ID     string
+	UserID string
+}
+
+func (q *Queries) SetListenHidden(ctx context.Context, arg SetListenHiddenParams) error {
+	_, err := q.db.Exec(ctx, setListenHidden, arg.Hidden, arg.ID, arg.UserID)
+	return err
+}
+
+const updateListen = `-- name: UpdateListen :exec
+UPDATE listens SET isrc = $1, listened_at = $2 WHERE id = $3 AND user_id = $4
+`
+
+type UpdateListenParams struct {
+	Isrc       string
+	ListenedAt time.Time
+	ID         string
+	UserID     string
+}
+
+func (q *Queries) UpdateListen(ctx context.Context, arg UpdateListenParams) error {
+	_, err := q.db.Exec(ctx, updateListen,
+		arg.Isrc,
+		arg.ListenedAt,
+		arg.ID,
+		arg.UserID,
+	)
+	return err
+}
@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+
+	"github.com/mootslive/mono/backend/trace"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// LatestMigrationVersion returns the version of the newest migration, which
+// the database should have been migrated to.
+func LatestMigrationVersion() (uint, error) {
+	names, err := fs.Glob(migrations, "migrations/*.up.sql")
+	if err != nil {
+		return 0, fmt.Errorf("listing migrations: %w", err)
+	}
+	var latest uint
+	for _, name := range names {
+		// Migrations are named like 000001_create_initial_tables.up.sql.
+		prefix, _, _ := strings.Cut(strings.TrimPrefix(name, "migrations/"), "_")
+		version, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parsing version of %q: %w", name, err)
+		}
+		if uint(version) > latest {
+			latest = uint(version)
+		}
+	}
+	return latest, nil
+}
+
+// schema_migrations is created by golang-migrate rather than by one of our
+// migrations, so sqlc doesn't know about it and this query is written by hand.
+const getSchemaMigration = `-- name: GetSchemaMigration :one
+SELECT version, dirty FROM schema_migrations LIMIT 1
+`
+
+// SchemaMigration is the version the database has been migrated to. Dirty is
+// set when a migration failed part way through.
+type SchemaMigration struct {
+	Version int64
+	Dirty   bool
+}
+
+func (q *Queries) GetSchemaMigration(ctx context.Context) (SchemaMigration, error) {
+	row := q.db.QueryRow(ctx, getSchemaMigration)
+	var i SchemaMigration
+	err := row.Scan(&i.Version, &i.Dirty)
+	return i, err
+}
+
+func (q *queriesWrapper) GetSchemaMigration(ctx context.Context) (SchemaMigration, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.GetSchemaMigration")
+	defer span.End()
+	return q.queries.GetSchemaMigration(ctx)
+}
@@ -0,0 +1,2 @@
+DROP TABLE tweets;
+DROP TABLE twitter_sharing_settings;
@@ -0,0 +1,22 @@
+CREATE TABLE twitter_sharing_settings (
+    user_id CHAR(27) PRIMARY KEY REFERENCES users ON DELETE CASCADE,
+    listen_sharing_enabled BOOLEAN NOT NULL,
+    weekly_recap_enabled BOOLEAN NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE tweets (
+    id CHAR(27) PRIMARY KEY,
+    user_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    twitter_user_id VARCHAR(32) NOT NULL,
+    kind VARCHAR(32) NOT NULL,
+    -- dedupe_key identifies the content of the tweet within its kind, e.g the
+    -- listen ID or the recap week, and prevents it being posted twice.
+    dedupe_key VARCHAR(64) NOT NULL,
+    text TEXT NOT NULL,
+    -- tweet_id and posted_at are populated once Twitter accepts the tweet.
+    tweet_id VARCHAR(32),
+    posted_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (user_id, kind, dedupe_key)
+);
@@ -0,0 +1,2 @@
+DROP TABLE twitter_follow_syncs;
+DROP TABLE twitter_follows;
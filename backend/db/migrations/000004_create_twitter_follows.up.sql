@@ -0,0 +1,24 @@
+CREATE TABLE twitter_follows (
+    follower_id VARCHAR(32) NOT NULL,
+    followee_id VARCHAR(32) NOT NULL,
+    synced_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (follower_id, followee_id)
+);
+
+CREATE INDEX twitter_follows_followee_id_idx ON twitter_follows (followee_id);
+
+-- twitter_follow_syncs tracks the progress of paging through the following
+-- or followers of a linked Twitter account, so that a sync interrupted by
+-- rate limits can resume where it left off.
+CREATE TABLE twitter_follow_syncs (
+    twitter_user_id VARCHAR(32) NOT NULL REFERENCES twitter_accounts ON DELETE CASCADE,
+    direction VARCHAR(16) NOT NULL,
+    started_at TIMESTAMPTZ,
+    pagination_token VARCHAR(256),
+    completed_at TIMESTAMPTZ,
+    PRIMARY KEY (twitter_user_id, direction)
+);
+
+INSERT INTO twitter_follow_syncs (twitter_user_id, direction)
+SELECT twitter_user_id, direction FROM twitter_accounts
+CROSS JOIN (VALUES ('following'), ('followers')) AS directions (direction);
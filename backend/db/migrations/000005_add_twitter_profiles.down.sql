@@ -0,0 +1,5 @@
+ALTER TABLE twitter_accounts
+    DROP COLUMN name,
+    DROP COLUMN username,
+    DROP COLUMN profile_image_url,
+    DROP COLUMN profile_updated_at;
@@ -0,0 +1,5 @@
+ALTER TABLE twitter_accounts
+    ADD COLUMN name VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN username VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN profile_image_url TEXT NOT NULL DEFAULT '',
+    ADD COLUMN profile_updated_at TIMESTAMPTZ;
@@ -0,0 +1,3 @@
+DROP VIEW moots;
+DROP TABLE follow_settings;
+DROP TABLE follows;
@@ -0,0 +1,39 @@
+CREATE TABLE follows (
+    follower_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    followee_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    -- status is 'pending' until the followee approves the follow request, or
+    -- 'accepted' straight away if they don't require approval.
+    status VARCHAR(16) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    accepted_at TIMESTAMPTZ,
+    PRIMARY KEY (follower_id, followee_id)
+);
+
+CREATE INDEX follows_followee_id_status_idx ON follows (followee_id, status);
+
+CREATE TABLE follow_settings (
+    user_id CHAR(27) PRIMARY KEY REFERENCES users ON DELETE CASCADE,
+    require_approval BOOLEAN NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+-- moots pairs each user with the users they are mutuals with, either through
+-- their linked Twitter accounts or by following each other on mootslive.
+CREATE VIEW moots AS
+SELECT mine.user_id, theirs.user_id AS moot_id
+FROM twitter_accounts AS mine
+INNER JOIN twitter_follows AS outgoing
+    ON outgoing.follower_id = mine.twitter_user_id
+INNER JOIN twitter_follows AS incoming
+    ON incoming.follower_id = outgoing.followee_id
+    AND incoming.followee_id = mine.twitter_user_id
+INNER JOIN twitter_accounts AS theirs
+    ON theirs.twitter_user_id = outgoing.followee_id
+WHERE theirs.user_id != mine.user_id
+UNION
+SELECT outgoing.follower_id AS user_id, outgoing.followee_id AS moot_id
+FROM follows AS outgoing
+INNER JOIN follows AS incoming
+    ON incoming.follower_id = outgoing.followee_id
+    AND incoming.followee_id = outgoing.follower_id
+WHERE outgoing.status = 'accepted' AND incoming.status = 'accepted';
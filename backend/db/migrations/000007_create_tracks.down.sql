@@ -0,0 +1,2 @@
+DROP INDEX listens_user_id_listened_at_id_idx;
+DROP TABLE tracks;
@@ -0,0 +1,19 @@
+-- tracks caches the metadata of tracks that have been listened to, keyed by
+-- the ISRC that listens record.
+CREATE TABLE tracks (
+    isrc CHAR(12) PRIMARY KEY,
+    spotify_track_id VARCHAR(64) NOT NULL,
+    name TEXT NOT NULL,
+    artist_ids VARCHAR(64)[] NOT NULL,
+    artist_names TEXT[] NOT NULL,
+    album_id VARCHAR(64) NOT NULL,
+    album_name TEXT NOT NULL,
+    album_image_url TEXT NOT NULL,
+    duration_ms INTEGER NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+-- Supports fetching the most recent listens of a user, e.g when building the
+-- feed of everyone they follow.
+CREATE INDEX listens_user_id_listened_at_id_idx
+    ON listens (user_id, listened_at DESC, id DESC);
@@ -0,0 +1 @@
+DROP INDEX listens_user_id_created_at_idx;
@@ -0,0 +1,3 @@
+-- Supports finding the listens recorded for a set of users since a point in
+-- time, which is how live updates catch up after a reconnect.
+CREATE INDEX listens_user_id_created_at_idx ON listens (user_id, created_at);
@@ -0,0 +1,2 @@
+DROP TABLE compatibility_scores;
+DROP TABLE daily_track_plays;
@@ -0,0 +1,34 @@
+-- daily_track_plays counts the plays of each track by each user per UTC day.
+-- It's kept up to date as listens are recorded, so that aggregating listens
+-- over long periods doesn't require scanning every listen.
+CREATE TABLE daily_track_plays (
+    user_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    day DATE NOT NULL,
+    isrc CHAR(12) NOT NULL,
+    plays INTEGER NOT NULL,
+    PRIMARY KEY (user_id, day, isrc)
+);
+
+INSERT INTO daily_track_plays (user_id, day, isrc, plays)
+SELECT user_id, (listened_at AT TIME ZONE 'UTC')::DATE, isrc, COUNT(*)
+FROM listens
+GROUP BY 1, 2, 3;
+
+-- compatibility_scores caches how similar the listening of two users is over
+-- a window. Each pair is stored once, with user_id < other_user_id.
+CREATE TABLE compatibility_scores (
+    user_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    other_user_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    time_window VARCHAR(16) NOT NULL,
+    score DOUBLE PRECISION NOT NULL,
+    shared_isrcs VARCHAR(12)[] NOT NULL,
+    shared_artist_ids VARCHAR(64)[] NOT NULL,
+    shared_artist_names TEXT[] NOT NULL,
+    -- stale is set when either user records new listens.
+    stale BOOLEAN NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, other_user_id, time_window)
+);
+
+CREATE INDEX compatibility_scores_other_user_id_idx
+    ON compatibility_scores (other_user_id);
@@ -0,0 +1,3 @@
+ALTER TABLE listens DROP COLUMN hidden;
+DROP TABLE incognito_windows;
+DROP TABLE privacy_settings;
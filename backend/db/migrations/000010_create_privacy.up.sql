@@ -0,0 +1,24 @@
+CREATE TABLE privacy_settings (
+    user_id CHAR(27) PRIMARY KEY REFERENCES users ON DELETE CASCADE,
+    -- visibility is who can see the user's listens: 'public', 'moots' or
+    -- 'private'.
+    visibility VARCHAR(16) NOT NULL,
+    -- hidden_sources are the sources of listens that are hidden from others.
+    hidden_sources VARCHAR(32)[] NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+-- incognito_windows are periods of time during which a user's listens are
+-- hidden from others.
+CREATE TABLE incognito_windows (
+    id CHAR(27) PRIMARY KEY,
+    user_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    CHECK (start_time < end_time)
+);
+
+CREATE INDEX incognito_windows_user_id_idx ON incognito_windows (user_id);
+
+ALTER TABLE listens ADD COLUMN hidden BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,3 @@
+DROP TABLE comment_flags;
+DROP TABLE comments;
+DROP TABLE reactions;
@@ -0,0 +1,29 @@
+CREATE TABLE reactions (
+    listen_id CHAR(27) NOT NULL REFERENCES listens ON DELETE CASCADE,
+    user_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    emoji VARCHAR(32) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (listen_id, user_id, emoji)
+);
+
+CREATE INDEX reactions_user_id_idx ON reactions (user_id);
+
+CREATE TABLE comments (
+    id CHAR(27) PRIMARY KEY,
+    listen_id CHAR(27) NOT NULL REFERENCES listens ON DELETE CASCADE,
+    user_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    text TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    -- flag_count is how many users have flagged the comment for moderation.
+    flag_count INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX comments_listen_id_id_idx ON comments (listen_id, id);
+CREATE INDEX comments_user_id_idx ON comments (user_id);
+
+CREATE TABLE comment_flags (
+    comment_id CHAR(27) NOT NULL REFERENCES comments ON DELETE CASCADE,
+    user_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (comment_id, user_id)
+);
@@ -0,0 +1,28 @@
+CREATE TABLE notifications (
+    id CHAR(27) PRIMARY KEY,
+    user_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    kind VARCHAR(32) NOT NULL,
+    -- target_id is what the notification is about, such as the ID of a
+    -- listen, or empty if it's about the user themselves.
+    target_id VARCHAR(64) NOT NULL,
+    -- actor_ids are the users who caused the notification, most recent
+    -- first. Unread notifications of the same kind and target are grouped
+    -- into one, with each new actor added to the front.
+    actor_ids CHAR(27)[] NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    read_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX notifications_unread_group_idx
+    ON notifications (user_id, kind, target_id) WHERE read_at IS NULL;
+CREATE INDEX notifications_user_id_updated_at_idx
+    ON notifications (user_id, updated_at DESC, id DESC);
+
+CREATE TABLE notification_preferences (
+    user_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    kind VARCHAR(32) NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, kind)
+);
@@ -0,0 +1,5 @@
+DROP INDEX users_handle_idx;
+ALTER TABLE users
+    DROP COLUMN handle,
+    DROP COLUMN display_name,
+    DROP COLUMN bio;
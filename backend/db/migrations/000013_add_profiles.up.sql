@@ -0,0 +1,23 @@
+-- handle is unique regardless of case, but is stored as the user typed it.
+ALTER TABLE users
+    ADD COLUMN handle VARCHAR(30),
+    ADD COLUMN display_name VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN bio VARCHAR(160) NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX users_handle_idx ON users (lower(handle));
+
+-- Existing users are given the username of the Twitter account they signed
+-- up with, where that's a valid handle. Where several users share a username
+-- differing only by case, the oldest user gets it.
+UPDATE users SET handle = candidates.username
+FROM (
+    SELECT DISTINCT ON (lower(firsts.username)) firsts.user_id, firsts.username
+    FROM (
+        SELECT DISTINCT ON (user_id) user_id, username, created_at
+        FROM twitter_accounts
+        ORDER BY user_id, created_at
+    ) AS firsts
+    WHERE firsts.username ~ '^[A-Za-z0-9_]{3,30}$'
+    ORDER BY lower(firsts.username), firsts.created_at
+) AS candidates
+WHERE users.id = candidates.user_id;
@@ -0,0 +1,20 @@
+CREATE OR REPLACE VIEW moots AS
+SELECT mine.user_id, theirs.user_id AS moot_id
+FROM twitter_accounts AS mine
+INNER JOIN twitter_follows AS outgoing
+    ON outgoing.follower_id = mine.twitter_user_id
+INNER JOIN twitter_follows AS incoming
+    ON incoming.follower_id = outgoing.followee_id
+    AND incoming.followee_id = mine.twitter_user_id
+INNER JOIN twitter_accounts AS theirs
+    ON theirs.twitter_user_id = outgoing.followee_id
+WHERE theirs.user_id != mine.user_id
+UNION
+SELECT outgoing.follower_id AS user_id, outgoing.followee_id AS moot_id
+FROM follows AS outgoing
+INNER JOIN follows AS incoming
+    ON incoming.follower_id = outgoing.followee_id
+    AND incoming.followee_id = outgoing.follower_id
+WHERE outgoing.status = 'accepted' AND incoming.status = 'accepted';
+DROP TABLE mutes;
+DROP TABLE blocks;
@@ -0,0 +1,46 @@
+-- blocks hide the blocker and the blocked user from each other entirely.
+CREATE TABLE blocks (
+    blocker_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    blocked_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (blocker_id, blocked_id)
+);
+
+CREATE INDEX blocks_blocked_id_idx ON blocks (blocked_id);
+
+-- mutes hide the muted user from the user's feed and notifications only.
+CREATE TABLE mutes (
+    user_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    muted_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, muted_id)
+);
+
+-- Users who've blocked one another aren't moots, even if they're still
+-- mutuals on Twitter.
+CREATE OR REPLACE VIEW moots AS
+SELECT all_moots.user_id, all_moots.moot_id
+FROM (
+    SELECT mine.user_id, theirs.user_id AS moot_id
+    FROM twitter_accounts AS mine
+    INNER JOIN twitter_follows AS outgoing
+        ON outgoing.follower_id = mine.twitter_user_id
+    INNER JOIN twitter_follows AS incoming
+        ON incoming.follower_id = outgoing.followee_id
+        AND incoming.followee_id = mine.twitter_user_id
+    INNER JOIN twitter_accounts AS theirs
+        ON theirs.twitter_user_id = outgoing.followee_id
+    WHERE theirs.user_id != mine.user_id
+    UNION
+    SELECT outgoing.follower_id AS user_id, outgoing.followee_id AS moot_id
+    FROM follows AS outgoing
+    INNER JOIN follows AS incoming
+        ON incoming.follower_id = outgoing.followee_id
+        AND incoming.followee_id = outgoing.follower_id
+    WHERE outgoing.status = 'accepted' AND incoming.status = 'accepted'
+) AS all_moots
+WHERE NOT EXISTS (
+    SELECT 1 FROM blocks
+    WHERE (blocks.blocker_id = all_moots.user_id AND blocks.blocked_id = all_moots.moot_id)
+    OR (blocks.blocker_id = all_moots.moot_id AND blocks.blocked_id = all_moots.user_id)
+);
@@ -0,0 +1,3 @@
+-- time_zone is the IANA name of the time zone the user's listening stats are
+-- computed in.
+ALTER TABLE users ADD COLUMN time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC';
@@ -0,0 +1,2 @@
+-- is_admin users can use the admin service. It's only set by hand.
+ALTER TABLE users ADD COLUMN is_admin BOOLEAN NOT NULL DEFAULT FALSE;
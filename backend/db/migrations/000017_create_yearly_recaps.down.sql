@@ -0,0 +1 @@
+DROP TABLE yearly_recaps;
@@ -0,0 +1,17 @@
+-- yearly_recaps are snapshots of a user's listening over a year. Each time a
+-- recap is regenerated a new version is added, and the latest is served, so a
+-- recap doesn't change as listens are imported late unless it's asked to.
+CREATE TABLE yearly_recaps (
+    user_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    year INTEGER NOT NULL,
+    version INTEGER NOT NULL,
+    -- format_version is the version of the code that generated the recap, so
+    -- that recaps generated by older code can be found.
+    format_version INTEGER NOT NULL,
+    -- time_zone is the time zone the year was taken to be in.
+    time_zone VARCHAR(64) NOT NULL,
+    -- data is the recap as a serialised mootslive.v1.YearlyRecap.
+    data BYTEA NOT NULL,
+    generated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, year, version)
+);
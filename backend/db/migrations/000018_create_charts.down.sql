@@ -0,0 +1,3 @@
+DROP INDEX daily_track_plays_day_idx;
+DROP TABLE chart_entries;
+DROP TABLE charts;
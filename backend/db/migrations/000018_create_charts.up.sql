@@ -0,0 +1,43 @@
+-- charts are snapshots of the most played tracks and artists over a day, week
+-- or month. Global charts have no user_id, while moots charts belong to the
+-- user whose moots they count.
+CREATE TABLE charts (
+    id CHAR(27) PRIMARY KEY,
+    -- scope is 'global' or 'moots'.
+    scope VARCHAR(16) NOT NULL,
+    user_id CHAR(27) REFERENCES users ON DELETE CASCADE,
+    -- period is 'daily', 'weekly' or 'monthly'.
+    period VARCHAR(16) NOT NULL,
+    -- start_day and end_day are the UTC days the chart covers. end_day is
+    -- excluded.
+    start_day DATE NOT NULL,
+    end_day DATE NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL,
+    CHECK ((scope = 'global') = (user_id IS NULL))
+);
+
+CREATE UNIQUE INDEX charts_scope_period_idx
+    ON charts (scope, COALESCE(user_id, ''), period, start_day);
+CREATE INDEX charts_user_id_idx ON charts (user_id);
+
+CREATE TABLE chart_entries (
+    chart_id CHAR(27) NOT NULL REFERENCES charts ON DELETE CASCADE,
+    -- kind is 'track' or 'artist'.
+    kind VARCHAR(16) NOT NULL,
+    rank INTEGER NOT NULL,
+    -- item_id is the ISRC of a track or the ID of an artist.
+    item_id VARCHAR(64) NOT NULL,
+    -- item_name is the name of an artist, as tracks are looked up by ISRC.
+    item_name TEXT NOT NULL,
+    plays BIGINT NOT NULL,
+    listeners INTEGER NOT NULL,
+    -- previous_rank is the rank of the item in the chart of the previous
+    -- period, or zero if it wasn't in it.
+    previous_rank INTEGER NOT NULL,
+    -- movement is 'up', 'down', 'none', 'new' or 're_entry'.
+    movement VARCHAR(16) NOT NULL,
+    PRIMARY KEY (chart_id, kind, rank)
+);
+
+-- Charts aggregate the plays of every user over a range of days.
+CREATE INDEX daily_track_plays_day_idx ON daily_track_plays (day);
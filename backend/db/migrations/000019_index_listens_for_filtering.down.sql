@@ -0,0 +1,2 @@
+DROP INDEX tracks_artist_ids_idx;
+DROP INDEX listens_user_id_isrc_listened_at_id_idx;
@@ -0,0 +1,6 @@
+-- Listing a user's listens is paged by (listened_at, id), which
+-- listens_user_id_listened_at_id_idx supports in either order. These support
+-- filtering the listing by track or artist.
+CREATE INDEX listens_user_id_isrc_listened_at_id_idx
+    ON listens (user_id, isrc, listened_at DESC, id DESC);
+CREATE INDEX tracks_artist_ids_idx ON tracks USING GIN (artist_ids);
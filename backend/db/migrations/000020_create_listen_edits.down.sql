@@ -0,0 +1 @@
+DROP TABLE listen_edits;
@@ -0,0 +1,20 @@
+-- listen_edits are the history of the changes users have made to their
+-- listens by hand. They're kept after the listen is deleted, so have no
+-- reference to it.
+CREATE TABLE listen_edits (
+    id CHAR(27) PRIMARY KEY,
+    user_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    listen_id CHAR(27) NOT NULL,
+    -- action is 'create', 'update' or 'delete'.
+    action VARCHAR(16) NOT NULL,
+    -- The old values are null for a listen being created, and the new
+    -- values are null for one being deleted.
+    old_isrc CHAR(12),
+    old_listened_at TIMESTAMPTZ,
+    new_isrc CHAR(12),
+    new_listened_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX listen_edits_user_id_created_at_id_idx
+    ON listen_edits (user_id, created_at DESC, id DESC);
@@ -0,0 +1,6 @@
+-- disabled_at is set while an admin has disabled the user, who is refused
+-- access and whose listens aren't polled.
+ALTER TABLE users ADD COLUMN disabled_at TIMESTAMPTZ;
+-- sessions_revoked_at is when the user's sessions were last revoked. Tokens
+-- issued before it are refused.
+ALTER TABLE users ADD COLUMN sessions_revoked_at TIMESTAMPTZ;
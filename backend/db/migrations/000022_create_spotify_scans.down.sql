@@ -0,0 +1,7 @@
+ALTER TABLE spotify_accounts
+    DROP COLUMN last_scan_error,
+    DROP COLUMN failure_streak,
+    DROP COLUMN last_succeeded_at,
+    DROP COLUMN last_scanned_at;
+
+DROP TABLE spotify_scans;
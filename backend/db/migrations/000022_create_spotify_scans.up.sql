@@ -0,0 +1,28 @@
+-- spotify_scans records each time the poller scans a Spotify account for new
+-- listens. They're kept for a week.
+CREATE TABLE spotify_scans (
+    id CHAR(27) PRIMARY KEY,
+    spotify_user_id VARCHAR(256) NOT NULL REFERENCES spotify_accounts ON DELETE CASCADE,
+    -- replica is the host name of the replica that ran the scan.
+    replica VARCHAR(256) NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ,
+    -- result is 'succeeded' or 'failed', and is NULL while the scan runs or if
+    -- it was interrupted.
+    result VARCHAR(16),
+    listens_found INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX spotify_scans_spotify_user_id_idx
+    ON spotify_scans (spotify_user_id, started_at DESC);
+CREATE INDEX spotify_scans_started_at_idx ON spotify_scans (started_at);
+
+-- The outcome of the latest scans are kept on the account too, as the scans
+-- themselves don't stay around.
+ALTER TABLE spotify_accounts
+    ADD COLUMN last_scanned_at TIMESTAMPTZ,
+    ADD COLUMN last_succeeded_at TIMESTAMPTZ,
+    -- failure_streak is how many scans in a row have failed.
+    ADD COLUMN failure_streak INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN last_scan_error TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,43 @@
+-- audit_events records security relevant events, such as logins and admin
+-- actions. Events have no foreign keys, as they must outlive the users they
+-- refer to, and may never be changed or removed.
+CREATE TABLE audit_events (
+    id CHAR(27) PRIMARY KEY,
+    kind VARCHAR(64) NOT NULL,
+    -- actor_user_id is the user who caused the event. It's NULL for events
+    -- caused by mootslive itself, e.g. the deletion of an account once its
+    -- grace period is up.
+    actor_user_id CHAR(27),
+    -- target_user_id is the user the event affected.
+    target_user_id CHAR(27),
+    -- provider and provider_user_id identify the linked account involved in
+    -- the event, if there was one.
+    provider VARCHAR(16) NOT NULL DEFAULT '',
+    provider_user_id VARCHAR(256) NOT NULL DEFAULT '',
+    -- ip_address and user_agent describe the client that made the request
+    -- that caused the event. They're empty for events that weren't caused by
+    -- a request.
+    ip_address VARCHAR(64) NOT NULL DEFAULT '',
+    user_agent VARCHAR(512) NOT NULL DEFAULT '',
+    trace_id VARCHAR(32) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX audit_events_created_at_idx ON audit_events (created_at DESC, id DESC);
+CREATE INDEX audit_events_actor_user_id_idx
+    ON audit_events (actor_user_id, created_at DESC, id DESC);
+CREATE INDEX audit_events_target_user_id_idx
+    ON audit_events (target_user_id, created_at DESC, id DESC);
+
+CREATE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
+CREATE TRIGGER audit_events_no_truncate
+    BEFORE TRUNCATE ON audit_events
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_events_append_only();
@@ -0,0 +1,3 @@
+DELETE FROM daily_track_plays WHERE public_plays = 0;
+UPDATE daily_track_plays SET plays = public_plays;
+ALTER TABLE daily_track_plays DROP COLUMN public_plays;
@@ -0,0 +1,28 @@
+-- daily_track_plays previously left out listens hidden from others, which hid
+-- them from the user themselves too. plays now counts every listen, and
+-- public_plays counts those that others can see.
+ALTER TABLE daily_track_plays ADD COLUMN public_plays INTEGER NOT NULL DEFAULT 0;
+
+DELETE FROM daily_track_plays;
+
+INSERT INTO daily_track_plays (user_id, day, isrc, plays, public_plays)
+SELECT
+    listens.user_id,
+    (listens.listened_at AT TIME ZONE 'UTC')::DATE,
+    listens.isrc,
+    COUNT(*),
+    COUNT(*) FILTER (
+        WHERE NOT listens.hidden
+        AND NOT listens.source = ANY(COALESCE(privacy_settings.hidden_sources, '{}'))
+        AND NOT EXISTS (
+            SELECT 1 FROM incognito_windows
+            WHERE incognito_windows.user_id = listens.user_id
+            AND listens.listened_at >= incognito_windows.start_time
+            AND listens.listened_at < incognito_windows.end_time
+        )
+    )
+FROM listens
+LEFT JOIN privacy_settings ON privacy_settings.user_id = listens.user_id
+GROUP BY 1, 2, 3;
+
+ALTER TABLE daily_track_plays ALTER COLUMN public_plays DROP DEFAULT;
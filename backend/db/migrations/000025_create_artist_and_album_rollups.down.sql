@@ -0,0 +1,4 @@
+DROP INDEX tracks_album_id_idx;
+DROP INDEX daily_track_plays_isrc_idx;
+DROP TABLE daily_album_plays;
+DROP TABLE daily_artist_plays;
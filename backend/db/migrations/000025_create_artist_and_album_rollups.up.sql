@@ -0,0 +1,50 @@
+-- daily_artist_plays and daily_album_plays count the plays of each artist and
+-- album by each user per UTC day, like daily_track_plays, so that a user's top
+-- artists and albums can be found without joining every play to its track.
+CREATE TABLE daily_artist_plays (
+    user_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    day DATE NOT NULL,
+    artist_id VARCHAR(64) NOT NULL,
+    plays INTEGER NOT NULL,
+    public_plays INTEGER NOT NULL,
+    PRIMARY KEY (user_id, day, artist_id)
+);
+
+CREATE TABLE daily_album_plays (
+    user_id CHAR(27) NOT NULL REFERENCES users ON DELETE CASCADE,
+    day DATE NOT NULL,
+    album_id VARCHAR(64) NOT NULL,
+    plays INTEGER NOT NULL,
+    public_plays INTEGER NOT NULL,
+    PRIMARY KEY (user_id, day, album_id)
+);
+
+INSERT INTO daily_artist_plays (user_id, day, artist_id, plays, public_plays)
+SELECT
+    daily_track_plays.user_id,
+    daily_track_plays.day,
+    artist.id,
+    SUM(daily_track_plays.plays),
+    SUM(daily_track_plays.public_plays)
+FROM daily_track_plays
+INNER JOIN tracks ON tracks.isrc = daily_track_plays.isrc
+CROSS JOIN LATERAL (SELECT DISTINCT unnest(tracks.artist_ids) AS id) AS artist
+GROUP BY 1, 2, 3;
+
+INSERT INTO daily_album_plays (user_id, day, album_id, plays, public_plays)
+SELECT
+    daily_track_plays.user_id,
+    daily_track_plays.day,
+    tracks.album_id,
+    SUM(daily_track_plays.plays),
+    SUM(daily_track_plays.public_plays)
+FROM daily_track_plays
+INNER JOIN tracks ON tracks.isrc = daily_track_plays.isrc
+GROUP BY 1, 2, 3;
+
+-- Supports moving the plays of a track between artists and albums when its
+-- metadata changes.
+CREATE INDEX daily_track_plays_isrc_idx ON daily_track_plays (isrc);
+
+-- Supports finding the name of an album.
+CREATE INDEX tracks_album_id_idx ON tracks (album_id);
@@ -0,0 +1,8 @@
+ALTER TABLE compatibility_scores
+    DROP COLUMN window_start,
+    DROP COLUMN shared_tracks,
+    DROP COLUMN union_tracks,
+    DROP COLUMN shared_artists,
+    DROP COLUMN union_artists,
+    DROP COLUMN min_plays,
+    DROP COLUMN max_plays;
@@ -0,0 +1,16 @@
+-- The parts a compatibility score is made up of are kept alongside it, so the
+-- score can be adjusted as listens are counted rather than recomputed.
+-- window_start is the first day the parts cover.
+ALTER TABLE compatibility_scores
+    ADD COLUMN window_start DATE NOT NULL DEFAULT '0001-01-01',
+    ADD COLUMN shared_tracks INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN union_tracks INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN shared_artists INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN union_artists INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN min_plays BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN max_plays BIGINT NOT NULL DEFAULT 0;
+
+-- Existing scores have none of their parts, so are recomputed when next read.
+-- From here on, stale is only set when the rollups of either user are
+-- rebuilt.
+UPDATE compatibility_scores SET stale = TRUE;
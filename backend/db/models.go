@@ -9,6 +9,127 @@ import (
 	"time"
 )
 
+type AuditEvent struct {
+	ID             string
+	Kind           string
+	ActorUserID    sql.NullString
+	TargetUserID   sql.NullString
+	Provider       string
+	ProviderUserID string
+	IpAddress      string
+	UserAgent      string
+	TraceID        string
+	CreatedAt      time.Time
+}
+
+type Block struct {
+	BlockerID string
+	BlockedID string
+	CreatedAt time.Time
+}
+
+type Chart struct {
+	ID         string
+	Scope      string
+	UserID     sql.NullString
+	Period     string
+	StartDay   time.Time
+	EndDay     time.Time
+	ComputedAt time.Time
+}
+
+type ChartEntry struct {
+	ChartID      string
+	Kind         string
+	Rank         int32
+	ItemID       string
+	ItemName     string
+	Plays        int64
+	Listeners    int32
+	PreviousRank int32
+	Movement     string
+}
+
+type Comment struct {
+	ID        string
+	ListenID  string
+	UserID    string
+	Text      string
+	CreatedAt time.Time
+	FlagCount int32
+}
+
+type CommentFlag struct {
+	CommentID string
+	UserID    string
+	CreatedAt time.Time
+}
+
+type CompatibilityScore struct {
+	UserID            string
+	OtherUserID       string
+	TimeWindow        string
+	Score             float64
+	SharedIsrcs       []string
+	SharedArtistIds   []string
+	SharedArtistNames []string
+	Stale             bool
+	ComputedAt        time.Time
+	WindowStart       time.Time
+	SharedTracks      int32
+	UnionTracks       int32
+	SharedArtists     int32
+	UnionArtists      int32
+	MinPlays          int64
+	MaxPlays          int64
+}
+
+type DailyAlbumPlay struct {
+	UserID      string
+	Day         time.Time
+	AlbumID     string
+	Plays       int32
+	PublicPlays int32
+}
+
+type DailyArtistPlay struct {
+	UserID      string
+	Day         time.Time
+	ArtistID    string
+	Plays       int32
+	PublicPlays int32
+}
+
+type DailyTrackPlay struct {
+	UserID      string
+	Day         time.Time
+	Isrc        string
+	Plays       int32
+	PublicPlays int32
+}
+
+type Follow struct {
+	FollowerID string
+	FolloweeID string
+	Status     string
+	CreatedAt  time.Time
+	AcceptedAt sql.NullTime
+}
+
+type FollowSetting struct {
+	UserID          string
+	RequireApproval bool
+	UpdatedAt       time.Time
+}
+
+type IncognitoWindow struct {
+	ID        string
+	UserID    string
+	StartTime time.Time
+	EndTime   time.Time
+	CreatedAt time.Time
+}
+
 type Listen struct {
 	ID         string
 	UserID     string
@@ -16,24 +137,163 @@ type Listen struct {
 	ListenedAt time.Time
 	Isrc       string
 	Source     string
+	Hidden     bool
+}
+
+type ListenEdit struct {
+	ID            string
+	UserID        string
+	ListenID      string
+	Action        string
+	OldIsrc       sql.NullString
+	OldListenedAt sql.NullTime
+	NewIsrc       sql.NullString
+	NewListenedAt sql.NullTime
+	CreatedAt     time.Time
+}
+
+type Moot struct {
+	UserID string
+	MootID string
+}
+
+type Mute struct {
+	UserID    string
+	MutedID   string
+	CreatedAt time.Time
+}
+
+type Notification struct {
+	ID        string
+	UserID    string
+	Kind      string
+	TargetID  string
+	ActorIds  []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	ReadAt    sql.NullTime
+}
+
+type NotificationPreference struct {
+	UserID    string
+	Kind      string
+	Enabled   bool
+	UpdatedAt time.Time
+}
+
+type PrivacySetting struct {
+	UserID        string
+	Visibility    string
+	HiddenSources []string
+	UpdatedAt     time.Time
+}
+
+type Reaction struct {
+	ListenID  string
+	UserID    string
+	Emoji     string
+	CreatedAt time.Time
 }
 
 type SpotifyAccount struct {
-	SpotifyUserID  string
-	UserID         string
-	OauthToken     OAuth2Token
-	LastListenedAt sql.NullTime
-	CreatedAt      time.Time
+	SpotifyUserID   string
+	UserID          string
+	OauthToken      OAuth2Token
+	LastListenedAt  sql.NullTime
+	CreatedAt       time.Time
+	LastScannedAt   sql.NullTime
+	LastSucceededAt sql.NullTime
+	FailureStreak   int32
+	LastScanError   string
 }
 
-type TwitterAccount struct {
-	TwitterUserID string
+type SpotifyScan struct {
+	ID            string
+	SpotifyUserID string
+	Replica       string
+	StartedAt     time.Time
+	FinishedAt    sql.NullTime
+	Result        sql.NullString
+	ListensFound  int32
+	Error         string
+}
+
+type Track struct {
+	Isrc           string
+	SpotifyTrackID string
+	Name           string
+	ArtistIds      []string
+	ArtistNames    []string
+	AlbumID        string
+	AlbumName      string
+	AlbumImageUrl  string
+	DurationMs     int32
+	UpdatedAt      time.Time
+}
+
+type Tweet struct {
+	ID            string
 	UserID        string
-	OauthToken    OAuth2Token
+	TwitterUserID string
+	Kind          string
+	DedupeKey     string
+	Text          string
+	TweetID       sql.NullString
+	PostedAt      sql.NullTime
 	CreatedAt     time.Time
 }
 
+type TwitterAccount struct {
+	TwitterUserID    string
+	UserID           string
+	OauthToken       OAuth2Token
+	CreatedAt        time.Time
+	Name             string
+	Username         string
+	ProfileImageUrl  string
+	ProfileUpdatedAt sql.NullTime
+}
+
+type TwitterFollow struct {
+	FollowerID string
+	FolloweeID string
+	SyncedAt   time.Time
+}
+
+type TwitterFollowSync struct {
+	TwitterUserID   string
+	Direction       string
+	StartedAt       sql.NullTime
+	PaginationToken sql.NullString
+	CompletedAt     sql.NullTime
+}
+
+type TwitterSharingSetting struct {
+	UserID               string
+	ListenSharingEnabled bool
+	WeeklyRecapEnabled   bool
+	UpdatedAt            time.Time
+}
+
 type User struct {
-	ID        string
-	CreatedAt time.Time
+	ID                  string
+	CreatedAt           time.Time
+	DeletionRequestedAt sql.NullTime
+	Handle              sql.NullString
+	DisplayName         string
+	Bio                 string
+	TimeZone            string
+	IsAdmin             bool
+	DisabledAt          sql.NullTime
+	SessionsRevokedAt   sql.NullTime
+}
+
+type YearlyRecap struct {
+	UserID        string
+	Year          int32
+	Version       int32
+	FormatVersion int32
+	TimeZone      string
+	Data          []byte
+	GeneratedAt   time.Time
 }
@@ -6,19 +6,188 @@ package db
 
 import (
 	"context"
+	"time"
 )
 
 type Querier interface {
+	AcceptAllFollowRequests(ctx context.Context, arg AcceptAllFollowRequestsParams) error
+	AcceptFollowRequest(ctx context.Context, arg AcceptFollowRequestParams) (int64, error)
+	AddArtistPlaysToCompatibilityScores(ctx context.Context, arg AddArtistPlaysToCompatibilityScoresParams) error
+	AddDailyAlbumPlays(ctx context.Context, arg AddDailyAlbumPlaysParams) error
+	AddDailyArtistPlays(ctx context.Context, arg AddDailyArtistPlaysParams) error
+	AddDailyTrackPlays(ctx context.Context, arg AddDailyTrackPlaysParams) error
+	AddTrackPlaysToCompatibilityScores(ctx context.Context, arg AddTrackPlaysToCompatibilityScoresParams) error
+	AddTrackToDailyAlbumPlays(ctx context.Context, arg AddTrackToDailyAlbumPlaysParams) error
+	AddTrackToDailyArtistPlays(ctx context.Context, arg AddTrackToDailyArtistPlaysParams) error
+	CompleteTwitterFollowSync(ctx context.Context, arg CompleteTwitterFollowSyncParams) error
+	CountNewTrackPlays(ctx context.Context, arg CountNewTrackPlaysParams) (int64, error)
+	CountReactionsByUserForListen(ctx context.Context, arg CountReactionsByUserForListenParams) (int64, error)
+	CountUnreadNotifications(ctx context.Context, userID string) (int64, error)
+	CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) error
+	CreateBlock(ctx context.Context, arg CreateBlockParams) (int64, error)
+	CreateChart(ctx context.Context, arg CreateChartParams) error
+	CreateChartEntry(ctx context.Context, arg CreateChartEntryParams) error
+	CreateComment(ctx context.Context, arg CreateCommentParams) error
+	CreateCommentFlag(ctx context.Context, arg CreateCommentFlagParams) (int64, error)
+	CreateFollow(ctx context.Context, arg CreateFollowParams) (int64, error)
+	CreateIncognitoWindow(ctx context.Context, arg CreateIncognitoWindowParams) error
 	CreateListen(ctx context.Context, arg CreateListenParams) error
+	CreateListenEdit(ctx context.Context, arg CreateListenEditParams) error
+	CreateMute(ctx context.Context, arg CreateMuteParams) (int64, error)
+	CreateReaction(ctx context.Context, arg CreateReactionParams) (int64, error)
 	CreateSpotifyAccount(ctx context.Context, arg CreateSpotifyAccountParams) error
+	CreateSpotifyScan(ctx context.Context, arg CreateSpotifyScanParams) error
+	CreateTweet(ctx context.Context, arg CreateTweetParams) (int64, error)
 	CreateTwitterAccount(ctx context.Context, arg CreateTwitterAccountParams) error
 	CreateUser(ctx context.Context, arg CreateUserParams) error
+	CreateYearlyRecap(ctx context.Context, arg CreateYearlyRecapParams) (YearlyRecap, error)
+	DeleteBlock(ctx context.Context, arg DeleteBlockParams) (int64, error)
+	DeleteComment(ctx context.Context, id string) error
+	DeleteDailyAlbumPlaysBetween(ctx context.Context, arg DeleteDailyAlbumPlaysBetweenParams) error
+	DeleteDailyArtistPlaysBetween(ctx context.Context, arg DeleteDailyArtistPlaysBetweenParams) error
+	DeleteDailyTrackPlaysBetween(ctx context.Context, arg DeleteDailyTrackPlaysBetweenParams) error
+	DeleteEmptyDailyAlbumPlays(ctx context.Context, arg DeleteEmptyDailyAlbumPlaysParams) error
+	DeleteEmptyDailyAlbumPlaysForTrack(ctx context.Context, arg DeleteEmptyDailyAlbumPlaysForTrackParams) error
+	DeleteEmptyDailyArtistPlays(ctx context.Context, arg DeleteEmptyDailyArtistPlaysParams) error
+	DeleteEmptyDailyArtistPlaysForTrack(ctx context.Context, arg DeleteEmptyDailyArtistPlaysForTrackParams) error
+	DeleteEmptyDailyTrackPlays(ctx context.Context, arg DeleteEmptyDailyTrackPlaysParams) error
+	DeleteFollow(ctx context.Context, arg DeleteFollowParams) (int64, error)
+	DeleteFollowRequest(ctx context.Context, arg DeleteFollowRequestParams) (int64, error)
+	DeleteIncognitoWindow(ctx context.Context, arg DeleteIncognitoWindowParams) (IncognitoWindow, error)
+	DeleteListen(ctx context.Context, arg DeleteListenParams) error
+	DeleteListensForUserBetween(ctx context.Context, arg DeleteListensForUserBetweenParams) (int64, error)
+	DeleteMute(ctx context.Context, arg DeleteMuteParams) (int64, error)
+	DeleteReaction(ctx context.Context, arg DeleteReactionParams) (int64, error)
+	DeleteSpotifyScansBefore(ctx context.Context, startedAt time.Time) error
+	DeleteStaleTwitterFollowers(ctx context.Context, arg DeleteStaleTwitterFollowersParams) error
+	DeleteStaleTwitterFollowing(ctx context.Context, arg DeleteStaleTwitterFollowingParams) error
+	DeleteTweet(ctx context.Context, id string) error
+	DeleteTwitterFollowsForAccount(ctx context.Context, followerID string) error
+	DeleteUser(ctx context.Context, id string) error
+	FinishSpotifyScan(ctx context.Context, arg FinishSpotifyScanParams) error
+	GetChart(ctx context.Context, arg GetChartParams) (Chart, error)
+	GetComment(ctx context.Context, id string) (Comment, error)
+	GetCompatibilityScore(ctx context.Context, arg GetCompatibilityScoreParams) (CompatibilityScore, error)
+	GetFollow(ctx context.Context, arg GetFollowParams) (Follow, error)
+	GetFollowSettings(ctx context.Context, userID string) (FollowSetting, error)
+	GetLatestChart(ctx context.Context, arg GetLatestChartParams) (Chart, error)
+	GetLatestYearlyRecap(ctx context.Context, arg GetLatestYearlyRecapParams) (YearlyRecap, error)
+	GetListen(ctx context.Context, id string) (Listen, error)
+	GetListenForUser(ctx context.Context, arg GetListenForUserParams) (Listen, error)
+	GetListeningTotals(ctx context.Context, arg GetListeningTotalsParams) (GetListeningTotalsRow, error)
+	GetPollerSummary(ctx context.Context, overdueBefore time.Time) (GetPollerSummaryRow, error)
+	GetPrivacySettings(ctx context.Context, userID string) (PrivacySetting, error)
+	GetSpotifyAccount(ctx context.Context, spotifyUserID string) (SpotifyAccount, error)
 	GetSpotifyAccountsForScanning(ctx context.Context) ([]SpotifyAccount, error)
+	GetTrack(ctx context.Context, isrc string) (Track, error)
 	GetTwitterAccount(ctx context.Context, twitterUserID string) (TwitterAccount, error)
+	GetTwitterFollowSync(ctx context.Context, arg GetTwitterFollowSyncParams) (TwitterFollowSync, error)
+	GetTwitterSharingSettings(ctx context.Context, userID string) (TwitterSharingSetting, error)
 	GetUser(ctx context.Context, id string) (User, error)
-	ListListensForUser(ctx context.Context, userID string) ([]Listen, error)
+	GetUserByHandle(ctx context.Context, handle string) (User, error)
+	IncrementCommentFlagCount(ctx context.Context, id string) error
+	InsertDailyAlbumPlaysBetween(ctx context.Context, arg InsertDailyAlbumPlaysBetweenParams) error
+	InsertDailyArtistPlaysBetween(ctx context.Context, arg InsertDailyArtistPlaysBetweenParams) error
+	InsertDailyTrackPlaysBetween(ctx context.Context, arg InsertDailyTrackPlaysBetweenParams) error
+	ListArtistNames(ctx context.Context, artistIds []string) ([]ListArtistNamesRow, error)
+	ListArtistPlaysForUser(ctx context.Context, arg ListArtistPlaysForUserParams) ([]ListArtistPlaysForUserRow, error)
+	ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error)
+	ListAuditEventsForUser(ctx context.Context, arg ListAuditEventsForUserParams) ([]AuditEvent, error)
+	ListBlockedUserIDs(ctx context.Context, userID string) ([]string, error)
+	ListBlocksForUser(ctx context.Context, blockerID string) ([]Block, error)
+	ListChartArtists(ctx context.Context, arg ListChartArtistsParams) ([]ListChartArtistsRow, error)
+	ListChartEntries(ctx context.Context, chartID string) ([]ChartEntry, error)
+	ListChartTracks(ctx context.Context, arg ListChartTracksParams) ([]ListChartTracksRow, error)
+	ListChartedItemIDs(ctx context.Context, arg ListChartedItemIDsParams) ([]string, error)
+	ListCommentsForListen(ctx context.Context, arg ListCommentsForListenParams) ([]Comment, error)
+	ListCommentsForUser(ctx context.Context, userID string) ([]Comment, error)
+	ListDailyPlays(ctx context.Context, arg ListDailyPlaysParams) ([]ListDailyPlaysRow, error)
+	ListFeedListens(ctx context.Context, arg ListFeedListensParams) ([]ListFeedListensRow, error)
+	ListFeedUserIDs(ctx context.Context, userID string) ([]string, error)
+	ListFollowRequests(ctx context.Context, followeeID string) ([]Follow, error)
+	ListFollowers(ctx context.Context, followeeID string) ([]Follow, error)
+	ListFollowing(ctx context.Context, followerID string) ([]Follow, error)
+	ListHourlyPlays(ctx context.Context, arg ListHourlyPlaysParams) ([]ListHourlyPlaysRow, error)
+	ListIncognitoWindowsForUser(ctx context.Context, userID string) ([]IncognitoWindow, error)
+	ListIncognitoWindowsForUsers(ctx context.Context, userIds []string) ([]IncognitoWindow, error)
+	ListKnownTrackISRCs(ctx context.Context, isrcs []string) ([]string, error)
+	ListLaggingSpotifyAccounts(ctx context.Context, limit int32) ([]SpotifyAccount, error)
+	ListLatestListens(ctx context.Context, userIds []string) ([]Listen, error)
+	ListListenEdits(ctx context.Context, arg ListListenEditsParams) ([]ListenEdit, error)
+	ListListenEditsForUser(ctx context.Context, userID string) ([]ListenEdit, error)
+	ListListeningDays(ctx context.Context, arg ListListeningDaysParams) ([]time.Time, error)
+	ListListensCreatedSince(ctx context.Context, arg ListListensCreatedSinceParams) ([]Listen, error)
+	ListListensForUserAfterID(ctx context.Context, arg ListListensForUserAfterIDParams) ([]Listen, error)
+	ListListensForUserBetween(ctx context.Context, arg ListListensForUserBetweenParams) ([]Listen, error)
+	ListListensPage(ctx context.Context, arg ListListensPageParams) ([]Listen, error)
+	ListListensPageAscending(ctx context.Context, arg ListListensPageAscendingParams) ([]Listen, error)
+	ListMootIDsForUser(ctx context.Context, userID string) ([]string, error)
+	ListMutedUserIDs(ctx context.Context, userID string) ([]string, error)
+	ListMutesForUser(ctx context.Context, userID string) ([]Mute, error)
+	ListNotificationPreferences(ctx context.Context, userID string) ([]NotificationPreference, error)
+	ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]Notification, error)
+	ListNotificationsUpdatedSince(ctx context.Context, arg ListNotificationsUpdatedSinceParams) ([]Notification, error)
+	ListPlaysForUsers(ctx context.Context, arg ListPlaysForUsersParams) ([]ListPlaysForUsersRow, error)
+	ListPollerReplicaThroughput(ctx context.Context, startedAt time.Time) ([]ListPollerReplicaThroughputRow, error)
+	ListPrivacySettings(ctx context.Context, userIds []string) ([]PrivacySetting, error)
+	ListReactionCounts(ctx context.Context, arg ListReactionCountsParams) ([]ListReactionCountsRow, error)
+	ListReactionsForUser(ctx context.Context, userID string) ([]Reaction, error)
+	ListRecentListensForUser(ctx context.Context, arg ListRecentListensForUserParams) ([]Listen, error)
+	ListRecentListensOfTrackByMoots(ctx context.Context, arg ListRecentListensOfTrackByMootsParams) ([]Listen, error)
+	ListSpotifyAccountsForUser(ctx context.Context, userID string) ([]SpotifyAccount, error)
+	ListSpotifyScans(ctx context.Context, arg ListSpotifyScansParams) ([]SpotifyScan, error)
+	ListTopAlbums(ctx context.Context, arg ListTopAlbumsParams) ([]ListTopAlbumsRow, error)
+	ListTopArtists(ctx context.Context, arg ListTopArtistsParams) ([]ListTopArtistsRow, error)
+	ListTopISRCsForUser(ctx context.Context, arg ListTopISRCsForUserParams) ([]ListTopISRCsForUserRow, error)
+	ListTopTracks(ctx context.Context, arg ListTopTracksParams) ([]ListTopTracksRow, error)
+	ListTrackPlaysForUser(ctx context.Context, arg ListTrackPlaysForUserParams) ([]ListTrackPlaysForUserRow, error)
+	ListTracks(ctx context.Context, isrcs []string) ([]Track, error)
+	ListTwitterAccountsDueProfileRefresh(ctx context.Context, updatedBefore time.Time) ([]TwitterAccount, error)
+	ListTwitterAccountsForUser(ctx context.Context, userID string) ([]TwitterAccount, error)
+	ListTwitterFollowSyncsDue(ctx context.Context, completedBefore time.Time) ([]TwitterFollowSync, error)
+	ListTwitterMootsForUser(ctx context.Context, userID string) ([]ListTwitterMootsForUserRow, error)
+	ListUserSummaries(ctx context.Context, userIds []string) ([]ListUserSummariesRow, error)
+	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	ListUsersDueForDeletion(ctx context.Context, requestedBefore time.Time) ([]User, error)
+	ListUsersDueMootsChart(ctx context.Context, arg ListUsersDueMootsChartParams) ([]string, error)
+	ListUsersDueWeeklyRecap(ctx context.Context, recapWeek string) ([]string, error)
+	ListUsersDueYearlyRecap(ctx context.Context, arg ListUsersDueYearlyRecapParams) ([]string, error)
+	ListUsersWithTrackPlays(ctx context.Context, isrc string) ([]ListUsersWithTrackPlaysRow, error)
+	LockAllRollups(ctx context.Context) error
+	LockAllRollupsShared(ctx context.Context) error
+	LockCompatibilityScoresForUser(ctx context.Context, userID string) error
+	LockReactionsByUserForListen(ctx context.Context, arg LockReactionsByUserForListenParams) error
+	LockRollupsForUser(ctx context.Context, userID string) error
+	MarkAllNotificationsRead(ctx context.Context, arg MarkAllNotificationsReadParams) error
+	MarkCompatibilityScoresStale(ctx context.Context, userID string) error
+	MarkCompatibilityScoresStaleForTrack(ctx context.Context, isrc string) error
+	MarkNotificationsRead(ctx context.Context, arg MarkNotificationsReadParams) error
+	NotifyEvent(ctx context.Context, payload string) error
+	RecordSpotifyAccountScanFailed(ctx context.Context, arg RecordSpotifyAccountScanFailedParams) error
+	RecordSpotifyAccountScanSucceeded(ctx context.Context, arg RecordSpotifyAccountScanSucceededParams) error
+	RequestTwitterFollowSync(ctx context.Context, arg RequestTwitterFollowSyncParams) error
 	SelectSpotifyAccountForUpdate(ctx context.Context, spotifyUserID string) (SpotifyAccount, error)
+	SelectTwitterFollowSyncForUpdate(ctx context.Context, arg SelectTwitterFollowSyncForUpdateParams) (TwitterFollowSync, error)
+	SelectUserForUpdate(ctx context.Context, id string) (User, error)
+	SetListenHidden(ctx context.Context, arg SetListenHiddenParams) error
+	SetTweetPosted(ctx context.Context, arg SetTweetPostedParams) error
+	SetUserDeletionRequestedAt(ctx context.Context, arg SetUserDeletionRequestedAtParams) error
+	SetUserDisabledAt(ctx context.Context, arg SetUserDisabledAtParams) error
+	SetUserSessionsRevokedAt(ctx context.Context, arg SetUserSessionsRevokedAtParams) error
+	UpdateListen(ctx context.Context, arg UpdateListenParams) error
 	UpdateSpotifyAccountListenedAt(ctx context.Context, arg UpdateSpotifyAccountListenedAtParams) error
+	UpdateTwitterAccountProfile(ctx context.Context, arg UpdateTwitterAccountProfileParams) error
+	UpdateTwitterAccountToken(ctx context.Context, arg UpdateTwitterAccountTokenParams) error
+	UpdateTwitterFollowSyncProgress(ctx context.Context, arg UpdateTwitterFollowSyncProgressParams) error
+	UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) error
+	UpsertCompatibilityScore(ctx context.Context, arg UpsertCompatibilityScoreParams) error
+	UpsertFollowSettings(ctx context.Context, arg UpsertFollowSettingsParams) error
+	UpsertNotification(ctx context.Context, arg UpsertNotificationParams) error
+	UpsertNotificationPreference(ctx context.Context, arg UpsertNotificationPreferenceParams) error
+	UpsertPrivacySettings(ctx context.Context, arg UpsertPrivacySettingsParams) error
+	UpsertTrack(ctx context.Context, arg UpsertTrackParams) error
+	UpsertTwitterFollows(ctx context.Context, arg UpsertTwitterFollowsParams) error
+	UpsertTwitterSharingSettings(ctx context.Context, arg UpsertTwitterSharingSettingsParams) error
 }
 
 var _ Querier = (*Queries)(nil)
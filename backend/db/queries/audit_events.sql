@@ -0,0 +1,44 @@
+-- name: CreateAuditEvent :exec
+INSERT INTO audit_events (
+    id,
+    kind,
+    actor_user_id,
+    target_user_id,
+    provider,
+    provider_user_id,
+    ip_address,
+    user_agent,
+    trace_id,
+    created_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: ListAuditEvents :many
+SELECT * FROM audit_events
+WHERE (
+    sqlc.arg(actor_user_id)::TEXT = ''
+    OR audit_events.actor_user_id = sqlc.arg(actor_user_id)
+)
+AND (
+    sqlc.arg(target_user_id)::TEXT = ''
+    OR audit_events.target_user_id = sqlc.arg(target_user_id)
+)
+AND (
+    cardinality(sqlc.arg(kinds)::TEXT[]) = 0
+    OR audit_events.kind = ANY(sqlc.arg(kinds)::TEXT[])
+)
+AND audit_events.created_at >= sqlc.arg(start_time)::TIMESTAMPTZ
+AND audit_events.created_at < sqlc.arg(end_time)::TIMESTAMPTZ
+AND (audit_events.created_at, audit_events.id) < (
+    sqlc.arg(before_created_at)::TIMESTAMPTZ, sqlc.arg(before_id)::CHAR(27)
+)
+ORDER BY audit_events.created_at DESC, audit_events.id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListAuditEventsForUser :many
+SELECT * FROM audit_events
+WHERE audit_events.target_user_id = sqlc.arg(user_id)
+AND (audit_events.created_at, audit_events.id) < (
+    sqlc.arg(before_created_at)::TIMESTAMPTZ, sqlc.arg(before_id)::CHAR(27)
+)
+ORDER BY audit_events.created_at DESC, audit_events.id DESC
+LIMIT sqlc.arg(row_limit);
@@ -0,0 +1,29 @@
+-- name: CreateBlock :execrows
+INSERT INTO blocks (blocker_id, blocked_id, created_at)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteBlock :execrows
+DELETE FROM blocks WHERE blocker_id = $1 AND blocked_id = $2;
+
+-- name: ListBlocksForUser :many
+SELECT * FROM blocks WHERE blocker_id = $1 ORDER BY created_at DESC;
+
+-- name: ListBlockedUserIDs :many
+SELECT blocked_id AS user_id FROM blocks WHERE blocker_id = sqlc.arg(user_id)
+UNION
+SELECT blocker_id AS user_id FROM blocks WHERE blocked_id = sqlc.arg(user_id);
+
+-- name: CreateMute :execrows
+INSERT INTO mutes (user_id, muted_id, created_at)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteMute :execrows
+DELETE FROM mutes WHERE user_id = $1 AND muted_id = $2;
+
+-- name: ListMutesForUser :many
+SELECT * FROM mutes WHERE user_id = $1 ORDER BY created_at DESC;
+
+-- name: ListMutedUserIDs :many
+SELECT muted_id FROM mutes WHERE user_id = $1;
@@ -0,0 +1,116 @@
+-- name: CreateChart :exec
+INSERT INTO charts (
+    id, scope, user_id, period, start_day, end_day, computed_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: CreateChartEntry :exec
+INSERT INTO chart_entries (
+    chart_id,
+    kind,
+    rank,
+    item_id,
+    item_name,
+    plays,
+    listeners,
+    previous_rank,
+    movement
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: GetChart :one
+SELECT * FROM charts
+WHERE scope = sqlc.arg(scope)
+AND user_id IS NOT DISTINCT FROM sqlc.narg(user_id)
+AND period = sqlc.arg(period)
+AND start_day = sqlc.arg(start_day);
+
+-- name: GetLatestChart :one
+SELECT * FROM charts
+WHERE scope = sqlc.arg(scope)
+AND user_id IS NOT DISTINCT FROM sqlc.narg(user_id)
+AND period = sqlc.arg(period)
+ORDER BY start_day DESC
+LIMIT 1;
+
+-- name: ListChartEntries :many
+SELECT * FROM chart_entries WHERE chart_id = $1 ORDER BY kind, rank;
+
+-- name: ListChartedItemIDs :many
+SELECT DISTINCT chart_entries.item_id FROM chart_entries
+INNER JOIN charts ON charts.id = chart_entries.chart_id
+WHERE charts.scope = sqlc.arg(scope)
+AND charts.user_id IS NOT DISTINCT FROM sqlc.narg(user_id)
+AND charts.period = sqlc.arg(period)
+AND charts.start_day < sqlc.arg(before_day)::DATE
+AND chart_entries.kind = sqlc.arg(kind);
+
+-- name: ListChartTracks :many
+SELECT
+    isrc,
+    SUM(public_plays)::BIGINT AS plays,
+    COUNT(DISTINCT user_id)::INTEGER AS listeners
+FROM daily_track_plays
+WHERE day >= sqlc.arg(start_day)::DATE
+AND day < sqlc.arg(end_day)::DATE
+AND public_plays > 0
+AND (
+    sqlc.narg(user_ids)::CHAR(27)[] IS NULL
+    OR user_id = ANY(sqlc.narg(user_ids)::CHAR(27)[])
+)
+AND NOT EXISTS (
+    SELECT 1 FROM privacy_settings
+    WHERE privacy_settings.user_id = daily_track_plays.user_id
+    AND privacy_settings.visibility <> 'public'
+)
+AND NOT EXISTS (
+    SELECT 1 FROM users
+    WHERE users.id = daily_track_plays.user_id
+    AND users.deletion_requested_at IS NOT NULL
+)
+GROUP BY isrc
+ORDER BY plays DESC, listeners DESC, isrc
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListChartArtists :many
+SELECT
+    artist.id::TEXT AS artist_id,
+    MAX(artist.name)::TEXT AS artist_name,
+    SUM(daily_track_plays.public_plays)::BIGINT AS plays,
+    COUNT(DISTINCT daily_track_plays.user_id)::INTEGER AS listeners
+FROM daily_track_plays
+INNER JOIN tracks ON tracks.isrc = daily_track_plays.isrc
+CROSS JOIN LATERAL unnest(tracks.artist_ids, tracks.artist_names) AS artist(id, name)
+WHERE daily_track_plays.day >= sqlc.arg(start_day)::DATE
+AND daily_track_plays.day < sqlc.arg(end_day)::DATE
+AND daily_track_plays.public_plays > 0
+AND (
+    sqlc.narg(user_ids)::CHAR(27)[] IS NULL
+    OR daily_track_plays.user_id = ANY(sqlc.narg(user_ids)::CHAR(27)[])
+)
+AND NOT EXISTS (
+    SELECT 1 FROM privacy_settings
+    WHERE privacy_settings.user_id = daily_track_plays.user_id
+    AND privacy_settings.visibility <> 'public'
+)
+AND NOT EXISTS (
+    SELECT 1 FROM users
+    WHERE users.id = daily_track_plays.user_id
+    AND users.deletion_requested_at IS NOT NULL
+)
+GROUP BY artist.id
+ORDER BY plays DESC, listeners DESC, artist.id
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListUsersDueMootsChart :many
+SELECT users.id FROM users
+WHERE users.deletion_requested_at IS NULL
+AND EXISTS (SELECT 1 FROM moots WHERE moots.user_id = users.id)
+AND NOT EXISTS (
+    SELECT 1 FROM charts
+    WHERE charts.scope = 'moots'
+    AND charts.user_id = users.id
+    AND charts.period = sqlc.arg(period)
+    AND charts.start_day = sqlc.arg(start_day)::DATE
+)
+AND users.id > sqlc.arg(after_id)
+ORDER BY users.id
+LIMIT sqlc.arg(row_limit);
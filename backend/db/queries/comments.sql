@@ -0,0 +1,36 @@
+-- name: CreateComment :exec
+INSERT INTO comments (
+    id,
+    listen_id,
+    user_id,
+    text,
+    created_at
+) VALUES ($1, $2, $3, $4, $5);
+
+-- name: GetComment :one
+SELECT * FROM comments WHERE id = $1;
+
+-- name: DeleteComment :exec
+DELETE FROM comments WHERE id = $1;
+
+-- name: ListCommentsForListen :many
+SELECT * FROM comments
+WHERE listen_id = sqlc.arg(listen_id)
+AND id > sqlc.arg(after_id)
+AND (flag_count < sqlc.arg(flag_threshold) OR user_id = sqlc.arg(viewer_id))
+ORDER BY id
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListCommentsForUser :many
+SELECT * FROM comments WHERE user_id = $1 ORDER BY id;
+
+-- name: CreateCommentFlag :execrows
+INSERT INTO comment_flags (
+    comment_id,
+    user_id,
+    created_at
+) VALUES ($1, $2, $3)
+ON CONFLICT (comment_id, user_id) DO NOTHING;
+
+-- name: IncrementCommentFlagCount :exec
+UPDATE comments SET flag_count = flag_count + 1 WHERE id = $1;
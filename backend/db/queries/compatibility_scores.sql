@@ -0,0 +1,165 @@
+-- name: GetCompatibilityScore :one
+SELECT * FROM compatibility_scores
+WHERE user_id = $1 AND other_user_id = $2 AND time_window = $3;
+
+-- name: UpsertCompatibilityScore :exec
+INSERT INTO compatibility_scores (
+    user_id,
+    other_user_id,
+    time_window,
+    score,
+    shared_isrcs,
+    shared_artist_ids,
+    shared_artist_names,
+    stale,
+    computed_at,
+    window_start,
+    shared_tracks,
+    union_tracks,
+    shared_artists,
+    union_artists,
+    min_plays,
+    max_plays
+) VALUES ($1, $2, $3, $4, $5, $6, $7, FALSE, $8, $9, $10, $11, $12, $13, $14, $15)
+ON CONFLICT (user_id, other_user_id, time_window) DO UPDATE SET
+    score = EXCLUDED.score,
+    shared_isrcs = EXCLUDED.shared_isrcs,
+    shared_artist_ids = EXCLUDED.shared_artist_ids,
+    shared_artist_names = EXCLUDED.shared_artist_names,
+    stale = FALSE,
+    computed_at = EXCLUDED.computed_at,
+    window_start = EXCLUDED.window_start,
+    shared_tracks = EXCLUDED.shared_tracks,
+    union_tracks = EXCLUDED.union_tracks,
+    shared_artists = EXCLUDED.shared_artists,
+    union_artists = EXCLUDED.union_artists,
+    min_plays = EXCLUDED.min_plays,
+    max_plays = EXCLUDED.max_plays;
+
+-- name: MarkCompatibilityScoresStale :exec
+UPDATE compatibility_scores SET stale = TRUE
+FROM (
+    SELECT user_id, other_user_id, time_window FROM compatibility_scores
+    WHERE (user_id = $1 OR other_user_id = $1) AND NOT stale
+    ORDER BY user_id, other_user_id, time_window
+    FOR UPDATE
+) AS locked
+WHERE compatibility_scores.user_id = locked.user_id
+AND compatibility_scores.other_user_id = locked.other_user_id
+AND compatibility_scores.time_window = locked.time_window;
+
+-- name: MarkCompatibilityScoresStaleForTrack :exec
+UPDATE compatibility_scores SET stale = TRUE
+FROM (
+    SELECT user_id, other_user_id, time_window FROM compatibility_scores
+    WHERE NOT stale AND EXISTS (
+        SELECT 1 FROM daily_track_plays
+        WHERE daily_track_plays.isrc = $1
+        AND daily_track_plays.user_id IN (
+            compatibility_scores.user_id, compatibility_scores.other_user_id
+        )
+    )
+    ORDER BY user_id, other_user_id, time_window
+    FOR UPDATE
+) AS locked
+WHERE compatibility_scores.user_id = locked.user_id
+AND compatibility_scores.other_user_id = locked.other_user_id
+AND compatibility_scores.time_window = locked.time_window;
+
+-- name: LockCompatibilityScoresForUser :exec
+SELECT 1 FROM compatibility_scores
+WHERE (user_id = $1 OR other_user_id = $1) AND NOT stale
+ORDER BY user_id, other_user_id, time_window
+FOR UPDATE;
+
+-- name: AddTrackPlaysToCompatibilityScores :exec
+UPDATE compatibility_scores
+SET
+    shared_tracks = compatibility_scores.shared_tracks
+        + CASE WHEN plays.b > 0 THEN plays.became ELSE 0 END,
+    union_tracks = compatibility_scores.union_tracks
+        + CASE WHEN plays.b > 0 THEN 0 ELSE plays.became END,
+    min_plays = compatibility_scores.min_plays
+        + LEAST(plays.a, plays.b) - LEAST(plays.a - sqlc.arg(delta)::INTEGER, plays.b),
+    max_plays = compatibility_scores.max_plays
+        + GREATEST(plays.a, plays.b) - GREATEST(plays.a - sqlc.arg(delta)::INTEGER, plays.b)
+FROM (
+    SELECT
+        scores.user_id,
+        scores.other_user_id,
+        scores.time_window,
+        a.plays AS a,
+        b.plays AS b,
+        (SIGN(a.plays) - SIGN(a.plays - sqlc.arg(delta)::INTEGER))::INTEGER AS became
+    FROM compatibility_scores AS scores
+    CROSS JOIN LATERAL (
+        SELECT COALESCE(SUM(public_plays), 0)::BIGINT AS plays FROM daily_track_plays
+        WHERE daily_track_plays.user_id = sqlc.arg(user_id)
+        AND daily_track_plays.isrc = sqlc.arg(isrc)
+        AND daily_track_plays.day >= scores.window_start
+    ) AS a
+    CROSS JOIN LATERAL (
+        SELECT COALESCE(SUM(public_plays), 0)::BIGINT AS plays FROM daily_track_plays
+        WHERE daily_track_plays.user_id = CASE
+            WHEN scores.user_id = sqlc.arg(user_id) THEN scores.other_user_id
+            ELSE scores.user_id
+        END
+        AND daily_track_plays.isrc = sqlc.arg(isrc)
+        AND daily_track_plays.day >= scores.window_start
+    ) AS b
+    WHERE (scores.user_id = sqlc.arg(user_id) OR scores.other_user_id = sqlc.arg(user_id))
+    AND NOT scores.stale
+    AND scores.window_start <= sqlc.arg(day)::DATE
+) AS plays
+WHERE compatibility_scores.user_id = plays.user_id
+AND compatibility_scores.other_user_id = plays.other_user_id
+AND compatibility_scores.time_window = plays.time_window;
+
+-- name: AddArtistPlaysToCompatibilityScores :exec
+UPDATE compatibility_scores
+SET
+    shared_artists = compatibility_scores.shared_artists + changes.shared,
+    union_artists = compatibility_scores.union_artists + changes.unioned
+FROM (
+    SELECT
+        scores.user_id,
+        scores.other_user_id,
+        scores.time_window,
+        SUM(
+            CASE WHEN b.plays > 0
+            THEN SIGN(a.plays) - SIGN(a.plays - sqlc.arg(delta)::INTEGER)
+            ELSE 0 END
+        )::INTEGER AS shared,
+        SUM(
+            CASE WHEN b.plays > 0
+            THEN 0
+            ELSE SIGN(a.plays) - SIGN(a.plays - sqlc.arg(delta)::INTEGER) END
+        )::INTEGER AS unioned
+    FROM compatibility_scores AS scores
+    CROSS JOIN (
+        SELECT DISTINCT unnest(tracks.artist_ids) AS id FROM tracks
+        WHERE tracks.isrc = sqlc.arg(isrc)
+    ) AS artist
+    CROSS JOIN LATERAL (
+        SELECT COALESCE(SUM(public_plays), 0)::BIGINT AS plays FROM daily_artist_plays
+        WHERE daily_artist_plays.user_id = sqlc.arg(user_id)
+        AND daily_artist_plays.artist_id = artist.id
+        AND daily_artist_plays.day >= scores.window_start
+    ) AS a
+    CROSS JOIN LATERAL (
+        SELECT COALESCE(SUM(public_plays), 0)::BIGINT AS plays FROM daily_artist_plays
+        WHERE daily_artist_plays.user_id = CASE
+            WHEN scores.user_id = sqlc.arg(user_id) THEN scores.other_user_id
+            ELSE scores.user_id
+        END
+        AND daily_artist_plays.artist_id = artist.id
+        AND daily_artist_plays.day >= scores.window_start
+    ) AS b
+    WHERE (scores.user_id = sqlc.arg(user_id) OR scores.other_user_id = sqlc.arg(user_id))
+    AND NOT scores.stale
+    AND scores.window_start <= sqlc.arg(day)::DATE
+    GROUP BY 1, 2, 3
+) AS changes
+WHERE compatibility_scores.user_id = changes.user_id
+AND compatibility_scores.other_user_id = changes.other_user_id
+AND compatibility_scores.time_window = changes.time_window;
@@ -0,0 +1,2 @@
+-- name: NotifyEvent :exec
+SELECT pg_notify('mootslive_events', sqlc.arg(payload)::TEXT);
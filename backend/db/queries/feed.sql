@@ -0,0 +1,35 @@
+-- name: ListFeedUserIDs :many
+SELECT follows.followee_id AS user_id FROM follows
+WHERE follows.follower_id = sqlc.arg(user_id) AND follows.status = 'accepted'
+UNION
+SELECT moots.moot_id AS user_id FROM moots
+WHERE moots.user_id = sqlc.arg(user_id);
+
+-- name: ListFeedListens :many
+SELECT feed.* FROM unnest(sqlc.arg(user_ids)::CHAR(27)[]) AS followed(user_id)
+CROSS JOIN LATERAL (
+    SELECT * FROM listens
+    WHERE listens.user_id = followed.user_id
+    AND (listens.listened_at, listens.id) < (
+        sqlc.arg(before_listened_at)::TIMESTAMPTZ, sqlc.arg(before_id)::CHAR(27)
+    )
+    ORDER BY listens.listened_at DESC, listens.id DESC
+    LIMIT sqlc.arg(row_limit)
+) AS feed
+ORDER BY feed.listened_at DESC, feed.id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListUserSummaries :many
+SELECT
+    users.id AS user_id,
+    COALESCE(users.handle, '')::TEXT AS handle,
+    COALESCE(NULLIF(users.display_name, ''), twitter.name, '')::TEXT AS display_name,
+    COALESCE(twitter.profile_image_url, '')::TEXT AS profile_image_url
+FROM users
+LEFT JOIN LATERAL (
+    SELECT name, profile_image_url FROM twitter_accounts
+    WHERE twitter_accounts.user_id = users.id
+    ORDER BY created_at
+    LIMIT 1
+) AS twitter ON TRUE
+WHERE users.id = ANY(sqlc.arg(user_ids)::CHAR(27)[]);
@@ -0,0 +1,58 @@
+-- name: CreateFollow :execrows
+INSERT INTO follows (
+    follower_id,
+    followee_id,
+    status,
+    created_at,
+    accepted_at
+) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (follower_id, followee_id) DO NOTHING;
+
+-- name: GetFollow :one
+SELECT * FROM follows WHERE follower_id = $1 AND followee_id = $2;
+
+-- name: DeleteFollow :execrows
+DELETE FROM follows WHERE follower_id = $1 AND followee_id = $2;
+
+-- name: AcceptFollowRequest :execrows
+UPDATE follows SET status = 'accepted', accepted_at = $1
+WHERE follower_id = $2 AND followee_id = $3 AND status = 'pending';
+
+-- name: AcceptAllFollowRequests :exec
+UPDATE follows SET status = 'accepted', accepted_at = $1
+WHERE followee_id = $2 AND status = 'pending';
+
+-- name: DeleteFollowRequest :execrows
+DELETE FROM follows
+WHERE follower_id = $1 AND followee_id = $2 AND status = 'pending';
+
+-- name: ListFollowers :many
+SELECT * FROM follows
+WHERE followee_id = $1 AND status = 'accepted'
+ORDER BY accepted_at DESC;
+
+-- name: ListFollowing :many
+SELECT * FROM follows
+WHERE follower_id = $1 AND status = 'accepted'
+ORDER BY accepted_at DESC;
+
+-- name: ListFollowRequests :many
+SELECT * FROM follows
+WHERE followee_id = $1 AND status = 'pending'
+ORDER BY created_at DESC;
+
+-- name: GetFollowSettings :one
+SELECT * FROM follow_settings WHERE user_id = $1;
+
+-- name: UpsertFollowSettings :exec
+INSERT INTO follow_settings (
+    user_id,
+    require_approval,
+    updated_at
+) VALUES ($1, $2, $3)
+ON CONFLICT (user_id) DO UPDATE SET
+    require_approval = EXCLUDED.require_approval,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: ListMootIDsForUser :many
+SELECT moot_id FROM moots WHERE user_id = $1;
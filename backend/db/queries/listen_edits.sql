@@ -0,0 +1,24 @@
+-- name: CreateListenEdit :exec
+INSERT INTO listen_edits (
+    id,
+    user_id,
+    listen_id,
+    action,
+    old_isrc,
+    old_listened_at,
+    new_isrc,
+    new_listened_at,
+    created_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: ListListenEdits :many
+SELECT * FROM listen_edits
+WHERE user_id = sqlc.arg(user_id)
+AND (created_at, id) < (
+    sqlc.arg(before_created_at)::TIMESTAMPTZ, sqlc.arg(before_id)::CHAR(27)
+)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListListenEditsForUser :many
+SELECT * FROM listen_edits WHERE user_id = $1 ORDER BY created_at, id;
@@ -8,5 +8,126 @@ INSERT INTO listens (
     listened_at
 ) VALUES ($1, $2, $3, $4, $5, $6);
 
--- name: ListListensForUser :many
-SELECT * FROM listens WHERE user_id = $1;
\ No newline at end of file
+-- name: ListListensForUserAfterID :many
+SELECT * FROM listens
+WHERE user_id = $1 AND id > $2
+ORDER BY id
+LIMIT $3;
+
+-- name: GetListen :one
+SELECT * FROM listens WHERE id = $1;
+
+-- name: GetListenForUser :one
+SELECT * FROM listens WHERE id = $1 AND user_id = $2;
+
+-- name: ListTopISRCsForUser :many
+SELECT isrc, COUNT(*) AS plays FROM listens
+WHERE user_id = sqlc.arg(user_id)
+AND listened_at >= sqlc.arg(start_time)
+AND listened_at < sqlc.arg(end_time)
+GROUP BY isrc
+ORDER BY plays DESC, isrc
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListListensCreatedSince :many
+SELECT * FROM listens
+WHERE user_id = ANY(sqlc.arg(user_ids)::CHAR(27)[])
+AND (created_at, id) > (sqlc.arg(created_since)::TIMESTAMPTZ, sqlc.arg(after_id)::CHAR(27))
+ORDER BY created_at, id
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListLatestListens :many
+SELECT DISTINCT ON (user_id) * FROM listens
+WHERE user_id = ANY(sqlc.arg(user_ids)::CHAR(27)[])
+ORDER BY user_id, listened_at DESC, id DESC;
+
+-- name: SetListenHidden :exec
+UPDATE listens SET hidden = $1 WHERE id = $2 AND user_id = $3;
+
+-- name: ListRecentListensOfTrackByMoots :many
+SELECT listens.* FROM listens
+INNER JOIN moots ON moots.moot_id = listens.user_id
+WHERE moots.user_id = sqlc.arg(user_id)
+AND listens.isrc = sqlc.arg(isrc)
+AND listens.listened_at >= sqlc.arg(listened_since);
+
+-- name: ListRecentListensForUser :many
+SELECT * FROM listens
+WHERE user_id = $1
+ORDER BY listened_at DESC, id DESC
+LIMIT $2;
+
+-- name: ListListensPage :many
+SELECT listens.* FROM listens
+WHERE listens.user_id = sqlc.arg(user_id)
+AND listens.listened_at >= sqlc.arg(start_time)::TIMESTAMPTZ
+AND listens.listened_at < sqlc.arg(end_time)::TIMESTAMPTZ
+AND (sqlc.arg(source)::TEXT = '' OR listens.source = sqlc.arg(source))
+AND (sqlc.arg(isrc)::TEXT = '' OR listens.isrc = sqlc.arg(isrc))
+AND (
+    sqlc.arg(artist_id)::TEXT = ''
+    OR listens.isrc IN (
+        SELECT tracks.isrc FROM tracks
+        WHERE tracks.artist_ids @> ARRAY[sqlc.arg(artist_id)]::VARCHAR[]
+    )
+)
+AND (listens.listened_at, listens.id) < (
+    sqlc.arg(before_listened_at)::TIMESTAMPTZ, sqlc.arg(before_id)::CHAR(27)
+)
+ORDER BY listens.listened_at DESC, listens.id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListListensPageAscending :many
+SELECT listens.* FROM listens
+WHERE listens.user_id = sqlc.arg(user_id)
+AND listens.listened_at >= sqlc.arg(start_time)::TIMESTAMPTZ
+AND listens.listened_at < sqlc.arg(end_time)::TIMESTAMPTZ
+AND (sqlc.arg(source)::TEXT = '' OR listens.source = sqlc.arg(source))
+AND (sqlc.arg(isrc)::TEXT = '' OR listens.isrc = sqlc.arg(isrc))
+AND (
+    sqlc.arg(artist_id)::TEXT = ''
+    OR listens.isrc IN (
+        SELECT tracks.isrc FROM tracks
+        WHERE tracks.artist_ids @> ARRAY[sqlc.arg(artist_id)]::VARCHAR[]
+    )
+)
+AND (listens.listened_at, listens.id) > (
+    sqlc.arg(after_listened_at)::TIMESTAMPTZ, sqlc.arg(after_id)::CHAR(27)
+)
+ORDER BY listens.listened_at, listens.id
+LIMIT sqlc.arg(row_limit);
+
+-- name: UpdateListen :exec
+UPDATE listens SET isrc = $1, listened_at = $2 WHERE id = $3 AND user_id = $4;
+
+-- name: DeleteListen :exec
+DELETE FROM listens WHERE id = $1 AND user_id = $2;
+
+-- name: DeleteListensForUserBetween :one
+WITH deleted AS (
+    DELETE FROM listens
+    WHERE user_id = sqlc.arg(user_id)
+    AND listened_at >= sqlc.arg(start_time)
+    AND listened_at < sqlc.arg(end_time)
+    RETURNING id, user_id, isrc, listened_at
+), edits AS (
+    INSERT INTO listen_edits (
+        id,
+        user_id,
+        listen_id,
+        action,
+        old_isrc,
+        old_listened_at,
+        created_at
+    )
+    SELECT
+        id,
+        user_id,
+        id,
+        sqlc.arg(action),
+        isrc,
+        listened_at,
+        sqlc.arg(created_at)
+    FROM deleted
+)
+SELECT COUNT(*) FROM deleted;
@@ -0,0 +1,68 @@
+-- name: UpsertNotification :exec
+INSERT INTO notifications (
+    id,
+    user_id,
+    kind,
+    target_id,
+    actor_ids,
+    created_at,
+    updated_at
+) VALUES (
+    sqlc.arg(id),
+    sqlc.arg(user_id),
+    sqlc.arg(kind),
+    sqlc.arg(target_id),
+    ARRAY[sqlc.arg(actor_id)::CHAR(27)],
+    sqlc.arg(created_at),
+    sqlc.arg(created_at)
+)
+ON CONFLICT (user_id, kind, target_id) WHERE read_at IS NULL DO UPDATE SET
+    actor_ids = array_prepend(
+        sqlc.arg(actor_id)::CHAR(27),
+        array_remove(notifications.actor_ids, sqlc.arg(actor_id)::CHAR(27))
+    ),
+    updated_at = EXCLUDED.updated_at;
+
+-- name: ListNotifications :many
+SELECT * FROM notifications
+WHERE user_id = sqlc.arg(user_id)
+AND (updated_at, id) < (
+    sqlc.arg(before_updated_at)::TIMESTAMPTZ, sqlc.arg(before_id)::CHAR(27)
+)
+ORDER BY updated_at DESC, id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListNotificationsUpdatedSince :many
+SELECT * FROM notifications
+WHERE user_id = sqlc.arg(user_id) AND updated_at >= sqlc.arg(updated_since)
+ORDER BY updated_at, id
+LIMIT sqlc.arg(row_limit);
+
+-- name: CountUnreadNotifications :one
+SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL;
+
+-- name: MarkNotificationsRead :exec
+UPDATE notifications SET read_at = sqlc.arg(read_at)
+WHERE user_id = sqlc.arg(user_id)
+AND id = ANY(sqlc.arg(ids)::CHAR(27)[])
+AND read_at IS NULL;
+
+-- name: MarkAllNotificationsRead :exec
+UPDATE notifications SET read_at = sqlc.arg(read_at)
+WHERE user_id = sqlc.arg(user_id)
+AND updated_at <= sqlc.arg(updated_before)
+AND read_at IS NULL;
+
+-- name: ListNotificationPreferences :many
+SELECT * FROM notification_preferences WHERE user_id = $1;
+
+-- name: UpsertNotificationPreference :exec
+INSERT INTO notification_preferences (
+    user_id,
+    kind,
+    enabled,
+    updated_at
+) VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, kind) DO UPDATE SET
+    enabled = EXCLUDED.enabled,
+    updated_at = EXCLUDED.updated_at;
@@ -0,0 +1,37 @@
+-- name: GetPrivacySettings :one
+SELECT * FROM privacy_settings WHERE user_id = $1;
+
+-- name: ListPrivacySettings :many
+SELECT * FROM privacy_settings
+WHERE user_id = ANY(sqlc.arg(user_ids)::CHAR(27)[]);
+
+-- name: UpsertPrivacySettings :exec
+INSERT INTO privacy_settings (
+    user_id,
+    visibility,
+    hidden_sources,
+    updated_at
+) VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id) DO UPDATE SET
+    visibility = EXCLUDED.visibility,
+    hidden_sources = EXCLUDED.hidden_sources,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: CreateIncognitoWindow :exec
+INSERT INTO incognito_windows (
+    id,
+    user_id,
+    start_time,
+    end_time,
+    created_at
+) VALUES ($1, $2, $3, $4, $5);
+
+-- name: DeleteIncognitoWindow :one
+DELETE FROM incognito_windows WHERE id = $1 AND user_id = $2 RETURNING *;
+
+-- name: ListIncognitoWindowsForUser :many
+SELECT * FROM incognito_windows WHERE user_id = $1 ORDER BY start_time;
+
+-- name: ListIncognitoWindowsForUsers :many
+SELECT * FROM incognito_windows
+WHERE user_id = ANY(sqlc.arg(user_ids)::CHAR(27)[]);
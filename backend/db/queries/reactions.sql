@@ -0,0 +1,42 @@
+-- name: LockReactionsByUserForListen :exec
+SELECT pg_advisory_xact_lock(
+    hashtext('reactions:' || sqlc.arg(listen_id)::TEXT || ':' || sqlc.arg(user_id)::TEXT)
+);
+
+-- name: CreateReaction :execrows
+INSERT INTO reactions (
+    listen_id,
+    user_id,
+    emoji,
+    created_at
+)
+SELECT
+    sqlc.arg(listen_id)::CHAR(27),
+    sqlc.arg(user_id)::CHAR(27),
+    sqlc.arg(emoji)::VARCHAR(32),
+    sqlc.arg(created_at)::TIMESTAMPTZ
+WHERE (
+    SELECT COUNT(*) FROM reactions
+    WHERE listen_id = sqlc.arg(listen_id) AND user_id = sqlc.arg(user_id)
+) < sqlc.arg(max_reactions)::INTEGER
+ON CONFLICT (listen_id, user_id, emoji) DO NOTHING;
+
+-- name: DeleteReaction :execrows
+DELETE FROM reactions WHERE listen_id = $1 AND user_id = $2 AND emoji = $3;
+
+-- name: CountReactionsByUserForListen :one
+SELECT COUNT(*) FROM reactions WHERE listen_id = $1 AND user_id = $2;
+
+-- name: ListReactionCounts :many
+SELECT
+    listen_id,
+    emoji,
+    COUNT(*) AS count,
+    BOOL_OR(user_id = sqlc.arg(viewer_id))::BOOLEAN AS reacted
+FROM reactions
+WHERE listen_id = ANY(sqlc.arg(listen_ids)::CHAR(27)[])
+GROUP BY listen_id, emoji
+ORDER BY listen_id, count DESC, MIN(created_at);
+
+-- name: ListReactionsForUser :many
+SELECT * FROM reactions WHERE user_id = $1 ORDER BY created_at;
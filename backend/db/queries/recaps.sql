@@ -0,0 +1,58 @@
+-- name: CreateYearlyRecap :one
+INSERT INTO yearly_recaps (
+    user_id, year, version, format_version, time_zone, data, generated_at
+)
+SELECT
+    sqlc.arg(user_id)::CHAR(27),
+    sqlc.arg(year)::INTEGER,
+    COALESCE(MAX(version), 0) + 1,
+    sqlc.arg(format_version)::INTEGER,
+    sqlc.arg(time_zone)::TEXT,
+    sqlc.arg(data)::BYTEA,
+    sqlc.arg(generated_at)::TIMESTAMPTZ
+FROM yearly_recaps
+WHERE user_id = sqlc.arg(user_id) AND year = sqlc.arg(year)
+RETURNING *;
+
+-- name: GetLatestYearlyRecap :one
+SELECT * FROM yearly_recaps
+WHERE user_id = $1 AND year = $2
+ORDER BY version DESC
+LIMIT 1;
+
+-- name: ListUsersDueYearlyRecap :many
+SELECT users.id FROM users
+WHERE users.deletion_requested_at IS NULL
+AND EXISTS (
+    SELECT 1 FROM listens
+    WHERE listens.user_id = users.id
+    AND listens.listened_at >= sqlc.arg(listened_since)
+    AND listens.listened_at < sqlc.arg(listened_before)
+)
+AND NOT EXISTS (
+    SELECT 1 FROM yearly_recaps
+    WHERE yearly_recaps.user_id = users.id
+    AND yearly_recaps.year = sqlc.arg(year)
+)
+AND users.id > sqlc.arg(after_id)
+ORDER BY users.id
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListListensForUserBetween :many
+SELECT * FROM listens
+WHERE user_id = sqlc.arg(user_id)
+AND listened_at >= sqlc.arg(start_time)
+AND listened_at < sqlc.arg(end_time)
+ORDER BY listened_at, id;
+
+-- name: ListPlaysForUsers :many
+SELECT
+    user_id,
+    SUM(
+        CASE WHEN user_id = sqlc.arg(owner_id) THEN plays ELSE public_plays END
+    )::BIGINT AS plays
+FROM daily_track_plays
+WHERE user_id = ANY(sqlc.arg(user_ids)::CHAR(27)[])
+AND day >= sqlc.arg(start_day)::DATE
+AND day < sqlc.arg(end_day)::DATE
+GROUP BY user_id;
@@ -0,0 +1,279 @@
+-- name: AddDailyTrackPlays :exec
+INSERT INTO daily_track_plays (user_id, day, isrc, plays, public_plays)
+VALUES (
+    sqlc.arg(user_id),
+    sqlc.arg(day),
+    sqlc.arg(isrc),
+    sqlc.arg(plays),
+    sqlc.arg(public_plays)
+)
+ON CONFLICT (user_id, day, isrc) DO UPDATE
+SET plays = daily_track_plays.plays + EXCLUDED.plays,
+    public_plays = daily_track_plays.public_plays + EXCLUDED.public_plays;
+
+-- name: AddDailyArtistPlays :exec
+INSERT INTO daily_artist_plays (user_id, day, artist_id, plays, public_plays)
+SELECT
+    sqlc.arg(user_id)::CHAR(27),
+    sqlc.arg(day)::DATE,
+    artist.id,
+    sqlc.arg(plays)::INTEGER,
+    sqlc.arg(public_plays)::INTEGER
+FROM tracks
+CROSS JOIN LATERAL (SELECT DISTINCT unnest(tracks.artist_ids) AS id) AS artist
+WHERE tracks.isrc = sqlc.arg(isrc)
+ON CONFLICT (user_id, day, artist_id) DO UPDATE
+SET plays = daily_artist_plays.plays + EXCLUDED.plays,
+    public_plays = daily_artist_plays.public_plays + EXCLUDED.public_plays;
+
+-- name: AddDailyAlbumPlays :exec
+INSERT INTO daily_album_plays (user_id, day, album_id, plays, public_plays)
+SELECT
+    sqlc.arg(user_id)::CHAR(27),
+    sqlc.arg(day)::DATE,
+    tracks.album_id,
+    sqlc.arg(plays)::INTEGER,
+    sqlc.arg(public_plays)::INTEGER
+FROM tracks
+WHERE tracks.isrc = sqlc.arg(isrc)
+ON CONFLICT (user_id, day, album_id) DO UPDATE
+SET plays = daily_album_plays.plays + EXCLUDED.plays,
+    public_plays = daily_album_plays.public_plays + EXCLUDED.public_plays;
+
+-- name: ListTrackPlaysForUser :many
+SELECT isrc, SUM(public_plays)::BIGINT AS plays FROM daily_track_plays
+WHERE user_id = sqlc.arg(user_id)
+AND day >= sqlc.arg(start_day)::DATE
+AND public_plays > 0
+GROUP BY isrc;
+
+-- name: ListArtistPlaysForUser :many
+SELECT artist_id, SUM(public_plays)::BIGINT AS plays FROM daily_artist_plays
+WHERE user_id = sqlc.arg(user_id)
+AND day >= sqlc.arg(start_day)::DATE
+AND public_plays > 0
+GROUP BY artist_id;
+
+-- name: DeleteEmptyDailyTrackPlays :exec
+DELETE FROM daily_track_plays
+WHERE user_id = $1 AND day = $2 AND isrc = $3 AND plays <= 0;
+
+-- name: DeleteEmptyDailyArtistPlays :exec
+DELETE FROM daily_artist_plays
+WHERE user_id = $1 AND day = $2 AND plays <= 0;
+
+-- name: DeleteEmptyDailyAlbumPlays :exec
+DELETE FROM daily_album_plays
+WHERE user_id = $1 AND day = $2 AND plays <= 0;
+
+-- name: DeleteDailyTrackPlaysBetween :exec
+DELETE FROM daily_track_plays
+WHERE user_id = sqlc.arg(user_id)
+AND day >= sqlc.arg(start_day)::DATE
+AND day < sqlc.arg(end_day)::DATE;
+
+-- name: InsertDailyTrackPlaysBetween :exec
+INSERT INTO daily_track_plays (user_id, day, isrc, plays, public_plays)
+SELECT
+    listens.user_id,
+    (listens.listened_at AT TIME ZONE 'UTC')::DATE,
+    listens.isrc,
+    COUNT(*),
+    COUNT(*) FILTER (
+        WHERE NOT listens.hidden
+        AND NOT listens.source = ANY(COALESCE(privacy_settings.hidden_sources, '{}'))
+        AND NOT EXISTS (
+            SELECT 1 FROM incognito_windows
+            WHERE incognito_windows.user_id = listens.user_id
+            AND listens.listened_at >= incognito_windows.start_time
+            AND listens.listened_at < incognito_windows.end_time
+        )
+    )
+FROM listens
+LEFT JOIN privacy_settings ON privacy_settings.user_id = listens.user_id
+WHERE listens.user_id = sqlc.arg(user_id)
+AND listens.listened_at >= sqlc.arg(start_day)::TIMESTAMPTZ
+AND listens.listened_at < sqlc.arg(end_day)::TIMESTAMPTZ
+GROUP BY 1, 2, 3;
+
+-- name: DeleteDailyArtistPlaysBetween :exec
+DELETE FROM daily_artist_plays
+WHERE user_id = sqlc.arg(user_id)
+AND day >= sqlc.arg(start_day)::DATE
+AND day < sqlc.arg(end_day)::DATE;
+
+-- name: InsertDailyArtistPlaysBetween :exec
+INSERT INTO daily_artist_plays (user_id, day, artist_id, plays, public_plays)
+SELECT
+    daily_track_plays.user_id,
+    daily_track_plays.day,
+    artist.id,
+    SUM(daily_track_plays.plays),
+    SUM(daily_track_plays.public_plays)
+FROM daily_track_plays
+INNER JOIN tracks ON tracks.isrc = daily_track_plays.isrc
+CROSS JOIN LATERAL (SELECT DISTINCT unnest(tracks.artist_ids) AS id) AS artist
+WHERE daily_track_plays.user_id = sqlc.arg(user_id)
+AND daily_track_plays.day >= sqlc.arg(start_day)::DATE
+AND daily_track_plays.day < sqlc.arg(end_day)::DATE
+GROUP BY 1, 2, 3;
+
+-- name: DeleteDailyAlbumPlaysBetween :exec
+DELETE FROM daily_album_plays
+WHERE user_id = sqlc.arg(user_id)
+AND day >= sqlc.arg(start_day)::DATE
+AND day < sqlc.arg(end_day)::DATE;
+
+-- name: InsertDailyAlbumPlaysBetween :exec
+INSERT INTO daily_album_plays (user_id, day, album_id, plays, public_plays)
+SELECT
+    daily_track_plays.user_id,
+    daily_track_plays.day,
+    tracks.album_id,
+    SUM(daily_track_plays.plays),
+    SUM(daily_track_plays.public_plays)
+FROM daily_track_plays
+INNER JOIN tracks ON tracks.isrc = daily_track_plays.isrc
+WHERE daily_track_plays.user_id = sqlc.arg(user_id)
+AND daily_track_plays.day >= sqlc.arg(start_day)::DATE
+AND daily_track_plays.day < sqlc.arg(end_day)::DATE
+GROUP BY 1, 2, 3;
+
+-- name: ListUsersWithTrackPlays :many
+SELECT
+    user_id,
+    MIN(day)::DATE AS first_day,
+    MAX(day)::DATE AS last_day
+FROM daily_track_plays
+WHERE isrc = sqlc.arg(isrc)
+GROUP BY user_id;
+
+-- name: AddTrackToDailyArtistPlays :exec
+INSERT INTO daily_artist_plays (user_id, day, artist_id, plays, public_plays)
+SELECT
+    daily_track_plays.user_id,
+    daily_track_plays.day,
+    artist.id,
+    sqlc.arg(sign)::INTEGER * daily_track_plays.plays,
+    sqlc.arg(sign)::INTEGER * daily_track_plays.public_plays
+FROM daily_track_plays
+CROSS JOIN unnest(sqlc.arg(artist_ids)::VARCHAR(64)[]) AS artist(id)
+WHERE daily_track_plays.isrc = sqlc.arg(isrc)
+ON CONFLICT (user_id, day, artist_id) DO UPDATE
+SET plays = daily_artist_plays.plays + EXCLUDED.plays,
+    public_plays = daily_artist_plays.public_plays + EXCLUDED.public_plays;
+
+-- name: DeleteEmptyDailyArtistPlaysForTrack :exec
+DELETE FROM daily_artist_plays
+USING daily_track_plays
+WHERE daily_track_plays.isrc = sqlc.arg(isrc)
+AND daily_artist_plays.user_id = daily_track_plays.user_id
+AND daily_artist_plays.day = daily_track_plays.day
+AND daily_artist_plays.artist_id = ANY(sqlc.arg(artist_ids)::VARCHAR(64)[])
+AND daily_artist_plays.plays <= 0;
+
+-- name: AddTrackToDailyAlbumPlays :exec
+INSERT INTO daily_album_plays (user_id, day, album_id, plays, public_plays)
+SELECT
+    daily_track_plays.user_id,
+    daily_track_plays.day,
+    sqlc.arg(album_id)::VARCHAR(64),
+    sqlc.arg(sign)::INTEGER * daily_track_plays.plays,
+    sqlc.arg(sign)::INTEGER * daily_track_plays.public_plays
+FROM daily_track_plays
+WHERE daily_track_plays.isrc = sqlc.arg(isrc)
+ON CONFLICT (user_id, day, album_id) DO UPDATE
+SET plays = daily_album_plays.plays + EXCLUDED.plays,
+    public_plays = daily_album_plays.public_plays + EXCLUDED.public_plays;
+
+-- name: DeleteEmptyDailyAlbumPlaysForTrack :exec
+DELETE FROM daily_album_plays
+USING daily_track_plays
+WHERE daily_track_plays.isrc = sqlc.arg(isrc)
+AND daily_album_plays.user_id = daily_track_plays.user_id
+AND daily_album_plays.day = daily_track_plays.day
+AND daily_album_plays.album_id = sqlc.arg(album_id)
+AND daily_album_plays.plays <= 0;
+
+-- name: LockRollupsForUser :exec
+SELECT pg_advisory_xact_lock(hashtext('rollups:' || sqlc.arg(user_id)::TEXT));
+
+-- name: LockAllRollups :exec
+SELECT pg_advisory_xact_lock(hashtext('rollups'));
+
+-- name: LockAllRollupsShared :exec
+SELECT pg_advisory_xact_lock_shared(hashtext('rollups'));
+
+-- name: ListTopTracks :many
+SELECT
+    isrc,
+    SUM(
+        CASE WHEN sqlc.arg(include_hidden)::BOOLEAN THEN plays ELSE public_plays END
+    )::BIGINT AS plays
+FROM daily_track_plays
+WHERE user_id = sqlc.arg(user_id)
+AND day >= sqlc.arg(start_day)::DATE
+AND day < sqlc.arg(end_day)::DATE
+AND (sqlc.arg(include_hidden)::BOOLEAN OR public_plays > 0)
+GROUP BY isrc
+ORDER BY plays DESC, isrc
+LIMIT sqlc.arg(row_limit);
+
+-- name: ListTopArtists :many
+SELECT
+    top.artist_id::TEXT AS artist_id,
+    COALESCE(latest.name, '')::TEXT AS artist_name,
+    top.plays::BIGINT AS plays
+FROM (
+    SELECT
+        artist_id,
+        SUM(
+            CASE WHEN sqlc.arg(include_hidden)::BOOLEAN THEN plays ELSE public_plays END
+        ) AS plays
+    FROM daily_artist_plays
+    WHERE user_id = sqlc.arg(user_id)
+    AND day >= sqlc.arg(start_day)::DATE
+    AND day < sqlc.arg(end_day)::DATE
+    AND (sqlc.arg(include_hidden)::BOOLEAN OR public_plays > 0)
+    GROUP BY artist_id
+    ORDER BY plays DESC, artist_id
+    LIMIT sqlc.arg(row_limit)
+) AS top
+LEFT JOIN LATERAL (
+    SELECT artist.name FROM tracks
+    CROSS JOIN LATERAL unnest(tracks.artist_ids, tracks.artist_names) AS artist(id, name)
+    WHERE tracks.artist_ids @> ARRAY[top.artist_id]::VARCHAR[]
+    AND artist.id = top.artist_id
+    ORDER BY tracks.updated_at DESC
+    LIMIT 1
+) AS latest ON TRUE
+ORDER BY top.plays DESC, top.artist_id;
+
+-- name: ListTopAlbums :many
+SELECT
+    top.album_id::TEXT AS album_id,
+    COALESCE(latest.album_name, '')::TEXT AS album_name,
+    COALESCE(latest.album_image_url, '')::TEXT AS album_image_url,
+    top.plays::BIGINT AS plays
+FROM (
+    SELECT
+        album_id,
+        SUM(
+            CASE WHEN sqlc.arg(include_hidden)::BOOLEAN THEN plays ELSE public_plays END
+        ) AS plays
+    FROM daily_album_plays
+    WHERE user_id = sqlc.arg(user_id)
+    AND day >= sqlc.arg(start_day)::DATE
+    AND day < sqlc.arg(end_day)::DATE
+    AND (sqlc.arg(include_hidden)::BOOLEAN OR public_plays > 0)
+    GROUP BY album_id
+    ORDER BY plays DESC, album_id
+    LIMIT sqlc.arg(row_limit)
+) AS top
+LEFT JOIN LATERAL (
+    SELECT tracks.album_name, tracks.album_image_url FROM tracks
+    WHERE tracks.album_id = top.album_id
+    ORDER BY tracks.updated_at DESC
+    LIMIT 1
+) AS latest ON TRUE
+ORDER BY top.plays DESC, top.album_id;
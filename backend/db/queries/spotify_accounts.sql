@@ -1,5 +1,8 @@
 -- name: GetSpotifyAccountsForScanning :many
-SELECT * FROM spotify_accounts;
+SELECT spotify_accounts.* FROM spotify_accounts
+INNER JOIN users ON users.id = spotify_accounts.user_id
+WHERE users.deletion_requested_at IS NULL
+AND users.disabled_at IS NULL;
 
 -- name: CreateSpotifyAccount :exec
 INSERT INTO spotify_accounts (
@@ -13,4 +16,40 @@ INSERT INTO spotify_accounts (
 SELECT * FROM spotify_accounts WHERE spotify_user_id = $1 FOR UPDATE;
 
 -- name: UpdateSpotifyAccountListenedAt :exec
-UPDATE spotify_accounts SET last_listened_at = $1 WHERE spotify_user_id = $2;
\ No newline at end of file
+UPDATE spotify_accounts SET last_listened_at = $1 WHERE spotify_user_id = $2;
+
+-- name: ListSpotifyAccountsForUser :many
+SELECT * FROM spotify_accounts WHERE user_id = $1;
+
+-- name: GetSpotifyAccount :one
+SELECT * FROM spotify_accounts WHERE spotify_user_id = $1;
+
+-- name: RecordSpotifyAccountScanSucceeded :exec
+UPDATE spotify_accounts
+SET last_scanned_at = $1, last_succeeded_at = $1, failure_streak = 0, last_scan_error = ''
+WHERE spotify_user_id = $2;
+
+-- name: RecordSpotifyAccountScanFailed :exec
+UPDATE spotify_accounts
+SET last_scanned_at = $1, failure_streak = failure_streak + 1, last_scan_error = $2
+WHERE spotify_user_id = $3;
+
+-- name: GetPollerSummary :one
+SELECT
+    COUNT(*) AS accounts,
+    COUNT(*) FILTER (
+        WHERE COALESCE(spotify_accounts.last_succeeded_at, spotify_accounts.created_at) < sqlc.arg(overdue_before)::TIMESTAMPTZ
+    ) AS overdue_accounts,
+    COUNT(*) FILTER (WHERE spotify_accounts.failure_streak > 0) AS failing_accounts
+FROM spotify_accounts
+INNER JOIN users ON users.id = spotify_accounts.user_id
+WHERE users.deletion_requested_at IS NULL
+AND users.disabled_at IS NULL;
+
+-- name: ListLaggingSpotifyAccounts :many
+SELECT spotify_accounts.* FROM spotify_accounts
+INNER JOIN users ON users.id = spotify_accounts.user_id
+WHERE users.deletion_requested_at IS NULL
+AND users.disabled_at IS NULL
+ORDER BY COALESCE(spotify_accounts.last_succeeded_at, spotify_accounts.created_at), spotify_accounts.spotify_user_id
+LIMIT $1;
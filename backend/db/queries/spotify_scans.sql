@@ -0,0 +1,28 @@
+-- name: CreateSpotifyScan :exec
+INSERT INTO spotify_scans (id, spotify_user_id, replica, started_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: FinishSpotifyScan :exec
+UPDATE spotify_scans
+SET finished_at = $1, result = $2, listens_found = $3, error = $4
+WHERE id = $5;
+
+-- name: DeleteSpotifyScansBefore :exec
+DELETE FROM spotify_scans WHERE started_at < $1;
+
+-- name: ListSpotifyScans :many
+SELECT * FROM spotify_scans
+WHERE spotify_user_id = $1
+ORDER BY started_at DESC
+LIMIT $2;
+
+-- name: ListPollerReplicaThroughput :many
+SELECT
+    replica,
+    COUNT(*) AS scans,
+    COUNT(*) FILTER (WHERE result = 'failed') AS failed_scans,
+    COALESCE(SUM(listens_found), 0)::BIGINT AS listens_found
+FROM spotify_scans
+WHERE started_at >= $1
+GROUP BY replica
+ORDER BY replica;
@@ -0,0 +1,51 @@
+-- name: ListHourlyPlays :many
+SELECT
+    EXTRACT(ISODOW FROM listened_at AT TIME ZONE sqlc.arg(time_zone)::TEXT)::INTEGER AS weekday,
+    EXTRACT(HOUR FROM listened_at AT TIME ZONE sqlc.arg(time_zone)::TEXT)::INTEGER AS hour,
+    COUNT(*) AS plays
+FROM listens
+WHERE user_id = sqlc.arg(user_id)
+AND listened_at >= sqlc.arg(start_time)
+AND listened_at < sqlc.arg(end_time)
+GROUP BY weekday, hour
+ORDER BY weekday, hour;
+
+-- name: ListDailyPlays :many
+SELECT
+    (listened_at AT TIME ZONE sqlc.arg(time_zone)::TEXT)::DATE AS day,
+    COUNT(*) AS plays
+FROM listens
+WHERE user_id = sqlc.arg(user_id)
+AND listened_at >= sqlc.arg(start_time)
+AND listened_at < sqlc.arg(end_time)
+GROUP BY day
+ORDER BY day;
+
+-- name: ListListeningDays :many
+SELECT DISTINCT (listened_at AT TIME ZONE sqlc.arg(time_zone)::TEXT)::DATE AS day
+FROM listens
+WHERE user_id = sqlc.arg(user_id)
+ORDER BY day;
+
+-- name: GetListeningTotals :one
+SELECT
+    COUNT(*) AS plays,
+    COUNT(tracks.isrc) AS plays_with_duration,
+    COALESCE(SUM(tracks.duration_ms), 0)::BIGINT AS duration_ms
+FROM listens
+LEFT JOIN tracks ON tracks.isrc = listens.isrc
+WHERE listens.user_id = sqlc.arg(user_id)
+AND listens.listened_at >= sqlc.arg(start_time)
+AND listens.listened_at < sqlc.arg(end_time);
+
+-- name: CountNewTrackPlays :one
+SELECT COUNT(*) FROM listens
+WHERE listens.user_id = sqlc.arg(user_id)
+AND listens.listened_at >= sqlc.arg(start_time)
+AND listens.listened_at < sqlc.arg(end_time)
+AND NOT EXISTS (
+    SELECT 1 FROM listens AS earlier
+    WHERE earlier.user_id = listens.user_id
+    AND earlier.isrc = listens.isrc
+    AND earlier.listened_at < sqlc.arg(start_time)
+);
@@ -0,0 +1,42 @@
+-- name: UpsertTrack :exec
+INSERT INTO tracks (
+    isrc,
+    spotify_track_id,
+    name,
+    artist_ids,
+    artist_names,
+    album_id,
+    album_name,
+    album_image_url,
+    duration_ms,
+    updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (isrc) DO UPDATE SET
+    spotify_track_id = EXCLUDED.spotify_track_id,
+    name = EXCLUDED.name,
+    artist_ids = EXCLUDED.artist_ids,
+    artist_names = EXCLUDED.artist_names,
+    album_id = EXCLUDED.album_id,
+    album_name = EXCLUDED.album_name,
+    album_image_url = EXCLUDED.album_image_url,
+    duration_ms = EXCLUDED.duration_ms,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: ListTracks :many
+SELECT * FROM tracks WHERE isrc = ANY(sqlc.arg(isrcs)::CHAR(12)[]);
+
+-- name: ListKnownTrackISRCs :many
+SELECT isrc FROM tracks WHERE isrc = ANY(sqlc.arg(isrcs)::CHAR(12)[]);
+
+-- name: GetTrack :one
+SELECT * FROM tracks WHERE isrc = $1;
+
+-- name: ListArtistNames :many
+SELECT DISTINCT ON (artist.id)
+    artist.id::TEXT AS artist_id,
+    artist.name::TEXT AS artist_name
+FROM tracks
+CROSS JOIN LATERAL unnest(tracks.artist_ids, tracks.artist_names) AS artist(id, name)
+WHERE tracks.artist_ids && sqlc.arg(artist_ids)::VARCHAR(64)[]
+AND artist.id = ANY(sqlc.arg(artist_ids)::VARCHAR(64)[])
+ORDER BY artist.id, tracks.updated_at DESC;
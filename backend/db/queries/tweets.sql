@@ -0,0 +1,29 @@
+-- name: CreateTweet :execrows
+INSERT INTO tweets (
+    id,
+    user_id,
+    twitter_user_id,
+    kind,
+    dedupe_key,
+    text,
+    created_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (user_id, kind, dedupe_key) DO NOTHING;
+
+-- name: SetTweetPosted :exec
+UPDATE tweets SET tweet_id = $1, posted_at = $2 WHERE id = $3;
+
+-- name: DeleteTweet :exec
+DELETE FROM tweets WHERE id = $1;
+
+-- name: ListUsersDueWeeklyRecap :many
+SELECT twitter_sharing_settings.user_id FROM twitter_sharing_settings
+INNER JOIN users ON users.id = twitter_sharing_settings.user_id
+WHERE twitter_sharing_settings.weekly_recap_enabled
+AND users.deletion_requested_at IS NULL
+AND NOT EXISTS (
+    SELECT 1 FROM tweets
+    WHERE tweets.user_id = twitter_sharing_settings.user_id
+    AND tweets.kind = 'weekly_recap'
+    AND tweets.dedupe_key = sqlc.arg(recap_week)
+);
\ No newline at end of file
@@ -6,5 +6,30 @@ INSERT INTO twitter_accounts (
     twitter_user_id,
     user_id,
     oauth_token,
-    created_at
-)  VALUES ($1, $2, $3, $4);
\ No newline at end of file
+    created_at,
+    name,
+    username,
+    profile_image_url,
+    profile_updated_at
+)  VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: ListTwitterAccountsForUser :many
+SELECT * FROM twitter_accounts WHERE user_id = $1 ORDER BY created_at;
+
+-- name: UpdateTwitterAccountToken :exec
+UPDATE twitter_accounts SET oauth_token = $1 WHERE twitter_user_id = $2;
+
+-- name: UpdateTwitterAccountProfile :exec
+UPDATE twitter_accounts
+SET name = $1, username = $2, profile_image_url = $3, profile_updated_at = $4
+WHERE twitter_user_id = $5;
+
+-- name: ListTwitterAccountsDueProfileRefresh :many
+SELECT twitter_accounts.* FROM twitter_accounts
+INNER JOIN users ON users.id = twitter_accounts.user_id
+WHERE users.deletion_requested_at IS NULL
+AND (
+    twitter_accounts.profile_updated_at IS NULL
+    OR twitter_accounts.profile_updated_at < sqlc.arg(updated_before)::TIMESTAMPTZ
+)
+ORDER BY twitter_accounts.profile_updated_at NULLS FIRST;
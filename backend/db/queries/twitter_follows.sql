@@ -0,0 +1,65 @@
+-- name: RequestTwitterFollowSync :exec
+INSERT INTO twitter_follow_syncs (twitter_user_id, direction)
+VALUES
+    (sqlc.arg(twitter_user_id), 'following'),
+    (sqlc.arg(twitter_user_id), 'followers')
+ON CONFLICT (twitter_user_id, direction) DO UPDATE SET completed_at = NULL
+WHERE twitter_follow_syncs.completed_at < sqlc.arg(completed_before)::TIMESTAMPTZ;
+
+-- name: ListTwitterFollowSyncsDue :many
+SELECT twitter_follow_syncs.* FROM twitter_follow_syncs
+INNER JOIN twitter_accounts ON twitter_accounts.twitter_user_id = twitter_follow_syncs.twitter_user_id
+INNER JOIN users ON users.id = twitter_accounts.user_id
+WHERE users.deletion_requested_at IS NULL
+AND (
+    twitter_follow_syncs.completed_at IS NULL
+    OR twitter_follow_syncs.completed_at < sqlc.arg(completed_before)::TIMESTAMPTZ
+);
+
+-- name: GetTwitterFollowSync :one
+SELECT * FROM twitter_follow_syncs
+WHERE twitter_user_id = $1 AND direction = $2;
+
+-- name: SelectTwitterFollowSyncForUpdate :one
+SELECT * FROM twitter_follow_syncs
+WHERE twitter_user_id = $1 AND direction = $2
+FOR UPDATE;
+
+-- name: UpdateTwitterFollowSyncProgress :exec
+UPDATE twitter_follow_syncs SET started_at = $1, pagination_token = $2
+WHERE twitter_user_id = $3 AND direction = $4;
+
+-- name: CompleteTwitterFollowSync :exec
+UPDATE twitter_follow_syncs
+SET completed_at = $1, started_at = NULL, pagination_token = NULL
+WHERE twitter_user_id = $2 AND direction = $3;
+
+-- name: UpsertTwitterFollows :exec
+INSERT INTO twitter_follows (follower_id, followee_id, synced_at)
+SELECT
+    unnest(sqlc.arg(follower_ids)::VARCHAR[]),
+    unnest(sqlc.arg(followee_ids)::VARCHAR[]),
+    sqlc.arg(synced_at)::TIMESTAMPTZ
+ON CONFLICT (follower_id, followee_id) DO UPDATE SET synced_at = EXCLUDED.synced_at;
+
+-- name: DeleteStaleTwitterFollowing :exec
+DELETE FROM twitter_follows WHERE follower_id = $1 AND synced_at < $2;
+
+-- name: DeleteStaleTwitterFollowers :exec
+DELETE FROM twitter_follows WHERE followee_id = $1 AND synced_at < $2;
+
+-- name: DeleteTwitterFollowsForAccount :exec
+DELETE FROM twitter_follows WHERE follower_id = $1 OR followee_id = $1;
+
+-- name: ListTwitterMootsForUser :many
+SELECT DISTINCT theirs.user_id, theirs.twitter_user_id
+FROM twitter_accounts AS mine
+INNER JOIN twitter_follows AS outgoing
+    ON outgoing.follower_id = mine.twitter_user_id
+INNER JOIN twitter_follows AS incoming
+    ON incoming.follower_id = outgoing.followee_id
+    AND incoming.followee_id = mine.twitter_user_id
+INNER JOIN twitter_accounts AS theirs
+    ON theirs.twitter_user_id = outgoing.followee_id
+WHERE mine.user_id = $1 AND theirs.user_id != $1
+ORDER BY theirs.user_id;
\ No newline at end of file
@@ -0,0 +1,14 @@
+-- name: GetTwitterSharingSettings :one
+SELECT * FROM twitter_sharing_settings WHERE user_id = $1;
+
+-- name: UpsertTwitterSharingSettings :exec
+INSERT INTO twitter_sharing_settings (
+    user_id,
+    listen_sharing_enabled,
+    weekly_recap_enabled,
+    updated_at
+) VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id) DO UPDATE SET
+    listen_sharing_enabled = EXCLUDED.listen_sharing_enabled,
+    weekly_recap_enabled = EXCLUDED.weekly_recap_enabled,
+    updated_at = EXCLUDED.updated_at;
\ No newline at end of file
@@ -1,7 +1,60 @@
 -- name: CreateUser :exec
 INSERT INTO users (
-    id, created_at
-) VALUES ($1, $2);
+    id, created_at, handle
+) VALUES ($1, $2, $3);
 
 -- name: GetUser :one
-SELECT * FROM users WHERE id = $1;
\ No newline at end of file
+SELECT * FROM users WHERE id = $1;
+
+-- name: GetUserByHandle :one
+SELECT * FROM users WHERE lower(handle) = lower(sqlc.arg(handle));
+
+-- name: SelectUserForUpdate :one
+SELECT * FROM users WHERE id = $1 FOR UPDATE;
+
+-- name: SetUserDeletionRequestedAt :exec
+UPDATE users SET deletion_requested_at = $1 WHERE id = $2;
+
+-- name: UpdateUserProfile :exec
+UPDATE users
+SET handle = $1, display_name = $2, bio = $3, time_zone = $4
+WHERE id = $5;
+
+-- name: ListUsersDueForDeletion :many
+SELECT * FROM users
+WHERE deletion_requested_at < sqlc.arg(requested_before)::TIMESTAMPTZ;
+
+-- name: DeleteUser :exec
+DELETE FROM users WHERE id = $1;
+
+-- name: SetUserDisabledAt :exec
+UPDATE users SET disabled_at = $1 WHERE id = $2;
+
+-- name: SetUserSessionsRevokedAt :exec
+UPDATE users SET sessions_revoked_at = $1 WHERE id = $2;
+
+-- name: ListUsers :many
+SELECT * FROM users
+WHERE (
+    sqlc.arg(query)::TEXT = ''
+    OR users.id = sqlc.arg(query)
+    OR starts_with(lower(users.handle), lower(sqlc.arg(query)))
+    OR EXISTS (
+        SELECT 1 FROM twitter_accounts
+        WHERE twitter_accounts.user_id = users.id
+        AND (
+            twitter_accounts.twitter_user_id = sqlc.arg(query)
+            OR lower(twitter_accounts.username) = lower(sqlc.arg(query))
+        )
+    )
+    OR EXISTS (
+        SELECT 1 FROM spotify_accounts
+        WHERE spotify_accounts.user_id = users.id
+        AND spotify_accounts.spotify_user_id = sqlc.arg(query)
+    )
+)
+AND (users.created_at, users.id) < (
+    sqlc.arg(before_created_at)::TIMESTAMPTZ, sqlc.arg(before_id)::CHAR(27)
+)
+ORDER BY users.created_at DESC, users.id DESC
+LIMIT sqlc.arg(row_limit);
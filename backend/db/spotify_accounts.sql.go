@@ -37,8 +37,58 @@ func (q *Queries) CreateSpotifyAccount(ctx context.Context, arg CreateSpotifyAcc
 	return err
 }
 
+const getPollerSummary = `-- name: GetPollerSummary :one
+SELECT
+    COUNT(*) AS accounts,
+    COUNT(*) FILTER (
+        WHERE COALESCE(spotify_accounts.last_succeeded_at, spotify_accounts.created_at) < $1::TIMESTAMPTZ
+    ) AS overdue_accounts,
+    COUNT(*) FILTER (WHERE spotify_accounts.failure_streak > 0) AS failing_accounts
+FROM spotify_accounts
+INNER JOIN users ON users.id = spotify_accounts.user_id
+WHERE users.deletion_requested_at IS NULL
+AND users.disabled_at IS NULL
+`
+
+type GetPollerSummaryRow struct {
+	Accounts        int64
+	OverdueAccounts int64
+	FailingAccounts int64
+}
+
+func (q *Queries) GetPollerSummary(ctx context.Context, overdueBefore time.Time) (GetPollerSummaryRow, error) {
+	row := q.db.QueryRow(ctx, getPollerSummary, overdueBefore)
+	var i GetPollerSummaryRow
+	err := row.Scan(&i.Accounts, &i.OverdueAccounts, &i.FailingAccounts)
+	return i, err
+}
+
+const getSpotifyAccount = `-- name: GetSpotifyAccount :one
+SELECT spotify_user_id, user_id, oauth_token, last_listened_at, created_at, last_scanned_at, last_succeeded_at, failure_streak, last_scan_error FROM spotify_accounts WHERE spotify_user_id = $1
+`
+
+func (q *Queries) GetSpotifyAccount(ctx context.Context, spotifyUserID string) (SpotifyAccount, error) {
+	row := q.db.QueryRow(ctx, getSpotifyAccount, spotifyUserID)
+	var i SpotifyAccount
+	err := row.Scan(
+		&i.SpotifyUserID,
+		&i.UserID,
+		&i.OauthToken,
+		&i.LastListenedAt,
+		&i.CreatedAt,
+		&i.LastScannedAt,
+		&i.LastSucceededAt,
+		&i.FailureStreak,
+		&i.LastScanError,
+	)
+	return i, err
+}
+
 const getSpotifyAccountsForScanning = `-- name: GetSpotifyAccountsForScanning :many
-SELECT spotify_user_id, user_id, oauth_token, last_listened_at, created_at FROM spotify_accounts
+SELECT spotify_accounts.spotify_user_id, spotify_accounts.user_id, spotify_accounts.oauth_token, spotify_accounts.last_listened_at, spotify_accounts.created_at, spotify_accounts.last_scanned_at, spotify_accounts.last_succeeded_at, spotify_accounts.failure_streak, spotify_accounts.last_scan_error FROM spotify_accounts
+INNER JOIN users ON users.id = spotify_accounts.user_id
+WHERE users.deletion_requested_at IS NULL
+AND users.disabled_at IS NULL
 `
 
 func (q *Queries) GetSpotifyAccountsForScanning(ctx context.Context) ([]SpotifyAccount, error) {
@@ -56,6 +106,83 @@ func (q *Queries) GetSpotifyAccountsForScanning(ctx context.Context) ([]SpotifyA
 			&i.OauthToken,
 			&i.LastListenedAt,
 			&i.CreatedAt,
+			&i.LastScannedAt,
+			&i.LastSucceededAt,
+			&i.FailureStreak,
+			&i.LastScanError,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLaggingSpotifyAccounts = `-- name: ListLaggingSpotifyAccounts :many
+SELECT spotify_accounts.spotify_user_id, spotify_accounts.user_id, spotify_accounts.oauth_token, spotify_accounts.last_listened_at, spotify_accounts.created_at, spotify_accounts.last_scanned_at, spotify_accounts.last_succeeded_at, spotify_accounts.failure_streak, spotify_accounts.last_scan_error FROM spotify_accounts
+INNER JOIN users ON users.id = spotify_accounts.user_id
+WHERE users.deletion_requested_at IS NULL
+AND users.disabled_at IS NULL
+ORDER BY COALESCE(spotify_accounts.last_succeeded_at, spotify_accounts.created_at), spotify_accounts.spotify_user_id
+LIMIT $1
+`
+
+func (q *Queries) ListLaggingSpotifyAccounts(ctx context.Context, limit int32) ([]SpotifyAccount, error) {
+	rows, err := q.db.Query(ctx, listLaggingSpotifyAccounts, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SpotifyAccount
+	for rows.Next() {
+		var i SpotifyAccount
+		if err := rows.Scan(
+			&i.SpotifyUserID,
+			&i.UserID,
+			&i.OauthToken,
+			&i.LastListenedAt,
+			&i.CreatedAt,
+			&i.LastScannedAt,
+			&i.LastSucceededAt,
+			&i.FailureStreak,
+			&i.LastScanError,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSpotifyAccountsForUser = `-- name: ListSpotifyAccountsForUser :many
+SELECT spotify_user_id, user_id, oauth_token, last_listened_at, created_at, last_scanned_at, last_succeeded_at, failure_streak, last_scan_error FROM spotify_accounts WHERE user_id = $1
+`
+
+func (q *Queries) ListSpotifyAccountsForUser(ctx context.Context, userID string) ([]SpotifyAccount, error) {
+	rows, err := q.db.Query(ctx, listSpotifyAccountsForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SpotifyAccount
+	for rows.Next() {
+		var i SpotifyAccount
+		if err := rows.Scan(
+			&i.SpotifyUserID,
+			&i.UserID,
+			&i.OauthToken,
+			&i.LastListenedAt,
+			&i.CreatedAt,
+			&i.LastScannedAt,
+			&i.LastSucceededAt,
+			&i.FailureStreak,
+			&i.LastScanError,
 		); err != nil {
 			return nil, err
 		}
@@ -67,8 +194,41 @@ func (q *Queries) GetSpotifyAccountsForScanning(ctx context.Context) ([]SpotifyA
 	return items, nil
 }
 
+const recordSpotifyAccountScanFailed = `-- name: RecordSpotifyAccountScanFailed :exec
+UPDATE spotify_accounts
+SET last_scanned_at = $1, failure_streak = failure_streak + 1, last_scan_error = $2
+WHERE spotify_user_id = $3
+`
+
+type RecordSpotifyAccountScanFailedParams struct {
+	LastScannedAt sql.NullTime
+	LastScanError string
+	SpotifyUserID string
+}
+
+func (q *Queries) RecordSpotifyAccountScanFailed(ctx context.Context, arg RecordSpotifyAccountScanFailedParams) error {
+	_, err := q.db.Exec(ctx, recordSpotifyAccountScanFailed, arg.LastScannedAt, arg.LastScanError, arg.SpotifyUserID)
+	return err
+}
+
+const recordSpotifyAccountScanSucceeded = `-- name: RecordSpotifyAccountScanSucceeded :exec
+UPDATE spotify_accounts
+SET last_scanned_at = $1, last_succeeded_at = $1, failure_streak = 0, last_scan_error = ''
+WHERE spotify_user_id = $2
+`
+
+type RecordSpotifyAccountScanSucceededParams struct {
+	LastScannedAt sql.NullTime
+	SpotifyUserID string
+}
+
+func (q *Queries) RecordSpotifyAccountScanSucceeded(ctx context.Context, arg RecordSpotifyAccountScanSucceededParams) error {
+	_, err := q.db.Exec(ctx, recordSpotifyAccountScanSucceeded, arg.LastScannedAt, arg.SpotifyUserID)
+	return err
+}
+
 const selectSpotifyAccountForUpdate = `-- name: SelectSpotifyAccountForUpdate :one
-SELECT spotify_user_id, user_id, oauth_token, last_listened_at, created_at FROM spotify_accounts WHERE spotify_user_id = $1 FOR UPDATE
+SELECT spotify_user_id, user_id, oauth_token, last_listened_at, created_at, last_scanned_at, last_succeeded_at, failure_streak, last_scan_error FROM spotify_accounts WHERE spotify_user_id = $1 FOR UPDATE
 `
 
 func (q *Queries) SelectSpotifyAccountForUpdate(ctx context.Context, spotifyUserID string) (SpotifyAccount, error) {
@@ -80,6 +240,10 @@ func (q *Queries) SelectSpotifyAccountForUpdate(ctx context.Context, spotifyUser
 		&i.OauthToken,
 		&i.LastListenedAt,
 		&i.CreatedAt,
+		&i.LastScannedAt,
+		&i.LastSucceededAt,
+		&i.FailureStreak,
+		&i.LastScanError,
 	)
 	return i, err
 }
@@ -0,0 +1,101 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mootslive/mono/backend/trace"
+)
+
+// sqlc can only generate queries that load every row into memory, so queries
+// that may return too many rows for that are written by hand here. Each passes
+// its rows to a callback as they're read from the connection.
+
+const streamListensForExport = `-- name: StreamListensForExport
+SELECT
+    listens.id,
+    listens.user_id,
+    listens.created_at,
+    listens.listened_at,
+    listens.isrc,
+    listens.source,
+    listens.hidden,
+    COALESCE(tracks.name, '')::TEXT AS track_name,
+    COALESCE(tracks.artist_names, '{}')::TEXT[] AS artist_names,
+    COALESCE(tracks.album_name, '')::TEXT AS album_name,
+    COALESCE(tracks.duration_ms, 0)::INTEGER AS duration_ms,
+    COALESCE(tracks.spotify_track_id, '')::TEXT AS spotify_track_id
+FROM listens
+LEFT JOIN tracks ON tracks.isrc = listens.isrc
+WHERE listens.user_id = $1
+AND listens.listened_at >= $2
+AND listens.listened_at < $3
+ORDER BY listens.listened_at, listens.id
+`
+
+type StreamListensForExportParams struct {
+	UserID    string
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// StreamListensForExportRow is a listen along with the metadata of its track.
+// The metadata is empty if we don't hold it.
+type StreamListensForExportRow struct {
+	Listen         Listen
+	TrackName      string
+	ArtistNames    []string
+	AlbumName      string
+	DurationMs     int32
+	SpotifyTrackID string
+}
+
+func (q *Queries) StreamListensForExport(
+	ctx context.Context,
+	arg StreamListensForExportParams,
+	fn func(StreamListensForExportRow) error,
+) error {
+	rows, err := q.db.Query(ctx, streamListensForExport,
+		arg.UserID,
+		arg.StartTime,
+		arg.EndTime,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var i StreamListensForExportRow
+		if err := rows.Scan(
+			&i.Listen.ID,
+			&i.Listen.UserID,
+			&i.Listen.CreatedAt,
+			&i.Listen.ListenedAt,
+			&i.Listen.Isrc,
+			&i.Listen.Source,
+			&i.Listen.Hidden,
+			&i.TrackName,
+			&i.ArtistNames,
+			&i.AlbumName,
+			&i.DurationMs,
+			&i.SpotifyTrackID,
+		); err != nil {
+			return err
+		}
+		if err := fn(i); err != nil {
+			return fmt.Errorf("handling row: %w", err)
+		}
+	}
+	return rows.Err()
+}
+
+func (q *queriesWrapper) StreamListensForExport(
+	ctx context.Context,
+	arg StreamListensForExportParams,
+	fn func(StreamListensForExportRow) error,
+) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.StreamListensForExport")
+	defer span.End()
+	return q.queries.StreamListensForExport(ctx, arg, fn)
+}
@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"time"
 )
 
@@ -15,15 +16,23 @@ INSERT INTO twitter_accounts (
     twitter_user_id,
     user_id,
     oauth_token,
-    created_at
-)  VALUES ($1, $2, $3, $4)
+    created_at,
+    name,
+    username,
+    profile_image_url,
+    profile_updated_at
+)  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 `
 
 type CreateTwitterAccountParams struct {
-	TwitterUserID string
-	UserID        string
-	OauthToken    OAuth2Token
-	CreatedAt     time.Time
+	TwitterUserID    string
+	UserID           string
+	OauthToken       OAuth2Token
+	CreatedAt        time.Time
+	Name             string
+	Username         string
+	ProfileImageUrl  string
+	ProfileUpdatedAt sql.NullTime
 }
 
 func (q *Queries) CreateTwitterAccount(ctx context.Context, arg CreateTwitterAccountParams) error {
@@ -32,12 +41,16 @@ func (q *Queries) CreateTwitterAccount(ctx context.Context, arg CreateTwitterAcc
 		arg.UserID,
 		arg.OauthToken,
 		arg.CreatedAt,
+		arg.Name,
+		arg.Username,
+		arg.ProfileImageUrl,
+		arg.ProfileUpdatedAt,
 	)
 	return err
 }
 
 const getTwitterAccount = `-- name: GetTwitterAccount :one
-SELECT twitter_user_id, user_id, oauth_token, created_at FROM twitter_accounts WHERE twitter_user_id = $1 LIMIT 1
+SELECT twitter_user_id, user_id, oauth_token, created_at, name, username, profile_image_url, profile_updated_at FROM twitter_accounts WHERE twitter_user_id = $1 LIMIT 1
 `
 
 func (q *Queries) GetTwitterAccount(ctx context.Context, twitterUserID string) (TwitterAccount, error) {
@@ -48,6 +61,122 @@ func (q *Queries) GetTwitterAccount(ctx context.Context, twitterUserID string) (
 		&i.UserID,
 		&i.OauthToken,
 		&i.CreatedAt,
+		&i.Name,
+		&i.Username,
+		&i.ProfileImageUrl,
+		&i.ProfileUpdatedAt,
 	)
 	return i, err
 }
+
+const listTwitterAccountsDueProfileRefresh = `-- name: ListTwitterAccountsDueProfileRefresh :many
+SELECT twitter_accounts.twitter_user_id, twitter_accounts.user_id, twitter_accounts.oauth_token, twitter_accounts.created_at, twitter_accounts.name, twitter_accounts.username, twitter_accounts.profile_image_url, twitter_accounts.profile_updated_at FROM twitter_accounts
+INNER JOIN users ON users.id = twitter_accounts.user_id
+WHERE users.deletion_requested_at IS NULL
+AND (
+    twitter_accounts.profile_updated_at IS NULL
+    OR twitter_accounts.profile_updated_at < $1::TIMESTAMPTZ
+)
+ORDER BY twitter_accounts.profile_updated_at NULLS FIRST
+`
+
+func (q *Queries) ListTwitterAccountsDueProfileRefresh(ctx context.Context, updatedBefore time.Time) ([]TwitterAccount, error) {
+	rows, err := q.db.Query(ctx, listTwitterAccountsDueProfileRefresh, updatedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TwitterAccount
+	for rows.Next() {
+		var i TwitterAccount
+		if err := rows.Scan(
+			&i.TwitterUserID,
+			&i.UserID,
+			&i.OauthToken,
+			&i.CreatedAt,
+			&i.Name,
+			&i.Username,
+			&i.ProfileImageUrl,
+			&i.ProfileUpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTwitterAccountsForUser = `-- name: ListTwitterAccountsForUser :many
+SELECT twitter_user_id, user_id, oauth_token, created_at, name, username, profile_image_url, profile_updated_at FROM twitter_accounts WHERE user_id = $1 ORDER BY created_at
+`
+
+func (q *Queries) ListTwitterAccountsForUser(ctx context.Context, userID string) ([]TwitterAccount, error) {
+	rows, err := q.db.Query(ctx, listTwitterAccountsForUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TwitterAccount
+	for rows.Next() {
+		var i TwitterAccount
+		if err := rows.Scan(
+			&i.TwitterUserID,
+			&i.UserID,
+			&i.OauthToken,
+			&i.CreatedAt,
+			&i.Name,
+			&i.Username,
+			&i.ProfileImageUrl,
+			&i.ProfileUpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateTwitterAccountProfile = `-- name: UpdateTwitterAccountProfile :exec
+UPDATE twitter_accounts
+SET name = $1, username = $2, profile_image_url = $3, profile_updated_at = $4
+WHERE twitter_user_id = $5
+`
+
+type UpdateTwitterAccountProfileParams struct {
+	Name             string
+	Username         string
+	ProfileImageUrl  string
+	ProfileUpdatedAt sql.NullTime
+	TwitterUserID    string
+}
+
+func (q *Queries) UpdateTwitterAccountProfile(ctx context.Context, arg UpdateTwitterAccountProfileParams) error {
+	_, err := q.db.Exec(ctx, updateTwitterAccountProfile,
+		arg.Name,
+		arg.Username,
+		arg.ProfileImageUrl,
+		arg.ProfileUpdatedAt,
+		arg.TwitterUserID,
+	)
+	return err
+}
+
+const updateTwitterAccountToken = `-- name: UpdateTwitterAccountToken :exec
+UPDATE twitter_accounts SET oauth_token = $1 WHERE twitter_user_id = $2
+`
+
+type UpdateTwitterAccountTokenParams struct {
+	OauthToken    OAuth2Token
+	TwitterUserID string
+}
+
+func (q *Queries) UpdateTwitterAccountToken(ctx context.Context, arg UpdateTwitterAccountTokenParams) error {
+	_, err := q.db.Exec(ctx, updateTwitterAccountToken, arg.OauthToken, arg.TwitterUserID)
+	return err
+}
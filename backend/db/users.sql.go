@@ -7,32 +7,271 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"time"
 )
 
 const createUser = `-- name: CreateUser :exec
 INSERT INTO users (
-    id, created_at
-) VALUES ($1, $2)
+    id, created_at, handle
+) VALUES ($1, $2, $3)
 `
 
 type CreateUserParams struct {
 	ID        string
 	CreatedAt time.Time
+	Handle    sql.NullString
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
-	_, err := q.db.Exec(ctx, createUser, arg.ID, arg.CreatedAt)
+	_, err := q.db.Exec(ctx, createUser, arg.ID, arg.CreatedAt, arg.Handle)
+	return err
+}
+
+const deleteUser = `-- name: DeleteUser :exec
+DELETE FROM users WHERE id = $1
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteUser, id)
 	return err
 }
 
 const getUser = `-- name: GetUser :one
-SELECT id, created_at FROM users WHERE id = $1
+SELECT id, created_at, deletion_requested_at, handle, display_name, bio, time_zone, is_admin, disabled_at, sessions_revoked_at FROM users WHERE id = $1
 `
 
 func (q *Queries) GetUser(ctx context.Context, id string) (User, error) {
 	row := q.db.QueryRow(ctx, getUser, id)
 	var i User
-	err := row.Scan(&i.ID, &i.CreatedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.DeletionRequestedAt,
+		&i.Handle,
+		&i.DisplayName,
+		&i.Bio,
+		&i.TimeZone,
+		&i.IsAdmin,
+		&i.DisabledAt,
+		&i.SessionsRevokedAt,
+	)
+	return i, err
+}
+
+const getUserByHandle = `-- name: GetUserByHandle :one
+SELECT id, created_at, deletion_requested_at, handle, display_name, bio, time_zone, is_admin, disabled_at, sessions_revoked_at FROM users WHERE lower(handle) = lower($1)
+`
+
+func (q *Queries) GetUserByHandle(ctx context.Context, handle string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByHandle, handle)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.DeletionRequestedAt,
+		&i.Handle,
+		&i.DisplayName,
+		&i.Bio,
+		&i.TimeZone,
+		&i.IsAdmin,
+		&i.DisabledAt,
+		&i.SessionsRevokedAt,
+	)
+	return i, err
+}
+
+const listUsers = `-- name: ListUsers :many
+SELECT id, created_at, deletion_requested_at, handle, display_name, bio, time_zone, is_admin, disabled_at, sessions_revoked_at FROM users
+WHERE (
+    $1::TEXT = ''
+    OR users.id = $1
+    OR starts_with(lower(users.handle), lower($1))
+    OR EXISTS (
+        SELECT 1 FROM twitter_accounts
+        WHERE twitter_accounts.user_id = users.id
+        AND (
+            twitter_accounts.twitter_user_id = $1
+            OR lower(twitter_accounts.username) = lower($1)
+        )
+    )
+    OR EXISTS (
+        SELECT 1 FROM spotify_accounts
+        WHERE spotify_accounts.user_id = users.id
+        AND spotify_accounts.spotify_user_id = $1
+    )
+)
+AND (users.created_at, users.id) < (
+    $2::TIMESTAMPTZ, $3::CHAR(27)
+)
+ORDER BY users.created_at DESC, users.id DESC
+LIMIT $4
+`
+
+type ListUsersParams struct {
+	Query           string
+	BeforeCreatedAt time.Time
+	BeforeID        string
+	RowLimit        int32
+}
+
+func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error) {
+	rows, err := q.db.Query(ctx, listUsers,
+		arg.Query,
+		arg.BeforeCreatedAt,
+		arg.BeforeID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.DeletionRequestedAt,
+			&i.Handle,
+			&i.DisplayName,
+			&i.Bio,
+			&i.TimeZone,
+			&i.IsAdmin,
+			&i.DisabledAt,
+			&i.SessionsRevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersDueForDeletion = `-- name: ListUsersDueForDeletion :many
+SELECT id, created_at, deletion_requested_at, handle, display_name, bio, time_zone, is_admin, disabled_at, sessions_revoked_at FROM users
+WHERE deletion_requested_at < $1::TIMESTAMPTZ
+`
+
+func (q *Queries) ListUsersDueForDeletion(ctx context.Context, requestedBefore time.Time) ([]User, error) {
+	rows, err := q.db.Query(ctx, listUsersDueForDeletion, requestedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.DeletionRequestedAt,
+			&i.Handle,
+			&i.DisplayName,
+			&i.Bio,
+			&i.TimeZone,
+			&i.IsAdmin,
+			&i.DisabledAt,
+			&i.SessionsRevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectUserForUpdate = `-- name: SelectUserForUpdate :one
+SELECT id, created_at, deletion_requested_at, handle, display_name, bio, time_zone, is_admin, disabled_at, sessions_revoked_at FROM users WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) SelectUserForUpdate(ctx context.Context, id string) (User, error) {
+	row := q.db.QueryRow(ctx, selectUserForUpdate, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.DeletionRequestedAt,
+		&i.Handle,
+		&i.DisplayName,
+		&i.Bio,
+		&i.TimeZone,
+		&i.IsAdmin,
+		&i.DisabledAt,
+		&i.SessionsRevokedAt,
+	)
 	return i, err
 }
+
+const setUserDeletionRequestedAt = `-- name: SetUserDeletionRequestedAt :exec
+UPDATE users SET deletion_requested_at = $1 WHERE id = $2
+`
+
+type SetUserDeletionRequestedAtParams struct {
+	DeletionRequestedAt sql.NullTime
+	ID                  string
+}
+
+func (q *Queries) SetUserDeletionRequestedAt(ctx context.Context, arg SetUserDeletionRequestedAtParams) error {
+	_, err := q.db.Exec(ctx, setUserDeletionRequestedAt, arg.DeletionRequestedAt, arg.ID)
+	return err
+}
+
+const setUserDisabledAt = `-- name: SetUserDisabledAt :exec
+UPDATE users SET disabled_at = $1 WHERE id = $2
+`
+
+type SetUserDisabledAtParams struct {
+	DisabledAt sql.NullTime
+	ID         string
+}
+
+func (q *Queries) SetUserDisabledAt(ctx context.Context, arg SetUserDisabledAtParams) error {
+	_, err := q.db.Exec(ctx, setUserDisabledAt, arg.DisabledAt, arg.ID)
+	return err
+}
+
+const setUserSessionsRevokedAt = `-- name: SetUserSessionsRevokedAt :exec
+UPDATE users SET sessions_revoked_at = $1 WHERE id = $2
+`
+
+type SetUserSessionsRevokedAtParams struct {
+	SessionsRevokedAt sql.NullTime
+	ID                string
+}
+
+func (q *Queries) SetUserSessionsRevokedAt(ctx context.Context, arg SetUserSessionsRevokedAtParams) error {
+	_, err := q.db.Exec(ctx, setUserSessionsRevokedAt, arg.SessionsRevokedAt, arg.ID)
+	return err
+}
+
+const updateUserProfile = `-- name: UpdateUserProfile :exec
+UPDATE users
+SET handle = $1, display_name = $2, bio = $3, time_zone = $4
+WHERE id = $5
+`
+
+type UpdateUserProfileParams struct {
+	Handle      sql.NullString
+	DisplayName string
+	Bio         string
+	TimeZone    string
+	ID          string
+}
+
+func (q *Queries) UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) error {
+	_, err := q.db.Exec(ctx, updateUserProfile,
+		arg.Handle,
+		arg.DisplayName,
+		arg.Bio,
+		arg.TimeZone,
+		arg.ID,
+	)
+	return err
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/jackc/pgx/v4"
 	"github.com/mootslive/mono/backend/trace"
+	"time"
 )
 
 // What you observe here is pretty horrible.
@@ -25,6 +26,10 @@ type dbtxer interface {
 
 type TXQuerier interface {
 	Querier
+	// StreamListensForExport is written by hand, see streams.go.
+	StreamListensForExport(ctx context.Context, arg StreamListensForExportParams, fn func(StreamListensForExportRow) error) error
+	// GetSchemaMigration is written by hand, see migrations.go.
+	GetSchemaMigration(ctx context.Context) (SchemaMigration, error)
 	BeginTx(ctx context.Context) (func(ctx context.Context) error, func(ctx context.Context) error, TXQuerier, error)
 }
 
@@ -45,7 +50,7 @@ func NewQueries(db dbtxer) TXQuerier {
 func (q *queriesWrapper) BeginTx(ctx context.Context) (func(ctx context.Context) error, func(ctx context.Context) error, TXQuerier, error) {
 	tx, err := q.dbtx.Begin(ctx)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("beginning transaction: %w")
+		return nil, nil, nil, fmt.Errorf("beginning transaction: %w", err)
 	}
 
 	return tx.Commit, tx.Rollback, NewQueries(tx), nil
@@ -101,14 +106,1022 @@ func (q *queriesWrapper) GetTwitterAccount(ctx context.Context, twitterUserID st
 	return q.queries.GetTwitterAccount(ctx, twitterUserID)
 }
 
-func (q *queriesWrapper) ListListensForUser(ctx context.Context, userID string) ([]Listen, error) {
-	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListListensForUser")
-	defer span.End()
-	return q.queries.ListListensForUser(ctx, userID)
-}
-
 func (q *queriesWrapper) UpdateSpotifyAccountListenedAt(ctx context.Context, arg UpdateSpotifyAccountListenedAtParams) error {
 	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.UpdateSpotifyAccountListenedAt")
 	defer span.End()
 	return q.queries.UpdateSpotifyAccountListenedAt(ctx, arg)
 }
+
+func (q *queriesWrapper) DeleteUser(ctx context.Context, id string) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteUser")
+	defer span.End()
+	return q.queries.DeleteUser(ctx, id)
+}
+
+func (q *queriesWrapper) ListListensForUserAfterID(ctx context.Context, arg ListListensForUserAfterIDParams) ([]Listen, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListListensForUserAfterID")
+	defer span.End()
+	return q.queries.ListListensForUserAfterID(ctx, arg)
+}
+
+func (q *queriesWrapper) ListSpotifyAccountsForUser(ctx context.Context, userID string) ([]SpotifyAccount, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListSpotifyAccountsForUser")
+	defer span.End()
+	return q.queries.ListSpotifyAccountsForUser(ctx, userID)
+}
+
+func (q *queriesWrapper) ListTwitterAccountsForUser(ctx context.Context, userID string) ([]TwitterAccount, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListTwitterAccountsForUser")
+	defer span.End()
+	return q.queries.ListTwitterAccountsForUser(ctx, userID)
+}
+
+func (q *queriesWrapper) ListUsersDueForDeletion(ctx context.Context, requestedBefore time.Time) ([]User, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListUsersDueForDeletion")
+	defer span.End()
+	return q.queries.ListUsersDueForDeletion(ctx, requestedBefore)
+}
+
+func (q *queriesWrapper) SelectUserForUpdate(ctx context.Context, id string) (User, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.SelectUserForUpdate")
+	defer span.End()
+	return q.queries.SelectUserForUpdate(ctx, id)
+}
+
+func (q *queriesWrapper) SetUserDeletionRequestedAt(ctx context.Context, arg SetUserDeletionRequestedAtParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.SetUserDeletionRequestedAt")
+	defer span.End()
+	return q.queries.SetUserDeletionRequestedAt(ctx, arg)
+}
+
+func (q *queriesWrapper) CreateTweet(ctx context.Context, arg CreateTweetParams) (int64, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.CreateTweet")
+	defer span.End()
+	return q.queries.CreateTweet(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteTweet(ctx context.Context, id string) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteTweet")
+	defer span.End()
+	return q.queries.DeleteTweet(ctx, id)
+}
+
+func (q *queriesWrapper) GetListenForUser(ctx context.Context, arg GetListenForUserParams) (Listen, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.GetListenForUser")
+	defer span.End()
+	return q.queries.GetListenForUser(ctx, arg)
+}
+
+func (q *queriesWrapper) GetTwitterSharingSettings(ctx context.Context, userID string) (TwitterSharingSetting, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.GetTwitterSharingSettings")
+	defer span.End()
+	return q.queries.GetTwitterSharingSettings(ctx, userID)
+}
+
+func (q *queriesWrapper) ListTopISRCsForUser(ctx context.Context, arg ListTopISRCsForUserParams) ([]ListTopISRCsForUserRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListTopISRCsForUser")
+	defer span.End()
+	return q.queries.ListTopISRCsForUser(ctx, arg)
+}
+
+func (q *queriesWrapper) ListUsersDueWeeklyRecap(ctx context.Context, recapWeek string) ([]string, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListUsersDueWeeklyRecap")
+	defer span.End()
+	return q.queries.ListUsersDueWeeklyRecap(ctx, recapWeek)
+}
+
+func (q *queriesWrapper) SetTweetPosted(ctx context.Context, arg SetTweetPostedParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.SetTweetPosted")
+	defer span.End()
+	return q.queries.SetTweetPosted(ctx, arg)
+}
+
+func (q *queriesWrapper) UpdateTwitterAccountToken(ctx context.Context, arg UpdateTwitterAccountTokenParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.UpdateTwitterAccountToken")
+	defer span.End()
+	return q.queries.UpdateTwitterAccountToken(ctx, arg)
+}
+
+func (q *queriesWrapper) UpsertTwitterSharingSettings(ctx context.Context, arg UpsertTwitterSharingSettingsParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.UpsertTwitterSharingSettings")
+	defer span.End()
+	return q.queries.UpsertTwitterSharingSettings(ctx, arg)
+}
+
+func (q *queriesWrapper) CompleteTwitterFollowSync(ctx context.Context, arg CompleteTwitterFollowSyncParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.CompleteTwitterFollowSync")
+	defer span.End()
+	return q.queries.CompleteTwitterFollowSync(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteStaleTwitterFollowers(ctx context.Context, arg DeleteStaleTwitterFollowersParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteStaleTwitterFollowers")
+	defer span.End()
+	return q.queries.DeleteStaleTwitterFollowers(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteStaleTwitterFollowing(ctx context.Context, arg DeleteStaleTwitterFollowingParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteStaleTwitterFollowing")
+	defer span.End()
+	return q.queries.DeleteStaleTwitterFollowing(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteTwitterFollowsForAccount(ctx context.Context, followerID string) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteTwitterFollowsForAccount")
+	defer span.End()
+	return q.queries.DeleteTwitterFollowsForAccount(ctx, followerID)
+}
+
+func (q *queriesWrapper) ListTwitterFollowSyncsDue(ctx context.Context, completedBefore time.Time) ([]TwitterFollowSync, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListTwitterFollowSyncsDue")
+	defer span.End()
+	return q.queries.ListTwitterFollowSyncsDue(ctx, completedBefore)
+}
+
+func (q *queriesWrapper) ListTwitterMootsForUser(ctx context.Context, userID string) ([]ListTwitterMootsForUserRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListTwitterMootsForUser")
+	defer span.End()
+	return q.queries.ListTwitterMootsForUser(ctx, userID)
+}
+
+func (q *queriesWrapper) RequestTwitterFollowSync(ctx context.Context, arg RequestTwitterFollowSyncParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.RequestTwitterFollowSync")
+	defer span.End()
+	return q.queries.RequestTwitterFollowSync(ctx, arg)
+}
+
+func (q *queriesWrapper) SelectTwitterFollowSyncForUpdate(ctx context.Context, arg SelectTwitterFollowSyncForUpdateParams) (TwitterFollowSync, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.SelectTwitterFollowSyncForUpdate")
+	defer span.End()
+	return q.queries.SelectTwitterFollowSyncForUpdate(ctx, arg)
+}
+
+func (q *queriesWrapper) UpdateTwitterFollowSyncProgress(ctx context.Context, arg UpdateTwitterFollowSyncProgressParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.UpdateTwitterFollowSyncProgress")
+	defer span.End()
+	return q.queries.UpdateTwitterFollowSyncProgress(ctx, arg)
+}
+
+func (q *queriesWrapper) UpsertTwitterFollows(ctx context.Context, arg UpsertTwitterFollowsParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.UpsertTwitterFollows")
+	defer span.End()
+	return q.queries.UpsertTwitterFollows(ctx, arg)
+}
+
+func (q *queriesWrapper) UpdateTwitterAccountProfile(ctx context.Context, arg UpdateTwitterAccountProfileParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.UpdateTwitterAccountProfile")
+	defer span.End()
+	return q.queries.UpdateTwitterAccountProfile(ctx, arg)
+}
+
+func (q *queriesWrapper) ListTwitterAccountsDueProfileRefresh(ctx context.Context, updatedBefore time.Time) ([]TwitterAccount, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListTwitterAccountsDueProfileRefresh")
+	defer span.End()
+	return q.queries.ListTwitterAccountsDueProfileRefresh(ctx, updatedBefore)
+}
+
+func (q *queriesWrapper) AcceptAllFollowRequests(ctx context.Context, arg AcceptAllFollowRequestsParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.AcceptAllFollowRequests")
+	defer span.End()
+	return q.queries.AcceptAllFollowRequests(ctx, arg)
+}
+
+func (q *queriesWrapper) AcceptFollowRequest(ctx context.Context, arg AcceptFollowRequestParams) (int64, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.AcceptFollowRequest")
+	defer span.End()
+	return q.queries.AcceptFollowRequest(ctx, arg)
+}
+
+func (q *queriesWrapper) CreateFollow(ctx context.Context, arg CreateFollowParams) (int64, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.CreateFollow")
+	defer span.End()
+	return q.queries.CreateFollow(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteFollow(ctx context.Context, arg DeleteFollowParams) (int64, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteFollow")
+	defer span.End()
+	return q.queries.DeleteFollow(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteFollowRequest(ctx context.Context, arg DeleteFollowRequestParams) (int64, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteFollowRequest")
+	defer span.End()
+	return q.queries.DeleteFollowRequest(ctx, arg)
+}
+
+func (q *queriesWrapper) GetFollow(ctx context.Context, arg GetFollowParams) (Follow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.GetFollow")
+	defer span.End()
+	return q.queries.GetFollow(ctx, arg)
+}
+
+func (q *queriesWrapper) GetFollowSettings(ctx context.Context, userID string) (FollowSetting, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.GetFollowSettings")
+	defer span.End()
+	return q.queries.GetFollowSettings(ctx, userID)
+}
+
+func (q *queriesWrapper) ListFollowRequests(ctx context.Context, followeeID string) ([]Follow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListFollowRequests")
+	defer span.End()
+	return q.queries.ListFollowRequests(ctx, followeeID)
+}
+
+func (q *queriesWrapper) ListFollowers(ctx context.Context, followeeID string) ([]Follow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListFollowers")
+	defer span.End()
+	return q.queries.ListFollowers(ctx, followeeID)
+}
+
+func (q *queriesWrapper) ListFollowing(ctx context.Context, followerID string) ([]Follow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListFollowing")
+	defer span.End()
+	return q.queries.ListFollowing(ctx, followerID)
+}
+
+func (q *queriesWrapper) ListMootIDsForUser(ctx context.Context, userID string) ([]string, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListMootIDsForUser")
+	defer span.End()
+	return q.queries.ListMootIDsForUser(ctx, userID)
+}
+
+func (q *queriesWrapper) UpsertFollowSettings(ctx context.Context, arg UpsertFollowSettingsParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.UpsertFollowSettings")
+	defer span.End()
+	return q.queries.UpsertFollowSettings(ctx, arg)
+}
+
+func (q *queriesWrapper) ListKnownTrackISRCs(ctx context.Context, isrcs []string) ([]string, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListKnownTrackISRCs")
+	defer span.End()
+	return q.queries.ListKnownTrackISRCs(ctx, isrcs)
+}
+
+func (q *queriesWrapper) ListTracks(ctx context.Context, isrcs []string) ([]Track, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListTracks")
+	defer span.End()
+	return q.queries.ListTracks(ctx, isrcs)
+}
+
+func (q *queriesWrapper) UpsertTrack(ctx context.Context, arg UpsertTrackParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.UpsertTrack")
+	defer span.End()
+	return q.queries.UpsertTrack(ctx, arg)
+}
+
+func (q *queriesWrapper) ListFeedListens(ctx context.Context, arg ListFeedListensParams) ([]ListFeedListensRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListFeedListens")
+	defer span.End()
+	return q.queries.ListFeedListens(ctx, arg)
+}
+
+func (q *queriesWrapper) ListUserSummaries(ctx context.Context, userIds []string) ([]ListUserSummariesRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListUserSummaries")
+	defer span.End()
+	return q.queries.ListUserSummaries(ctx, userIds)
+}
+
+func (q *queriesWrapper) ListListensCreatedSince(ctx context.Context, arg ListListensCreatedSinceParams) ([]Listen, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListListensCreatedSince")
+	defer span.End()
+	return q.queries.ListListensCreatedSince(ctx, arg)
+}
+
+func (q *queriesWrapper) NotifyEvent(ctx context.Context, payload string) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.NotifyEvent")
+	defer span.End()
+	return q.queries.NotifyEvent(ctx, payload)
+}
+
+func (q *queriesWrapper) ListTrackPlaysForUser(ctx context.Context, arg ListTrackPlaysForUserParams) ([]ListTrackPlaysForUserRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListTrackPlaysForUser")
+	defer span.End()
+	return q.queries.ListTrackPlaysForUser(ctx, arg)
+}
+
+func (q *queriesWrapper) GetCompatibilityScore(ctx context.Context, arg GetCompatibilityScoreParams) (CompatibilityScore, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.GetCompatibilityScore")
+	defer span.End()
+	return q.queries.GetCompatibilityScore(ctx, arg)
+}
+
+func (q *queriesWrapper) MarkCompatibilityScoresStale(ctx context.Context, userID string) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.MarkCompatibilityScoresStale")
+	defer span.End()
+	return q.queries.MarkCompatibilityScoresStale(ctx, userID)
+}
+
+func (q *queriesWrapper) UpsertCompatibilityScore(ctx context.Context, arg UpsertCompatibilityScoreParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.UpsertCompatibilityScore")
+	defer span.End()
+	return q.queries.UpsertCompatibilityScore(ctx, arg)
+}
+
+func (q *queriesWrapper) ListLatestListens(ctx context.Context, userIds []string) ([]Listen, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListLatestListens")
+	defer span.End()
+	return q.queries.ListLatestListens(ctx, userIds)
+}
+
+func (q *queriesWrapper) SetListenHidden(ctx context.Context, arg SetListenHiddenParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.SetListenHidden")
+	defer span.End()
+	return q.queries.SetListenHidden(ctx, arg)
+}
+
+func (q *queriesWrapper) ListFeedUserIDs(ctx context.Context, userID string) ([]string, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListFeedUserIDs")
+	defer span.End()
+	return q.queries.ListFeedUserIDs(ctx, userID)
+}
+
+func (q *queriesWrapper) DeleteEmptyDailyTrackPlays(ctx context.Context, arg DeleteEmptyDailyTrackPlaysParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteEmptyDailyTrackPlays")
+	defer span.End()
+	return q.queries.DeleteEmptyDailyTrackPlays(ctx, arg)
+}
+
+func (q *queriesWrapper) LockRollupsForUser(ctx context.Context, userID string) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.LockRollupsForUser")
+	defer span.End()
+	return q.queries.LockRollupsForUser(ctx, userID)
+}
+
+func (q *queriesWrapper) CreateIncognitoWindow(ctx context.Context, arg CreateIncognitoWindowParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.CreateIncognitoWindow")
+	defer span.End()
+	return q.queries.CreateIncognitoWindow(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteIncognitoWindow(ctx context.Context, arg DeleteIncognitoWindowParams) (IncognitoWindow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteIncognitoWindow")
+	defer span.End()
+	return q.queries.DeleteIncognitoWindow(ctx, arg)
+}
+
+func (q *queriesWrapper) GetPrivacySettings(ctx context.Context, userID string) (PrivacySetting, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.GetPrivacySettings")
+	defer span.End()
+	return q.queries.GetPrivacySettings(ctx, userID)
+}
+
+func (q *queriesWrapper) ListIncognitoWindowsForUser(ctx context.Context, userID string) ([]IncognitoWindow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListIncognitoWindowsForUser")
+	defer span.End()
+	return q.queries.ListIncognitoWindowsForUser(ctx, userID)
+}
+
+func (q *queriesWrapper) ListIncognitoWindowsForUsers(ctx context.Context, userIds []string) ([]IncognitoWindow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListIncognitoWindowsForUsers")
+	defer span.End()
+	return q.queries.ListIncognitoWindowsForUsers(ctx, userIds)
+}
+
+func (q *queriesWrapper) ListPrivacySettings(ctx context.Context, userIds []string) ([]PrivacySetting, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListPrivacySettings")
+	defer span.End()
+	return q.queries.ListPrivacySettings(ctx, userIds)
+}
+
+func (q *queriesWrapper) UpsertPrivacySettings(ctx context.Context, arg UpsertPrivacySettingsParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.UpsertPrivacySettings")
+	defer span.End()
+	return q.queries.UpsertPrivacySettings(ctx, arg)
+}
+
+func (q *queriesWrapper) CountReactionsByUserForListen(ctx context.Context, arg CountReactionsByUserForListenParams) (int64, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.CountReactionsByUserForListen")
+	defer span.End()
+	return q.queries.CountReactionsByUserForListen(ctx, arg)
+}
+
+func (q *queriesWrapper) CreateReaction(ctx context.Context, arg CreateReactionParams) (int64, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.CreateReaction")
+	defer span.End()
+	return q.queries.CreateReaction(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteReaction(ctx context.Context, arg DeleteReactionParams) (int64, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteReaction")
+	defer span.End()
+	return q.queries.DeleteReaction(ctx, arg)
+}
+
+func (q *queriesWrapper) ListReactionCounts(ctx context.Context, arg ListReactionCountsParams) ([]ListReactionCountsRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListReactionCounts")
+	defer span.End()
+	return q.queries.ListReactionCounts(ctx, arg)
+}
+
+func (q *queriesWrapper) ListReactionsForUser(ctx context.Context, userID string) ([]Reaction, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListReactionsForUser")
+	defer span.End()
+	return q.queries.ListReactionsForUser(ctx, userID)
+}
+
+func (q *queriesWrapper) CreateComment(ctx context.Context, arg CreateCommentParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.CreateComment")
+	defer span.End()
+	return q.queries.CreateComment(ctx, arg)
+}
+
+func (q *queriesWrapper) CreateCommentFlag(ctx context.Context, arg CreateCommentFlagParams) (int64, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.CreateCommentFlag")
+	defer span.End()
+	return q.queries.CreateCommentFlag(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteComment(ctx context.Context, id string) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteComment")
+	defer span.End()
+	return q.queries.DeleteComment(ctx, id)
+}
+
+func (q *queriesWrapper) GetComment(ctx context.Context, id string) (Comment, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.GetComment")
+	defer span.End()
+	return q.queries.GetComment(ctx, id)
+}
+
+func (q *queriesWrapper) IncrementCommentFlagCount(ctx context.Context, id string) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.IncrementCommentFlagCount")
+	defer span.End()
+	return q.queries.IncrementCommentFlagCount(ctx, id)
+}
+
+func (q *queriesWrapper) ListCommentsForListen(ctx context.Context, arg ListCommentsForListenParams) ([]Comment, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListCommentsForListen")
+	defer span.End()
+	return q.queries.ListCommentsForListen(ctx, arg)
+}
+
+func (q *queriesWrapper) ListCommentsForUser(ctx context.Context, userID string) ([]Comment, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListCommentsForUser")
+	defer span.End()
+	return q.queries.ListCommentsForUser(ctx, userID)
+}
+
+func (q *queriesWrapper) GetListen(ctx context.Context, id string) (Listen, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.GetListen")
+	defer span.End()
+	return q.queries.GetListen(ctx, id)
+}
+
+func (q *queriesWrapper) CountUnreadNotifications(ctx context.Context, userID string) (int64, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.CountUnreadNotifications")
+	defer span.End()
+	return q.queries.CountUnreadNotifications(ctx, userID)
+}
+
+func (q *queriesWrapper) ListNotificationPreferences(ctx context.Context, userID string) ([]NotificationPreference, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListNotificationPreferences")
+	defer span.End()
+	return q.queries.ListNotificationPreferences(ctx, userID)
+}
+
+func (q *queriesWrapper) ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]Notification, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListNotifications")
+	defer span.End()
+	return q.queries.ListNotifications(ctx, arg)
+}
+
+func (q *queriesWrapper) ListNotificationsUpdatedSince(ctx context.Context, arg ListNotificationsUpdatedSinceParams) ([]Notification, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListNotificationsUpdatedSince")
+	defer span.End()
+	return q.queries.ListNotificationsUpdatedSince(ctx, arg)
+}
+
+func (q *queriesWrapper) MarkAllNotificationsRead(ctx context.Context, arg MarkAllNotificationsReadParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.MarkAllNotificationsRead")
+	defer span.End()
+	return q.queries.MarkAllNotificationsRead(ctx, arg)
+}
+
+func (q *queriesWrapper) MarkNotificationsRead(ctx context.Context, arg MarkNotificationsReadParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.MarkNotificationsRead")
+	defer span.End()
+	return q.queries.MarkNotificationsRead(ctx, arg)
+}
+
+func (q *queriesWrapper) UpsertNotification(ctx context.Context, arg UpsertNotificationParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.UpsertNotification")
+	defer span.End()
+	return q.queries.UpsertNotification(ctx, arg)
+}
+
+func (q *queriesWrapper) UpsertNotificationPreference(ctx context.Context, arg UpsertNotificationPreferenceParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.UpsertNotificationPreference")
+	defer span.End()
+	return q.queries.UpsertNotificationPreference(ctx, arg)
+}
+
+func (q *queriesWrapper) ListRecentListensOfTrackByMoots(ctx context.Context, arg ListRecentListensOfTrackByMootsParams) ([]Listen, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListRecentListensOfTrackByMoots")
+	defer span.End()
+	return q.queries.ListRecentListensOfTrackByMoots(ctx, arg)
+}
+
+func (q *queriesWrapper) GetUserByHandle(ctx context.Context, handle string) (User, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.GetUserByHandle")
+	defer span.End()
+	return q.queries.GetUserByHandle(ctx, handle)
+}
+
+func (q *queriesWrapper) UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.UpdateUserProfile")
+	defer span.End()
+	return q.queries.UpdateUserProfile(ctx, arg)
+}
+
+func (q *queriesWrapper) ListRecentListensForUser(ctx context.Context, arg ListRecentListensForUserParams) ([]Listen, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListRecentListensForUser")
+	defer span.End()
+	return q.queries.ListRecentListensForUser(ctx, arg)
+}
+
+func (q *queriesWrapper) CreateBlock(ctx context.Context, arg CreateBlockParams) (int64, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.CreateBlock")
+	defer span.End()
+	return q.queries.CreateBlock(ctx, arg)
+}
+
+func (q *queriesWrapper) CreateMute(ctx context.Context, arg CreateMuteParams) (int64, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.CreateMute")
+	defer span.End()
+	return q.queries.CreateMute(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteBlock(ctx context.Context, arg DeleteBlockParams) (int64, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteBlock")
+	defer span.End()
+	return q.queries.DeleteBlock(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteMute(ctx context.Context, arg DeleteMuteParams) (int64, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteMute")
+	defer span.End()
+	return q.queries.DeleteMute(ctx, arg)
+}
+
+func (q *queriesWrapper) ListBlockedUserIDs(ctx context.Context, userID string) ([]string, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListBlockedUserIDs")
+	defer span.End()
+	return q.queries.ListBlockedUserIDs(ctx, userID)
+}
+
+func (q *queriesWrapper) ListBlocksForUser(ctx context.Context, blockerID string) ([]Block, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListBlocksForUser")
+	defer span.End()
+	return q.queries.ListBlocksForUser(ctx, blockerID)
+}
+
+func (q *queriesWrapper) ListMutedUserIDs(ctx context.Context, userID string) ([]string, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListMutedUserIDs")
+	defer span.End()
+	return q.queries.ListMutedUserIDs(ctx, userID)
+}
+
+func (q *queriesWrapper) ListMutesForUser(ctx context.Context, userID string) ([]Mute, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListMutesForUser")
+	defer span.End()
+	return q.queries.ListMutesForUser(ctx, userID)
+}
+
+func (q *queriesWrapper) ListTopAlbums(ctx context.Context, arg ListTopAlbumsParams) ([]ListTopAlbumsRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListTopAlbums")
+	defer span.End()
+	return q.queries.ListTopAlbums(ctx, arg)
+}
+
+func (q *queriesWrapper) ListTopArtists(ctx context.Context, arg ListTopArtistsParams) ([]ListTopArtistsRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListTopArtists")
+	defer span.End()
+	return q.queries.ListTopArtists(ctx, arg)
+}
+
+func (q *queriesWrapper) ListTopTracks(ctx context.Context, arg ListTopTracksParams) ([]ListTopTracksRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListTopTracks")
+	defer span.End()
+	return q.queries.ListTopTracks(ctx, arg)
+}
+
+func (q *queriesWrapper) GetListeningTotals(ctx context.Context, arg GetListeningTotalsParams) (GetListeningTotalsRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.GetListeningTotals")
+	defer span.End()
+	return q.queries.GetListeningTotals(ctx, arg)
+}
+
+func (q *queriesWrapper) ListDailyPlays(ctx context.Context, arg ListDailyPlaysParams) ([]ListDailyPlaysRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListDailyPlays")
+	defer span.End()
+	return q.queries.ListDailyPlays(ctx, arg)
+}
+
+func (q *queriesWrapper) ListHourlyPlays(ctx context.Context, arg ListHourlyPlaysParams) ([]ListHourlyPlaysRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListHourlyPlays")
+	defer span.End()
+	return q.queries.ListHourlyPlays(ctx, arg)
+}
+
+func (q *queriesWrapper) ListListeningDays(ctx context.Context, arg ListListeningDaysParams) ([]time.Time, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListListeningDays")
+	defer span.End()
+	return q.queries.ListListeningDays(ctx, arg)
+}
+
+func (q *queriesWrapper) CreateYearlyRecap(ctx context.Context, arg CreateYearlyRecapParams) (YearlyRecap, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.CreateYearlyRecap")
+	defer span.End()
+	return q.queries.CreateYearlyRecap(ctx, arg)
+}
+
+func (q *queriesWrapper) GetLatestYearlyRecap(ctx context.Context, arg GetLatestYearlyRecapParams) (YearlyRecap, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.GetLatestYearlyRecap")
+	defer span.End()
+	return q.queries.GetLatestYearlyRecap(ctx, arg)
+}
+
+func (q *queriesWrapper) ListListensForUserBetween(ctx context.Context, arg ListListensForUserBetweenParams) ([]Listen, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListListensForUserBetween")
+	defer span.End()
+	return q.queries.ListListensForUserBetween(ctx, arg)
+}
+
+func (q *queriesWrapper) ListPlaysForUsers(ctx context.Context, arg ListPlaysForUsersParams) ([]ListPlaysForUsersRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListPlaysForUsers")
+	defer span.End()
+	return q.queries.ListPlaysForUsers(ctx, arg)
+}
+
+func (q *queriesWrapper) ListUsersDueYearlyRecap(ctx context.Context, arg ListUsersDueYearlyRecapParams) ([]string, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListUsersDueYearlyRecap")
+	defer span.End()
+	return q.queries.ListUsersDueYearlyRecap(ctx, arg)
+}
+
+func (q *queriesWrapper) CreateChart(ctx context.Context, arg CreateChartParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.CreateChart")
+	defer span.End()
+	return q.queries.CreateChart(ctx, arg)
+}
+
+func (q *queriesWrapper) CreateChartEntry(ctx context.Context, arg CreateChartEntryParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.CreateChartEntry")
+	defer span.End()
+	return q.queries.CreateChartEntry(ctx, arg)
+}
+
+func (q *queriesWrapper) GetChart(ctx context.Context, arg GetChartParams) (Chart, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.GetChart")
+	defer span.End()
+	return q.queries.GetChart(ctx, arg)
+}
+
+func (q *queriesWrapper) GetLatestChart(ctx context.Context, arg GetLatestChartParams) (Chart, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.GetLatestChart")
+	defer span.End()
+	return q.queries.GetLatestChart(ctx, arg)
+}
+
+func (q *queriesWrapper) ListChartArtists(ctx context.Context, arg ListChartArtistsParams) ([]ListChartArtistsRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListChartArtists")
+	defer span.End()
+	return q.queries.ListChartArtists(ctx, arg)
+}
+
+func (q *queriesWrapper) ListChartEntries(ctx context.Context, chartID string) ([]ChartEntry, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListChartEntries")
+	defer span.End()
+	return q.queries.ListChartEntries(ctx, chartID)
+}
+
+func (q *queriesWrapper) ListChartTracks(ctx context.Context, arg ListChartTracksParams) ([]ListChartTracksRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListChartTracks")
+	defer span.End()
+	return q.queries.ListChartTracks(ctx, arg)
+}
+
+func (q *queriesWrapper) ListChartedItemIDs(ctx context.Context, arg ListChartedItemIDsParams) ([]string, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListChartedItemIDs")
+	defer span.End()
+	return q.queries.ListChartedItemIDs(ctx, arg)
+}
+
+func (q *queriesWrapper) ListUsersDueMootsChart(ctx context.Context, arg ListUsersDueMootsChartParams) ([]string, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListUsersDueMootsChart")
+	defer span.End()
+	return q.queries.ListUsersDueMootsChart(ctx, arg)
+}
+
+func (q *queriesWrapper) ListListensPage(ctx context.Context, arg ListListensPageParams) ([]Listen, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListListensPage")
+	defer span.End()
+	return q.queries.ListListensPage(ctx, arg)
+}
+
+func (q *queriesWrapper) ListListensPageAscending(ctx context.Context, arg ListListensPageAscendingParams) ([]Listen, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListListensPageAscending")
+	defer span.End()
+	return q.queries.ListListensPageAscending(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteListen(ctx context.Context, arg DeleteListenParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteListen")
+	defer span.End()
+	return q.queries.DeleteListen(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteListensForUserBetween(ctx context.Context, arg DeleteListensForUserBetweenParams) (int64, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteListensForUserBetween")
+	defer span.End()
+	return q.queries.DeleteListensForUserBetween(ctx, arg)
+}
+
+func (q *queriesWrapper) UpdateListen(ctx context.Context, arg UpdateListenParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.UpdateListen")
+	defer span.End()
+	return q.queries.UpdateListen(ctx, arg)
+}
+
+func (q *queriesWrapper) CreateListenEdit(ctx context.Context, arg CreateListenEditParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.CreateListenEdit")
+	defer span.End()
+	return q.queries.CreateListenEdit(ctx, arg)
+}
+
+func (q *queriesWrapper) ListListenEdits(ctx context.Context, arg ListListenEditsParams) ([]ListenEdit, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListListenEdits")
+	defer span.End()
+	return q.queries.ListListenEdits(ctx, arg)
+}
+
+func (q *queriesWrapper) ListListenEditsForUser(ctx context.Context, userID string) ([]ListenEdit, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListListenEditsForUser")
+	defer span.End()
+	return q.queries.ListListenEditsForUser(ctx, userID)
+}
+
+func (q *queriesWrapper) GetSpotifyAccount(ctx context.Context, spotifyUserID string) (SpotifyAccount, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.GetSpotifyAccount")
+	defer span.End()
+	return q.queries.GetSpotifyAccount(ctx, spotifyUserID)
+}
+
+func (q *queriesWrapper) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListUsers")
+	defer span.End()
+	return q.queries.ListUsers(ctx, arg)
+}
+
+func (q *queriesWrapper) SetUserDisabledAt(ctx context.Context, arg SetUserDisabledAtParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.SetUserDisabledAt")
+	defer span.End()
+	return q.queries.SetUserDisabledAt(ctx, arg)
+}
+
+func (q *queriesWrapper) SetUserSessionsRevokedAt(ctx context.Context, arg SetUserSessionsRevokedAtParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.SetUserSessionsRevokedAt")
+	defer span.End()
+	return q.queries.SetUserSessionsRevokedAt(ctx, arg)
+}
+
+func (q *queriesWrapper) GetPollerSummary(ctx context.Context, overdueBefore time.Time) (GetPollerSummaryRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.GetPollerSummary")
+	defer span.End()
+	return q.queries.GetPollerSummary(ctx, overdueBefore)
+}
+
+func (q *queriesWrapper) ListLaggingSpotifyAccounts(ctx context.Context, limit int32) ([]SpotifyAccount, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListLaggingSpotifyAccounts")
+	defer span.End()
+	return q.queries.ListLaggingSpotifyAccounts(ctx, limit)
+}
+
+func (q *queriesWrapper) RecordSpotifyAccountScanFailed(ctx context.Context, arg RecordSpotifyAccountScanFailedParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.RecordSpotifyAccountScanFailed")
+	defer span.End()
+	return q.queries.RecordSpotifyAccountScanFailed(ctx, arg)
+}
+
+func (q *queriesWrapper) RecordSpotifyAccountScanSucceeded(ctx context.Context, arg RecordSpotifyAccountScanSucceededParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.RecordSpotifyAccountScanSucceeded")
+	defer span.End()
+	return q.queries.RecordSpotifyAccountScanSucceeded(ctx, arg)
+}
+
+func (q *queriesWrapper) CreateSpotifyScan(ctx context.Context, arg CreateSpotifyScanParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.CreateSpotifyScan")
+	defer span.End()
+	return q.queries.CreateSpotifyScan(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteSpotifyScansBefore(ctx context.Context, startedAt time.Time) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteSpotifyScansBefore")
+	defer span.End()
+	return q.queries.DeleteSpotifyScansBefore(ctx, startedAt)
+}
+
+func (q *queriesWrapper) FinishSpotifyScan(ctx context.Context, arg FinishSpotifyScanParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.FinishSpotifyScan")
+	defer span.End()
+	return q.queries.FinishSpotifyScan(ctx, arg)
+}
+
+func (q *queriesWrapper) ListPollerReplicaThroughput(ctx context.Context, startedAt time.Time) ([]ListPollerReplicaThroughputRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListPollerReplicaThroughput")
+	defer span.End()
+	return q.queries.ListPollerReplicaThroughput(ctx, startedAt)
+}
+
+func (q *queriesWrapper) ListSpotifyScans(ctx context.Context, arg ListSpotifyScansParams) ([]SpotifyScan, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListSpotifyScans")
+	defer span.End()
+	return q.queries.ListSpotifyScans(ctx, arg)
+}
+
+func (q *queriesWrapper) CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.CreateAuditEvent")
+	defer span.End()
+	return q.queries.CreateAuditEvent(ctx, arg)
+}
+
+func (q *queriesWrapper) ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListAuditEvents")
+	defer span.End()
+	return q.queries.ListAuditEvents(ctx, arg)
+}
+
+func (q *queriesWrapper) ListAuditEventsForUser(ctx context.Context, arg ListAuditEventsForUserParams) ([]AuditEvent, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListAuditEventsForUser")
+	defer span.End()
+	return q.queries.ListAuditEventsForUser(ctx, arg)
+}
+
+func (q *queriesWrapper) GetTwitterFollowSync(ctx context.Context, arg GetTwitterFollowSyncParams) (TwitterFollowSync, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.GetTwitterFollowSync")
+	defer span.End()
+	return q.queries.GetTwitterFollowSync(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteDailyTrackPlaysBetween(ctx context.Context, arg DeleteDailyTrackPlaysBetweenParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteDailyTrackPlaysBetween")
+	defer span.End()
+	return q.queries.DeleteDailyTrackPlaysBetween(ctx, arg)
+}
+
+func (q *queriesWrapper) InsertDailyTrackPlaysBetween(ctx context.Context, arg InsertDailyTrackPlaysBetweenParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.InsertDailyTrackPlaysBetween")
+	defer span.End()
+	return q.queries.InsertDailyTrackPlaysBetween(ctx, arg)
+}
+
+func (q *queriesWrapper) CountNewTrackPlays(ctx context.Context, arg CountNewTrackPlaysParams) (int64, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.CountNewTrackPlays")
+	defer span.End()
+	return q.queries.CountNewTrackPlays(ctx, arg)
+}
+
+func (q *queriesWrapper) AddDailyAlbumPlays(ctx context.Context, arg AddDailyAlbumPlaysParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.AddDailyAlbumPlays")
+	defer span.End()
+	return q.queries.AddDailyAlbumPlays(ctx, arg)
+}
+
+func (q *queriesWrapper) AddDailyArtistPlays(ctx context.Context, arg AddDailyArtistPlaysParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.AddDailyArtistPlays")
+	defer span.End()
+	return q.queries.AddDailyArtistPlays(ctx, arg)
+}
+
+func (q *queriesWrapper) AddDailyTrackPlays(ctx context.Context, arg AddDailyTrackPlaysParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.AddDailyTrackPlays")
+	defer span.End()
+	return q.queries.AddDailyTrackPlays(ctx, arg)
+}
+
+func (q *queriesWrapper) AddTrackToDailyAlbumPlays(ctx context.Context, arg AddTrackToDailyAlbumPlaysParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.AddTrackToDailyAlbumPlays")
+	defer span.End()
+	return q.queries.AddTrackToDailyAlbumPlays(ctx, arg)
+}
+
+func (q *queriesWrapper) AddTrackToDailyArtistPlays(ctx context.Context, arg AddTrackToDailyArtistPlaysParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.AddTrackToDailyArtistPlays")
+	defer span.End()
+	return q.queries.AddTrackToDailyArtistPlays(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteDailyAlbumPlaysBetween(ctx context.Context, arg DeleteDailyAlbumPlaysBetweenParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteDailyAlbumPlaysBetween")
+	defer span.End()
+	return q.queries.DeleteDailyAlbumPlaysBetween(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteDailyArtistPlaysBetween(ctx context.Context, arg DeleteDailyArtistPlaysBetweenParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteDailyArtistPlaysBetween")
+	defer span.End()
+	return q.queries.DeleteDailyArtistPlaysBetween(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteEmptyDailyAlbumPlays(ctx context.Context, arg DeleteEmptyDailyAlbumPlaysParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteEmptyDailyAlbumPlays")
+	defer span.End()
+	return q.queries.DeleteEmptyDailyAlbumPlays(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteEmptyDailyAlbumPlaysForTrack(ctx context.Context, arg DeleteEmptyDailyAlbumPlaysForTrackParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteEmptyDailyAlbumPlaysForTrack")
+	defer span.End()
+	return q.queries.DeleteEmptyDailyAlbumPlaysForTrack(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteEmptyDailyArtistPlays(ctx context.Context, arg DeleteEmptyDailyArtistPlaysParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteEmptyDailyArtistPlays")
+	defer span.End()
+	return q.queries.DeleteEmptyDailyArtistPlays(ctx, arg)
+}
+
+func (q *queriesWrapper) DeleteEmptyDailyArtistPlaysForTrack(ctx context.Context, arg DeleteEmptyDailyArtistPlaysForTrackParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.DeleteEmptyDailyArtistPlaysForTrack")
+	defer span.End()
+	return q.queries.DeleteEmptyDailyArtistPlaysForTrack(ctx, arg)
+}
+
+func (q *queriesWrapper) InsertDailyAlbumPlaysBetween(ctx context.Context, arg InsertDailyAlbumPlaysBetweenParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.InsertDailyAlbumPlaysBetween")
+	defer span.End()
+	return q.queries.InsertDailyAlbumPlaysBetween(ctx, arg)
+}
+
+func (q *queriesWrapper) InsertDailyArtistPlaysBetween(ctx context.Context, arg InsertDailyArtistPlaysBetweenParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.InsertDailyArtistPlaysBetween")
+	defer span.End()
+	return q.queries.InsertDailyArtistPlaysBetween(ctx, arg)
+}
+
+func (q *queriesWrapper) LockAllRollups(ctx context.Context) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.LockAllRollups")
+	defer span.End()
+	return q.queries.LockAllRollups(ctx)
+}
+
+func (q *queriesWrapper) LockAllRollupsShared(ctx context.Context) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.LockAllRollupsShared")
+	defer span.End()
+	return q.queries.LockAllRollupsShared(ctx)
+}
+
+func (q *queriesWrapper) GetTrack(ctx context.Context, isrc string) (Track, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.GetTrack")
+	defer span.End()
+	return q.queries.GetTrack(ctx, isrc)
+}
+
+func (q *queriesWrapper) LockReactionsByUserForListen(ctx context.Context, arg LockReactionsByUserForListenParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.LockReactionsByUserForListen")
+	defer span.End()
+	return q.queries.LockReactionsByUserForListen(ctx, arg)
+}
+
+func (q *queriesWrapper) ListArtistPlaysForUser(ctx context.Context, arg ListArtistPlaysForUserParams) ([]ListArtistPlaysForUserRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListArtistPlaysForUser")
+	defer span.End()
+	return q.queries.ListArtistPlaysForUser(ctx, arg)
+}
+
+func (q *queriesWrapper) ListArtistNames(ctx context.Context, artistIds []string) ([]ListArtistNamesRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListArtistNames")
+	defer span.End()
+	return q.queries.ListArtistNames(ctx, artistIds)
+}
+
+func (q *queriesWrapper) ListUsersWithTrackPlays(ctx context.Context, isrc string) ([]ListUsersWithTrackPlaysRow, error) {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.ListUsersWithTrackPlays")
+	defer span.End()
+	return q.queries.ListUsersWithTrackPlays(ctx, isrc)
+}
+
+func (q *queriesWrapper) AddArtistPlaysToCompatibilityScores(ctx context.Context, arg AddArtistPlaysToCompatibilityScoresParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.AddArtistPlaysToCompatibilityScores")
+	defer span.End()
+	return q.queries.AddArtistPlaysToCompatibilityScores(ctx, arg)
+}
+
+func (q *queriesWrapper) AddTrackPlaysToCompatibilityScores(ctx context.Context, arg AddTrackPlaysToCompatibilityScoresParams) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.AddTrackPlaysToCompatibilityScores")
+	defer span.End()
+	return q.queries.AddTrackPlaysToCompatibilityScores(ctx, arg)
+}
+
+func (q *queriesWrapper) LockCompatibilityScoresForUser(ctx context.Context, userID string) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.LockCompatibilityScoresForUser")
+	defer span.End()
+	return q.queries.LockCompatibilityScoresForUser(ctx, userID)
+}
+
+func (q *queriesWrapper) MarkCompatibilityScoresStaleForTrack(ctx context.Context, isrc string) error {
+	ctx, span := trace.Start(ctx, "backend/db/queriesWrapper.MarkCompatibilityScoresStaleForTrack")
+	defer span.End()
+	return q.queries.MarkCompatibilityScoresStaleForTrack(ctx, isrc)
+}
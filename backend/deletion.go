@@ -0,0 +1,169 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/mootslive/mono/backend/db"
+	"github.com/mootslive/mono/backend/trace"
+	"github.com/mootslive/mono/backend/twitter"
+	"golang.org/x/exp/slog"
+	"golang.org/x/oauth2"
+)
+
+// accountDeletionGracePeriod is how long a user has to change their mind
+// after requesting the deletion of their account.
+const accountDeletionGracePeriod = time.Hour * 24 * 30
+
+// AccountDeleter permanently deletes the accounts of users whose deletion
+// grace period has elapsed.
+type AccountDeleter struct {
+	queries db.TXQuerier
+	log     *slog.Logger
+}
+
+func NewAccountDeleter(log *slog.Logger, queries db.TXQuerier) *AccountDeleter {
+	return &AccountDeleter{
+		log:     log,
+		queries: queries,
+	}
+}
+
+func (ad *AccountDeleter) Run(ctx context.Context) error {
+	ad.log.Info("starting account deleter")
+
+	for {
+		users, err := ad.queries.ListUsersDueForDeletion(
+			ctx, time.Now().Add(-accountDeletionGracePeriod),
+		)
+		if err != nil {
+			return fmt.Errorf("fetching users due for deletion: %w", err)
+		}
+
+		for _, user := range users {
+			// One account failing to delete shouldn't hold up the others,
+			// it'll be tried again on the next pass.
+			if err := ad.DeleteAccount(ctx, user.ID); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				ad.log.Error("failed to delete account", err,
+					slog.String("user_id", user.ID),
+				)
+			}
+		}
+
+		select {
+		case <-time.After(time.Minute * 15):
+			continue
+		case <-ctx.Done():
+			ad.log.Info("context cancelled, stopping account deleter")
+			return ctx.Err()
+		}
+	}
+}
+
+// DeleteAccount deletes the user, relying on ON DELETE CASCADE to remove the
+// rest of their data, and then revokes the tokens we held for their linked
+// accounts.
+func (ad *AccountDeleter) DeleteAccount(ctx context.Context, userID string) error {
+	ctx, span := trace.Start(ctx, "backend/AccountDeleter.DeleteAccount")
+	defer span.End()
+
+	commit, rollback, tx, err := ad.queries.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		if err := rollback(context.Background()); err != nil {
+			if !errors.Is(err, pgx.ErrTxClosed) {
+				ad.log.Error("failed to rollback", err)
+			}
+		}
+	}()
+
+	user, err := tx.SelectUserForUpdate(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("locking user: %w", err)
+	}
+
+	// The user may have cancelled the deletion since we listed them.
+	if !user.DeletionRequestedAt.Valid ||
+		time.Since(user.DeletionRequestedAt.Time) < accountDeletionGracePeriod {
+		return nil
+	}
+
+	twitterAccounts, err := tx.ListTwitterAccountsForUser(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("fetching twitter accounts: %w", err)
+	}
+	for _, account := range twitterAccounts {
+		err := tx.DeleteTwitterFollowsForAccount(ctx, account.TwitterUserID)
+		if err != nil {
+			return fmt.Errorf("deleting twitter follows: %w", err)
+		}
+
+		err = recordAuditEvent(ctx, tx, auditEvent{
+			kind:           auditProviderUnlinked,
+			targetUserID:   user.ID,
+			provider:       providerTwitter,
+			providerUserID: account.TwitterUserID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Spotify offers no endpoint for revoking tokens, so the best we can do is
+	// discard them, which happens as part of deleting the user.
+	spotifyAccounts, err := tx.ListSpotifyAccountsForUser(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("fetching spotify accounts: %w", err)
+	}
+	for _, account := range spotifyAccounts {
+		err := recordAuditEvent(ctx, tx, auditEvent{
+			kind:           auditProviderUnlinked,
+			targetUserID:   user.ID,
+			provider:       providerSpotify,
+			providerUserID: account.SpotifyUserID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.DeleteUser(ctx, user.ID); err != nil {
+		return fmt.Errorf("deleting user: %w", err)
+	}
+	err = recordAuditEvent(ctx, tx, auditEvent{
+		kind:         auditAccountDeleted,
+		targetUserID: user.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	ad.log.Info("deleted account", slog.String("user_id", user.ID))
+
+	// Revoking makes requests to Twitter, so happens once the user's row is
+	// no longer locked. Failing to revoke can't undo the deletion, and the
+	// token has been forgotten regardless.
+	for _, account := range twitterAccounts {
+		tok := oauth2.Token(account.OauthToken)
+		if err := twitter.RevokeToken(ctx, &tok); err != nil {
+			ad.log.Error("failed to revoke twitter token", err,
+				slog.String("user_id", user.ID),
+				slog.String("twitter_user_id", account.TwitterUserID),
+			)
+		}
+	}
+
+	return nil
+}
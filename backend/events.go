@@ -0,0 +1,211 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/mootslive/mono/backend/db"
+	"golang.org/x/exp/slog"
+)
+
+// eventsChannel is the Postgres notification channel that events are
+// published on.
+const eventsChannel = "mootslive_events"
+
+const (
+	// eventKindListens is published when new listens are recorded for a
+	// user.
+	eventKindListens = "listens"
+	// eventKindNotifications is published when a user's notifications
+	// change.
+	eventKindNotifications = "notifications"
+)
+
+// event tells subscribers that something has changed for a user. Events
+// carry no data beyond that, subscribers fetch what has changed from the
+// database, so an event that is dropped or coalesced with another loses
+// nothing.
+type event struct {
+	Kind   string `json:"kind"`
+	UserID string `json:"user_id"`
+}
+
+// publishEvent publishes an event to every replica. If queries is a
+// transaction, the event is only delivered once it commits.
+func publishEvent(
+	ctx context.Context, queries db.Querier, kind, userID string,
+) error {
+	payload, err := json.Marshal(event{
+		Kind:   kind,
+		UserID: userID,
+	})
+	if err != nil {
+		return fmt.Errorf("marshalling event: %w", err)
+	}
+	if err := queries.NotifyEvent(ctx, string(payload)); err != nil {
+		return fmt.Errorf("publishing event: %w", err)
+	}
+	return nil
+}
+
+// EventBus receives events published by any replica using Postgres
+// LISTEN/NOTIFY, and fans them out to subscribers within this process.
+type EventBus struct {
+	pool *pgxpool.Pool
+	log  *slog.Logger
+
+	mu   sync.Mutex
+	subs map[*subscription]struct{}
+}
+
+func NewEventBus(log *slog.Logger, pool *pgxpool.Pool) *EventBus {
+	return &EventBus{
+		log:  log,
+		pool: pool,
+		subs: map[*subscription]struct{}{},
+	}
+}
+
+// subscription receives the kinds of events that have occurred for the users
+// it is interested in. Rather than queueing each event, kinds are accumulated
+// until they are taken, so a slow subscriber never holds up the bus or grows
+// without bound.
+type subscription struct {
+	// ready receives a value when there are pending kinds to take.
+	ready chan struct{}
+
+	mu sync.Mutex
+	// userIDs holds the users the subscriber is interested in, for each kind
+	// of event.
+	userIDs map[string]map[string]bool
+	pending map[string]bool
+	// resync is set when events may have been missed, and the subscriber
+	// should assume everything has changed.
+	resync bool
+}
+
+// setUserIDs sets the users whose events of a kind are delivered.
+func (s *subscription) setUserIDs(kind string, userIDs []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ids := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		ids[id] = true
+	}
+	s.userIDs[kind] = ids
+}
+
+func (s *subscription) deliver(e event, resync bool) {
+	s.mu.Lock()
+	if resync {
+		s.resync = true
+	} else if s.userIDs[e.Kind][e.UserID] {
+		s.pending[e.Kind] = true
+	} else {
+		s.mu.Unlock()
+		return
+	}
+	s.mu.Unlock()
+
+	select {
+	case s.ready <- struct{}{}:
+	default:
+		// The subscriber has already been told there's something to take.
+	}
+}
+
+// take returns the kinds of events that have occurred since it was last
+// called, and whether events may have been missed.
+func (s *subscription) take() (map[string]bool, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pending, resync := s.pending, s.resync
+	s.pending = map[string]bool{}
+	s.resync = false
+	return pending, resync
+}
+
+// subscribe starts delivering events, for the users given to setUserIDs.
+// The subscription must be released with unsubscribe.
+func (eb *EventBus) subscribe() *subscription {
+	sub := &subscription{
+		ready:   make(chan struct{}, 1),
+		userIDs: map[string]map[string]bool{},
+		pending: map[string]bool{},
+	}
+
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+	eb.subs[sub] = struct{}{}
+	return sub
+}
+
+func (eb *EventBus) unsubscribe(sub *subscription) {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+	delete(eb.subs, sub)
+}
+
+func (eb *EventBus) dispatch(e event, resync bool) {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+	for sub := range eb.subs {
+		sub.deliver(e, resync)
+	}
+}
+
+func (eb *EventBus) Run(ctx context.Context) error {
+	eb.log.Info("starting event bus")
+
+	for {
+		err := eb.listen(ctx)
+		if ctx.Err() != nil {
+			eb.log.Info("context cancelled, stopping event bus")
+			return ctx.Err()
+		}
+		eb.log.Error("stopped listening for events", err)
+
+		select {
+		case <-time.After(time.Second * 5):
+			continue
+		case <-ctx.Done():
+			eb.log.Info("context cancelled, stopping event bus")
+			return ctx.Err()
+		}
+	}
+}
+
+func (eb *EventBus) listen(ctx context.Context) error {
+	poolConn, err := eb.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquiring connection: %w", err)
+	}
+	// The connection is left listening, so it can't be returned to the pool.
+	conn := poolConn.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+eventsChannel); err != nil {
+		return fmt.Errorf("listening: %w", err)
+	}
+	// Anything published whilst we weren't listening has been missed.
+	eb.dispatch(event{}, true)
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("waiting for notification: %w", err)
+		}
+		e := event{}
+		if err := json.Unmarshal([]byte(n.Payload), &e); err != nil {
+			eb.log.Error("failed to unmarshal event", err,
+				slog.String("payload", n.Payload),
+			)
+			continue
+		}
+		eb.dispatch(e, false)
+	}
+}
@@ -0,0 +1,438 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mootslive/mono/backend/db"
+	"github.com/mootslive/mono/backend/trace"
+	mootslivepbv1 "github.com/mootslive/mono/proto/mootslive/v1"
+	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	exportFormatCSV          = "csv"
+	exportFormatJSONL        = "jsonl"
+	exportFormatListenBrainz = "listenbrainz"
+	exportFormatScrobblerLog = "scrobbler_log"
+
+	// exportChunkSize is roughly how many bytes of an export are buffered
+	// before being sent to the client.
+	exportChunkSize = 64 * 1024
+	// exportClientName identifies us to services that listens are imported
+	// into.
+	exportClientName = "moots.live"
+)
+
+var exportFormats = map[string]mootslivepbv1.ExportFormat{
+	exportFormatCSV:          mootslivepbv1.ExportFormat_EXPORT_FORMAT_CSV,
+	exportFormatJSONL:        mootslivepbv1.ExportFormat_EXPORT_FORMAT_JSONL,
+	exportFormatListenBrainz: mootslivepbv1.ExportFormat_EXPORT_FORMAT_LISTENBRAINZ,
+	exportFormatScrobblerLog: mootslivepbv1.ExportFormat_EXPORT_FORMAT_SCROBBLER_LOG,
+}
+
+func exportFormatFromProto(format mootslivepbv1.ExportFormat) (string, bool) {
+	for k, pb := range exportFormats {
+		if pb == format {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// listenEncoder writes listens in one of the export formats. Listens are
+// passed to it oldest first.
+type listenEncoder interface {
+	contentType() string
+	filename() string
+	header(w io.Writer) error
+	listen(w io.Writer, row db.StreamListensForExportRow) error
+	footer(w io.Writer) error
+}
+
+func newListenEncoder(format string) listenEncoder {
+	switch format {
+	case exportFormatCSV:
+		return csvListenEncoder{}
+	case exportFormatJSONL:
+		return jsonlListenEncoder{}
+	case exportFormatListenBrainz:
+		return &listenBrainzListenEncoder{}
+	case exportFormatScrobblerLog:
+		return scrobblerLogListenEncoder{}
+	}
+	return nil
+}
+
+type csvListenEncoder struct{}
+
+func (csvListenEncoder) contentType() string { return "text/csv; charset=utf-8" }
+func (csvListenEncoder) filename() string    { return "listens.csv" }
+
+func (csvListenEncoder) writeRecord(w io.Writer, record []string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func (e csvListenEncoder) header(w io.Writer) error {
+	return e.writeRecord(w, []string{
+		"id",
+		"listened_at",
+		"isrc",
+		"source",
+		"hidden",
+		"track_name",
+		"artist_names",
+		"album_name",
+		"duration_ms",
+	})
+}
+
+func (e csvListenEncoder) listen(w io.Writer, row db.StreamListensForExportRow) error {
+	return e.writeRecord(w, []string{
+		row.Listen.ID,
+		row.Listen.ListenedAt.UTC().Format(time.RFC3339),
+		row.Listen.Isrc,
+		row.Listen.Source,
+		strconv.FormatBool(row.Listen.Hidden),
+		row.TrackName,
+		strings.Join(row.ArtistNames, "; "),
+		row.AlbumName,
+		strconv.Itoa(int(row.DurationMs)),
+	})
+}
+
+func (csvListenEncoder) footer(w io.Writer) error { return nil }
+
+// exportedListenWithTrack is a listen as exported to JSON Lines, with the
+// metadata of its track if we hold it.
+type exportedListenWithTrack struct {
+	exportedListen
+	TrackName   string   `json:"track_name,omitempty"`
+	ArtistNames []string `json:"artist_names,omitempty"`
+	AlbumName   string   `json:"album_name,omitempty"`
+	DurationMs  int32    `json:"duration_ms,omitempty"`
+}
+
+type jsonlListenEncoder struct{}
+
+func (jsonlListenEncoder) contentType() string      { return "application/jsonl" }
+func (jsonlListenEncoder) filename() string         { return "listens.jsonl" }
+func (jsonlListenEncoder) header(w io.Writer) error { return nil }
+
+func (jsonlListenEncoder) listen(w io.Writer, row db.StreamListensForExportRow) error {
+	// Encode ends each object with a newline.
+	return json.NewEncoder(w).Encode(exportedListenWithTrack{
+		exportedListen: exportedListen{
+			ID:         row.Listen.ID,
+			Source:     row.Listen.Source,
+			ISRC:       row.Listen.Isrc,
+			ListenedAt: row.Listen.ListenedAt,
+			CreatedAt:  row.Listen.CreatedAt,
+			Hidden:     row.Listen.Hidden,
+		},
+		TrackName:   row.TrackName,
+		ArtistNames: row.ArtistNames,
+		AlbumName:   row.AlbumName,
+		DurationMs:  row.DurationMs,
+	})
+}
+
+func (jsonlListenEncoder) footer(w io.Writer) error { return nil }
+
+type listenBrainzAdditionalInfo struct {
+	ISRC             string `json:"isrc"`
+	DurationMs       int32  `json:"duration_ms,omitempty"`
+	SpotifyID        string `json:"spotify_id,omitempty"`
+	SubmissionClient string `json:"submission_client"`
+}
+
+type listenBrainzTrackMetadata struct {
+	ArtistName     string                     `json:"artist_name"`
+	TrackName      string                     `json:"track_name"`
+	ReleaseName    string                     `json:"release_name,omitempty"`
+	AdditionalInfo listenBrainzAdditionalInfo `json:"additional_info"`
+}
+
+type listenBrainzListen struct {
+	ListenedAt    int64                     `json:"listened_at"`
+	TrackMetadata listenBrainzTrackMetadata `json:"track_metadata"`
+}
+
+// listenBrainzListenEncoder writes the listens as a JSON array, which is
+// what ListenBrainz exports and can import.
+type listenBrainzListenEncoder struct {
+	written bool
+}
+
+func (*listenBrainzListenEncoder) contentType() string { return "application/json" }
+func (*listenBrainzListenEncoder) filename() string    { return "listens.listenbrainz.json" }
+
+func (*listenBrainzListenEncoder) header(w io.Writer) error {
+	_, err := io.WriteString(w, "[")
+	return err
+}
+
+func (e *listenBrainzListenEncoder) listen(
+	w io.Writer, row db.StreamListensForExportRow,
+) error {
+	// ListenBrainz requires the names of the track and its artist.
+	if row.TrackName == "" || len(row.ArtistNames) == 0 {
+		return nil
+	}
+	l := listenBrainzListen{
+		ListenedAt: row.Listen.ListenedAt.Unix(),
+		TrackMetadata: listenBrainzTrackMetadata{
+			ArtistName:  strings.Join(row.ArtistNames, ", "),
+			TrackName:   row.TrackName,
+			ReleaseName: row.AlbumName,
+			AdditionalInfo: listenBrainzAdditionalInfo{
+				ISRC:             row.Listen.Isrc,
+				DurationMs:       row.DurationMs,
+				SubmissionClient: exportClientName,
+			},
+		},
+	}
+	if row.SpotifyTrackID != "" {
+		l.TrackMetadata.AdditionalInfo.SpotifyID = "https://open.spotify.com/track/" + row.SpotifyTrackID
+	}
+	b, err := json.Marshal(l)
+	if err != nil {
+		return fmt.Errorf("marshalling listen: %w", err)
+	}
+	if e.written {
+		b = append([]byte{','}, b...)
+	}
+	e.written = true
+	_, err = w.Write(b)
+	return err
+}
+
+func (*listenBrainzListenEncoder) footer(w io.Writer) error {
+	_, err := io.WriteString(w, "]")
+	return err
+}
+
+// scrobblerLogListenEncoder writes the listens as an Audioscrobbler 1.1
+// .scrobbler.log, a line of tab separated fields per listen.
+type scrobblerLogListenEncoder struct{}
+
+func (scrobblerLogListenEncoder) contentType() string { return "text/plain; charset=utf-8" }
+func (scrobblerLogListenEncoder) filename() string    { return ".scrobbler.log" }
+
+func (scrobblerLogListenEncoder) header(w io.Writer) error {
+	_, err := io.WriteString(
+		w, "#AUDIOSCROBBLER/1.1\n#TZ/UTC\n#CLIENT/"+exportClientName+"\n",
+	)
+	return err
+}
+
+// scrobblerLogField strips the characters that would break a line of a
+// scrobbler log from a field.
+var scrobblerLogField = strings.NewReplacer("\t", " ", "\n", " ", "\r", " ")
+
+func (scrobblerLogListenEncoder) listen(
+	w io.Writer, row db.StreamListensForExportRow,
+) error {
+	if row.TrackName == "" || len(row.ArtistNames) == 0 {
+		return nil
+	}
+	fields := []string{
+		// Last.fm expects the main artist alone, rather than everyone
+		// credited on the track.
+		row.ArtistNames[0],
+		row.AlbumName,
+		row.TrackName,
+		// The track number, which we don't know.
+		"",
+		strconv.Itoa(int(row.DurationMs / 1000)),
+		// Rated L as the track was listened to rather than skipped.
+		"L",
+		strconv.FormatInt(row.Listen.ListenedAt.Unix(), 10),
+		// The MusicBrainz track ID, which we don't know.
+		"",
+	}
+	for i, f := range fields {
+		fields[i] = scrobblerLogField.Replace(f)
+	}
+	_, err := io.WriteString(w, strings.Join(fields, "\t")+"\n")
+	return err
+}
+
+func (scrobblerLogListenEncoder) footer(w io.Writer) error { return nil }
+
+// exportTimeRange returns the range of listens an export covers, replacing
+// unset bounds with ones that include every listen.
+func exportTimeRange(
+	start, end *timestamppb.Timestamp,
+) (time.Time, time.Time, error) {
+	startTime, endTime := time.Time{}, pageStart.Time
+	if start != nil {
+		startTime = start.AsTime()
+	}
+	if end != nil {
+		endTime = end.AsTime()
+	}
+	if !startTime.Before(endTime) {
+		return time.Time{}, time.Time{}, fmt.Errorf(
+			"start_time must be before end_time",
+		)
+	}
+	return startTime, endTime, nil
+}
+
+// exportListens encodes a user's listens in a range, passing the result to
+// send in chunks. The listens are streamed from the database as they're
+// encoded, so the export is never held in memory. The chunk passed to send
+// is reused once it returns.
+func exportListens(
+	ctx context.Context,
+	queries db.TXQuerier,
+	enc listenEncoder,
+	userID string,
+	startTime, endTime time.Time,
+	send func([]byte) error,
+) error {
+	ctx, span := trace.Start(ctx, "backend/exportListens")
+	defer span.End()
+
+	buf := &bytes.Buffer{}
+	if err := enc.header(buf); err != nil {
+		return fmt.Errorf("encoding header: %w", err)
+	}
+	err := queries.StreamListensForExport(ctx, db.StreamListensForExportParams{
+		UserID:    userID,
+		StartTime: startTime,
+		EndTime:   endTime,
+	}, func(row db.StreamListensForExportRow) error {
+		if err := enc.listen(buf, row); err != nil {
+			return fmt.Errorf("encoding listen: %w", err)
+		}
+		if buf.Len() < exportChunkSize {
+			return nil
+		}
+		if err := send(buf.Bytes()); err != nil {
+			return err
+		}
+		buf.Reset()
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("streaming listens: %w", err)
+	}
+	if err := enc.footer(buf); err != nil {
+		return fmt.Errorf("encoding footer: %w", err)
+	}
+	if buf.Len() > 0 {
+		return send(buf.Bytes())
+	}
+	return nil
+}
+
+// ListenExportHandler serves exports of a user's listens as plain HTTP
+// downloads, for clients that can't consume a connect stream.
+type ListenExportHandler struct {
+	queries    db.TXQuerier
+	log        *slog.Logger
+	authEngine *authEngine
+}
+
+func NewListenExportHandler(
+	queries db.TXQuerier,
+	log *slog.Logger,
+	authEngine *authEngine,
+) *ListenExportHandler {
+	return &ListenExportHandler{
+		queries:    queries,
+		log:        log,
+		authEngine: authEngine,
+	}
+}
+
+// timeQueryParam parses an RFC 3339 time from a query parameter, returning
+// nil if it isn't set.
+func timeQueryParam(query url.Values, name string) (*timestamppb.Timestamp, error) {
+	if query.Get(name) == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, query.Get(name))
+	if err != nil {
+		return nil, fmt.Errorf("%s must be an RFC 3339 time", name)
+	}
+	return timestamppb.New(t), nil
+}
+
+func (h *ListenExportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.Start(r.Context(), "backend/ListenExportHandler.ServeHTTP")
+	defer span.End()
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	authCtx, err := h.authEngine.handleHTTPReq(ctx, r, handleReqOpts{})
+	if err != nil {
+		http.Error(w, "access denied", http.StatusUnauthorized)
+		return
+	}
+
+	query := r.URL.Query()
+	format := query.Get("format")
+	if _, ok := exportFormats[format]; !ok {
+		http.Error(w, fmt.Sprintf("unsupported format %q", format), http.StatusBadRequest)
+		return
+	}
+	start, err := timeQueryParam(query, "start_time")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	end, err := timeQueryParam(query, "end_time")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	startTime, endTime, err := exportTimeRange(start, end)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	enc := newListenEncoder(format)
+	w.Header().Set("Content-Type", enc.contentType())
+	w.Header().Set(
+		"Content-Disposition", fmt.Sprintf("attachment; filename=%q", enc.filename()),
+	)
+	flusher, _ := w.(http.Flusher)
+	err = exportListens(ctx, h.queries, enc, authCtx.user.ID, startTime, endTime, func(b []byte) error {
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		h.log.Error("failed to export listens", err,
+			slog.String("user_id", authCtx.user.ID),
+		)
+		// The status has already been sent, so the connection is dropped
+		// to stop the client mistaking a partial export for a whole one.
+		panic(http.ErrAbortHandler)
+	}
+}
@@ -0,0 +1,132 @@
+package backend
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/mootslive/mono/backend/db"
+)
+
+func TestListenEncoders(t *testing.T) {
+	listenedAt := time.Date(2023, time.March, 8, 17, 30, 0, 0, time.UTC)
+	rows := []db.StreamListensForExportRow{
+		{
+			Listen: db.Listen{
+				ID:         "l1",
+				UserID:     "u1",
+				CreatedAt:  listenedAt.Add(time.Minute),
+				ListenedAt: listenedAt,
+				Isrc:       "USRC17607839",
+				Source:     "spotify",
+			},
+			TrackName:      "Song, Part 2",
+			ArtistNames:    []string{"A", "B"},
+			AlbumName:      `Album "Deluxe"`,
+			DurationMs:     183500,
+			SpotifyTrackID: "abc",
+		},
+		{
+			// A track we hold no metadata for.
+			Listen: db.Listen{
+				ID:         "l2",
+				UserID:     "u1",
+				CreatedAt:  listenedAt.Add(time.Minute * 31),
+				ListenedAt: listenedAt.Add(time.Minute * 30),
+				Isrc:       "GBAYE0000001",
+				Source:     "manual",
+				Hidden:     true,
+			},
+		},
+		{
+			Listen: db.Listen{
+				ID:         "l3",
+				UserID:     "u1",
+				CreatedAt:  listenedAt.Add(time.Minute * 61),
+				ListenedAt: listenedAt.Add(time.Hour),
+				Isrc:       "GBAYE0000002",
+				Source:     "spotify",
+			},
+			TrackName:   "Other\tSong\nLive",
+			ArtistNames: []string{"C\rD", "E"},
+			DurationMs:  59999,
+		},
+	}
+
+	tests := []struct {
+		name   string
+		format string
+		want   string
+	}{
+		{
+			name:   "csv",
+			format: exportFormatCSV,
+			want: "id,listened_at,isrc,source,hidden,track_name,artist_names,album_name,duration_ms\n" +
+				`l1,2023-03-08T17:30:00Z,USRC17607839,spotify,false,"Song, Part 2",A; B,"Album ""Deluxe""",183500` + "\n" +
+				"l2,2023-03-08T18:00:00Z,GBAYE0000001,manual,true,,,,0\n" +
+				"l3,2023-03-08T18:30:00Z,GBAYE0000002,spotify,false,\"Other\tSong\nLive\",\"C\rD; E\",,59999\n",
+		},
+		{
+			name:   "json lines",
+			format: exportFormatJSONL,
+			want: `{"id":"l1","source":"spotify","isrc":"USRC17607839","listened_at":"2023-03-08T17:30:00Z","created_at":"2023-03-08T17:31:00Z","hidden":false,"track_name":"Song, Part 2","artist_names":["A","B"],"album_name":"Album \"Deluxe\"","duration_ms":183500}` + "\n" +
+				`{"id":"l2","source":"manual","isrc":"GBAYE0000001","listened_at":"2023-03-08T18:00:00Z","created_at":"2023-03-08T18:01:00Z","hidden":true}` + "\n" +
+				`{"id":"l3","source":"spotify","isrc":"GBAYE0000002","listened_at":"2023-03-08T18:30:00Z","created_at":"2023-03-08T18:31:00Z","hidden":false,"track_name":"Other\tSong\nLive","artist_names":["C\rD","E"],"duration_ms":59999}` + "\n",
+		},
+		{
+			// Listens without the names ListenBrainz requires are left out,
+			// without leaving a stray comma behind.
+			name:   "listenbrainz",
+			format: exportFormatListenBrainz,
+			want: `[` +
+				`{"listened_at":1678296600,"track_metadata":{"artist_name":"A, B","track_name":"Song, Part 2","release_name":"Album \"Deluxe\"","additional_info":{"isrc":"USRC17607839","duration_ms":183500,"spotify_id":"https://open.spotify.com/track/abc","submission_client":"moots.live"}}},` +
+				`{"listened_at":1678300200,"track_metadata":{"artist_name":"C\rD, E","track_name":"Other\tSong\nLive","additional_info":{"isrc":"GBAYE0000002","duration_ms":59999,"submission_client":"moots.live"}}}` +
+				`]`,
+		},
+		{
+			name:   "scrobbler log",
+			format: exportFormatScrobblerLog,
+			want: "#AUDIOSCROBBLER/1.1\n#TZ/UTC\n#CLIENT/moots.live\n" +
+				"A\tAlbum \"Deluxe\"\tSong, Part 2\t\t183\tL\t1678296600\t\n" +
+				"C D\t\tOther Song Live\t\t59\tL\t1678300200\t\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := encodeListens(t, newListenEncoder(tt.format), rows)
+			if got != tt.want {
+				t.Errorf("expected:\n%q\ngot:\n%q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestListenBrainzListenEncoderEmpty(t *testing.T) {
+	rows := []db.StreamListensForExportRow{
+		{Listen: db.Listen{ID: "l1", Isrc: "GBAYE0000001"}},
+	}
+	got := encodeListens(t, newListenEncoder(exportFormatListenBrainz), rows)
+	if got != "[]" {
+		t.Errorf("expected an empty array, got %q", got)
+	}
+}
+
+// encodeListens returns the export of rows in the format of enc.
+func encodeListens(
+	t *testing.T, enc listenEncoder, rows []db.StreamListensForExportRow,
+) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := enc.header(&buf); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, row := range rows {
+		if err := enc.listen(&buf, row); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if err := enc.footer(&buf); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return buf.String()
+}
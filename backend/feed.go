@@ -0,0 +1,37 @@
+package backend
+
+import (
+	"github.com/mootslive/mono/backend/db"
+)
+
+const (
+	defaultFeedPageSize = 50
+	maxFeedPageSize     = 100
+)
+
+// collapseFeed groups consecutive listens by the same user to tracks from the
+// same album, so that playing through an album produces a single feed entry.
+// Listens must be ordered newest first, as they are in the feed, and each
+// group retains that order.
+func collapseFeed(
+	listens []db.ListFeedListensRow, tracks map[string]db.Track,
+) [][]db.ListFeedListensRow {
+	entries := [][]db.ListFeedListensRow{}
+	// latest holds the index of the entry containing the previous listen of
+	// each user.
+	latest := map[string]int{}
+	for _, listen := range listens {
+		if i, ok := latest[listen.UserID]; ok {
+			entry := entries[i]
+			prev := entry[len(entry)-1]
+			album := tracks[listen.Isrc].AlbumID
+			if album != "" && album == tracks[prev.Isrc].AlbumID {
+				entries[i] = append(entry, listen)
+				continue
+			}
+		}
+		latest[listen.UserID] = len(entries)
+		entries = append(entries, []db.ListFeedListensRow{listen})
+	}
+	return entries
+}
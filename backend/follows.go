@@ -0,0 +1,31 @@
+package backend
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/mootslive/mono/backend/db"
+)
+
+const (
+	followStatusPending  = "pending"
+	followStatusAccepted = "accepted"
+)
+
+// defaultFollowSettings are the settings of a user who has never changed them.
+var defaultFollowSettings = db.FollowSetting{
+	RequireApproval: false,
+}
+
+func getFollowSettings(
+	ctx context.Context, queries db.Querier, userID string,
+) (db.FollowSetting, error) {
+	settings, err := queries.GetFollowSettings(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		settings = defaultFollowSettings
+		settings.UserID = userID
+		return settings, nil
+	}
+	return settings, err
+}
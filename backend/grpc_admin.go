@@ -2,24 +2,613 @@ package backend
 
 import (
 	"context"
-	"log"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/bufbuild/connect-go"
+	"github.com/jackc/pgx/v4"
+	"github.com/mootslive/mono/backend/db"
 	mootslivepbv1 "github.com/mootslive/mono/proto/mootslive/v1"
 	"github.com/mootslive/mono/proto/mootslive/v1/mootslivepbv1connect"
+	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultAdminUsersPageSize = 50
+	maxAdminUsersPageSize     = 100
 )
 
 type AdminServerHandler struct {
 	mootslivepbv1connect.UnimplementedAdminServiceHandler
+
+	queries    db.TXQuerier
+	log        *slog.Logger
+	authEngine *authEngine
+	poller     *SpotifyPoller
+	health     *HealthChecker
+}
+
+func NewAdminServiceHandler(
+	queries db.TXQuerier,
+	log *slog.Logger,
+	authEngine *authEngine,
+	poller *SpotifyPoller,
+	health *HealthChecker,
+) *AdminServerHandler {
+	return &AdminServerHandler{
+		queries:    queries,
+		log:        log,
+		authEngine: authEngine,
+		poller:     poller,
+		health:     health,
+	}
+}
+
+func adminUserToProto(user db.User) *mootslivepbv1.AdminUser {
+	return &mootslivepbv1.AdminUser{
+		Id:                  user.ID,
+		CreatedAt:           timestamppb.New(user.CreatedAt),
+		Handle:              user.Handle.String,
+		DisplayName:         user.DisplayName,
+		IsAdmin:             user.IsAdmin,
+		DisabledAt:          nullTimeToProto(user.DisabledAt),
+		DeletionRequestedAt: nullTimeToProto(user.DeletionRequestedAt),
+		SessionsRevokedAt:   nullTimeToProto(user.SessionsRevokedAt),
+	}
+}
+
+// getUser fetches a user for an admin, returning a not found error if they
+// don't exist.
+func (as *AdminServerHandler) getUser(
+	ctx context.Context, userID string,
+) (db.User, error) {
+	user, err := as.queries.GetUser(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.User{}, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("user not found"),
+		)
+	} else if err != nil {
+		return db.User{}, fmt.Errorf("fetching user: %w", err)
+	}
+	return user, nil
+}
+
+// updateUser makes a change to a user, recording the audit event for it
+// within the same transaction.
+func (as *AdminServerHandler) updateUser(
+	ctx context.Context,
+	event auditEvent,
+	update func(tx db.TXQuerier) error,
+) error {
+	commit, rollback, tx, err := as.queries.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		if err := rollback(context.Background()); err != nil {
+			if !errors.Is(err, pgx.ErrTxClosed) {
+				as.log.Error("failed to rollback", err)
+			}
+		}
+	}()
+
+	if err := update(tx); err != nil {
+		return err
+	}
+	if err := recordAuditEvent(ctx, tx, event); err != nil {
+		return err
+	}
+
+	if err := commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
 }
 
 func (as *AdminServerHandler) GetStatus(
 	ctx context.Context,
 	req *connect.Request[mootslivepbv1.GetStatusRequest],
 ) (*connect.Response[mootslivepbv1.GetStatusResponse], error) {
-	log.Println("Request headers: ", req.Header())
+	report := as.health.run(ctx, healthCheckReadiness)
+	names := make([]string, 0, len(report.Checks))
+	for name := range report.Checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	checks := make([]*mootslivepbv1.StatusCheck, 0, len(names))
+	for _, name := range names {
+		checks = append(checks, &mootslivepbv1.StatusCheck{
+			Name:    name,
+			Healthy: report.Checks[name].Healthy,
+		})
+	}
 	res := connect.NewResponse(&mootslivepbv1.GetStatusResponse{
-		XClacksOverhead: "GNU Corey Kendall",
+		Healthy: report.Healthy,
+		Checks:  checks,
+	})
+	return res, nil
+}
+
+func (as *AdminServerHandler) GenerateRecap(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.GenerateRecapRequest],
+) (*connect.Response[mootslivepbv1.GenerateRecapResponse], error) {
+	authCtx, err := as.authEngine.handleReq(ctx, req, handleReqOpts{
+		admin: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	if req.Msg.Year < 1 || int(req.Msg.Year) > time.Now().Year() {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("year %d is not a year that has started", req.Msg.Year),
+		)
+	}
+	if _, err := as.getUser(ctx, req.Msg.UserId); err != nil {
+		return nil, err
+	}
+
+	stored, recap, err := generateYearlyRecap(
+		ctx, as.queries, req.Msg.UserId, req.Msg.Year,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = recordAuditEvent(ctx, as.queries, auditEvent{
+		kind:         auditRecapGenerated,
+		actorUserID:  authCtx.user.ID,
+		targetUserID: req.Msg.UserId,
+		client:       auditClientFromReq(req),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.GenerateRecapResponse{
+		Recap:   recap,
+		Version: stored.Version,
+	})
+	return res, nil
+}
+
+func (as *AdminServerHandler) ListUsers(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ListUsersRequest],
+) (*connect.Response[mootslivepbv1.ListUsersResponse], error) {
+	_, err := as.authEngine.handleReq(ctx, req, handleReqOpts{
+		admin: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	pageSize, err := clampPageSize(
+		req.Msg.PageSize, defaultAdminUsersPageSize, maxAdminUsersPageSize,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	search := strings.TrimSpace(req.Msg.Query)
+	query := pageQuery(search)
+	cursor, err := decodePageCursor(req.Msg.PageToken, query)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	users, err := as.queries.ListUsers(ctx, db.ListUsersParams{
+		Query:           search,
+		BeforeCreatedAt: cursor.Time,
+		BeforeID:        cursor.ID,
+		RowLimit:        pageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching users: %w", err)
+	}
+
+	res := &mootslivepbv1.ListUsersResponse{
+		Users: make([]*mootslivepbv1.AdminUser, 0, len(users)),
+	}
+	for _, user := range users {
+		res.Users = append(res.Users, adminUserToProto(user))
+	}
+	// A short page means we've reached the end of the users.
+	if len(users) == int(pageSize) {
+		last := users[len(users)-1]
+		res.NextPageToken = pageCursor{
+			Time:  last.CreatedAt,
+			ID:    last.ID,
+			Query: query,
+		}.encode()
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (as *AdminServerHandler) GetUser(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.GetUserRequest],
+) (*connect.Response[mootslivepbv1.GetUserResponse], error) {
+	_, err := as.authEngine.handleReq(ctx, req, handleReqOpts{
+		admin: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	user, err := as.getUser(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := loadOwnProfile(ctx, as.queries, user)
+	if err != nil {
+		return nil, err
+	}
+	spotifyAccounts, err := as.queries.ListSpotifyAccountsForUser(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching spotify accounts: %w", err)
+	}
+
+	res := &mootslivepbv1.GetUserResponse{
+		User:            adminUserToProto(user),
+		LinkedAccounts:  profile.LinkedAccounts,
+		SpotifyAccounts: make([]*mootslivepbv1.SpotifyAccountHealth, 0, len(spotifyAccounts)),
+	}
+	polled := !user.DisabledAt.Valid && !user.DeletionRequestedAt.Valid
+	now := time.Now()
+	for _, account := range spotifyAccounts {
+		health := spotifyAccountHealthToProto(account, polled, now)
+		scans, err := as.queries.ListSpotifyScans(ctx, db.ListSpotifyScansParams{
+			SpotifyUserID: account.SpotifyUserID,
+			Limit:         recentSpotifyScansCount,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fetching scans: %w", err)
+		}
+		for _, scan := range scans {
+			health.RecentScans = append(health.RecentScans, spotifyScanToProto(scan))
+		}
+		res.SpotifyAccounts = append(res.SpotifyAccounts, health)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (as *AdminServerHandler) DisableUser(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.DisableUserRequest],
+) (*connect.Response[mootslivepbv1.DisableUserResponse], error) {
+	authCtx, err := as.authEngine.handleReq(ctx, req, handleReqOpts{
+		admin: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+	// An admin disabling themselves would have no way to undo it.
+	if req.Msg.UserId == authCtx.user.ID {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("admins cannot disable themselves"),
+		)
+	}
+
+	user, err := as.getUser(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	// Disabling a user that's already disabled keeps when they were first
+	// disabled.
+	if !user.DisabledAt.Valid {
+		user.DisabledAt = sql.NullTime{Valid: true, Time: time.Now()}
+		err := as.updateUser(ctx, auditEvent{
+			kind:         auditUserDisabled,
+			actorUserID:  authCtx.user.ID,
+			targetUserID: user.ID,
+			client:       auditClientFromReq(req),
+		}, func(tx db.TXQuerier) error {
+			if err := tx.SetUserDisabledAt(ctx, db.SetUserDisabledAtParams{
+				DisabledAt: user.DisabledAt,
+				ID:         user.ID,
+			}); err != nil {
+				return fmt.Errorf("disabling user: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.DisableUserResponse{
+		User: adminUserToProto(user),
+	})
+	return res, nil
+}
+
+func (as *AdminServerHandler) EnableUser(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.EnableUserRequest],
+) (*connect.Response[mootslivepbv1.EnableUserResponse], error) {
+	authCtx, err := as.authEngine.handleReq(ctx, req, handleReqOpts{
+		admin: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	user, err := as.getUser(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user.DisabledAt.Valid {
+		user.DisabledAt = sql.NullTime{}
+		err := as.updateUser(ctx, auditEvent{
+			kind:         auditUserEnabled,
+			actorUserID:  authCtx.user.ID,
+			targetUserID: user.ID,
+			client:       auditClientFromReq(req),
+		}, func(tx db.TXQuerier) error {
+			if err := tx.SetUserDisabledAt(ctx, db.SetUserDisabledAtParams{
+				DisabledAt: user.DisabledAt,
+				ID:         user.ID,
+			}); err != nil {
+				return fmt.Errorf("enabling user: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.EnableUserResponse{
+		User: adminUserToProto(user),
+	})
+	return res, nil
+}
+
+func (as *AdminServerHandler) ForceRescan(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ForceRescanRequest],
+) (*connect.Response[mootslivepbv1.ForceRescanResponse], error) {
+	authCtx, err := as.authEngine.handleReq(ctx, req, handleReqOpts{
+		admin: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	account, err := as.queries.GetSpotifyAccount(ctx, req.Msg.SpotifyUserId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("spotify account not found"),
+		)
+	} else if err != nil {
+		return nil, fmt.Errorf("fetching spotify account: %w", err)
+	}
+	user, err := as.getUser(ctx, account.UserID)
+	if err != nil {
+		return nil, err
+	}
+	// The poller skips these users, so shouldn't be made to scan them here
+	// either.
+	if user.DisabledAt.Valid || user.DeletionRequestedAt.Valid {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf("user is disabled or being deleted"),
+		)
+	}
+
+	err = recordAuditEvent(ctx, as.queries, auditEvent{
+		kind:           auditRescanForced,
+		actorUserID:    authCtx.user.ID,
+		targetUserID:   user.ID,
+		provider:       providerSpotify,
+		providerUserID: account.SpotifyUserID,
+		client:         auditClientFromReq(req),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := as.poller.ScanAccount(ctx, account.SpotifyUserID); err != nil {
+		return nil, fmt.Errorf("scanning account: %w", err)
+	}
+
+	return connect.NewResponse(&mootslivepbv1.ForceRescanResponse{}), nil
+}
+
+func (as *AdminServerHandler) RevokeUserSessions(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.RevokeUserSessionsRequest],
+) (*connect.Response[mootslivepbv1.RevokeUserSessionsResponse], error) {
+	authCtx, err := as.authEngine.handleReq(ctx, req, handleReqOpts{
+		admin: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	user, err := as.getUser(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+	user.SessionsRevokedAt = sql.NullTime{Valid: true, Time: time.Now()}
+	err = as.updateUser(ctx, auditEvent{
+		kind:         auditSessionsRevoked,
+		actorUserID:  authCtx.user.ID,
+		targetUserID: user.ID,
+		client:       auditClientFromReq(req),
+	}, func(tx db.TXQuerier) error {
+		if err := tx.SetUserSessionsRevokedAt(ctx, db.SetUserSessionsRevokedAtParams{
+			SessionsRevokedAt: user.SessionsRevokedAt,
+			ID:                user.ID,
+		}); err != nil {
+			return fmt.Errorf("revoking sessions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.RevokeUserSessionsResponse{
+		User: adminUserToProto(user),
 	})
 	return res, nil
 }
+
+func (as *AdminServerHandler) GetPollerStatus(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.GetPollerStatusRequest],
+) (*connect.Response[mootslivepbv1.GetPollerStatusResponse], error) {
+	_, err := as.authEngine.handleReq(ctx, req, handleReqOpts{
+		admin: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	accountLimit, err := clampPageSize(
+		req.Msg.AccountLimit, defaultPollerStatusAccounts, maxPollerStatusAccounts,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	now := time.Now()
+	summary, err := as.queries.GetPollerSummary(
+		ctx, now.Add(-spotifyAccountOverdueAfter),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("fetching summary: %w", err)
+	}
+	accounts, err := as.queries.ListLaggingSpotifyAccounts(ctx, accountLimit)
+	if err != nil {
+		return nil, fmt.Errorf("fetching accounts: %w", err)
+	}
+	replicas, err := as.queries.ListPollerReplicaThroughput(
+		ctx, now.Add(-pollerThroughputWindow),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("fetching throughput: %w", err)
+	}
+
+	res := &mootslivepbv1.GetPollerStatusResponse{
+		Accounts:         int32(summary.Accounts),
+		OverdueAccounts:  int32(summary.OverdueAccounts),
+		FailingAccounts:  int32(summary.FailingAccounts),
+		MaxLag:           durationpb.New(0),
+		LaggingAccounts:  make([]*mootslivepbv1.SpotifyAccountHealth, 0, len(accounts)),
+		Replicas:         make([]*mootslivepbv1.PollerReplicaThroughput, 0, len(replicas)),
+		ThroughputWindow: durationpb.New(pollerThroughputWindow),
+	}
+	// The accounts come most lagging first, so the first has the most lag
+	// of them all.
+	if len(accounts) > 0 {
+		res.MaxLag = durationpb.New(spotifyAccountLag(accounts[0], now))
+	}
+	for _, account := range accounts {
+		res.LaggingAccounts = append(
+			res.LaggingAccounts, spotifyAccountHealthToProto(account, true, now),
+		)
+	}
+	for _, replica := range replicas {
+		res.Replicas = append(res.Replicas, pollerReplicaThroughputToProto(replica))
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (as *AdminServerHandler) ListAuditEvents(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ListAuditEventsRequest],
+) (*connect.Response[mootslivepbv1.ListAuditEventsResponse], error) {
+	_, err := as.authEngine.handleReq(ctx, req, handleReqOpts{
+		admin: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	pageSize, err := clampPageSize(
+		req.Msg.PageSize, defaultAuditEventsPageSize, maxAuditEventsPageSize,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	// An empty list of kinds matches events of every kind, so mustn't be nil
+	// which the database would see as NULL.
+	kinds := make([]string, 0, len(req.Msg.Kinds))
+	for _, kind := range req.Msg.Kinds {
+		k, err := auditEventKindFromProto(kind)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		kinds = append(kinds, k)
+	}
+	// Unset bounds are replaced with ones that include every event.
+	startTime, endTime := time.Time{}, pageStart.Time
+	if req.Msg.StartTime != nil {
+		startTime = req.Msg.StartTime.AsTime()
+	}
+	if req.Msg.EndTime != nil {
+		endTime = req.Msg.EndTime.AsTime()
+	}
+	if !startTime.Before(endTime) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("start_time must be before end_time"),
+		)
+	}
+	query := pageQuery(append([]string{
+		req.Msg.ActorUserId,
+		req.Msg.TargetUserId,
+		startTime.Format(time.RFC3339Nano),
+		endTime.Format(time.RFC3339Nano),
+	}, kinds...)...)
+	cursor, err := decodePageCursor(req.Msg.PageToken, query)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	events, err := as.queries.ListAuditEvents(ctx, db.ListAuditEventsParams{
+		ActorUserID:     req.Msg.ActorUserId,
+		TargetUserID:    req.Msg.TargetUserId,
+		Kinds:           kinds,
+		StartTime:       startTime,
+		EndTime:         endTime,
+		BeforeCreatedAt: cursor.Time,
+		BeforeID:        cursor.ID,
+		RowLimit:        pageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching audit events: %w", err)
+	}
+
+	res := &mootslivepbv1.ListAuditEventsResponse{
+		Events: make([]*mootslivepbv1.AuditEvent, 0, len(events)),
+	}
+	for _, event := range events {
+		res.Events = append(res.Events, auditEventToProto(event))
+	}
+	// A short page means we've reached the end of the events.
+	if len(events) == int(pageSize) {
+		last := events[len(events)-1]
+		res.NextPageToken = pageCursor{
+			Time:  last.CreatedAt,
+			ID:    last.ID,
+			Query: query,
+		}.encode()
+	}
+
+	return connect.NewResponse(res), nil
+}
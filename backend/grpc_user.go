@@ -2,9 +2,12 @@ package backend
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/mootslive/mono/backend/twitter"
+	"sort"
 	"time"
 
 	"github.com/bufbuild/connect-go"
@@ -14,6 +17,7 @@ import (
 	"github.com/segmentio/ksuid"
 	"golang.org/x/exp/slog"
 	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -22,19 +26,27 @@ type UserServiceHandler struct {
 	log        *slog.Logger
 	twitterCfg *oauth2.Config
 	authEngine *authEngine
+	tweeter    *tweeter
+	events     *EventBus
 }
 
 func NewUserServiceHandler(
 	queries db.TXQuerier,
 	log *slog.Logger,
 	authEngine *authEngine,
+	events *EventBus,
 ) *UserServiceHandler {
 	return &UserServiceHandler{
 		log:     log,
 		queries: queries,
+		events:  events,
 
 		twitterCfg: twitter.OAuthConfig(),
 		authEngine: authEngine,
+		tweeter: &tweeter{
+			log:     log,
+			queries: queries,
+		},
 	}
 }
 
@@ -49,9 +61,15 @@ func (us *UserServiceHandler) GetMe(
 		return nil, fmt.Errorf("access denied: %w", err)
 	}
 
+	profile, err := loadOwnProfile(ctx, us.queries, *authCtx.user)
+	if err != nil {
+		return nil, err
+	}
+
 	res := connect.NewResponse(&mootslivepbv1.GetMeResponse{
 		Id:        authCtx.user.ID,
 		CreatedAt: timestamppb.New(authCtx.user.CreatedAt),
+		Profile:   profile,
 	})
 	return res, nil
 }
@@ -106,6 +124,7 @@ func (us *UserServiceHandler) FinishTwitterAuth(
 		return nil, fmt.Errorf("twitter auth: %w", err)
 	}
 
+	reqClient := auditClientFromReq(req)
 	client := twitter.NewClient(ctx, tok)
 	me, err := client.GetMe(ctx)
 	if err != nil {
@@ -132,28 +151,40 @@ func (us *UserServiceHandler) FinishTwitterAuth(
 			}
 		}()
 
+		handle, err := defaultHandle(ctx, tx, me.Data.Username)
+		if err != nil {
+			return nil, err
+		}
 		userId := ksuid.New().String()
 		now := time.Now()
 		err = tx.CreateUser(ctx, db.CreateUserParams{
 			ID:        userId,
 			CreatedAt: now,
+			Handle:    handle,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("creating user: %w", err)
 		}
 
 		err = tx.CreateTwitterAccount(ctx, db.CreateTwitterAccountParams{
-			TwitterUserID: me.Data.ID,
-			UserID:        userId,
-			OauthToken:    db.OAuth2Token(*tok),
-			CreatedAt:     now,
+			TwitterUserID:   me.Data.ID,
+			UserID:          userId,
+			OauthToken:      db.OAuth2Token(*tok),
+			CreatedAt:       now,
+			Name:            me.Data.Name,
+			Username:        me.Data.Username,
+			ProfileImageUrl: me.Data.ProfileImageURL,
+			ProfileUpdatedAt: sql.NullTime{
+				Valid: true,
+				Time:  now,
+			},
 		})
 		if err != nil {
 			return nil, fmt.Errorf("creating twitter account: %w", err)
 		}
 
-		if err := commit(ctx); err != nil {
-			return nil, fmt.Errorf("committing transaction: %w", err)
+		if err := requestTwitterFollowSync(ctx, tx, me.Data.ID); err != nil {
+			return nil, fmt.Errorf("requesting follow sync: %w", err)
 		}
 
 		idToken, err := us.authEngine.createIDToken(ctx, userId)
@@ -161,23 +192,78 @@ func (us *UserServiceHandler) FinishTwitterAuth(
 			return nil, fmt.Errorf("creating id token: %w", err)
 		}
 
+		for _, event := range []auditEvent{
+			{kind: auditSignup},
+			{
+				kind:           auditProviderLinked,
+				provider:       providerTwitter,
+				providerUserID: me.Data.ID,
+			},
+			{kind: auditTokenIssued},
+		} {
+			event.actorUserID = userId
+			event.targetUserID = userId
+			event.client = reqClient
+			if err := recordAuditEvent(ctx, tx, event); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := commit(ctx); err != nil {
+			return nil, fmt.Errorf("committing transaction: %w", err)
+		}
+
 		res := connect.NewResponse(&mootslivepbv1.FinishTwitterAuthResponse{
 			IdToken: idToken,
 		})
 		return res, nil
 	}
 
+	// Logging in again is how a user recovers an account whose stored token
+	// has gone stale or been revoked, so the new one replaces it.
+	err = us.queries.UpdateTwitterAccountToken(ctx, db.UpdateTwitterAccountTokenParams{
+		TwitterUserID: acct.TwitterUserID,
+		OauthToken:    db.OAuth2Token(*tok),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("saving token: %w", err)
+	}
+	if err := updateTwitterProfile(ctx, us.queries, me.Data); err != nil {
+		return nil, err
+	}
+
 	idToken, err := us.authEngine.createIDToken(ctx, acct.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("creating id token: %w", err)
 	}
 
+	for _, event := range []auditEvent{
+		{
+			kind:           auditLogin,
+			provider:       providerTwitter,
+			providerUserID: me.Data.ID,
+		},
+		{kind: auditTokenIssued},
+	} {
+		event.actorUserID = acct.UserID
+		event.targetUserID = acct.UserID
+		event.client = reqClient
+		if err := recordAuditEvent(ctx, us.queries, event); err != nil {
+			return nil, err
+		}
+	}
+
 	res := connect.NewResponse(&mootslivepbv1.FinishTwitterAuthResponse{
 		IdToken: idToken,
 	})
 	return res, nil
 }
 
+const (
+	defaultListensPageSize = 100
+	maxListensPageSize     = 1000
+)
+
 func (us *UserServiceHandler) ListListens(
 	ctx context.Context,
 	req *connect.Request[mootslivepbv1.ListListensRequest],
@@ -189,22 +275,3480 @@ func (us *UserServiceHandler) ListListens(
 		return nil, fmt.Errorf("access denied: %w", err)
 	}
 
-	listens, err := us.queries.ListListensForUser(ctx, authCtx.user.ID)
+	pageSize, err := clampPageSize(
+		req.Msg.PageSize, defaultListensPageSize, maxListensPageSize,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("fetching user: %w", err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.Source != "" && !listenSources[req.Msg.Source] {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("unsupported source %q", req.Msg.Source),
+		)
+	}
+	// Unset bounds are replaced with ones that include every listen.
+	startTime, endTime := time.Time{}, pageStart.Time
+	if req.Msg.StartTime != nil {
+		startTime = req.Msg.StartTime.AsTime()
+	}
+	if req.Msg.EndTime != nil {
+		endTime = req.Msg.EndTime.AsTime()
+	}
+	if !startTime.Before(endTime) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("start_time must be before end_time"),
+		)
+	}
+	order := req.Msg.Order
+	if order == mootslivepbv1.ListenOrder_LISTEN_ORDER_UNSPECIFIED {
+		order = mootslivepbv1.ListenOrder_LISTEN_ORDER_NEWEST_FIRST
+	}
+	query := pageQuery(
+		order.String(),
+		startTime.Format(time.RFC3339Nano),
+		endTime.Format(time.RFC3339Nano),
+		req.Msg.Source,
+		req.Msg.Isrc,
+		req.Msg.ArtistId,
+	)
+	cursor, err := decodePageCursor(req.Msg.PageToken, query)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var listens []db.Listen
+	switch order {
+	case mootslivepbv1.ListenOrder_LISTEN_ORDER_NEWEST_FIRST:
+		listens, err = us.queries.ListListensPage(ctx, db.ListListensPageParams{
+			UserID:           authCtx.user.ID,
+			StartTime:        startTime,
+			EndTime:          endTime,
+			Source:           req.Msg.Source,
+			Isrc:             req.Msg.Isrc,
+			ArtistID:         req.Msg.ArtistId,
+			BeforeListenedAt: cursor.Time,
+			BeforeID:         cursor.ID,
+			RowLimit:         pageSize,
+		})
+	case mootslivepbv1.ListenOrder_LISTEN_ORDER_OLDEST_FIRST:
+		// The first page of an oldest first listing starts before anything
+		// could have happened, rather than after.
+		if req.Msg.PageToken == "" {
+			cursor = pageCursor{}
+		}
+		listens, err = us.queries.ListListensPageAscending(ctx, db.ListListensPageAscendingParams{
+			UserID:          authCtx.user.ID,
+			StartTime:       startTime,
+			EndTime:         endTime,
+			Source:          req.Msg.Source,
+			Isrc:            req.Msg.Isrc,
+			ArtistID:        req.Msg.ArtistId,
+			AfterListenedAt: cursor.Time,
+			AfterID:         cursor.ID,
+			RowLimit:        pageSize,
+		})
+	default:
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("unsupported order %s", req.Msg.Order),
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fetching listens: %w", err)
 	}
 
 	res := &mootslivepbv1.ListListensResponse{
 		Listens: make([]*mootslivepbv1.Listen, 0, len(listens)),
 	}
 	for _, listen := range listens {
-		res.Listens = append(res.Listens, &mootslivepbv1.Listen{
-			Id:         listen.ID,
-			CreatedAt:  timestamppb.New(listen.CreatedAt),
-			Source:     listen.Source,
-			Isrc:       listen.Isrc,
-			ListenedAt: timestamppb.New(listen.ListenedAt),
+		res.Listens = append(res.Listens, listenToProto(listen))
+	}
+	if err := attachReactions(ctx, us.queries, authCtx.user.ID, res.Listens); err != nil {
+		return nil, err
+	}
+	// A short page means we've reached the end of the listens.
+	if len(listens) == int(pageSize) {
+		last := listens[len(listens)-1]
+		res.NextPageToken = pageCursor{
+			Time:  last.ListenedAt,
+			ID:    last.ID,
+			Query: query,
+		}.encode()
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func listenToProto(listen db.Listen) *mootslivepbv1.Listen {
+	return &mootslivepbv1.Listen{
+		Id:         listen.ID,
+		UserId:     listen.UserID,
+		CreatedAt:  timestamppb.New(listen.CreatedAt),
+		Source:     listen.Source,
+		Isrc:       listen.Isrc,
+		ListenedAt: timestamppb.New(listen.ListenedAt),
+		Hidden:     listen.Hidden,
+	}
+}
+
+func trackToProto(track db.Track) *mootslivepbv1.Track {
+	artists := make([]*mootslivepbv1.Artist, 0, len(track.ArtistIds))
+	for i, id := range track.ArtistIds {
+		artists = append(artists, &mootslivepbv1.Artist{
+			Id:   id,
+			Name: track.ArtistNames[i],
+		})
+	}
+	return &mootslivepbv1.Track{
+		Isrc:    track.Isrc,
+		Name:    track.Name,
+		Artists: artists,
+		Album: &mootslivepbv1.Album{
+			Id:       track.AlbumID,
+			Name:     track.AlbumName,
+			ImageUrl: track.AlbumImageUrl,
+		},
+		DurationMs:     track.DurationMs,
+		SpotifyTrackId: track.SpotifyTrackID,
+	}
+}
+
+// listUserSummaries fetches the public profile details of the given users,
+// keyed by user ID.
+func listUserSummaries(
+	ctx context.Context, queries db.Querier, userIDs []string,
+) (map[string]*mootslivepbv1.UserSummary, error) {
+	summaries := make(map[string]*mootslivepbv1.UserSummary, len(userIDs))
+	// Users who've since been deleted still get a summary, albeit one
+	// without any details.
+	for _, id := range userIDs {
+		summaries[id] = &mootslivepbv1.UserSummary{Id: id}
+	}
+
+	rows, err := queries.ListUserSummaries(ctx, uniqueStrings(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("fetching user summaries: %w", err)
+	}
+	for _, row := range rows {
+		summaries[row.UserID] = &mootslivepbv1.UserSummary{
+			Id:          row.UserID,
+			DisplayName: row.DisplayName,
+			Handle:      row.Handle,
+			AvatarUrl:   row.ProfileImageUrl,
+		}
+	}
+	return summaries, nil
+}
+
+func (us *UserServiceHandler) ListFeed(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ListFeedRequest],
+) (*connect.Response[mootslivepbv1.ListFeedResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	pageSize, err := clampPageSize(req.Msg.PageSize, defaultFeedPageSize, maxFeedPageSize)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	cursor, err := decodePageCursor(req.Msg.PageToken, "")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	followedIDs, err := us.queries.ListFeedUserIDs(ctx, authCtx.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching followed users: %w", err)
+	}
+	policies, err := loadPrivacyPolicies(
+		ctx, us.queries, authCtx.user.ID, followedIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	muted, err := listMutedUserIDs(ctx, us.queries, authCtx.user.ID)
+	if err != nil {
+		return nil, err
+	}
+	visibleIDs := make([]string, 0, len(followedIDs))
+	for _, id := range followedIDs {
+		if policies[id].canView() && !muted[id] {
+			visibleIDs = append(visibleIDs, id)
+		}
+	}
+
+	page, err := us.queries.ListFeedListens(ctx, db.ListFeedListensParams{
+		UserIds:          visibleIDs,
+		BeforeListenedAt: cursor.Time,
+		BeforeID:         cursor.ID,
+		RowLimit:         pageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching feed: %w", err)
+	}
+	listens := make([]db.ListFeedListensRow, 0, len(page))
+	for _, listen := range page {
+		if policies[listen.UserID].allows(db.Listen(listen)) {
+			listens = append(listens, listen)
+		}
+	}
+
+	isrcs := make([]string, 0, len(listens))
+	userIDs := make([]string, 0, len(listens))
+	for _, listen := range listens {
+		isrcs = append(isrcs, listen.Isrc)
+		userIDs = append(userIDs, listen.UserID)
+	}
+	tracks, err := listTracks(ctx, us.queries, isrcs)
+	if err != nil {
+		return nil, err
+	}
+	users, err := listUserSummaries(ctx, us.queries, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := collapseFeed(listens, tracks)
+	res := &mootslivepbv1.ListFeedResponse{
+		Entries: make([]*mootslivepbv1.FeedEntry, 0, len(entries)),
+	}
+	pbs := make([]*mootslivepbv1.Listen, 0, len(listens))
+	for _, group := range entries {
+		entry := &mootslivepbv1.FeedEntry{
+			User:    users[group[0].UserID],
+			Listens: make([]*mootslivepbv1.Listen, 0, len(group)),
+		}
+		if track, ok := tracks[group[0].Isrc]; ok {
+			entry.Track = trackToProto(track)
+		}
+		for _, listen := range group {
+			entry.Listens = append(entry.Listens, listenToProto(db.Listen(listen)))
+		}
+		pbs = append(pbs, entry.Listens...)
+		res.Entries = append(res.Entries, entry)
+	}
+	if err := attachReactions(ctx, us.queries, authCtx.user.ID, pbs); err != nil {
+		return nil, err
+	}
+	// A short page means we've reached the end of the feed. Hidden listens
+	// are dropped from pages rather than made up for, so the cursor is
+	// positioned after them regardless.
+	if len(page) == int(pageSize) {
+		last := page[len(page)-1]
+		res.NextPageToken = pageCursor{
+			Time: last.ListenedAt,
+			ID:   last.ID,
+		}.encode()
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// exportListensPageSize is how many listens are fetched from the database and
+// sent to the client per message during a data export.
+const exportListensPageSize = 500
+
+type exportedUser struct {
+	ID                  string     `json:"id"`
+	CreatedAt           time.Time  `json:"created_at"`
+	DeletionRequestedAt *time.Time `json:"deletion_requested_at,omitempty"`
+	Handle              string     `json:"handle,omitempty"`
+	DisplayName         string     `json:"display_name,omitempty"`
+	Bio                 string     `json:"bio,omitempty"`
+	TimeZone            string     `json:"time_zone"`
+}
+
+type exportedTwitterAccount struct {
+	TwitterUserID   string    `json:"twitter_user_id"`
+	Name            string    `json:"name"`
+	Username        string    `json:"username"`
+	ProfileImageURL string    `json:"profile_image_url"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+type exportedSpotifyAccount struct {
+	SpotifyUserID  string     `json:"spotify_user_id"`
+	LastListenedAt *time.Time `json:"last_listened_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+type exportedListen struct {
+	ID         string    `json:"id"`
+	Source     string    `json:"source"`
+	ISRC       string    `json:"isrc"`
+	ListenedAt time.Time `json:"listened_at"`
+	CreatedAt  time.Time `json:"created_at"`
+	Hidden     bool      `json:"hidden"`
+}
+
+type exportedListenEdit struct {
+	ListenID      string     `json:"listen_id"`
+	Action        string     `json:"action"`
+	OldISRC       string     `json:"old_isrc,omitempty"`
+	OldListenedAt *time.Time `json:"old_listened_at,omitempty"`
+	NewISRC       string     `json:"new_isrc,omitempty"`
+	NewListenedAt *time.Time `json:"new_listened_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+type exportedReaction struct {
+	ListenID  string    `json:"listen_id"`
+	Emoji     string    `json:"emoji"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type exportedComment struct {
+	ID        string    `json:"id"`
+	ListenID  string    `json:"listen_id"`
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type exportedBlock struct {
+	UserID    string    `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type exportedPrivacySettings struct {
+	Visibility    string   `json:"visibility"`
+	HiddenSources []string `json:"hidden_sources"`
+}
+
+type exportedIncognitoWindow struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// exportedData is everything in the export other than the listens, which are
+// streamed separately as there may be a great number of them.
+type exportedData struct {
+	User             exportedUser              `json:"user"`
+	TwitterAccounts  []exportedTwitterAccount  `json:"twitter_accounts"`
+	SpotifyAccounts  []exportedSpotifyAccount  `json:"spotify_accounts"`
+	PrivacySettings  exportedPrivacySettings   `json:"privacy_settings"`
+	IncognitoWindows []exportedIncognitoWindow `json:"incognito_windows"`
+	ListenEdits      []exportedListenEdit      `json:"listen_edits"`
+	Reactions        []exportedReaction        `json:"reactions"`
+	Comments         []exportedComment         `json:"comments"`
+	Blocks           []exportedBlock           `json:"blocks"`
+	Mutes            []exportedBlock           `json:"mutes"`
+}
+
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func (us *UserServiceHandler) ExportMyData(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ExportMyDataRequest],
+	stream *connect.ServerStream[mootslivepbv1.ExportMyDataResponse],
+) error {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return fmt.Errorf("access denied: %w", err)
+	}
+	user := authCtx.user
+
+	data := exportedData{
+		User: exportedUser{
+			ID:                  user.ID,
+			CreatedAt:           user.CreatedAt,
+			DeletionRequestedAt: nullTimePtr(user.DeletionRequestedAt),
+			Handle:              user.Handle.String,
+			DisplayName:         user.DisplayName,
+			Bio:                 user.Bio,
+			TimeZone:            user.TimeZone,
+		},
+		TwitterAccounts:  []exportedTwitterAccount{},
+		SpotifyAccounts:  []exportedSpotifyAccount{},
+		IncognitoWindows: []exportedIncognitoWindow{},
+		Reactions:        []exportedReaction{},
+		Comments:         []exportedComment{},
+		Blocks:           []exportedBlock{},
+		Mutes:            []exportedBlock{},
+	}
+
+	twitterAccounts, err := us.queries.ListTwitterAccountsForUser(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("fetching twitter accounts: %w", err)
+	}
+	for _, account := range twitterAccounts {
+		data.TwitterAccounts = append(data.TwitterAccounts, exportedTwitterAccount{
+			TwitterUserID:   account.TwitterUserID,
+			Name:            account.Name,
+			Username:        account.Username,
+			ProfileImageURL: account.ProfileImageUrl,
+			CreatedAt:       account.CreatedAt,
+		})
+	}
+
+	spotifyAccounts, err := us.queries.ListSpotifyAccountsForUser(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("fetching spotify accounts: %w", err)
+	}
+	for _, account := range spotifyAccounts {
+		data.SpotifyAccounts = append(data.SpotifyAccounts, exportedSpotifyAccount{
+			SpotifyUserID:  account.SpotifyUserID,
+			LastListenedAt: nullTimePtr(account.LastListenedAt),
+			CreatedAt:      account.CreatedAt,
+		})
+	}
+
+	privacySettings, err := getPrivacySettings(ctx, us.queries, user.ID)
+	if err != nil {
+		return fmt.Errorf("fetching privacy settings: %w", err)
+	}
+	data.PrivacySettings = exportedPrivacySettings{
+		Visibility:    privacySettings.Visibility,
+		HiddenSources: privacySettings.HiddenSources,
+	}
+
+	incognitoWindows, err := us.queries.ListIncognitoWindowsForUser(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("fetching incognito windows: %w", err)
+	}
+	for _, window := range incognitoWindows {
+		data.IncognitoWindows = append(data.IncognitoWindows, exportedIncognitoWindow{
+			StartTime: window.StartTime,
+			EndTime:   window.EndTime,
+			CreatedAt: window.CreatedAt,
+		})
+	}
+
+	listenEdits, err := us.queries.ListListenEditsForUser(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("fetching listen edits: %w", err)
+	}
+	for _, edit := range listenEdits {
+		data.ListenEdits = append(data.ListenEdits, exportedListenEdit{
+			ListenID:      edit.ListenID,
+			Action:        edit.Action,
+			OldISRC:       edit.OldIsrc.String,
+			OldListenedAt: nullTimePtr(edit.OldListenedAt),
+			NewISRC:       edit.NewIsrc.String,
+			NewListenedAt: nullTimePtr(edit.NewListenedAt),
+			CreatedAt:     edit.CreatedAt,
+		})
+	}
+
+	reactions, err := us.queries.ListReactionsForUser(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("fetching reactions: %w", err)
+	}
+	for _, reaction := range reactions {
+		data.Reactions = append(data.Reactions, exportedReaction{
+			ListenID:  reaction.ListenID,
+			Emoji:     reaction.Emoji,
+			CreatedAt: reaction.CreatedAt,
+		})
+	}
+
+	comments, err := us.queries.ListCommentsForUser(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("fetching comments: %w", err)
+	}
+	for _, comment := range comments {
+		data.Comments = append(data.Comments, exportedComment{
+			ID:        comment.ID,
+			ListenID:  comment.ListenID,
+			Text:      comment.Text,
+			CreatedAt: comment.CreatedAt,
+		})
+	}
+
+	blocks, err := us.queries.ListBlocksForUser(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("fetching blocks: %w", err)
+	}
+	for _, block := range blocks {
+		data.Blocks = append(data.Blocks, exportedBlock{
+			UserID:    block.BlockedID,
+			CreatedAt: block.CreatedAt,
+		})
+	}
+	mutes, err := us.queries.ListMutesForUser(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("fetching mutes: %w", err)
+	}
+	for _, mute := range mutes {
+		data.Mutes = append(data.Mutes, exportedBlock{
+			UserID:    mute.MutedID,
+			CreatedAt: mute.CreatedAt,
+		})
+	}
+
+	header, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("marshalling export: %w", err)
+	}
+	// Re-open the object so that the listens can be streamed into it.
+	header = append(header[:len(header)-1], `,"listens":[`...)
+	if err := stream.Send(&mootslivepbv1.ExportMyDataResponse{
+		Data: header,
+	}); err != nil {
+		return fmt.Errorf("sending export header: %w", err)
+	}
+
+	afterID := ""
+	first := true
+	for {
+		listens, err := us.queries.ListListensForUserAfterID(
+			ctx, db.ListListensForUserAfterIDParams{
+				UserID: user.ID,
+				ID:     afterID,
+				Limit:  exportListensPageSize,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("fetching listens: %w", err)
+		}
+		if len(listens) == 0 {
+			break
+		}
+
+		var chunk []byte
+		for _, listen := range listens {
+			b, err := json.Marshal(exportedListen{
+				ID:         listen.ID,
+				Source:     listen.Source,
+				ISRC:       listen.Isrc,
+				ListenedAt: listen.ListenedAt,
+				CreatedAt:  listen.CreatedAt,
+				Hidden:     listen.Hidden,
+			})
+			if err != nil {
+				return fmt.Errorf("marshalling listen: %w", err)
+			}
+			if !first {
+				chunk = append(chunk, ',')
+			}
+			chunk = append(chunk, b...)
+			first = false
+		}
+
+		if err := stream.Send(&mootslivepbv1.ExportMyDataResponse{
+			Data: chunk,
+		}); err != nil {
+			return fmt.Errorf("sending listens: %w", err)
+		}
+		afterID = listens[len(listens)-1].ID
+	}
+
+	if err := stream.Send(&mootslivepbv1.ExportMyDataResponse{
+		Data: []byte("]}"),
+	}); err != nil {
+		return fmt.Errorf("sending export footer: %w", err)
+	}
+
+	return nil
+}
+
+func (us *UserServiceHandler) DeleteMyAccount(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.DeleteMyAccountRequest],
+) (*connect.Response[mootslivepbv1.DeleteMyAccountResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	requestedAt := authCtx.user.DeletionRequestedAt
+	// Requesting deletion again shouldn't push the deletion back.
+	if !requestedAt.Valid {
+		requestedAt = sql.NullTime{
+			Valid: true,
+			Time:  time.Now(),
+		}
+		err := us.queries.SetUserDeletionRequestedAt(
+			ctx, db.SetUserDeletionRequestedAtParams{
+				ID:                  authCtx.user.ID,
+				DeletionRequestedAt: requestedAt,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("requesting deletion: %w", err)
+		}
+		err = recordAuditEvent(ctx, us.queries, auditEvent{
+			kind:         auditAccountDeletionRequested,
+			actorUserID:  authCtx.user.ID,
+			targetUserID: authCtx.user.ID,
+			client:       auditClientFromReq(req),
+		})
+		if err != nil {
+			return nil, err
+		}
+		us.log.Info("user requested account deletion",
+			slog.String("user_id", authCtx.user.ID),
+		)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.DeleteMyAccountResponse{
+		DeleteTime: timestamppb.New(
+			requestedAt.Time.Add(accountDeletionGracePeriod),
+		),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) CancelAccountDeletion(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.CancelAccountDeletionRequest],
+) (*connect.Response[mootslivepbv1.CancelAccountDeletionResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	err = us.queries.SetUserDeletionRequestedAt(
+		ctx, db.SetUserDeletionRequestedAtParams{
+			ID:                  authCtx.user.ID,
+			DeletionRequestedAt: sql.NullTime{},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cancelling deletion: %w", err)
+	}
+	if authCtx.user.DeletionRequestedAt.Valid {
+		err := recordAuditEvent(ctx, us.queries, auditEvent{
+			kind:         auditAccountDeletionCancelled,
+			actorUserID:  authCtx.user.ID,
+			targetUserID: authCtx.user.ID,
+			client:       auditClientFromReq(req),
 		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.CancelAccountDeletionResponse{})
+	return res, nil
+}
+
+func twitterSharingSettingsToProto(
+	settings db.TwitterSharingSetting,
+) *mootslivepbv1.TwitterSharingSettings {
+	return &mootslivepbv1.TwitterSharingSettings{
+		ListenSharingEnabled: settings.ListenSharingEnabled,
+		WeeklyRecapEnabled:   settings.WeeklyRecapEnabled,
+	}
+}
+
+func (us *UserServiceHandler) GetTwitterSharingSettings(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.GetTwitterSharingSettingsRequest],
+) (*connect.Response[mootslivepbv1.GetTwitterSharingSettingsResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	settings, err := getTwitterSharingSettings(ctx, us.queries, authCtx.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching settings: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.GetTwitterSharingSettingsResponse{
+		Settings: twitterSharingSettingsToProto(settings),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) UpdateTwitterSharingSettings(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.UpdateTwitterSharingSettingsRequest],
+) (*connect.Response[mootslivepbv1.UpdateTwitterSharingSettingsResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+	if req.Msg.Settings == nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument, fmt.Errorf("settings must be provided"),
+		)
+	}
+
+	settings := db.UpsertTwitterSharingSettingsParams{
+		UserID:               authCtx.user.ID,
+		ListenSharingEnabled: req.Msg.Settings.ListenSharingEnabled,
+		WeeklyRecapEnabled:   req.Msg.Settings.WeeklyRecapEnabled,
+		UpdatedAt:            time.Now(),
+	}
+	if err := us.queries.UpsertTwitterSharingSettings(ctx, settings); err != nil {
+		return nil, fmt.Errorf("updating settings: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.UpdateTwitterSharingSettingsResponse{
+		Settings: twitterSharingSettingsToProto(db.TwitterSharingSetting(settings)),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) ShareListen(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ShareListenRequest],
+) (*connect.Response[mootslivepbv1.ShareListenResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	settings, err := getTwitterSharingSettings(ctx, us.queries, authCtx.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching settings: %w", err)
+	}
+	if !settings.ListenSharingEnabled {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf("listen sharing is disabled"),
+		)
+	}
+
+	listen, err := us.queries.GetListenForUser(ctx, db.GetListenForUserParams{
+		ID:     req.Msg.ListenId,
+		UserID: authCtx.user.ID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("listen not found"),
+		)
+	} else if err != nil {
+		return nil, fmt.Errorf("fetching listen: %w", err)
+	}
+
+	tracks, err := lookupTracksByISRC(
+		ctx, us.queries, authCtx.user.ID, []string{listen.Isrc},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("looking up track: %w", err)
+	}
+	track, ok := tracks[listen.Isrc]
+	if !ok {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf("track details unavailable for listen"),
+		)
+	}
+
+	tweetID, err := us.tweeter.tweet(
+		ctx, authCtx.user.ID, tweetKindListen, listen.ID, formatListenTweet(track),
+	)
+	if errors.Is(err, errAlreadyTweeted) {
+		return nil, connect.NewError(connect.CodeAlreadyExists, err)
+	} else if err != nil {
+		return nil, fmt.Errorf("tweeting listen: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.ShareListenResponse{
+		TweetId: tweetID,
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) SyncMoots(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.SyncMootsRequest],
+) (*connect.Response[mootslivepbv1.SyncMootsResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	accounts, err := us.queries.ListTwitterAccountsForUser(ctx, authCtx.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching twitter accounts: %w", err)
+	}
+	for _, account := range accounts {
+		err := requestTwitterFollowSync(ctx, us.queries, account.TwitterUserID)
+		if err != nil {
+			return nil, fmt.Errorf("requesting follow sync: %w", err)
+		}
+	}
+
+	return connect.NewResponse(&mootslivepbv1.SyncMootsResponse{}), nil
+}
+
+func (us *UserServiceHandler) ListMoots(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ListMootsRequest],
+) (*connect.Response[mootslivepbv1.ListMootsResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	mootIDs, err := us.queries.ListMootIDsForUser(ctx, authCtx.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching moots: %w", err)
+	}
+	twitterMoots, err := us.queries.ListTwitterMootsForUser(ctx, authCtx.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching twitter moots: %w", err)
+	}
+	twitterUserIDs := make(map[string]string, len(twitterMoots))
+	for _, moot := range twitterMoots {
+		twitterUserIDs[moot.UserID] = moot.TwitterUserID
+	}
+
+	res := &mootslivepbv1.ListMootsResponse{
+		Moots: make([]*mootslivepbv1.Moot, 0, len(mootIDs)),
+	}
+	for _, mootID := range mootIDs {
+		res.Moots = append(res.Moots, &mootslivepbv1.Moot{
+			UserId:        mootID,
+			TwitterUserId: twitterUserIDs[mootID],
+		})
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func followToProto(follow db.Follow) *mootslivepbv1.Follow {
+	pb := &mootslivepbv1.Follow{
+		FollowerId: follow.FollowerID,
+		FolloweeId: follow.FolloweeID,
+		CreatedAt:  timestamppb.New(follow.CreatedAt),
+	}
+	switch follow.Status {
+	case followStatusPending:
+		pb.Status = mootslivepbv1.FollowStatus_FOLLOW_STATUS_PENDING
+	case followStatusAccepted:
+		pb.Status = mootslivepbv1.FollowStatus_FOLLOW_STATUS_ACCEPTED
+	}
+	if follow.AcceptedAt.Valid {
+		pb.AcceptedAt = timestamppb.New(follow.AcceptedAt.Time)
+	}
+	return pb
+}
+
+func followsToProto(follows []db.Follow) []*mootslivepbv1.Follow {
+	pbs := make([]*mootslivepbv1.Follow, 0, len(follows))
+	for _, follow := range follows {
+		pbs = append(pbs, followToProto(follow))
+	}
+	return pbs
+}
+
+func (us *UserServiceHandler) FollowUser(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.FollowUserRequest],
+) (*connect.Response[mootslivepbv1.FollowUserResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+	if req.Msg.UserId == authCtx.user.ID {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument, fmt.Errorf("cannot follow yourself"),
+		)
+	}
+
+	followee, err := us.queries.GetUser(ctx, req.Msg.UserId)
+	if errors.Is(err, pgx.ErrNoRows) || followee.DeletionRequestedAt.Valid {
+		return nil, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("user not found"),
+		)
+	} else if err != nil {
+		return nil, fmt.Errorf("fetching user: %w", err)
+	}
+
+	blocked, err := listBlockedUserIDs(ctx, us.queries, authCtx.user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked[followee.ID] {
+		return nil, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("user not found"),
+		)
+	}
+
+	settings, err := getFollowSettings(ctx, us.queries, followee.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching follow settings: %w", err)
+	}
+
+	now := time.Now()
+	follow := db.CreateFollowParams{
+		FollowerID: authCtx.user.ID,
+		FolloweeID: followee.ID,
+		Status:     followStatusAccepted,
+		CreatedAt:  now,
+		AcceptedAt: sql.NullTime{
+			Valid: true,
+			Time:  now,
+		},
+	}
+	if settings.RequireApproval {
+		follow.Status = followStatusPending
+		follow.AcceptedAt = sql.NullTime{}
+	}
+	created, err := us.queries.CreateFollow(ctx, follow)
+	if err != nil {
+		return nil, fmt.Errorf("creating follow: %w", err)
+	}
+
+	// Following someone you already follow, or have already requested to
+	// follow, leaves the existing follow as it is.
+	existing := db.Follow(follow)
+	if created > 0 {
+		kind := notificationKindNewFollower
+		if follow.Status == followStatusPending {
+			kind = notificationKindFollowRequest
+		}
+		if err := notify(ctx, us.queries, followee.ID, kind, "", authCtx.user.ID); err != nil {
+			us.log.Error("failed to notify of follow", err)
+		}
+	} else {
+		existing, err = us.queries.GetFollow(ctx, db.GetFollowParams{
+			FollowerID: authCtx.user.ID,
+			FolloweeID: followee.ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fetching follow: %w", err)
+		}
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.FollowUserResponse{
+		Follow: followToProto(existing),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) UnfollowUser(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.UnfollowUserRequest],
+) (*connect.Response[mootslivepbv1.UnfollowUserResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	// Unfollowing also withdraws a pending follow request, and is a no-op if
+	// the caller doesn't follow the user.
+	_, err = us.queries.DeleteFollow(ctx, db.DeleteFollowParams{
+		FollowerID: authCtx.user.ID,
+		FolloweeID: req.Msg.UserId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("deleting follow: %w", err)
+	}
+
+	return connect.NewResponse(&mootslivepbv1.UnfollowUserResponse{}), nil
+}
+
+func (us *UserServiceHandler) ListFollowers(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ListFollowersRequest],
+) (*connect.Response[mootslivepbv1.ListFollowersResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	follows, err := us.queries.ListFollowers(ctx, authCtx.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching followers: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.ListFollowersResponse{
+		Follows: followsToProto(follows),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) ListFollowing(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ListFollowingRequest],
+) (*connect.Response[mootslivepbv1.ListFollowingResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	follows, err := us.queries.ListFollowing(ctx, authCtx.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching following: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.ListFollowingResponse{
+		Follows: followsToProto(follows),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) ListFollowRequests(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ListFollowRequestsRequest],
+) (*connect.Response[mootslivepbv1.ListFollowRequestsResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	follows, err := us.queries.ListFollowRequests(ctx, authCtx.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching follow requests: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.ListFollowRequestsResponse{
+		Follows: followsToProto(follows),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) ApproveFollowRequest(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ApproveFollowRequestRequest],
+) (*connect.Response[mootslivepbv1.ApproveFollowRequestResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	accepted, err := us.queries.AcceptFollowRequest(ctx, db.AcceptFollowRequestParams{
+		FollowerID: req.Msg.UserId,
+		FolloweeID: authCtx.user.ID,
+		AcceptedAt: sql.NullTime{
+			Valid: true,
+			Time:  time.Now(),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("accepting follow request: %w", err)
+	}
+	if accepted == 0 {
+		return nil, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("follow request not found"),
+		)
+	}
+	err = notify(
+		ctx, us.queries, req.Msg.UserId, notificationKindFollowAccepted, "", authCtx.user.ID,
+	)
+	if err != nil {
+		us.log.Error("failed to notify of accepted follow", err)
+	}
+
+	follow, err := us.queries.GetFollow(ctx, db.GetFollowParams{
+		FollowerID: req.Msg.UserId,
+		FolloweeID: authCtx.user.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching follow: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.ApproveFollowRequestResponse{
+		Follow: followToProto(follow),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) DenyFollowRequest(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.DenyFollowRequestRequest],
+) (*connect.Response[mootslivepbv1.DenyFollowRequestResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	denied, err := us.queries.DeleteFollowRequest(ctx, db.DeleteFollowRequestParams{
+		FollowerID: req.Msg.UserId,
+		FolloweeID: authCtx.user.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("denying follow request: %w", err)
+	}
+	if denied == 0 {
+		return nil, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("follow request not found"),
+		)
+	}
+
+	return connect.NewResponse(&mootslivepbv1.DenyFollowRequestResponse{}), nil
+}
+
+func (us *UserServiceHandler) GetFollowSettings(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.GetFollowSettingsRequest],
+) (*connect.Response[mootslivepbv1.GetFollowSettingsResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	settings, err := getFollowSettings(ctx, us.queries, authCtx.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching settings: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.GetFollowSettingsResponse{
+		Settings: &mootslivepbv1.FollowSettings{
+			RequireApproval: settings.RequireApproval,
+		},
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) UpdateFollowSettings(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.UpdateFollowSettingsRequest],
+) (*connect.Response[mootslivepbv1.UpdateFollowSettingsResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+	if req.Msg.Settings == nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument, fmt.Errorf("settings must be provided"),
+		)
+	}
+
+	commit, rollback, tx, err := us.queries.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		if err := rollback(context.Background()); err != nil {
+			if !errors.Is(err, pgx.ErrTxClosed) {
+				us.log.Error("failed to rollback", err)
+			}
+		}
+	}()
+
+	now := time.Now()
+	err = tx.UpsertFollowSettings(ctx, db.UpsertFollowSettingsParams{
+		UserID:          authCtx.user.ID,
+		RequireApproval: req.Msg.Settings.RequireApproval,
+		UpdatedAt:       now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("updating settings: %w", err)
+	}
+
+	// Requests left pending when approval stops being required would
+	// otherwise never be dealt with.
+	if !req.Msg.Settings.RequireApproval {
+		err := tx.AcceptAllFollowRequests(ctx, db.AcceptAllFollowRequestsParams{
+			FolloweeID: authCtx.user.ID,
+			AcceptedAt: sql.NullTime{
+				Valid: true,
+				Time:  now,
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("accepting follow requests: %w", err)
+		}
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.UpdateFollowSettingsResponse{
+		Settings: &mootslivepbv1.FollowSettings{
+			RequireApproval: req.Msg.Settings.RequireApproval,
+		},
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) WatchListens(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.WatchListensRequest],
+	stream *connect.ServerStream[mootslivepbv1.WatchListensResponse],
+) error {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return fmt.Errorf("access denied: %w", err)
+	}
+
+	now := time.Now()
+	cursor := watchCursor{CreatedAt: now, NotificationsUpdatedAt: now}
+	if req.Msg.ResumeToken != "" {
+		cursor, err = decodeWatchCursor(req.Msg.ResumeToken)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if cursor.NotificationsUpdatedAt.IsZero() {
+			cursor.NotificationsUpdatedAt = now
+		}
+	}
+
+	userIDs, policies, err := watchedUsers(ctx, us.queries, authCtx.user.ID)
+	if err != nil {
+		return err
+	}
+	// Subscribe before fetching anything, so that nothing recorded in the
+	// meantime is missed.
+	sub := us.events.subscribe()
+	defer us.events.unsubscribe(sub)
+	sub.setUserIDs(eventKindListens, userIDs)
+	sub.setUserIDs(eventKindNotifications, []string{authCtx.user.ID})
+
+	lw := &listenWatcher{
+		queries:      us.queries,
+		stream:       stream,
+		sub:          sub,
+		userID:       authCtx.user.ID,
+		userIDs:      userIDs,
+		policies:     policies,
+		cursor:       cursor,
+		seen:         map[string]time.Time{},
+		lastListened: map[string]time.Time{},
+		listening:    map[string]bool{},
+		notified:     map[string]time.Time{},
+		unread:       -1,
+	}
+	if req.Msg.ResumeToken == "" {
+		if err := lw.skipExisting(ctx); err != nil {
+			return err
+		}
+	}
+	if err := lw.watch(ctx, userIDs); err != nil {
+		return err
+	}
+	if err := lw.catchUp(ctx, false); err != nil {
+		return err
+	}
+	if err := lw.catchUpNotifications(ctx); err != nil {
+		return err
+	}
+
+	presenceTicker := time.NewTicker(watchPresenceInterval)
+	defer presenceTicker.Stop()
+	usersTicker := time.NewTicker(watchUsersInterval)
+	defer usersTicker.Stop()
+	for {
+		select {
+		case <-sub.ready:
+			kinds, resync := sub.take()
+			if resync || kinds[eventKindListens] {
+				if err := lw.catchUp(ctx, resync); err != nil {
+					return err
+				}
+			}
+			if resync || kinds[eventKindNotifications] {
+				if err := lw.catchUpNotifications(ctx); err != nil {
+					return err
+				}
+			}
+		case <-presenceTicker.C:
+			if err := lw.expirePresence(); err != nil {
+				return err
+			}
+		case <-usersTicker.C:
+			userIDs, policies, err := watchedUsers(ctx, us.queries, authCtx.user.ID)
+			if err != nil {
+				return err
+			}
+			if err := lw.refreshUsers(ctx, userIDs, policies, false); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (us *UserServiceHandler) GetCompatibility(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.GetCompatibilityRequest],
+) (*connect.Response[mootslivepbv1.GetCompatibilityResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+	if req.Msg.OtherUserId == authCtx.user.ID {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("cannot compare a user with themselves"),
+		)
+	}
+	window := req.Msg.Window
+	if window == mootslivepbv1.TimeWindow_TIME_WINDOW_UNSPECIFIED {
+		window = mootslivepbv1.TimeWindow_TIME_WINDOW_LAST_90_DAYS
+	}
+	startDay, windowKey, err := timeWindowStart(window, rollupDay(time.Now()))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	other, err := us.queries.GetUser(ctx, req.Msg.OtherUserId)
+	if errors.Is(err, pgx.ErrNoRows) || other.DeletionRequestedAt.Valid {
+		return nil, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("user not found"),
+		)
+	} else if err != nil {
+		return nil, fmt.Errorf("fetching user: %w", err)
+	}
+	// Only the public plays of either user are compared, as both see the
+	// score, but the other user may not want to be compared at all.
+	policy, err := loadPrivacyPolicy(ctx, us.queries, authCtx.user.ID, other.ID)
+	if err != nil {
+		return nil, err
+	}
+	if policy.blocked {
+		return nil, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("user not found"),
+		)
+	}
+	if !policy.canView() {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			fmt.Errorf("user's listens are not visible to the caller"),
+		)
+	}
+
+	score, err := getCompatibility(
+		ctx, us.queries, authCtx.user.ID, other.ID, startDay, windowKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("computing compatibility: %w", err)
+	}
+
+	tracks, err := listTracks(ctx, us.queries, score.SharedIsrcs)
+	if err != nil {
+		return nil, err
+	}
+	res := &mootslivepbv1.GetCompatibilityResponse{
+		Score:         score.Score,
+		SharedTracks:  make([]*mootslivepbv1.Track, 0, len(score.SharedIsrcs)),
+		SharedArtists: make([]*mootslivepbv1.Artist, 0, len(score.SharedArtistIds)),
+		ComputedAt:    timestamppb.New(score.ComputedAt),
+	}
+	for _, isrc := range score.SharedIsrcs {
+		track, ok := tracks[isrc]
+		if !ok {
+			track = db.Track{Isrc: isrc}
+		}
+		res.SharedTracks = append(res.SharedTracks, trackToProto(track))
+	}
+	for i, id := range score.SharedArtistIds {
+		res.SharedArtists = append(res.SharedArtists, &mootslivepbv1.Artist{
+			Id:   id,
+			Name: score.SharedArtistNames[i],
+		})
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func privacySettingsToProto(
+	settings db.PrivacySetting,
+) *mootslivepbv1.PrivacySettings {
+	pb := &mootslivepbv1.PrivacySettings{
+		HiddenSources: settings.HiddenSources,
+	}
+	switch settings.Visibility {
+	case visibilityPublic:
+		pb.Visibility = mootslivepbv1.Visibility_VISIBILITY_PUBLIC
+	case visibilityMoots:
+		pb.Visibility = mootslivepbv1.Visibility_VISIBILITY_MOOTS
+	case visibilityPrivate:
+		pb.Visibility = mootslivepbv1.Visibility_VISIBILITY_PRIVATE
+	}
+	return pb
+}
+
+func (us *UserServiceHandler) GetPrivacySettings(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.GetPrivacySettingsRequest],
+) (*connect.Response[mootslivepbv1.GetPrivacySettingsResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	settings, err := getPrivacySettings(ctx, us.queries, authCtx.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching settings: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.GetPrivacySettingsResponse{
+		Settings: privacySettingsToProto(settings),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) UpdatePrivacySettings(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.UpdatePrivacySettingsRequest],
+) (*connect.Response[mootslivepbv1.UpdatePrivacySettingsResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+	if req.Msg.Settings == nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument, fmt.Errorf("settings must be provided"),
+		)
+	}
+
+	settings := db.UpsertPrivacySettingsParams{
+		UserID:        authCtx.user.ID,
+		HiddenSources: uniqueStrings(req.Msg.Settings.HiddenSources),
+		UpdatedAt:     time.Now(),
+	}
+	switch req.Msg.Settings.Visibility {
+	case mootslivepbv1.Visibility_VISIBILITY_PUBLIC:
+		settings.Visibility = visibilityPublic
+	case mootslivepbv1.Visibility_VISIBILITY_MOOTS:
+		settings.Visibility = visibilityMoots
+	case mootslivepbv1.Visibility_VISIBILITY_PRIVATE:
+		settings.Visibility = visibilityPrivate
+	default:
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument, fmt.Errorf("visibility must be provided"),
+		)
+	}
+	for _, source := range settings.HiddenSources {
+		if !listenSources[source] {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				fmt.Errorf("unknown listen source %q", source),
+			)
+		}
+	}
+
+	commit, rollback, tx, err := us.queries.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		if err := rollback(context.Background()); err != nil {
+			if !errors.Is(err, pgx.ErrTxClosed) {
+				us.log.Error("failed to rollback", err)
+			}
+		}
+	}()
+
+	current, err := getPrivacySettings(ctx, tx, authCtx.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching settings: %w", err)
+	}
+	if err := tx.UpsertPrivacySettings(ctx, settings); err != nil {
+		return nil, fmt.Errorf("updating settings: %w", err)
+	}
+	// Visibility is enforced as listens are read, but hidden sources change
+	// which listens are counted in the public rollups.
+	if !sameStrings(current.HiddenSources, settings.HiddenSources) {
+		if err := rebuildAllRollups(ctx, tx, authCtx.user.ID); err != nil {
+			return nil, fmt.Errorf("rebuilding rollups: %w", err)
+		}
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.UpdatePrivacySettingsResponse{
+		Settings: privacySettingsToProto(db.PrivacySetting(settings)),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) SetListenHidden(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.SetListenHiddenRequest],
+) (*connect.Response[mootslivepbv1.SetListenHiddenResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	commit, rollback, tx, err := us.queries.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		if err := rollback(context.Background()); err != nil {
+			if !errors.Is(err, pgx.ErrTxClosed) {
+				us.log.Error("failed to rollback", err)
+			}
+		}
+	}()
+
+	if err := lockRollups(ctx, tx, authCtx.user.ID); err != nil {
+		return nil, err
+	}
+	listen, err := tx.GetListenForUser(ctx, db.GetListenForUserParams{
+		ID:     req.Msg.ListenId,
+		UserID: authCtx.user.ID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("listen not found"),
+		)
+	} else if err != nil {
+		return nil, fmt.Errorf("fetching listen: %w", err)
+	}
+
+	if listen.Hidden != req.Msg.Hidden {
+		policy, err := loadPrivacyPolicy(ctx, tx, authCtx.user.ID, authCtx.user.ID)
+		if err != nil {
+			return nil, err
+		}
+		wasHidden := policy.hides(listen)
+		listen.Hidden = req.Msg.Hidden
+
+		err = tx.SetListenHidden(ctx, db.SetListenHiddenParams{
+			Hidden: listen.Hidden,
+			ID:     listen.ID,
+			UserID: listen.UserID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("updating listen: %w", err)
+		}
+
+		// The listen may already be hidden by its source or an incognito
+		// window, in which case the rollups are unaffected.
+		if isHidden := policy.hides(listen); isHidden != wasHidden {
+			delta := int32(1)
+			if isHidden {
+				delta = -1
+			}
+			if err := addListenPlays(ctx, tx, listen, 0, delta); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.SetListenHiddenResponse{
+		Listen: listenToProto(listen),
+	})
+	return res, nil
+}
+
+func incognitoWindowToProto(
+	window db.IncognitoWindow,
+) *mootslivepbv1.IncognitoWindow {
+	return &mootslivepbv1.IncognitoWindow{
+		Id:        window.ID,
+		StartTime: timestamppb.New(window.StartTime),
+		EndTime:   timestamppb.New(window.EndTime),
+		CreatedAt: timestamppb.New(window.CreatedAt),
+	}
+}
+
+func (us *UserServiceHandler) CreateIncognitoWindow(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.CreateIncognitoWindowRequest],
+) (*connect.Response[mootslivepbv1.CreateIncognitoWindowResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+	if req.Msg.StartTime == nil || req.Msg.EndTime == nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("start_time and end_time must be provided"),
+		)
+	}
+	window := db.CreateIncognitoWindowParams{
+		ID:        ksuid.New().String(),
+		UserID:    authCtx.user.ID,
+		StartTime: req.Msg.StartTime.AsTime(),
+		EndTime:   req.Msg.EndTime.AsTime(),
+		CreatedAt: time.Now(),
+	}
+	if !window.StartTime.Before(window.EndTime) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("start_time must be before end_time"),
+		)
+	}
+
+	commit, rollback, tx, err := us.queries.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		if err := rollback(context.Background()); err != nil {
+			if !errors.Is(err, pgx.ErrTxClosed) {
+				us.log.Error("failed to rollback", err)
+			}
+		}
+	}()
+
+	if err := tx.CreateIncognitoWindow(ctx, window); err != nil {
+		return nil, fmt.Errorf("creating incognito window: %w", err)
+	}
+	err = rebuildRollups(
+		ctx, tx, authCtx.user.ID, window.StartTime, window.EndTime,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("rebuilding rollups: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.CreateIncognitoWindowResponse{
+		Window: incognitoWindowToProto(db.IncognitoWindow(window)),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) ListIncognitoWindows(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ListIncognitoWindowsRequest],
+) (*connect.Response[mootslivepbv1.ListIncognitoWindowsResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	windows, err := us.queries.ListIncognitoWindowsForUser(ctx, authCtx.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching incognito windows: %w", err)
+	}
+
+	res := &mootslivepbv1.ListIncognitoWindowsResponse{
+		Windows: make([]*mootslivepbv1.IncognitoWindow, 0, len(windows)),
+	}
+	for _, window := range windows {
+		res.Windows = append(res.Windows, incognitoWindowToProto(window))
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (us *UserServiceHandler) DeleteIncognitoWindow(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.DeleteIncognitoWindowRequest],
+) (*connect.Response[mootslivepbv1.DeleteIncognitoWindowResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	commit, rollback, tx, err := us.queries.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		if err := rollback(context.Background()); err != nil {
+			if !errors.Is(err, pgx.ErrTxClosed) {
+				us.log.Error("failed to rollback", err)
+			}
+		}
+	}()
+
+	window, err := tx.DeleteIncognitoWindow(ctx, db.DeleteIncognitoWindowParams{
+		ID:     req.Msg.Id,
+		UserID: authCtx.user.ID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("incognito window not found"),
+		)
+	} else if err != nil {
+		return nil, fmt.Errorf("deleting incognito window: %w", err)
+	}
+	err = rebuildRollups(
+		ctx, tx, authCtx.user.ID, window.StartTime, window.EndTime,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("rebuilding rollups: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return connect.NewResponse(&mootslivepbv1.DeleteIncognitoWindowResponse{}), nil
+}
+
+func (us *UserServiceHandler) AddReaction(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.AddReactionRequest],
+) (*connect.Response[mootslivepbv1.AddReactionResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+	if !validEmoji(req.Msg.Emoji) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument, fmt.Errorf("emoji must be a single emoji"),
+		)
+	}
+
+	listen, err := getViewableListen(
+		ctx, us.queries, authCtx.user.ID, req.Msg.ListenId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	commit, rollback, tx, err := us.queries.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		if err := rollback(context.Background()); err != nil {
+			if !errors.Is(err, pgx.ErrTxClosed) {
+				us.log.Error("failed to rollback", err)
+			}
+		}
+	}()
+
+	// The limit is checked by the insert itself, with concurrent reactions
+	// by the same user to the same listen waiting their turn, so they can't
+	// all see room for one more.
+	err = tx.LockReactionsByUserForListen(ctx, db.LockReactionsByUserForListenParams{
+		ListenID: listen.ID,
+		UserID:   authCtx.user.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("locking reactions: %w", err)
+	}
+	created, err := tx.CreateReaction(ctx, db.CreateReactionParams{
+		ListenID:     listen.ID,
+		UserID:       authCtx.user.ID,
+		Emoji:        req.Msg.Emoji,
+		CreatedAt:    time.Now(),
+		MaxReactions: maxReactionsPerListen,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating reaction: %w", err)
+	}
+	if created == 0 {
+		// Either the reaction already exists, which is fine, or the user
+		// has used up their reactions to the listen.
+		reactions, err := tx.CountReactionsByUserForListen(
+			ctx, db.CountReactionsByUserForListenParams{
+				ListenID: listen.ID,
+				UserID:   authCtx.user.ID,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("counting reactions: %w", err)
+		}
+		if reactions >= maxReactionsPerListen {
+			return nil, connect.NewError(
+				connect.CodeFailedPrecondition,
+				fmt.Errorf("cannot react to a listen more than %d times", maxReactionsPerListen),
+			)
+		}
+	}
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	if created > 0 {
+		err := notify(
+			ctx, us.queries, listen.UserID, notificationKindReaction, listen.ID, authCtx.user.ID,
+		)
+		if err != nil {
+			us.log.Error("failed to notify of reaction", err)
+		}
+	}
+
+	counts, err := listReactionCounts(
+		ctx, us.queries, authCtx.user.ID, []string{listen.ID},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.AddReactionResponse{
+		Reactions: counts[listen.ID],
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) RemoveReaction(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.RemoveReactionRequest],
+) (*connect.Response[mootslivepbv1.RemoveReactionResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	// Removing a reaction only ever affects the caller's own data, so there
+	// is no need to check whether they can still see the listen.
+	removed, err := us.queries.DeleteReaction(ctx, db.DeleteReactionParams{
+		ListenID: req.Msg.ListenId,
+		UserID:   authCtx.user.ID,
+		Emoji:    req.Msg.Emoji,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("deleting reaction: %w", err)
+	}
+	if removed == 0 {
+		return nil, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("reaction not found"),
+		)
+	}
+
+	res := &mootslivepbv1.RemoveReactionResponse{}
+	if _, err := getViewableListen(
+		ctx, us.queries, authCtx.user.ID, req.Msg.ListenId,
+	); err == nil {
+		counts, err := listReactionCounts(
+			ctx, us.queries, authCtx.user.ID, []string{req.Msg.ListenId},
+		)
+		if err != nil {
+			return nil, err
+		}
+		res.Reactions = counts[req.Msg.ListenId]
+	} else if connect.CodeOf(err) != connect.CodeNotFound {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (us *UserServiceHandler) AddComment(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.AddCommentRequest],
+) (*connect.Response[mootslivepbv1.AddCommentResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+	text, err := normaliseComment(req.Msg.Text)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	listen, err := getViewableListen(
+		ctx, us.queries, authCtx.user.ID, req.Msg.ListenId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := db.CreateCommentParams{
+		ID:        ksuid.New().String(),
+		ListenID:  listen.ID,
+		UserID:    authCtx.user.ID,
+		Text:      text,
+		CreatedAt: time.Now(),
+	}
+	if err := us.queries.CreateComment(ctx, comment); err != nil {
+		return nil, fmt.Errorf("creating comment: %w", err)
+	}
+	err = notify(
+		ctx, us.queries, listen.UserID, notificationKindComment, listen.ID, authCtx.user.ID,
+	)
+	if err != nil {
+		us.log.Error("failed to notify of comment", err)
+	}
+
+	users, err := listUserSummaries(ctx, us.queries, []string{authCtx.user.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.AddCommentResponse{
+		Comment: commentToProto(db.Comment{
+			ID:        comment.ID,
+			ListenID:  comment.ListenID,
+			UserID:    comment.UserID,
+			Text:      comment.Text,
+			CreatedAt: comment.CreatedAt,
+		}, users[authCtx.user.ID]),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) DeleteComment(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.DeleteCommentRequest],
+) (*connect.Response[mootslivepbv1.DeleteCommentResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	notFound := connect.NewError(
+		connect.CodeNotFound, fmt.Errorf("comment not found"),
+	)
+	comment, err := us.queries.GetComment(ctx, req.Msg.Id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, notFound
+	} else if err != nil {
+		return nil, fmt.Errorf("fetching comment: %w", err)
+	}
+	if comment.UserID != authCtx.user.ID {
+		// Users are in charge of what's said about their own listens.
+		listen, err := us.queries.GetListen(ctx, comment.ListenID)
+		if err != nil {
+			return nil, fmt.Errorf("fetching listen: %w", err)
+		}
+		if listen.UserID != authCtx.user.ID {
+			return nil, connect.NewError(
+				connect.CodePermissionDenied,
+				fmt.Errorf("only the author or listener can delete a comment"),
+			)
+		}
+	}
+
+	if err := us.queries.DeleteComment(ctx, comment.ID); err != nil {
+		return nil, fmt.Errorf("deleting comment: %w", err)
+	}
+
+	return connect.NewResponse(&mootslivepbv1.DeleteCommentResponse{}), nil
+}
+
+func (us *UserServiceHandler) ListComments(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ListCommentsRequest],
+) (*connect.Response[mootslivepbv1.ListCommentsResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	pageSize, err := clampPageSize(req.Msg.PageSize, defaultCommentsPageSize, maxCommentsPageSize)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	afterID, err := decodeCommentsPageToken(req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	listen, err := getViewableListen(
+		ctx, us.queries, authCtx.user.ID, req.Msg.ListenId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := us.queries.ListCommentsForListen(ctx, db.ListCommentsForListenParams{
+		ListenID:      listen.ID,
+		AfterID:       afterID,
+		FlagThreshold: commentFlagThreshold,
+		ViewerID:      authCtx.user.ID,
+		RowLimit:      pageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching comments: %w", err)
+	}
+
+	blocked, err := listBlockedUserIDs(ctx, us.queries, authCtx.user.ID)
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]string, 0, len(comments))
+	for _, comment := range comments {
+		userIDs = append(userIDs, comment.UserID)
+	}
+	users, err := listUserSummaries(ctx, us.queries, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &mootslivepbv1.ListCommentsResponse{
+		Comments: make([]*mootslivepbv1.Comment, 0, len(comments)),
+	}
+	for _, comment := range comments {
+		if blocked[comment.UserID] {
+			continue
+		}
+		res.Comments = append(res.Comments, commentToProto(comment, users[comment.UserID]))
+	}
+	// A short page means we've reached the end of the comments.
+	if len(comments) == int(pageSize) {
+		res.NextPageToken = encodeCommentsPageToken(comments[len(comments)-1].ID)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (us *UserServiceHandler) FlagComment(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.FlagCommentRequest],
+) (*connect.Response[mootslivepbv1.FlagCommentResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	notFound := connect.NewError(
+		connect.CodeNotFound, fmt.Errorf("comment not found"),
+	)
+	comment, err := us.queries.GetComment(ctx, req.Msg.Id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, notFound
+	} else if err != nil {
+		return nil, fmt.Errorf("fetching comment: %w", err)
+	}
+	if comment.UserID == authCtx.user.ID {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument, fmt.Errorf("cannot flag your own comment"),
+		)
+	}
+	if _, err := getViewableListen(
+		ctx, us.queries, authCtx.user.ID, comment.ListenID,
+	); err != nil {
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			return nil, notFound
+		}
+		return nil, err
+	}
+
+	commit, rollback, tx, err := us.queries.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		if err := rollback(context.Background()); err != nil {
+			if !errors.Is(err, pgx.ErrTxClosed) {
+				us.log.Error("failed to rollback", err)
+			}
+		}
+	}()
+
+	// Each user's flag only counts once.
+	flagged, err := tx.CreateCommentFlag(ctx, db.CreateCommentFlagParams{
+		CommentID: comment.ID,
+		UserID:    authCtx.user.ID,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("flagging comment: %w", err)
+	}
+	if flagged > 0 {
+		if err := tx.IncrementCommentFlagCount(ctx, comment.ID); err != nil {
+			return nil, fmt.Errorf("counting flag: %w", err)
+		}
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	us.log.Info("comment flagged",
+		slog.String("comment_id", comment.ID),
+		slog.String("user_id", authCtx.user.ID),
+	)
+
+	return connect.NewResponse(&mootslivepbv1.FlagCommentResponse{}), nil
+}
+
+func (us *UserServiceHandler) ListNotifications(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ListNotificationsRequest],
+) (*connect.Response[mootslivepbv1.ListNotificationsResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	pageSize, err := clampPageSize(
+		req.Msg.PageSize, defaultNotificationsPageSize, maxNotificationsPageSize,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	cursor, err := decodePageCursor(req.Msg.PageToken, "")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	notifications, err := us.queries.ListNotifications(ctx, db.ListNotificationsParams{
+		UserID:          authCtx.user.ID,
+		BeforeUpdatedAt: cursor.Time,
+		BeforeID:        cursor.ID,
+		RowLimit:        pageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching notifications: %w", err)
+	}
+	unread, err := us.queries.CountUnreadNotifications(ctx, authCtx.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("counting unread notifications: %w", err)
+	}
+
+	pbs, err := notificationsToProto(ctx, us.queries, notifications)
+	if err != nil {
+		return nil, err
+	}
+	res := &mootslivepbv1.ListNotificationsResponse{
+		Notifications: pbs,
+		UnreadCount:   unread,
+	}
+	// A short page means we've reached the end of the notifications.
+	if len(notifications) == int(pageSize) {
+		last := notifications[len(notifications)-1]
+		res.NextPageToken = pageCursor{
+			Time: last.UpdatedAt,
+			ID:   last.ID,
+		}.encode()
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (us *UserServiceHandler) MarkNotificationsRead(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.MarkNotificationsReadRequest],
+) (*connect.Response[mootslivepbv1.MarkNotificationsReadResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	readAt := sql.NullTime{
+		Valid: true,
+		Time:  time.Now(),
+	}
+	if len(req.Msg.Ids) > 0 {
+		err := us.queries.MarkNotificationsRead(ctx, db.MarkNotificationsReadParams{
+			ReadAt: readAt,
+			UserID: authCtx.user.ID,
+			Ids:    req.Msg.Ids,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("marking notifications read: %w", err)
+		}
+	}
+	if req.Msg.AllUpdatedBefore != nil {
+		err := us.queries.MarkAllNotificationsRead(ctx, db.MarkAllNotificationsReadParams{
+			ReadAt:        readAt,
+			UserID:        authCtx.user.ID,
+			UpdatedBefore: req.Msg.AllUpdatedBefore.AsTime(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("marking notifications read: %w", err)
+		}
+	}
+
+	// Other sessions of the user will want to update their unread count.
+	if err := publishEvent(ctx, us.queries, eventKindNotifications, authCtx.user.ID); err != nil {
+		us.log.Error("failed to publish notifications event", err)
+	}
+
+	unread, err := us.queries.CountUnreadNotifications(ctx, authCtx.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("counting unread notifications: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.MarkNotificationsReadResponse{
+		UnreadCount: unread,
+	})
+	return res, nil
+}
+
+func notificationPreferencesToProto(
+	disabled map[string]bool,
+) []*mootslivepbv1.NotificationPreference {
+	prefs := make([]*mootslivepbv1.NotificationPreference, 0, len(notificationKinds))
+	for kind, pb := range notificationKinds {
+		prefs = append(prefs, &mootslivepbv1.NotificationPreference{
+			Kind:    pb,
+			Enabled: !disabled[kind],
+		})
+	}
+	sort.Slice(prefs, func(i, j int) bool {
+		return prefs[i].Kind < prefs[j].Kind
+	})
+	return prefs
+}
+
+func (us *UserServiceHandler) GetNotificationPreferences(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.GetNotificationPreferencesRequest],
+) (*connect.Response[mootslivepbv1.GetNotificationPreferencesResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	disabled, err := listDisabledNotificationKinds(ctx, us.queries, authCtx.user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.GetNotificationPreferencesResponse{
+		Preferences: notificationPreferencesToProto(disabled),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) UpdateNotificationPreferences(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.UpdateNotificationPreferencesRequest],
+) (*connect.Response[mootslivepbv1.UpdateNotificationPreferencesResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	params := make([]db.UpsertNotificationPreferenceParams, 0, len(req.Msg.Preferences))
+	now := time.Now()
+	for _, pref := range req.Msg.Preferences {
+		kind, ok := notificationKindFromProto(pref.Kind)
+		if !ok {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				fmt.Errorf("unknown notification kind %s", pref.Kind),
+			)
+		}
+		params = append(params, db.UpsertNotificationPreferenceParams{
+			UserID:    authCtx.user.ID,
+			Kind:      kind,
+			Enabled:   pref.Enabled,
+			UpdatedAt: now,
+		})
+	}
+
+	commit, rollback, tx, err := us.queries.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		if err := rollback(context.Background()); err != nil {
+			if !errors.Is(err, pgx.ErrTxClosed) {
+				us.log.Error("failed to rollback", err)
+			}
+		}
+	}()
+
+	for _, p := range params {
+		if err := tx.UpsertNotificationPreference(ctx, p); err != nil {
+			return nil, fmt.Errorf("updating notification preference: %w", err)
+		}
+	}
+	disabled, err := listDisabledNotificationKinds(ctx, tx, authCtx.user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.UpdateNotificationPreferencesResponse{
+		Preferences: notificationPreferencesToProto(disabled),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) UpdateProfile(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.UpdateProfileRequest],
+) (*connect.Response[mootslivepbv1.UpdateProfileResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	if err := validateHandle(req.Msg.Handle); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	displayName, err := normaliseProfileText(
+		"display name", req.Msg.DisplayName, maxDisplayNameLength,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	bio, err := normaliseProfileText("bio", req.Msg.Bio, maxBioLength)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	taken := connect.NewError(
+		connect.CodeAlreadyExists,
+		fmt.Errorf("handle %q is taken", req.Msg.Handle),
+	)
+	isTaken, err := handleTaken(ctx, us.queries, req.Msg.Handle, authCtx.user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if isTaken {
+		return nil, taken
+	}
+
+	user := *authCtx.user
+	if req.Msg.TimeZone != "" {
+		if _, err := loadTimeZone(req.Msg.TimeZone); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		user.TimeZone = req.Msg.TimeZone
+	}
+	user.Handle = sql.NullString{Valid: true, String: req.Msg.Handle}
+	user.DisplayName = displayName
+	user.Bio = bio
+	err = us.queries.UpdateUserProfile(ctx, db.UpdateUserProfileParams{
+		Handle:      user.Handle,
+		DisplayName: user.DisplayName,
+		Bio:         user.Bio,
+		TimeZone:    user.TimeZone,
+		ID:          user.ID,
+	})
+	// Someone else may have claimed the handle since we checked.
+	if isUniqueViolation(err) {
+		return nil, taken
+	} else if err != nil {
+		return nil, fmt.Errorf("updating profile: %w", err)
+	}
+
+	profile, err := loadOwnProfile(ctx, us.queries, user)
+	if err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.UpdateProfileResponse{
+		Profile: profile,
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) GetProfile(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.GetProfileRequest],
+) (*connect.Response[mootslivepbv1.GetProfileResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{optionalAuth: true},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+	viewerID := ""
+	if authCtx.user != nil {
+		viewerID = authCtx.user.ID
+	}
+
+	notFound := connect.NewError(
+		connect.CodeNotFound, fmt.Errorf("profile not found"),
+	)
+	user, err := us.queries.GetUserByHandle(ctx, req.Msg.Handle)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, notFound
+	} else if err != nil {
+		return nil, fmt.Errorf("fetching user: %w", err)
+	}
+	// Users who've asked for their account to be deleted are treated as
+	// though they're already gone.
+	if user.DeletionRequestedAt.Valid {
+		return nil, notFound
+	}
+
+	policy, err := loadPrivacyPolicy(ctx, us.queries, viewerID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if policy.blocked {
+		return nil, notFound
+	}
+	summaries, err := listUserSummaries(ctx, us.queries, []string{user.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &mootslivepbv1.GetProfileResponse{
+		User:           summaries[user.ID],
+		Bio:            user.Bio,
+		JoinedAt:       timestamppb.New(user.CreatedAt),
+		ListensVisible: policy.canView(),
+	}
+	if policy.canView() {
+		res.RecentListens, err = listRecentListens(ctx, us.queries, policy, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		listens := make([]*mootslivepbv1.Listen, 0, len(res.RecentListens))
+		for _, r := range res.RecentListens {
+			listens = append(listens, r.Listen)
+		}
+		if err := attachReactions(ctx, us.queries, viewerID, listens); err != nil {
+			return nil, err
+		}
+
+		q, err := newTopsQuery(
+			ctx,
+			us.queries,
+			viewerID,
+			user.ID,
+			mootslivepbv1.TimeWindow_TIME_WINDOW_LAST_90_DAYS,
+			nil,
+			profileTopArtistsCount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		res.TopArtists, err = listTopArtists(ctx, us.queries, q)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (us *UserServiceHandler) BlockUser(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.BlockUserRequest],
+) (*connect.Response[mootslivepbv1.BlockUserResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	blocked, err := getOtherUser(ctx, us.queries, authCtx.user.ID, req.Msg.UserId, "block")
+	if err != nil {
+		return nil, err
+	}
+
+	commit, rollback, tx, err := us.queries.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		if err := rollback(context.Background()); err != nil {
+			if !errors.Is(err, pgx.ErrTxClosed) {
+				us.log.Error("failed to rollback", err)
+			}
+		}
+	}()
+
+	_, err = tx.CreateBlock(ctx, db.CreateBlockParams{
+		BlockerID: authCtx.user.ID,
+		BlockedID: blocked.ID,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating block: %w", err)
+	}
+	// Follows in either direction, including those still awaiting approval,
+	// are removed.
+	_, err = tx.DeleteFollow(ctx, db.DeleteFollowParams{
+		FollowerID: authCtx.user.ID,
+		FolloweeID: blocked.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("deleting follow: %w", err)
+	}
+	_, err = tx.DeleteFollow(ctx, db.DeleteFollowParams{
+		FollowerID: blocked.ID,
+		FolloweeID: authCtx.user.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("deleting follow: %w", err)
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return connect.NewResponse(&mootslivepbv1.BlockUserResponse{}), nil
+}
+
+func (us *UserServiceHandler) UnblockUser(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.UnblockUserRequest],
+) (*connect.Response[mootslivepbv1.UnblockUserResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	_, err = us.queries.DeleteBlock(ctx, db.DeleteBlockParams{
+		BlockerID: authCtx.user.ID,
+		BlockedID: req.Msg.UserId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("deleting block: %w", err)
+	}
+
+	return connect.NewResponse(&mootslivepbv1.UnblockUserResponse{}), nil
+}
+
+func (us *UserServiceHandler) ListBlockedUsers(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ListBlockedUsersRequest],
+) (*connect.Response[mootslivepbv1.ListBlockedUsersResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	blocks, err := us.queries.ListBlocksForUser(ctx, authCtx.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching blocks: %w", err)
+	}
+	userIDs := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		userIDs = append(userIDs, block.BlockedID)
+	}
+	users, err := listUserSummaries(ctx, us.queries, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &mootslivepbv1.ListBlockedUsersResponse{
+		Users: make([]*mootslivepbv1.UserSummary, 0, len(userIDs)),
+	}
+	for _, id := range userIDs {
+		res.Users = append(res.Users, users[id])
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (us *UserServiceHandler) MuteUser(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.MuteUserRequest],
+) (*connect.Response[mootslivepbv1.MuteUserResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	muted, err := getOtherUser(ctx, us.queries, authCtx.user.ID, req.Msg.UserId, "mute")
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = us.queries.CreateMute(ctx, db.CreateMuteParams{
+		UserID:    authCtx.user.ID,
+		MutedID:   muted.ID,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating mute: %w", err)
+	}
+
+	return connect.NewResponse(&mootslivepbv1.MuteUserResponse{}), nil
+}
+
+func (us *UserServiceHandler) UnmuteUser(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.UnmuteUserRequest],
+) (*connect.Response[mootslivepbv1.UnmuteUserResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	_, err = us.queries.DeleteMute(ctx, db.DeleteMuteParams{
+		UserID:  authCtx.user.ID,
+		MutedID: req.Msg.UserId,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("deleting mute: %w", err)
+	}
+
+	return connect.NewResponse(&mootslivepbv1.UnmuteUserResponse{}), nil
+}
+
+func (us *UserServiceHandler) ListMutedUsers(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ListMutedUsersRequest],
+) (*connect.Response[mootslivepbv1.ListMutedUsersResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	mutes, err := us.queries.ListMutesForUser(ctx, authCtx.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching mutes: %w", err)
+	}
+	userIDs := make([]string, 0, len(mutes))
+	for _, mute := range mutes {
+		userIDs = append(userIDs, mute.MutedID)
+	}
+	users, err := listUserSummaries(ctx, us.queries, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &mootslivepbv1.ListMutedUsersResponse{
+		Users: make([]*mootslivepbv1.UserSummary, 0, len(userIDs)),
+	}
+	for _, id := range userIDs {
+		res.Users = append(res.Users, users[id])
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (us *UserServiceHandler) GetTopTracks(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.GetTopTracksRequest],
+) (*connect.Response[mootslivepbv1.GetTopTracksResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	q, err := newTopsQuery(
+		ctx,
+		us.queries,
+		authCtx.user.ID,
+		req.Msg.UserId,
+		req.Msg.Window,
+		req.Msg.CustomRange,
+		req.Msg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	top, err := listTopTracks(ctx, us.queries, q)
+	if err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.GetTopTracksResponse{
+		Tracks: top,
+		Range:  timeRangeToProto(q.startDay, q.endDay),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) GetTopArtists(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.GetTopArtistsRequest],
+) (*connect.Response[mootslivepbv1.GetTopArtistsResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	q, err := newTopsQuery(
+		ctx,
+		us.queries,
+		authCtx.user.ID,
+		req.Msg.UserId,
+		req.Msg.Window,
+		req.Msg.CustomRange,
+		req.Msg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	top, err := listTopArtists(ctx, us.queries, q)
+	if err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.GetTopArtistsResponse{
+		Artists: top,
+		Range:   timeRangeToProto(q.startDay, q.endDay),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) GetTopAlbums(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.GetTopAlbumsRequest],
+) (*connect.Response[mootslivepbv1.GetTopAlbumsResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	q, err := newTopsQuery(
+		ctx,
+		us.queries,
+		authCtx.user.ID,
+		req.Msg.UserId,
+		req.Msg.Window,
+		req.Msg.CustomRange,
+		req.Msg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	top, err := listTopAlbums(ctx, us.queries, q)
+	if err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.GetTopAlbumsResponse{
+		Albums: top,
+		Range:  timeRangeToProto(q.startDay, q.endDay),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) GetListeningStats(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.GetListeningStatsRequest],
+) (*connect.Response[mootslivepbv1.GetListeningStatsResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	loc := userTimeZone(*authCtx.user)
+	window := req.Msg.Window
+	if window == mootslivepbv1.TimeWindow_TIME_WINDOW_UNSPECIFIED {
+		window = mootslivepbv1.TimeWindow_TIME_WINDOW_LAST_30_DAYS
+	}
+	now := time.Now()
+	startDay, endDay, err := timeRangeDays(window, req.Msg.CustomRange, now, loc)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	rangeParams := db.ListHourlyPlaysParams{
+		TimeZone:  loc.String(),
+		UserID:    authCtx.user.ID,
+		StartTime: startDay,
+		EndTime:   endDay,
+	}
+
+	res := &mootslivepbv1.GetListeningStatsResponse{
+		TimeZone: loc.String(),
+		Range:    timeRangeToProto(startDay, endDay),
+	}
+
+	hourly, err := us.queries.ListHourlyPlays(ctx, rangeParams)
+	if err != nil {
+		return nil, fmt.Errorf("fetching hourly plays: %w", err)
+	}
+	for _, row := range hourly {
+		res.Heatmap = append(res.Heatmap, &mootslivepbv1.HeatmapCell{
+			DayOfWeek: row.Weekday,
+			Hour:      row.Hour,
+			Plays:     row.Plays,
+		})
+	}
+
+	daily, err := us.queries.ListDailyPlays(ctx, db.ListDailyPlaysParams(rangeParams))
+	if err != nil {
+		return nil, fmt.Errorf("fetching daily plays: %w", err)
+	}
+	for _, row := range daily {
+		res.DailyPlays = append(res.DailyPlays, &mootslivepbv1.DailyPlays{
+			Date:  row.Day.Format("2006-01-02"),
+			Plays: row.Plays,
+		})
+	}
+
+	days, err := us.queries.ListListeningDays(ctx, db.ListListeningDaysParams{
+		TimeZone: loc.String(),
+		UserID:   authCtx.user.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching listening days: %w", err)
+	}
+	// Dates come back from Postgres as midnight UTC, so today is compared
+	// with them in the same form.
+	y, m, d := now.In(loc).Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	res.CurrentStreakDays, res.LongestStreakDays = listeningStreaks(days, today)
+
+	totals, err := us.queries.GetListeningTotals(ctx, db.GetListeningTotalsParams{
+		UserID:    authCtx.user.ID,
+		StartTime: startDay,
+		EndTime:   endDay,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching listening totals: %w", err)
+	}
+	res.Plays = totals.Plays
+	res.EstimatedListeningMs = estimateListeningMs(totals)
+
+	newTrackPlays, err := us.queries.CountNewTrackPlays(ctx, db.CountNewTrackPlaysParams{
+		UserID:    authCtx.user.ID,
+		StartTime: startDay,
+		EndTime:   endDay,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("counting new track plays: %w", err)
+	}
+	res.NewTrackPlays = newTrackPlays
+	res.RepeatPlays = totals.Plays - newTrackPlays
+
+	return connect.NewResponse(res), nil
+}
+
+func (us *UserServiceHandler) GetRecap(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.GetRecapRequest],
+) (*connect.Response[mootslivepbv1.GetRecapResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	stored, err := us.queries.GetLatestYearlyRecap(ctx, db.GetLatestYearlyRecapParams{
+		UserID: authCtx.user.ID,
+		Year:   req.Msg.Year,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("no recap for %d", req.Msg.Year),
+		)
+	} else if err != nil {
+		return nil, fmt.Errorf("fetching recap: %w", err)
+	}
+	recap := &mootslivepbv1.YearlyRecap{}
+	if err := proto.Unmarshal(stored.Data, recap); err != nil {
+		return nil, fmt.Errorf("unmarshalling recap: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.GetRecapResponse{
+		Recap:       recap,
+		Version:     stored.Version,
+		GeneratedAt: timestamppb.New(stored.GeneratedAt),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) GetCharts(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.GetChartsRequest],
+) (*connect.Response[mootslivepbv1.GetChartsResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{optionalAuth: true},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	if req.Msg.Scope == mootslivepbv1.ChartScope_CHART_SCOPE_UNSPECIFIED {
+		req.Msg.Scope = mootslivepbv1.ChartScope_CHART_SCOPE_GLOBAL
+	}
+	scope, ok := chartScopeFromProto(req.Msg.Scope)
+	if !ok {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("unsupported scope %s", req.Msg.Scope),
+		)
+	}
+	if req.Msg.Period == mootslivepbv1.ChartPeriod_CHART_PERIOD_UNSPECIFIED {
+		req.Msg.Period = mootslivepbv1.ChartPeriod_CHART_PERIOD_WEEKLY
+	}
+	period, ok := chartPeriodFromProto(req.Msg.Period)
+	if !ok {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("unsupported period %s", req.Msg.Period),
+		)
+	}
+	owner := sql.NullString{}
+	if scope == chartScopeMoots {
+		if authCtx.user == nil {
+			return nil, connect.NewError(
+				connect.CodeUnauthenticated,
+				fmt.Errorf("moots charts require signing in"),
+			)
+		}
+		owner = chartOwner(scope, authCtx.user.ID)
+	}
+
+	var chart db.Chart
+	if req.Msg.Time == nil {
+		chart, err = us.queries.GetLatestChart(ctx, db.GetLatestChartParams{
+			Scope:  scope,
+			UserID: owner,
+			Period: period,
+		})
+	} else {
+		chart, err = us.queries.GetChart(ctx, db.GetChartParams{
+			Scope:    scope,
+			UserID:   owner,
+			Period:   period,
+			StartDay: chartPeriodStart(period, req.Msg.Time.AsTime()),
+		})
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("chart not found"),
+		)
+	} else if err != nil {
+		return nil, fmt.Errorf("fetching chart: %w", err)
+	}
+
+	msg, err := chartToProto(ctx, us.queries, chart)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(msg), nil
+}
+
+func (us *UserServiceHandler) CreateListen(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.CreateListenRequest],
+) (*connect.Response[mootslivepbv1.CreateListenResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	isrc, err := normaliseISRC(req.Msg.Isrc)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if req.Msg.ListenedAt == nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument, fmt.Errorf("listened_at must be set"),
+		)
+	}
+	now := time.Now()
+	listenedAt := req.Msg.ListenedAt.AsTime()
+	if err := validateListenedAt(listenedAt, now); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	commit, rollback, tx, err := us.queries.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		if err := rollback(context.Background()); err != nil {
+			if !errors.Is(err, pgx.ErrTxClosed) {
+				us.log.Error("failed to rollback", err)
+			}
+		}
+	}()
+
+	if err := lockRollups(ctx, tx, authCtx.user.ID); err != nil {
+		return nil, err
+	}
+	policy, err := loadPrivacyPolicy(ctx, tx, authCtx.user.ID, authCtx.user.ID)
+	if err != nil {
+		return nil, err
+	}
+	listen := db.Listen{
+		ID:         ksuid.New().String(),
+		UserID:     authCtx.user.ID,
+		CreatedAt:  now,
+		ListenedAt: listenedAt,
+		Isrc:       isrc,
+		Source:     sourceManual,
+	}
+	err = recordListen(ctx, tx, policy, db.CreateListenParams{
+		ID:         listen.ID,
+		UserID:     listen.UserID,
+		CreatedAt:  listen.CreatedAt,
+		Source:     listen.Source,
+		Isrc:       listen.Isrc,
+		ListenedAt: listen.ListenedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("recording listen: %w", err)
+	}
+	if err := recordListenEdit(ctx, tx, listenEditCreate, nil, &listen); err != nil {
+		return nil, err
+	}
+	if err := publishEvent(ctx, tx, eventKindListens, listen.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.CreateListenResponse{
+		Listen: listenToProto(listen),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) UpdateListen(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.UpdateListenRequest],
+) (*connect.Response[mootslivepbv1.UpdateListenResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	commit, rollback, tx, err := us.queries.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		if err := rollback(context.Background()); err != nil {
+			if !errors.Is(err, pgx.ErrTxClosed) {
+				us.log.Error("failed to rollback", err)
+			}
+		}
+	}()
+
+	if err := lockRollups(ctx, tx, authCtx.user.ID); err != nil {
+		return nil, err
+	}
+	old, err := tx.GetListenForUser(ctx, db.GetListenForUserParams{
+		ID:     req.Msg.ListenId,
+		UserID: authCtx.user.ID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("listen not found"),
+		)
+	} else if err != nil {
+		return nil, fmt.Errorf("fetching listen: %w", err)
+	}
+
+	updated := old
+	if req.Msg.Isrc != "" {
+		updated.Isrc, err = normaliseISRC(req.Msg.Isrc)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+	if req.Msg.ListenedAt != nil {
+		updated.ListenedAt = req.Msg.ListenedAt.AsTime()
+		if err := validateListenedAt(updated.ListenedAt, time.Now()); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	if updated.Isrc != old.Isrc || !updated.ListenedAt.Equal(old.ListenedAt) {
+		err = tx.UpdateListen(ctx, db.UpdateListenParams{
+			Isrc:       updated.Isrc,
+			ListenedAt: updated.ListenedAt,
+			ID:         updated.ID,
+			UserID:     updated.UserID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("updating listen: %w", err)
+		}
+		policy, err := loadPrivacyPolicy(ctx, tx, authCtx.user.ID, authCtx.user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if err := recountListen(ctx, tx, policy, &old, &updated); err != nil {
+			return nil, err
+		}
+		if err := recordListenEdit(ctx, tx, listenEditUpdate, &old, &updated); err != nil {
+			return nil, err
+		}
+		if err := publishEvent(ctx, tx, eventKindListens, updated.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.UpdateListenResponse{
+		Listen: listenToProto(updated),
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) DeleteListen(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.DeleteListenRequest],
+) (*connect.Response[mootslivepbv1.DeleteListenResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	commit, rollback, tx, err := us.queries.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		if err := rollback(context.Background()); err != nil {
+			if !errors.Is(err, pgx.ErrTxClosed) {
+				us.log.Error("failed to rollback", err)
+			}
+		}
+	}()
+
+	if err := lockRollups(ctx, tx, authCtx.user.ID); err != nil {
+		return nil, err
+	}
+	listen, err := tx.GetListenForUser(ctx, db.GetListenForUserParams{
+		ID:     req.Msg.ListenId,
+		UserID: authCtx.user.ID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("listen not found"),
+		)
+	} else if err != nil {
+		return nil, fmt.Errorf("fetching listen: %w", err)
+	}
+
+	err = tx.DeleteListen(ctx, db.DeleteListenParams{
+		ID:     listen.ID,
+		UserID: listen.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("deleting listen: %w", err)
+	}
+	policy, err := loadPrivacyPolicy(ctx, tx, authCtx.user.ID, authCtx.user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := recountListen(ctx, tx, policy, &listen, nil); err != nil {
+		return nil, err
+	}
+	if err := recordListenEdit(ctx, tx, listenEditDelete, &listen, nil); err != nil {
+		return nil, err
+	}
+	if err := publishEvent(ctx, tx, eventKindListens, listen.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return connect.NewResponse(&mootslivepbv1.DeleteListenResponse{}), nil
+}
+
+func (us *UserServiceHandler) DeleteListens(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.DeleteListensRequest],
+) (*connect.Response[mootslivepbv1.DeleteListensResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	if req.Msg.StartTime == nil || req.Msg.EndTime == nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("start_time and end_time must be set"),
+		)
+	}
+	startTime, endTime := req.Msg.StartTime.AsTime(), req.Msg.EndTime.AsTime()
+	if !startTime.Before(endTime) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("start_time must be before end_time"),
+		)
+	}
+
+	commit, rollback, tx, err := us.queries.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		if err := rollback(context.Background()); err != nil {
+			if !errors.Is(err, pgx.ErrTxClosed) {
+				us.log.Error("failed to rollback", err)
+			}
+		}
+	}()
+
+	if err := lockRollups(ctx, tx, authCtx.user.ID); err != nil {
+		return nil, err
+	}
+	// As many listens may be deleted, the edits recording their deletion are
+	// written by the same statement. Each listen can only be deleted once, so
+	// the ID of the listen doubles as the ID of the edit.
+	deleted, err := tx.DeleteListensForUserBetween(ctx, db.DeleteListensForUserBetweenParams{
+		UserID:    authCtx.user.ID,
+		StartTime: startTime,
+		EndTime:   endTime,
+		Action:    listenEditDelete,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("deleting listens: %w", err)
+	}
+	// Recounting the days deleted from is simpler than uncounting what may be
+	// a great many listens one at a time.
+	if deleted > 0 {
+		err := rebuildRollups(ctx, tx, authCtx.user.ID, startTime, endTime)
+		if err != nil {
+			return nil, fmt.Errorf("rebuilding rollups: %w", err)
+		}
+		if err := publishEvent(ctx, tx, eventKindListens, authCtx.user.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	res := connect.NewResponse(&mootslivepbv1.DeleteListensResponse{
+		Deleted: deleted,
+	})
+	return res, nil
+}
+
+func (us *UserServiceHandler) ListListenEdits(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ListListenEditsRequest],
+) (*connect.Response[mootslivepbv1.ListListenEditsResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	pageSize, err := clampPageSize(
+		req.Msg.PageSize, defaultListenEditsPageSize, maxListenEditsPageSize,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	cursor, err := decodePageCursor(req.Msg.PageToken, "")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	edits, err := us.queries.ListListenEdits(ctx, db.ListListenEditsParams{
+		UserID:          authCtx.user.ID,
+		BeforeCreatedAt: cursor.Time,
+		BeforeID:        cursor.ID,
+		RowLimit:        pageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching listen edits: %w", err)
+	}
+
+	res := &mootslivepbv1.ListListenEditsResponse{
+		Edits: make([]*mootslivepbv1.ListenEdit, 0, len(edits)),
+	}
+	for _, edit := range edits {
+		res.Edits = append(res.Edits, listenEditToProto(edit))
+	}
+	// A short page means we've reached the end of the edits.
+	if len(edits) == int(pageSize) {
+		last := edits[len(edits)-1]
+		res.NextPageToken = pageCursor{
+			Time: last.CreatedAt,
+			ID:   last.ID,
+		}.encode()
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (us *UserServiceHandler) ExportListens(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ExportListensRequest],
+	stream *connect.ServerStream[mootslivepbv1.ExportListensResponse],
+) error {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return fmt.Errorf("access denied: %w", err)
+	}
+
+	format, ok := exportFormatFromProto(req.Msg.Format)
+	if !ok {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("unsupported format %s", req.Msg.Format),
+		)
+	}
+	startTime, endTime, err := exportTimeRange(req.Msg.StartTime, req.Msg.EndTime)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	enc := newListenEncoder(format)
+	first := true
+	return exportListens(ctx, us.queries, enc, authCtx.user.ID, startTime, endTime, func(b []byte) error {
+		msg := &mootslivepbv1.ExportListensResponse{Data: b}
+		if first {
+			msg.ContentType = enc.contentType()
+			msg.Filename = enc.filename()
+			first = false
+		}
+		if err := stream.Send(msg); err != nil {
+			return fmt.Errorf("sending export: %w", err)
+		}
+		return nil
+	})
+}
+
+func (us *UserServiceHandler) GetSyncStatus(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.GetSyncStatusRequest],
+) (*connect.Response[mootslivepbv1.GetSyncStatusResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	accounts, err := us.queries.ListSpotifyAccountsForUser(ctx, authCtx.user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching spotify accounts: %w", err)
+	}
+
+	res := &mootslivepbv1.GetSyncStatusResponse{
+		Accounts: make([]*mootslivepbv1.AccountSyncStatus, 0, len(accounts)),
+	}
+	for _, account := range accounts {
+		res.Accounts = append(res.Accounts, &mootslivepbv1.AccountSyncStatus{
+			Provider:       mootslivepbv1.Provider_PROVIDER_SPOTIFY,
+			ProviderUserId: account.SpotifyUserID,
+			LastSyncedAt:   nullTimeToProto(account.LastSucceededAt),
+			LastListenedAt: nullTimeToProto(account.LastListenedAt),
+			Failing:        account.FailureStreak > 0,
+		})
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (us *UserServiceHandler) ListMySecurityEvents(
+	ctx context.Context,
+	req *connect.Request[mootslivepbv1.ListMySecurityEventsRequest],
+) (*connect.Response[mootslivepbv1.ListMySecurityEventsResponse], error) {
+	authCtx, err := us.authEngine.handleReq(
+		ctx, req, handleReqOpts{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("access denied: %w", err)
+	}
+
+	pageSize, err := clampPageSize(
+		req.Msg.PageSize, defaultAuditEventsPageSize, maxAuditEventsPageSize,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	cursor, err := decodePageCursor(req.Msg.PageToken, "")
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	events, err := us.queries.ListAuditEventsForUser(ctx, db.ListAuditEventsForUserParams{
+		UserID:          authCtx.user.ID,
+		BeforeCreatedAt: cursor.Time,
+		BeforeID:        cursor.ID,
+		RowLimit:        pageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching audit events: %w", err)
+	}
+
+	res := &mootslivepbv1.ListMySecurityEventsResponse{
+		Events: make([]*mootslivepbv1.SecurityEvent, 0, len(events)),
+	}
+	for _, event := range events {
+		res.Events = append(res.Events, securityEventToProto(event, authCtx.user.ID))
+	}
+	// A short page means we've reached the end of the events.
+	if len(events) == int(pageSize) {
+		last := events[len(events)-1]
+		res.NextPageToken = pageCursor{
+			Time: last.CreatedAt,
+			ID:   last.ID,
+		}.encode()
 	}
 
 	return connect.NewResponse(res), nil
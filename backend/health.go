@@ -0,0 +1,229 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	grpchealth "github.com/bufbuild/connect-grpchealth-go"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/mootslive/mono/backend/db"
+	"github.com/mootslive/mono/backend/trace"
+	"github.com/mootslive/mono/proto/mootslive/v1/mootslivepbv1connect"
+	"golang.org/x/exp/slog"
+)
+
+const (
+	// healthCheckTimeout is how long each check has to complete before it's
+	// considered failing.
+	healthCheckTimeout = time.Second * 5
+	// pollerStalledAfter is how long the poller can go without making
+	// progress before it's considered stuck. Scanning a single account
+	// should never take anywhere near this long.
+	pollerStalledAfter = time.Minute * 5
+)
+
+// healthCheckKind determines which probes a check affects.
+type healthCheckKind int
+
+const (
+	// healthCheckLiveness checks fail both probes. They should only fail
+	// when restarting the replica would fix the problem.
+	healthCheckLiveness healthCheckKind = iota
+	// healthCheckReadiness checks fail the readiness probe, taking the
+	// replica out of service until they pass again.
+	healthCheckReadiness
+	// healthCheckInformational checks are reported but never fail a probe,
+	// as the service works fine without the thing they check.
+	healthCheckInformational
+)
+
+type healthCheck struct {
+	name  string
+	kind  healthCheckKind
+	check func(ctx context.Context) error
+}
+
+// healthReport is the result of running the health checks, which is served as
+// JSON from /healthz and /readyz.
+type healthReport struct {
+	Healthy bool                         `json:"healthy"`
+	Checks  map[string]healthCheckResult `json:"checks"`
+}
+
+type healthCheckResult struct {
+	Healthy bool   `json:"healthy"`
+	Error   string `json:"error,omitempty"`
+}
+
+// HealthChecker checks whether this replica is working, for orchestrators
+// deciding whether to restart it or send it traffic.
+type HealthChecker struct {
+	log    *slog.Logger
+	checks []healthCheck
+}
+
+func NewHealthChecker(
+	log *slog.Logger,
+	pool *pgxpool.Pool,
+	queries db.TXQuerier,
+	poller *SpotifyPoller,
+	exporter *trace.MonitoredExporter,
+) *HealthChecker {
+	return &HealthChecker{
+		log: log,
+		checks: []healthCheck{
+			{
+				name:  "postgres",
+				kind:  healthCheckReadiness,
+				check: pool.Ping,
+			},
+			{
+				name: "migrations",
+				kind: healthCheckReadiness,
+				check: func(ctx context.Context) error {
+					return checkMigrations(ctx, queries)
+				},
+			},
+			{
+				name: "poller",
+				kind: healthCheckLiveness,
+				check: func(ctx context.Context) error {
+					since := time.Since(poller.ProgressAt())
+					if since > pollerStalledAfter {
+						return fmt.Errorf(
+							"poller hasn't made progress for %s",
+							since.Round(time.Second),
+						)
+					}
+					return nil
+				},
+			},
+			{
+				name: "trace_exporter",
+				kind: healthCheckInformational,
+				check: func(ctx context.Context) error {
+					if err := exporter.Err(); err != nil {
+						return fmt.Errorf("last export failed: %w", err)
+					}
+					return nil
+				},
+			},
+		},
+	}
+}
+
+// checkMigrations checks that the database has been migrated to the newest
+// migration we know of, without one failing part way through.
+func checkMigrations(ctx context.Context, queries db.TXQuerier) error {
+	latest, err := db.LatestMigrationVersion()
+	if err != nil {
+		return err
+	}
+	migration, err := queries.GetSchemaMigration(ctx)
+	if err != nil {
+		return fmt.Errorf("fetching schema migration: %w", err)
+	}
+	if migration.Dirty {
+		return fmt.Errorf("migration %d failed part way through", migration.Version)
+	}
+	if migration.Version != int64(latest) {
+		return fmt.Errorf(
+			"database is at migration %d rather than %d",
+			migration.Version, latest,
+		)
+	}
+	return nil
+}
+
+// run runs the checks up to and including the given kind. Informational checks
+// are always run, as they're only there to be reported.
+func (hc *HealthChecker) run(
+	ctx context.Context, upTo healthCheckKind,
+) healthReport {
+	ctx, span := trace.Start(ctx, "backend/HealthChecker.run")
+	defer span.End()
+
+	report := healthReport{
+		Healthy: true,
+		Checks:  map[string]healthCheckResult{},
+	}
+	for _, c := range hc.checks {
+		if c.kind > upTo && c.kind != healthCheckInformational {
+			continue
+		}
+		checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+		err := c.check(checkCtx)
+		cancel()
+
+		result := healthCheckResult{Healthy: err == nil}
+		if err != nil {
+			result.Error = err.Error()
+			if c.kind != healthCheckInformational {
+				report.Healthy = false
+			}
+		}
+		report.Checks[c.name] = result
+	}
+	return report
+}
+
+func (hc *HealthChecker) serveReport(
+	w http.ResponseWriter, r *http.Request, upTo healthCheckKind,
+) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	report := hc.run(r.Context(), upTo)
+	w.Header().Set("Content-Type", "application/json")
+	if !report.Healthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		hc.log.Error("failed to write health report", err)
+	}
+}
+
+// LivenessHandler serves /healthz, which fails when the replica is stuck and
+// should be restarted.
+func (hc *HealthChecker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hc.serveReport(w, r, healthCheckLiveness)
+	})
+}
+
+// ReadinessHandler serves /readyz, which fails when the replica can't serve
+// requests and shouldn't be sent any.
+func (hc *HealthChecker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hc.serveReport(w, r, healthCheckReadiness)
+	})
+}
+
+// Check implements grpchealth.Checker, serving the standard gRPC health
+// checking protocol with the readiness of the replica.
+func (hc *HealthChecker) Check(
+	ctx context.Context, req *grpchealth.CheckRequest,
+) (*grpchealth.CheckResponse, error) {
+	// An empty service asks after the server as a whole. Every service is
+	// served by this replica, so they're all as healthy as it is.
+	switch req.Service {
+	case "", mootslivepbv1connect.AdminServiceName, mootslivepbv1connect.UserServiceName:
+	default:
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("unknown service %q", req.Service),
+		)
+	}
+
+	status := grpchealth.StatusServing
+	if !hc.run(ctx, healthCheckReadiness).Healthy {
+		status = grpchealth.StatusNotServing
+	}
+	return &grpchealth.CheckResponse{Status: status}, nil
+}
@@ -0,0 +1,134 @@
+package backend
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/mootslive/mono/backend/db"
+	mootslivepbv1 "github.com/mootslive/mono/proto/mootslive/v1"
+	"github.com/segmentio/ksuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	listenEditCreate = "create"
+	listenEditUpdate = "update"
+	listenEditDelete = "delete"
+
+	defaultListenEditsPageSize = 50
+	maxListenEditsPageSize     = 100
+
+	// maxListenClockSkew is how far in the future a listen recorded by hand
+	// may be, to allow for the clocks of clients being a little ahead.
+	maxListenClockSkew = time.Minute * 5
+)
+
+var listenEditActions = map[string]mootslivepbv1.ListenEditAction{
+	listenEditCreate: mootslivepbv1.ListenEditAction_LISTEN_EDIT_ACTION_CREATE,
+	listenEditUpdate: mootslivepbv1.ListenEditAction_LISTEN_EDIT_ACTION_UPDATE,
+	listenEditDelete: mootslivepbv1.ListenEditAction_LISTEN_EDIT_ACTION_DELETE,
+}
+
+// isrcPattern matches an ISRC without hyphens: a country code, a registrant
+// code, a year and a designation code.
+var isrcPattern = regexp.MustCompile(`^[A-Z]{2}[A-Z0-9]{3}[0-9]{7}$`)
+
+// normaliseISRC upper cases an ISRC and strips any hyphens from it, before
+// checking it's valid.
+func normaliseISRC(isrc string) (string, error) {
+	isrc = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(isrc), "-", ""))
+	if !isrcPattern.MatchString(isrc) {
+		return "", fmt.Errorf("isrc %q is not a valid ISRC", isrc)
+	}
+	return isrc, nil
+}
+
+// validateListenedAt checks that a listen recorded by hand isn't in the
+// future.
+func validateListenedAt(listenedAt time.Time, now time.Time) error {
+	if listenedAt.After(now.Add(maxListenClockSkew)) {
+		return fmt.Errorf("listened_at must not be in the future")
+	}
+	return nil
+}
+
+// recordListenEdit adds a change to a listen to the user's history of edits.
+// old is nil for a listen being created, and updated is nil for one being
+// deleted.
+func recordListenEdit(
+	ctx context.Context,
+	queries db.Querier,
+	action string,
+	old, updated *db.Listen,
+) error {
+	edit := db.CreateListenEditParams{
+		ID:        ksuid.New().String(),
+		Action:    action,
+		CreatedAt: time.Now(),
+	}
+	for _, listen := range []*db.Listen{old, updated} {
+		if listen != nil {
+			edit.UserID = listen.UserID
+			edit.ListenID = listen.ID
+		}
+	}
+	if old != nil {
+		edit.OldIsrc = sql.NullString{Valid: true, String: old.Isrc}
+		edit.OldListenedAt = sql.NullTime{Valid: true, Time: old.ListenedAt}
+	}
+	if updated != nil {
+		edit.NewIsrc = sql.NullString{Valid: true, String: updated.Isrc}
+		edit.NewListenedAt = sql.NullTime{Valid: true, Time: updated.ListenedAt}
+	}
+	if err := queries.CreateListenEdit(ctx, edit); err != nil {
+		return fmt.Errorf("recording listen edit: %w", err)
+	}
+	return nil
+}
+
+// recountListen moves a listen that has been changed to where it now belongs
+// in the rollups. old or updated may be nil for a listen that's been created
+// or deleted. It should be called within a transaction holding the rollups
+// lock of the user.
+func recountListen(
+	ctx context.Context,
+	queries db.Querier,
+	policy *privacyPolicy,
+	old, updated *db.Listen,
+) error {
+	if old != nil {
+		if err := uncountListen(ctx, queries, policy, *old); err != nil {
+			return err
+		}
+	}
+	if updated != nil {
+		if err := countListen(ctx, queries, policy, *updated); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func nullTimeToProto(t sql.NullTime) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
+
+func listenEditToProto(edit db.ListenEdit) *mootslivepbv1.ListenEdit {
+	return &mootslivepbv1.ListenEdit{
+		Id:            edit.ID,
+		ListenId:      edit.ListenID,
+		Action:        listenEditActions[edit.Action],
+		OldIsrc:       edit.OldIsrc.String,
+		OldListenedAt: nullTimeToProto(edit.OldListenedAt),
+		NewIsrc:       edit.NewIsrc.String,
+		NewListenedAt: nullTimeToProto(edit.NewListenedAt),
+		CreatedAt:     timestamppb.New(edit.CreatedAt),
+	}
+}
@@ -0,0 +1,40 @@
+package backend
+
+import "testing"
+
+func TestNormaliseISRC(t *testing.T) {
+	tests := []struct {
+		name    string
+		isrc    string
+		want    string
+		wantErr bool
+	}{
+		{name: "already normalised", isrc: "USRC17607839", want: "USRC17607839"},
+		{name: "lower case", isrc: "usrc17607839", want: "USRC17607839"},
+		{name: "hyphenated", isrc: "US-RC1-76-07839", want: "USRC17607839"},
+		{name: "surrounding space", isrc: " USRC17607839\n", want: "USRC17607839"},
+		{name: "empty", isrc: "", wantErr: true},
+		{name: "too short", isrc: "USRC1760783", wantErr: true},
+		{name: "too long", isrc: "USRC176078390", wantErr: true},
+		{name: "digit in country code", isrc: "U1RC17607839", wantErr: true},
+		{name: "letter in designation code", isrc: "USRC1760783X", wantErr: true},
+		{name: "inner space", isrc: "USRC 17607839", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normaliseISRC(tt.isrc)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected %q to be rejected, got %q", tt.isrc, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
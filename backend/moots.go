@@ -0,0 +1,348 @@
+package backend
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/mootslive/mono/backend/db"
+	"github.com/mootslive/mono/backend/trace"
+	"github.com/mootslive/mono/backend/twitter"
+	"golang.org/x/exp/slog"
+)
+
+const (
+	followDirectionFollowing = "following"
+	followDirectionFollowers = "followers"
+
+	// twitterFollowSyncInterval is how often the follow graph of each linked
+	// Twitter account is refreshed.
+	twitterFollowSyncInterval = time.Hour * 24
+	// twitterFollowSyncCooldown is how soon after a completed sync a user can
+	// request another with SyncMoots.
+	twitterFollowSyncCooldown = time.Minute * 15
+)
+
+// requestTwitterFollowSync marks the follow graph of a Twitter account as due
+// a sync, unless it has been synced very recently.
+func requestTwitterFollowSync(
+	ctx context.Context, queries db.Querier, twitterUserID string,
+) error {
+	return queries.RequestTwitterFollowSync(ctx, db.RequestTwitterFollowSyncParams{
+		TwitterUserID:   twitterUserID,
+		CompletedBefore: time.Now().Add(-twitterFollowSyncCooldown),
+	})
+}
+
+// TwitterFollowSyncer pages through the following and followers of linked
+// Twitter accounts and stores them, so that we can work out who is a moot.
+type TwitterFollowSyncer struct {
+	queries db.TXQuerier
+	log     *slog.Logger
+
+	backoffMu sync.Mutex
+	// backoff holds when syncs that hit the Twitter rate limit can next be
+	// attempted.
+	backoff map[followSyncKey]time.Time
+}
+
+type followSyncKey struct {
+	twitterUserID string
+	direction     string
+}
+
+func NewTwitterFollowSyncer(
+	log *slog.Logger, queries db.TXQuerier,
+) *TwitterFollowSyncer {
+	return &TwitterFollowSyncer{
+		log:     log,
+		queries: queries,
+		backoff: map[followSyncKey]time.Time{},
+	}
+}
+
+func (tf *TwitterFollowSyncer) Run(ctx context.Context) error {
+	tf.log.Info("starting twitter follow syncer")
+
+	for {
+		syncs, err := tf.queries.ListTwitterFollowSyncsDue(
+			ctx, time.Now().Add(-twitterFollowSyncInterval),
+		)
+		if err != nil {
+			return fmt.Errorf("fetching follow syncs: %w", err)
+		}
+
+		for _, s := range syncs {
+			key := followSyncKey{
+				twitterUserID: s.TwitterUserID,
+				direction:     s.Direction,
+			}
+			if tf.backingOff(key) {
+				continue
+			}
+
+			err := tf.Sync(ctx, s.TwitterUserID, s.Direction)
+			rlErr := &twitter.RateLimitError{}
+			if errors.As(err, &rlErr) {
+				tf.log.Info("rate limited syncing twitter follows",
+					slog.String("twitter_user_id", s.TwitterUserID),
+					slog.String("direction", s.Direction),
+					slog.Time("reset", rlErr.RateLimit.Reset),
+				)
+				tf.setBackoff(key, rlErr.RateLimit.Reset)
+			} else if err != nil {
+				// One account having trouble, e.g a revoked token, shouldn't
+				// stop the others being synced.
+				tf.log.Error("failed to sync twitter follows", err,
+					slog.String("twitter_user_id", s.TwitterUserID),
+					slog.String("direction", s.Direction),
+				)
+			}
+		}
+
+		select {
+		case <-time.After(time.Minute):
+			continue
+		case <-ctx.Done():
+			tf.log.Info("context cancelled, stopping twitter follow syncer")
+			return ctx.Err()
+		}
+	}
+}
+
+func (tf *TwitterFollowSyncer) backingOff(
+	key followSyncKey,
+) bool {
+	tf.backoffMu.Lock()
+	defer tf.backoffMu.Unlock()
+	until, ok := tf.backoff[key]
+	if !ok {
+		return false
+	}
+	if time.Now().After(until) {
+		delete(tf.backoff, key)
+		return false
+	}
+	return true
+}
+
+func (tf *TwitterFollowSyncer) setBackoff(
+	key followSyncKey, until time.Time,
+) {
+	tf.backoffMu.Lock()
+	defer tf.backoffMu.Unlock()
+	tf.backoff[key] = until
+}
+
+// Sync pages through the following or followers of a Twitter account until
+// the last page is reached, or the rate limit for the endpoint is exhausted,
+// in which case a twitter.RateLimitError is returned. Progress is saved after
+// each page, so an interrupted sync resumes from where it stopped.
+func (tf *TwitterFollowSyncer) Sync(
+	ctx context.Context, twitterUserID, direction string,
+) error {
+	ctx, span := trace.Start(ctx, "backend/TwitterFollowSyncer.Sync")
+	defer span.End()
+
+	account, err := tf.queries.GetTwitterAccount(ctx, twitterUserID)
+	if err != nil {
+		return fmt.Errorf("fetching twitter account: %w", err)
+	}
+	// The sync runs in the background, so it's preferable to give up and
+	// try again later than to sit waiting for the rate limit to reset.
+	client := clientForTwitterAccount(
+		ctx, account, twitter.WithMaxRateLimitWait(0),
+	)
+	// Twitter's refresh tokens can only be used once, so a token refreshed
+	// during the sync has to be saved however the sync ends.
+	defer func() {
+		err := saveRefreshedTwitterToken(
+			context.Background(), tf.queries, account, client,
+		)
+		if err != nil {
+			tf.log.Error("failed to save refreshed twitter token", err,
+				slog.String("twitter_user_id", account.TwitterUserID),
+			)
+		}
+	}()
+
+	for {
+		done, err := tf.syncPage(ctx, client, account, direction)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+	}
+}
+
+// followSyncComplete reports whether a sync has been completed recently
+// enough that it doesn't need running again yet.
+func followSyncComplete(s db.TwitterFollowSync) bool {
+	return s.CompletedAt.Valid &&
+		time.Since(s.CompletedAt.Time) < twitterFollowSyncInterval
+}
+
+func (tf *TwitterFollowSyncer) syncPage(
+	ctx context.Context,
+	client *twitter.Client,
+	account db.TwitterAccount,
+	direction string,
+) (bool, error) {
+	ctx, span := trace.Start(ctx, "backend/TwitterFollowSyncer.syncPage")
+	defer span.End()
+
+	syncKey := db.GetTwitterFollowSyncParams{
+		TwitterUserID: account.TwitterUserID,
+		Direction:     direction,
+	}
+	// The page is fetched before locking the sync, as fetching it can take
+	// a while and we don't want to hold a transaction open meanwhile.
+	s, err := tf.queries.GetTwitterFollowSync(ctx, syncKey)
+	if err != nil {
+		return false, fmt.Errorf("fetching follow sync: %w", err)
+	}
+	if followSyncComplete(s) {
+		return true, nil
+	}
+
+	var page *twitter.ListUsersResponse
+	switch direction {
+	case followDirectionFollowing:
+		page, err = client.ListFollowing(
+			ctx, account.TwitterUserID, s.PaginationToken.String,
+		)
+	case followDirectionFollowers:
+		page, err = client.ListFollowers(
+			ctx, account.TwitterUserID, s.PaginationToken.String,
+		)
+	default:
+		return false, fmt.Errorf("unknown follow direction %q", direction)
+	}
+	if err != nil {
+		return false, fmt.Errorf("fetching %s: %w", direction, err)
+	}
+
+	commit, rollback, tx, err := tf.queries.BeginTx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		if err := rollback(context.Background()); err != nil {
+			if !errors.Is(err, pgx.ErrTxClosed) {
+				tf.log.Error("failed to rollback", err)
+			}
+		}
+	}()
+
+	locked, err := tx.SelectTwitterFollowSyncForUpdate(
+		ctx, db.SelectTwitterFollowSyncForUpdateParams(syncKey),
+	)
+	if err != nil {
+		return false, fmt.Errorf("locking follow sync: %w", err)
+	}
+	// Another replica may have finished the sync, or moved it on to another
+	// page, whilst we fetched this one. In which case the page is thrown
+	// away and we carry on from wherever it got to.
+	if followSyncComplete(locked) {
+		return true, nil
+	}
+	if locked.PaginationToken != s.PaginationToken ||
+		locked.StartedAt != s.StartedAt {
+		return false, nil
+	}
+
+	now := time.Now()
+	startedAt := now
+	if s.StartedAt.Valid {
+		startedAt = s.StartedAt.Time
+	}
+
+	followerIDs := make([]string, 0, len(page.Data))
+	followeeIDs := make([]string, 0, len(page.Data))
+	for _, user := range page.Data {
+		if direction == followDirectionFollowing {
+			followerIDs = append(followerIDs, account.TwitterUserID)
+			followeeIDs = append(followeeIDs, user.ID)
+		} else {
+			followerIDs = append(followerIDs, user.ID)
+			followeeIDs = append(followeeIDs, account.TwitterUserID)
+		}
+	}
+	if len(followerIDs) > 0 {
+		err := tx.UpsertTwitterFollows(ctx, db.UpsertTwitterFollowsParams{
+			FollowerIds: followerIDs,
+			FolloweeIds: followeeIDs,
+			SyncedAt:    now,
+		})
+		if err != nil {
+			return false, fmt.Errorf("storing follows: %w", err)
+		}
+	}
+
+	done := page.Meta.NextToken == ""
+	if done {
+		// Every follow that still exists has been seen during this sync, so
+		// anything older is an unfollow.
+		if direction == followDirectionFollowing {
+			err = tx.DeleteStaleTwitterFollowing(ctx, db.DeleteStaleTwitterFollowingParams{
+				FollowerID: account.TwitterUserID,
+				SyncedAt:   startedAt,
+			})
+		} else {
+			err = tx.DeleteStaleTwitterFollowers(ctx, db.DeleteStaleTwitterFollowersParams{
+				FolloweeID: account.TwitterUserID,
+				SyncedAt:   startedAt,
+			})
+		}
+		if err != nil {
+			return false, fmt.Errorf("deleting stale follows: %w", err)
+		}
+
+		err = tx.CompleteTwitterFollowSync(ctx, db.CompleteTwitterFollowSyncParams{
+			TwitterUserID: account.TwitterUserID,
+			Direction:     direction,
+			CompletedAt: sql.NullTime{
+				Valid: true,
+				Time:  now,
+			},
+		})
+		if err != nil {
+			return false, fmt.Errorf("completing follow sync: %w", err)
+		}
+	} else {
+		err = tx.UpdateTwitterFollowSyncProgress(ctx, db.UpdateTwitterFollowSyncProgressParams{
+			TwitterUserID: account.TwitterUserID,
+			Direction:     direction,
+			StartedAt: sql.NullTime{
+				Valid: true,
+				Time:  startedAt,
+			},
+			PaginationToken: sql.NullString{
+				Valid:  true,
+				String: page.Meta.NextToken,
+			},
+		})
+		if err != nil {
+			return false, fmt.Errorf("saving follow sync progress: %w", err)
+		}
+	}
+
+	if err := commit(ctx); err != nil {
+		return false, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	tf.log.Debug("synced page of twitter follows",
+		slog.String("twitter_user_id", account.TwitterUserID),
+		slog.String("direction", direction),
+		slog.Int("count", len(page.Data)),
+		slog.Bool("done", done),
+	)
+
+	return done, nil
+}
@@ -0,0 +1,227 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mootslive/mono/backend/db"
+	"github.com/mootslive/mono/backend/trace"
+	mootslivepbv1 "github.com/mootslive/mono/proto/mootslive/v1"
+	"github.com/segmentio/ksuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// notificationKindNewFollower targets nothing, so that new followers
+	// are grouped together.
+	notificationKindNewFollower    = "new_follower"
+	notificationKindFollowRequest  = "follow_request"
+	notificationKindFollowAccepted = "follow_accepted"
+	// notificationKindReaction and notificationKindComment target the
+	// listen that was reacted to or commented on.
+	notificationKindReaction = "reaction"
+	notificationKindComment  = "comment"
+	// notificationKindSameSong targets the ISRC of the song that the user
+	// and their moot are both listening to.
+	notificationKindSameSong = "same_song"
+
+	defaultNotificationsPageSize = 50
+	maxNotificationsPageSize     = 100
+	// notificationActorCount is how many of the users who caused a
+	// notification are included with it.
+	notificationActorCount = 3
+)
+
+// notificationKinds maps each kind of notification to its protobuf
+// equivalent. Every kind is enabled unless the user turns it off.
+var notificationKinds = map[string]mootslivepbv1.NotificationKind{
+	notificationKindNewFollower:    mootslivepbv1.NotificationKind_NOTIFICATION_KIND_NEW_FOLLOWER,
+	notificationKindFollowRequest:  mootslivepbv1.NotificationKind_NOTIFICATION_KIND_FOLLOW_REQUEST,
+	notificationKindFollowAccepted: mootslivepbv1.NotificationKind_NOTIFICATION_KIND_FOLLOW_ACCEPTED,
+	notificationKindReaction:       mootslivepbv1.NotificationKind_NOTIFICATION_KIND_REACTION,
+	notificationKindComment:        mootslivepbv1.NotificationKind_NOTIFICATION_KIND_COMMENT,
+	notificationKindSameSong:       mootslivepbv1.NotificationKind_NOTIFICATION_KIND_SAME_SONG,
+}
+
+func notificationKindFromProto(kind mootslivepbv1.NotificationKind) (string, bool) {
+	for k, pb := range notificationKinds {
+		if pb == kind {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// listDisabledNotificationKinds returns the kinds of notification a user has
+// turned off.
+func listDisabledNotificationKinds(
+	ctx context.Context, queries db.Querier, userID string,
+) (map[string]bool, error) {
+	prefs, err := queries.ListNotificationPreferences(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching notification preferences: %w", err)
+	}
+	disabled := map[string]bool{}
+	for _, pref := range prefs {
+		if !pref.Enabled {
+			disabled[pref.Kind] = true
+		}
+	}
+	return disabled, nil
+}
+
+// notify tells userID that actorID has done something concerning them, unless
+// they've turned off that kind of notification or have blocked or muted the
+// actor. If they have an unread notification of the same kind and target, the
+// actor is added to it rather than a new notification being created.
+func notify(
+	ctx context.Context,
+	queries db.Querier,
+	userID, kind, targetID, actorID string,
+) error {
+	ctx, span := trace.Start(ctx, "backend/notify")
+	defer span.End()
+
+	if userID == actorID {
+		return nil
+	}
+	disabled, err := listDisabledNotificationKinds(ctx, queries, userID)
+	if err != nil {
+		return err
+	}
+	if disabled[kind] {
+		return nil
+	}
+	blocked, err := listBlockedUserIDs(ctx, queries, userID)
+	if err != nil {
+		return err
+	}
+	muted, err := listMutedUserIDs(ctx, queries, userID)
+	if err != nil {
+		return err
+	}
+	if blocked[actorID] || muted[actorID] {
+		return nil
+	}
+
+	err = queries.UpsertNotification(ctx, db.UpsertNotificationParams{
+		ID:        ksuid.New().String(),
+		UserID:    userID,
+		Kind:      kind,
+		TargetID:  targetID,
+		ActorID:   actorID,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("creating notification: %w", err)
+	}
+	return publishEvent(ctx, queries, eventKindNotifications, userID)
+}
+
+// notifySameSong tells a user and their moots when they're listening to the
+// same song, as long as each can see the other's listen.
+func notifySameSong(
+	ctx context.Context, queries db.Querier, listens []db.Listen,
+) error {
+	ctx, span := trace.Start(ctx, "backend/notifySameSong")
+	defer span.End()
+
+	since := time.Now().Add(-presenceListeningWindow)
+	seenISRCs := map[string]bool{}
+	for _, listen := range listens {
+		// Backfilled listens are long finished.
+		if listen.ListenedAt.Before(since) || seenISRCs[listen.Isrc] {
+			continue
+		}
+		seenISRCs[listen.Isrc] = true
+
+		theirs, err := queries.ListRecentListensOfTrackByMoots(
+			ctx, db.ListRecentListensOfTrackByMootsParams{
+				UserID:        listen.UserID,
+				Isrc:          listen.Isrc,
+				ListenedSince: since,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("fetching moots' listens: %w", err)
+		}
+		mootIDs := make([]string, 0, len(theirs))
+		for _, their := range theirs {
+			mootIDs = append(mootIDs, their.UserID)
+		}
+		policies, err := loadPrivacyPolicies(ctx, queries, listen.UserID, mootIDs)
+		if err != nil {
+			return err
+		}
+
+		seenMoots := map[string]bool{}
+		for _, their := range theirs {
+			if seenMoots[their.UserID] {
+				continue
+			}
+			seenMoots[their.UserID] = true
+
+			mine, err := loadPrivacyPolicy(ctx, queries, their.UserID, listen.UserID)
+			if err != nil {
+				return err
+			}
+			if !mine.allows(listen) || !policies[their.UserID].allows(their) {
+				continue
+			}
+			err = notify(
+				ctx, queries, listen.UserID, notificationKindSameSong, listen.Isrc, their.UserID,
+			)
+			if err != nil {
+				return err
+			}
+			err = notify(
+				ctx, queries, their.UserID, notificationKindSameSong, listen.Isrc, listen.UserID,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// notificationsToProto converts notifications, filling in the most recent
+// of the users who caused each of them.
+func notificationsToProto(
+	ctx context.Context, queries db.Querier, notifications []db.Notification,
+) ([]*mootslivepbv1.Notification, error) {
+	actorIDs := []string{}
+	for _, n := range notifications {
+		actorIDs = append(actorIDs, recentActorIDs(n)...)
+	}
+	users, err := listUserSummaries(ctx, queries, actorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	pbs := make([]*mootslivepbv1.Notification, 0, len(notifications))
+	for _, n := range notifications {
+		pb := &mootslivepbv1.Notification{
+			Id:         n.ID,
+			Kind:       notificationKinds[n.Kind],
+			TargetId:   n.TargetID,
+			ActorCount: int32(len(n.ActorIds)),
+			CreatedAt:  timestamppb.New(n.CreatedAt),
+			UpdatedAt:  timestamppb.New(n.UpdatedAt),
+			Read:       n.ReadAt.Valid,
+		}
+		for _, id := range recentActorIDs(n) {
+			pb.Actors = append(pb.Actors, users[id])
+		}
+		pbs = append(pbs, pb)
+	}
+	return pbs, nil
+}
+
+func recentActorIDs(n db.Notification) []string {
+	if len(n.ActorIds) > notificationActorCount {
+		return n.ActorIds[:notificationActorCount]
+	}
+	return n.ActorIds
+}
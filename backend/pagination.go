@@ -0,0 +1,80 @@
+package backend
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// pageStart is the position of the first page of a newest first listing,
+// later than anything could have happened.
+var pageStart = pageCursor{
+	Time: time.Date(9999, time.January, 1, 0, 0, 0, 0, time.UTC),
+}
+
+// pageCursor is the position of the last item returned in a page of a
+// listing ordered by time and then ID. Clients receive it as an opaque page
+// token.
+type pageCursor struct {
+	Time time.Time `json:"t"`
+	ID   string    `json:"id"`
+	// Query identifies the filters and order of the listing the cursor was
+	// issued for, as made by pageQuery, so that it can't be used with
+	// another.
+	Query string `json:"q,omitempty"`
+}
+
+// pageQuery identifies the filters and order of a request for a listing.
+// Every field that affects which items are listed, or their order, should be
+// passed.
+func pageQuery(fields ...string) string {
+	h := sha256.New()
+	for _, f := range fields {
+		h.Write([]byte(f))
+		h.Write([]byte{0})
+	}
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil)[:12])
+}
+
+func (c pageCursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodePageCursor decodes a page token, returning pageStart if it's empty.
+// query is the pageQuery of the request, which the token must have been
+// issued for. It's empty for listings that can't be filtered.
+func decodePageCursor(token, query string) (pageCursor, error) {
+	if token == "" {
+		return pageStart, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return pageCursor{}, fmt.Errorf("decoding page token: %w", err)
+	}
+	c := pageCursor{}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return pageCursor{}, fmt.Errorf("unmarshalling page token: %w", err)
+	}
+	if c.Query != query {
+		return pageCursor{}, fmt.Errorf(
+			"page token was issued for a request with different filters or order",
+		)
+	}
+	return c, nil
+}
+
+// clampPageSize applies the default and maximum to a requested page size.
+func clampPageSize(requested, defaultSize, maxSize int32) (int32, error) {
+	switch {
+	case requested < 0:
+		return 0, fmt.Errorf("page_size must not be negative")
+	case requested == 0:
+		return defaultSize, nil
+	case requested > maxSize:
+		return maxSize, nil
+	}
+	return requested, nil
+}
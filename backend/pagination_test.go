@@ -0,0 +1,91 @@
+package backend
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+)
+
+func TestDecodePageCursor(t *testing.T) {
+	cursor := pageCursor{
+		Time:  time.Date(2023, time.March, 8, 17, 30, 0, 123, time.UTC),
+		ID:    "2MXDd3ZpSmRC3R1BHZ9T3AkFwlD",
+		Query: pageQuery("newest", "spotify"),
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		query   string
+		want    pageCursor
+		wantErr bool
+	}{
+		{
+			name:  "empty token is the first page",
+			token: "",
+			query: cursor.Query,
+			want:  pageStart,
+		},
+		{
+			name:  "round trip",
+			token: cursor.encode(),
+			query: cursor.Query,
+			want:  cursor,
+		},
+		{
+			name:  "unfiltered listing",
+			token: pageCursor{Time: cursor.Time, ID: cursor.ID}.encode(),
+			want:  pageCursor{Time: cursor.Time, ID: cursor.ID},
+		},
+		{
+			name:    "different filters",
+			token:   cursor.encode(),
+			query:   pageQuery("newest", "manual"),
+			wantErr: true,
+		},
+		{
+			name:    "filters added",
+			token:   pageCursor{Time: cursor.Time, ID: cursor.ID}.encode(),
+			query:   cursor.Query,
+			wantErr: true,
+		},
+		{
+			name:    "not base64",
+			token:   "not a token!",
+			wantErr: true,
+		},
+		{
+			name:    "not json",
+			token:   base64.RawURLEncoding.EncodeToString([]byte("nope")),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodePageCursor(tt.token, tt.query)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !got.Time.Equal(tt.want.Time) || got.ID != tt.want.ID || got.Query != tt.want.Query {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPageQuery(t *testing.T) {
+	// Fields are separated, so moving a character from one to the next
+	// changes the query.
+	if pageQuery("ab", "c") == pageQuery("a", "bc") {
+		t.Errorf("expected queries with differently split fields to differ")
+	}
+	if pageQuery("a", "b") != pageQuery("a", "b") {
+		t.Errorf("expected queries with the same fields to match")
+	}
+}
@@ -0,0 +1,83 @@
+package backend
+
+import (
+	"time"
+
+	"github.com/mootslive/mono/backend/db"
+	mootslivepbv1 "github.com/mootslive/mono/proto/mootslive/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// spotifyAccountOverdueAfter is how long an account can go without a
+	// successful scan before it's considered overdue. The poller gets round
+	// every account far more often than this when it's healthy.
+	spotifyAccountOverdueAfter = time.Minute * 15
+	// pollerThroughputWindow is how far back the throughput of replicas is
+	// measured over.
+	pollerThroughputWindow = time.Minute * 15
+
+	defaultPollerStatusAccounts = 20
+	maxPollerStatusAccounts     = 200
+	// recentSpotifyScansCount is how many scans of an account are shown to
+	// admins looking at a user.
+	recentSpotifyScansCount = 10
+)
+
+var spotifyScanResults = map[string]mootslivepbv1.SpotifyScanResult{
+	spotifyScanSucceeded: mootslivepbv1.SpotifyScanResult_SPOTIFY_SCAN_RESULT_SUCCEEDED,
+	spotifyScanFailed:    mootslivepbv1.SpotifyScanResult_SPOTIFY_SCAN_RESULT_FAILED,
+}
+
+// spotifyAccountLag is how long it's been since an account was last scanned
+// successfully, or since it was linked if it never has been.
+func spotifyAccountLag(account db.SpotifyAccount, now time.Time) time.Duration {
+	since := account.CreatedAt
+	if account.LastSucceededAt.Valid {
+		since = account.LastSucceededAt.Time
+	}
+	return now.Sub(since)
+}
+
+func spotifyAccountHealthToProto(
+	account db.SpotifyAccount, polled bool, now time.Time,
+) *mootslivepbv1.SpotifyAccountHealth {
+	lag := spotifyAccountLag(account, now)
+	return &mootslivepbv1.SpotifyAccountHealth{
+		SpotifyUserId:   account.SpotifyUserID,
+		UserId:          account.UserID,
+		Polled:          polled,
+		LastListenedAt:  nullTimeToProto(account.LastListenedAt),
+		LastScannedAt:   nullTimeToProto(account.LastScannedAt),
+		LastSucceededAt: nullTimeToProto(account.LastSucceededAt),
+		FailureStreak:   account.FailureStreak,
+		LastError:       account.LastScanError,
+		Lag:             durationpb.New(lag),
+		Overdue:         polled && lag > spotifyAccountOverdueAfter,
+	}
+}
+
+func spotifyScanToProto(scan db.SpotifyScan) *mootslivepbv1.SpotifyScan {
+	return &mootslivepbv1.SpotifyScan{
+		Id:           scan.ID,
+		Replica:      scan.Replica,
+		StartedAt:    timestamppb.New(scan.StartedAt),
+		FinishedAt:   nullTimeToProto(scan.FinishedAt),
+		Result:       spotifyScanResults[scan.Result.String],
+		ListensFound: scan.ListensFound,
+		Error:        scan.Error,
+	}
+}
+
+func pollerReplicaThroughputToProto(
+	row db.ListPollerReplicaThroughputRow,
+) *mootslivepbv1.PollerReplicaThroughput {
+	return &mootslivepbv1.PollerReplicaThroughput{
+		Replica:        row.Replica,
+		Scans:          row.Scans,
+		FailedScans:    row.FailedScans,
+		ListensFound:   row.ListensFound,
+		ScansPerMinute: float64(row.Scans) / pollerThroughputWindow.Minutes(),
+	}
+}
@@ -0,0 +1,180 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/mootslive/mono/backend/db"
+)
+
+const (
+	// visibilityPublic listens can be seen by anyone.
+	visibilityPublic = "public"
+	// visibilityMoots listens can be seen by the user's moots.
+	visibilityMoots = "moots"
+	// visibilityPrivate listens can only be seen by the user themselves.
+	visibilityPrivate = "private"
+)
+
+// listenSources are the sources a listen can be recorded from.
+var listenSources = map[string]bool{
+	sourceSpotify: true,
+	sourceManual:  true,
+}
+
+// defaultPrivacySettings are the settings of a user who has never changed
+// them.
+var defaultPrivacySettings = db.PrivacySetting{
+	Visibility:    visibilityPublic,
+	HiddenSources: []string{},
+}
+
+func getPrivacySettings(
+	ctx context.Context, queries db.Querier, userID string,
+) (db.PrivacySetting, error) {
+	settings, err := queries.GetPrivacySettings(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		settings = defaultPrivacySettings
+		settings.UserID = userID
+		return settings, nil
+	}
+	return settings, err
+}
+
+// privacyPolicy decides which of a user's listens can be seen by a viewer.
+// Anything that shows a user's listens, or something derived from them, to
+// someone other than that user must check it first.
+type privacyPolicy struct {
+	// self is whether the viewer is the owner of the listens, who can see
+	// all of them.
+	self bool
+	// visible is whether the viewer can see any of the owner's listens.
+	visible bool
+	// blocked is whether either of the viewer and the owner has blocked the
+	// other, in which case they can't see anything of each other.
+	blocked bool
+
+	hiddenSources map[string]bool
+	incognito     []db.IncognitoWindow
+}
+
+func newPrivacyPolicy(
+	settings db.PrivacySetting, incognito []db.IncognitoWindow,
+) *privacyPolicy {
+	p := &privacyPolicy{
+		hiddenSources: make(map[string]bool, len(settings.HiddenSources)),
+		incognito:     incognito,
+	}
+	for _, source := range settings.HiddenSources {
+		p.hiddenSources[source] = true
+	}
+	return p
+}
+
+// canView returns whether the viewer can see any of the owner's listens.
+func (p *privacyPolicy) canView() bool {
+	return p.self || p.visible
+}
+
+// hides returns whether the owner has hidden a listen from others, whether
+// individually, by its source or with an incognito window.
+func (p *privacyPolicy) hides(listen db.Listen) bool {
+	if listen.Hidden || p.hiddenSources[listen.Source] {
+		return true
+	}
+	for _, window := range p.incognito {
+		if !listen.ListenedAt.Before(window.StartTime) &&
+			listen.ListenedAt.Before(window.EndTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// allows returns whether the viewer can see a listen.
+func (p *privacyPolicy) allows(listen db.Listen) bool {
+	if p.self {
+		return true
+	}
+	return p.visible && !p.hides(listen)
+}
+
+// loadPrivacyPolicies returns the policy governing what viewerID can see of
+// the listens of each of ownerIDs. viewerID may be empty for a viewer who
+// isn't signed in.
+func loadPrivacyPolicies(
+	ctx context.Context, queries db.Querier, viewerID string, ownerIDs []string,
+) (map[string]*privacyPolicy, error) {
+	ownerIDs = uniqueStrings(ownerIDs)
+
+	settings, err := queries.ListPrivacySettings(ctx, ownerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("fetching privacy settings: %w", err)
+	}
+	incognito, err := queries.ListIncognitoWindowsForUsers(ctx, ownerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("fetching incognito windows: %w", err)
+	}
+	moots := map[string]bool{}
+	blocked := map[string]bool{}
+	if viewerID != "" {
+		mootIDs, err := queries.ListMootIDsForUser(ctx, viewerID)
+		if err != nil {
+			return nil, fmt.Errorf("fetching moots: %w", err)
+		}
+		for _, id := range mootIDs {
+			moots[id] = true
+		}
+		blocked, err = listBlockedUserIDs(ctx, queries, viewerID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	settingsByOwner := make(map[string]db.PrivacySetting, len(settings))
+	for _, s := range settings {
+		settingsByOwner[s.UserID] = s
+	}
+	incognitoByOwner := map[string][]db.IncognitoWindow{}
+	for _, window := range incognito {
+		incognitoByOwner[window.UserID] = append(
+			incognitoByOwner[window.UserID], window,
+		)
+	}
+
+	policies := make(map[string]*privacyPolicy, len(ownerIDs))
+	for _, ownerID := range ownerIDs {
+		s, ok := settingsByOwner[ownerID]
+		if !ok {
+			s = defaultPrivacySettings
+		}
+		p := newPrivacyPolicy(s, incognitoByOwner[ownerID])
+		p.self = viewerID != "" && viewerID == ownerID
+		switch s.Visibility {
+		case visibilityPublic:
+			p.visible = true
+		case visibilityMoots:
+			p.visible = moots[ownerID]
+		}
+		if blocked[ownerID] {
+			p.blocked = true
+			p.visible = false
+		}
+		policies[ownerID] = p
+	}
+	return policies, nil
+}
+
+// loadPrivacyPolicy returns the policy governing what viewerID can see of the
+// listens of ownerID.
+func loadPrivacyPolicy(
+	ctx context.Context, queries db.Querier, viewerID, ownerID string,
+) (*privacyPolicy, error) {
+	policies, err := loadPrivacyPolicies(ctx, queries, viewerID, []string{ownerID})
+	if err != nil {
+		return nil, err
+	}
+	return policies[ownerID], nil
+}
@@ -0,0 +1,237 @@
+package backend
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/mootslive/mono/backend/db"
+	mootslivepbv1 "github.com/mootslive/mono/proto/mootslive/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxHandleLength      = 30
+	maxDisplayNameLength = 64
+	maxBioLength         = 160
+
+	// profileRecentListensCount is how many of a user's most recent listens
+	// are shown on their profile. Fewer are shown if some are hidden from
+	// the viewer.
+	profileRecentListensCount = 20
+	profileTopArtistsCount    = 10
+
+	// pgUniqueViolation is the Postgres error code for a unique constraint
+	// being violated.
+	pgUniqueViolation = "23505"
+)
+
+var handlePattern = regexp.MustCompile(`^[A-Za-z0-9_]{3,30}$`)
+
+// reservedHandles can't be used as handles, as they'd be confused with pages
+// of the site or with us. They're compared case insensitively.
+var reservedHandles = map[string]bool{
+	"about":         true,
+	"admin":         true,
+	"administrator": true,
+	"api":           true,
+	"app":           true,
+	"auth":          true,
+	"explore":       true,
+	"feed":          true,
+	"help":          true,
+	"home":          true,
+	"login":         true,
+	"logout":        true,
+	"me":            true,
+	"moots":         true,
+	"mootslive":     true,
+	"notifications": true,
+	"null":          true,
+	"privacy":       true,
+	"root":          true,
+	"search":        true,
+	"settings":      true,
+	"signup":        true,
+	"static":        true,
+	"support":       true,
+	"system":        true,
+	"terms":         true,
+	"undefined":     true,
+	"user":          true,
+	"users":         true,
+	"www":           true,
+}
+
+func validateHandle(handle string) error {
+	if !handlePattern.MatchString(handle) {
+		return fmt.Errorf(
+			"handle must be 3 to %d letters, numbers or underscores", maxHandleLength,
+		)
+	}
+	if reservedHandles[strings.ToLower(handle)] {
+		return fmt.Errorf("handle %q is reserved", handle)
+	}
+	return nil
+}
+
+// handleTaken returns whether a handle belongs to a user other than userID.
+func handleTaken(
+	ctx context.Context, queries db.Querier, handle, userID string,
+) (bool, error) {
+	user, err := queries.GetUserByHandle(ctx, handle)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("fetching user by handle: %w", err)
+	}
+	return user.ID != userID, nil
+}
+
+// defaultHandle picks a handle for a new user based on their username with
+// another provider. If the username is taken, a number is added to the end
+// of it. No handle is picked if the username isn't a valid handle, or if
+// every variation tried is taken.
+func defaultHandle(
+	ctx context.Context, queries db.Querier, username string,
+) (sql.NullString, error) {
+	if validateHandle(username) != nil {
+		return sql.NullString{}, nil
+	}
+	for n := 1; n < 10; n++ {
+		candidate := username
+		if n > 1 {
+			suffix := "_" + strconv.Itoa(n)
+			if len(candidate)+len(suffix) > maxHandleLength {
+				candidate = candidate[:maxHandleLength-len(suffix)]
+			}
+			candidate += suffix
+		}
+		taken, err := handleTaken(ctx, queries, candidate, "")
+		if err != nil {
+			return sql.NullString{}, err
+		}
+		if !taken {
+			return sql.NullString{Valid: true, String: candidate}, nil
+		}
+	}
+	return sql.NullString{}, nil
+}
+
+// normaliseProfileText trims a field of a user's profile and checks its
+// length.
+func normaliseProfileText(field, text string, maxLength int) (string, error) {
+	text = strings.TrimSpace(text)
+	if !utf8.ValidString(text) {
+		return "", fmt.Errorf("%s must be valid UTF-8", field)
+	}
+	if n := utf8.RuneCountInString(text); n > maxLength {
+		return "", fmt.Errorf(
+			"%s must be at most %d characters, got %d", field, maxLength, n,
+		)
+	}
+	return text, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation
+}
+
+// loadOwnProfile returns the profile of a user, as shown to themselves.
+func loadOwnProfile(
+	ctx context.Context, queries db.Querier, user db.User,
+) (*mootslivepbv1.Profile, error) {
+	twitterAccounts, err := queries.ListTwitterAccountsForUser(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching twitter accounts: %w", err)
+	}
+	spotifyAccounts, err := queries.ListSpotifyAccountsForUser(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching spotify accounts: %w", err)
+	}
+
+	profile := &mootslivepbv1.Profile{
+		DisplayName: user.DisplayName,
+		Handle:      user.Handle.String,
+		Bio:         user.Bio,
+		TimeZone:    user.TimeZone,
+		LinkedAccounts: make(
+			[]*mootslivepbv1.LinkedAccount,
+			0,
+			len(twitterAccounts)+len(spotifyAccounts),
+		),
+	}
+	// Users can link more than one Twitter account, in which case the one
+	// they signed up with is used for their profile.
+	if len(twitterAccounts) > 0 {
+		if profile.DisplayName == "" {
+			profile.DisplayName = twitterAccounts[0].Name
+		}
+		profile.AvatarUrl = twitterAccounts[0].ProfileImageUrl
+	}
+	for _, account := range twitterAccounts {
+		profile.LinkedAccounts = append(profile.LinkedAccounts, &mootslivepbv1.LinkedAccount{
+			Provider:       mootslivepbv1.Provider_PROVIDER_TWITTER,
+			ProviderUserId: account.TwitterUserID,
+			Username:       account.Username,
+			LinkedAt:       timestamppb.New(account.CreatedAt),
+		})
+	}
+	for _, account := range spotifyAccounts {
+		profile.LinkedAccounts = append(profile.LinkedAccounts, &mootslivepbv1.LinkedAccount{
+			Provider:       mootslivepbv1.Provider_PROVIDER_SPOTIFY,
+			ProviderUserId: account.SpotifyUserID,
+			LinkedAt:       timestamppb.New(account.CreatedAt),
+		})
+	}
+	return profile, nil
+}
+
+// listRecentListens returns the most recent of a user's listens that the
+// policy allows, along with their tracks.
+func listRecentListens(
+	ctx context.Context,
+	queries db.Querier,
+	policy *privacyPolicy,
+	userID string,
+) ([]*mootslivepbv1.RecentListen, error) {
+	listens, err := queries.ListRecentListensForUser(ctx, db.ListRecentListensForUserParams{
+		UserID: userID,
+		Limit:  profileRecentListensCount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching listens: %w", err)
+	}
+	allowed := make([]db.Listen, 0, len(listens))
+	isrcs := make([]string, 0, len(listens))
+	for _, listen := range listens {
+		if policy.allows(listen) {
+			allowed = append(allowed, listen)
+			isrcs = append(isrcs, listen.Isrc)
+		}
+	}
+	tracks, err := listTracks(ctx, queries, isrcs)
+	if err != nil {
+		return nil, err
+	}
+
+	recent := make([]*mootslivepbv1.RecentListen, 0, len(allowed))
+	for _, listen := range allowed {
+		r := &mootslivepbv1.RecentListen{
+			Listen: listenToProto(listen),
+		}
+		if track, ok := tracks[listen.Isrc]; ok {
+			r.Track = trackToProto(track)
+		}
+		recent = append(recent, r)
+	}
+	return recent, nil
+}
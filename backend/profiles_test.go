@@ -0,0 +1,37 @@
+package backend
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateHandle(t *testing.T) {
+	tests := []struct {
+		name    string
+		handle  string
+		wantErr bool
+	}{
+		{name: "letters", handle: "tunes"},
+		{name: "letters numbers and underscores", handle: "Moot_42"},
+		{name: "shortest", handle: "abc"},
+		{name: "longest", handle: strings.Repeat("a", maxHandleLength)},
+		{name: "empty", handle: "", wantErr: true},
+		{name: "too short", handle: "ab", wantErr: true},
+		{name: "too long", handle: strings.Repeat("a", maxHandleLength+1), wantErr: true},
+		{name: "hyphen", handle: "moot-42", wantErr: true},
+		{name: "space", handle: "moot 42", wantErr: true},
+		{name: "non ascii", handle: "mööts", wantErr: true},
+		{name: "reserved", handle: "users", wantErr: true},
+		{name: "reserved in another case", handle: "Users", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateHandle(tt.handle)
+			if tt.wantErr && err == nil {
+				t.Errorf("expected handle %q to be rejected", tt.handle)
+			} else if !tt.wantErr && err != nil {
+				t.Errorf("expected handle %q to be accepted, got %s", tt.handle, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package backend
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/jackc/pgx/v4"
+	"github.com/mootslive/mono/backend/db"
+	mootslivepbv1 "github.com/mootslive/mono/proto/mootslive/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxReactionsPerListen is how many different emoji a user can react to
+	// a single listen with.
+	maxReactionsPerListen = 5
+	// maxEmojiLength is the length in bytes of the longest emoji we accept,
+	// which leaves room for sequences such as flags and families.
+	maxEmojiLength = 32
+
+	// maxCommentLength is the number of characters allowed in a comment.
+	maxCommentLength = 500
+	// commentFlagThreshold is how many users must flag a comment before it
+	// is hidden from everyone other than its author.
+	commentFlagThreshold = 3
+
+	defaultCommentsPageSize = 50
+	maxCommentsPageSize     = 100
+)
+
+// validEmoji returns whether s looks like a single emoji, or a sequence of
+// them joined into one.
+func validEmoji(s string) bool {
+	if s == "" || len(s) > maxEmojiLength || !utf8.ValidString(s) {
+		return false
+	}
+	for _, r := range s {
+		switch {
+		case unicode.Is(unicode.So, r):
+		// Skin tone modifiers.
+		case r >= 0x1F3FB && r <= 0x1F3FF:
+		// Zero width joiners, variation selectors and keycaps.
+		case r == 0x200D, r == 0xFE0F, r == 0x20E3:
+		// Tags, used by subdivision flags.
+		case r >= 0xE0020 && r <= 0xE007F:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// getViewableListen returns a listen, if the viewer can see it. Listens the
+// viewer can't see are reported as not found, so as not to reveal that they
+// exist.
+func getViewableListen(
+	ctx context.Context, queries db.Querier, viewerID, listenID string,
+) (db.Listen, error) {
+	notFound := connect.NewError(
+		connect.CodeNotFound, fmt.Errorf("listen not found"),
+	)
+	listen, err := queries.GetListen(ctx, listenID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.Listen{}, notFound
+	} else if err != nil {
+		return db.Listen{}, fmt.Errorf("fetching listen: %w", err)
+	}
+	policy, err := loadPrivacyPolicy(ctx, queries, viewerID, listen.UserID)
+	if err != nil {
+		return db.Listen{}, err
+	}
+	if !policy.allows(listen) {
+		return db.Listen{}, notFound
+	}
+	return listen, nil
+}
+
+// listReactionCounts returns the reactions to each of the given listens,
+// most popular first, keyed by listen ID.
+func listReactionCounts(
+	ctx context.Context, queries db.Querier, viewerID string, listenIDs []string,
+) (map[string][]*mootslivepbv1.ReactionCount, error) {
+	rows, err := queries.ListReactionCounts(ctx, db.ListReactionCountsParams{
+		ViewerID:  viewerID,
+		ListenIds: uniqueStrings(listenIDs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching reactions: %w", err)
+	}
+	counts := map[string][]*mootslivepbv1.ReactionCount{}
+	for _, row := range rows {
+		counts[row.ListenID] = append(counts[row.ListenID], &mootslivepbv1.ReactionCount{
+			Emoji:   row.Emoji,
+			Count:   row.Count,
+			Reacted: row.Reacted,
+		})
+	}
+	return counts, nil
+}
+
+// attachReactions fills in the reactions to each of the given listens.
+func attachReactions(
+	ctx context.Context,
+	queries db.Querier,
+	viewerID string,
+	listens []*mootslivepbv1.Listen,
+) error {
+	ids := make([]string, 0, len(listens))
+	for _, listen := range listens {
+		ids = append(ids, listen.Id)
+	}
+	counts, err := listReactionCounts(ctx, queries, viewerID, ids)
+	if err != nil {
+		return err
+	}
+	for _, listen := range listens {
+		listen.Reactions = counts[listen.Id]
+	}
+	return nil
+}
+
+// normaliseComment trims the text of a comment and checks its length.
+func normaliseComment(text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", fmt.Errorf("text must be provided")
+	}
+	if !utf8.ValidString(text) {
+		return "", fmt.Errorf("text must be valid UTF-8")
+	}
+	if n := utf8.RuneCountInString(text); n > maxCommentLength {
+		return "", fmt.Errorf(
+			"text must be at most %d characters, got %d", maxCommentLength, n,
+		)
+	}
+	return text, nil
+}
+
+// encodeCommentsPageToken returns the token for the page of comments after
+// lastID. Comment IDs are KSUIDs, which sort by the time they were created,
+// so the ID alone is enough to find where the next page starts.
+func encodeCommentsPageToken(lastID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(lastID))
+}
+
+func decodeCommentsPageToken(token string) (string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", fmt.Errorf("decoding page token: %w", err)
+	}
+	return string(b), nil
+}
+
+func commentToProto(
+	comment db.Comment, author *mootslivepbv1.UserSummary,
+) *mootslivepbv1.Comment {
+	return &mootslivepbv1.Comment{
+		Id:        comment.ID,
+		ListenId:  comment.ListenID,
+		Author:    author,
+		Text:      comment.Text,
+		CreatedAt: timestamppb.New(comment.CreatedAt),
+		Hidden:    comment.FlagCount >= commentFlagThreshold,
+	}
+}
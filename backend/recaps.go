@@ -0,0 +1,359 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/mootslive/mono/backend/db"
+	"github.com/mootslive/mono/backend/trace"
+	mootslivepbv1 "github.com/mootslive/mono/proto/mootslive/v1"
+	"golang.org/x/exp/slog"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// yearlyRecapFormatVersion should be incremented whenever what goes into
+	// a recap changes.
+	yearlyRecapFormatVersion = 1
+	yearlyRecapTopCount      = 10
+	// yearlyRecapBatchSize is how many recaps are generated in each pass.
+	yearlyRecapBatchSize = 100
+	// maxTimeZoneOffset is the furthest any time zone is from UTC.
+	maxTimeZoneOffset = time.Hour * 14
+)
+
+// recapYear returns the most recent year that has ended in every time zone.
+func recapYear(now time.Time) int32 {
+	// The year ends last twelve hours behind UTC.
+	return int32(now.UTC().Add(-time.Hour*12).Year() - 1)
+}
+
+// yearBounds returns the start of a year in loc, and the start of the next.
+func yearBounds(year int32, loc *time.Location) (time.Time, time.Time) {
+	start := time.Date(int(year), time.January, 1, 0, 0, 0, 0, loc)
+	return start, start.AddDate(1, 0, 0)
+}
+
+// topKeys returns the n keys with the highest counts, highest first.
+func topKeys(counts map[string]int64, n int) []string {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	if len(keys) > n {
+		keys = keys[:n]
+	}
+	return keys
+}
+
+func trackOrISRCToProto(
+	isrc string, tracks map[string]db.Track,
+) *mootslivepbv1.Track {
+	if track, ok := tracks[isrc]; ok {
+		return trackToProto(track)
+	}
+	return &mootslivepbv1.Track{Isrc: isrc}
+}
+
+// buildYearlyRecap summarises a user's listens over a year, which must be
+// ordered oldest first. The comparison with their moots is left to the
+// caller.
+func buildYearlyRecap(
+	year int32,
+	loc *time.Location,
+	listens []db.Listen,
+	tracks map[string]db.Track,
+) *mootslivepbv1.YearlyRecap {
+	recap := &mootslivepbv1.YearlyRecap{
+		Year:     year,
+		TimeZone: loc.String(),
+		Plays:    int64(len(listens)),
+	}
+	if len(listens) == 0 {
+		return recap
+	}
+
+	trackPlays := map[string]int64{}
+	monthPlays := map[time.Month]int64{}
+	totals := db.GetListeningTotalsRow{Plays: int64(len(listens))}
+	var looped *mootslivepbv1.LoopedTrack
+	var run int32
+	for i, listen := range listens {
+		trackPlays[listen.Isrc]++
+		monthPlays[listen.ListenedAt.In(loc).Month()]++
+		if track, ok := tracks[listen.Isrc]; ok {
+			totals.PlaysWithDuration++
+			totals.DurationMs += int64(track.DurationMs)
+		}
+
+		if i > 0 && listens[i-1].Isrc == listen.Isrc {
+			run++
+		} else {
+			run = 1
+		}
+		// A track has to be played at least twice in a row to have been
+		// looped.
+		if run > 1 && (looped == nil || run > looped.Plays) {
+			looped = &mootslivepbv1.LoopedTrack{
+				Track:     trackOrISRCToProto(listen.Isrc, tracks),
+				Plays:     run,
+				StartedAt: timestamppb.New(listens[i-int(run)+1].ListenedAt),
+			}
+		}
+	}
+	recap.MostLooped = looped
+	recap.EstimatedListeningMinutes = estimateListeningMs(totals) / time.Minute.Milliseconds()
+
+	for _, isrc := range topKeys(trackPlays, yearlyRecapTopCount) {
+		recap.TopTracks = append(recap.TopTracks, &mootslivepbv1.TopTrack{
+			Track: trackOrISRCToProto(isrc, tracks),
+			Plays: trackPlays[isrc],
+		})
+	}
+	plays := artistPlays(trackPlays, tracks)
+	names := artistNames(tracks)
+	for _, id := range topKeys(plays, yearlyRecapTopCount) {
+		recap.TopArtists = append(recap.TopArtists, &mootslivepbv1.TopArtist{
+			Artist: &mootslivepbv1.Artist{
+				Id:   id,
+				Name: names[id],
+			},
+			Plays: plays[id],
+		})
+	}
+
+	for month := time.January; month <= time.December; month++ {
+		if monthPlays[month] > recap.TopMonthPlays {
+			recap.TopMonth = int32(month)
+			recap.TopMonthPlays = monthPlays[month]
+		}
+	}
+
+	first := listens[0]
+	recap.FirstListen = &mootslivepbv1.RecentListen{
+		Listen: listenToProto(first),
+	}
+	if track, ok := tracks[first.Isrc]; ok {
+		recap.FirstListen.Track = trackToProto(track)
+	}
+	return recap
+}
+
+// compareWithMoots compares a user's year with those of the moots whose
+// listens they can see. It's based on rollups, which are split into UTC days,
+// so is approximate. Only the listens of moots that the user can see are
+// counted, whereas all of the user's own are.
+func compareWithMoots(
+	ctx context.Context,
+	queries db.Querier,
+	userID string,
+	start, end time.Time,
+	topArtistID string,
+) (*mootslivepbv1.MootsComparison, error) {
+	mootIDs, err := queries.ListMootIDsForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching moots: %w", err)
+	}
+	policies, err := loadPrivacyPolicies(ctx, queries, userID, mootIDs)
+	if err != nil {
+		return nil, err
+	}
+	visibleIDs := make([]string, 0, len(mootIDs))
+	for _, id := range mootIDs {
+		if policies[id].canView() {
+			visibleIDs = append(visibleIDs, id)
+		}
+	}
+	if len(visibleIDs) == 0 {
+		return nil, nil
+	}
+
+	startDay, endDay := rollupDay(start), rollupDay(end)
+	rows, err := queries.ListPlaysForUsers(ctx, db.ListPlaysForUsersParams{
+		OwnerID:  userID,
+		UserIds:  append([]string{userID}, visibleIDs...),
+		StartDay: startDay,
+		EndDay:   endDay,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching plays: %w", err)
+	}
+	plays := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		plays[row.UserID] = row.Plays
+	}
+
+	c := &mootslivepbv1.MootsComparison{
+		MootsCompared: int32(len(visibleIDs)),
+		Rank:          1,
+	}
+	var fewer int
+	for _, id := range visibleIDs {
+		switch {
+		case plays[id] > plays[userID]:
+			c.Rank++
+		case plays[id] < plays[userID]:
+			fewer++
+		}
+
+		if topArtistID == "" {
+			continue
+		}
+		top, err := listTopArtists(ctx, queries, topsQuery{
+			userID:   id,
+			startDay: startDay,
+			endDay:   endDay,
+			limit:    yearlyRecapTopCount,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, artist := range top {
+			if artist.Artist.Id == topArtistID {
+				c.TopArtistMoots++
+				break
+			}
+		}
+	}
+	c.Percentile = float64(fewer) * 100 / float64(len(visibleIDs))
+	return c, nil
+}
+
+// generateYearlyRecap generates a new version of a user's recap of a year.
+func generateYearlyRecap(
+	ctx context.Context, queries db.Querier, userID string, year int32,
+) (db.YearlyRecap, *mootslivepbv1.YearlyRecap, error) {
+	ctx, span := trace.Start(ctx, "backend/generateYearlyRecap")
+	defer span.End()
+
+	user, err := queries.GetUser(ctx, userID)
+	if err != nil {
+		return db.YearlyRecap{}, nil, fmt.Errorf("fetching user: %w", err)
+	}
+	loc := userTimeZone(user)
+	start, end := yearBounds(year, loc)
+
+	listens, err := queries.ListListensForUserBetween(ctx, db.ListListensForUserBetweenParams{
+		UserID:    userID,
+		StartTime: start,
+		EndTime:   end,
+	})
+	if err != nil {
+		return db.YearlyRecap{}, nil, fmt.Errorf("fetching listens: %w", err)
+	}
+	isrcs := make([]string, 0, len(listens))
+	for _, listen := range listens {
+		isrcs = append(isrcs, listen.Isrc)
+	}
+	tracks, err := listTracks(ctx, queries, isrcs)
+	if err != nil {
+		return db.YearlyRecap{}, nil, err
+	}
+
+	recap := buildYearlyRecap(year, loc, listens, tracks)
+	topArtistID := ""
+	if len(recap.TopArtists) > 0 {
+		topArtistID = recap.TopArtists[0].Artist.Id
+	}
+	recap.Moots, err = compareWithMoots(ctx, queries, userID, start, end, topArtistID)
+	if err != nil {
+		return db.YearlyRecap{}, nil, err
+	}
+
+	data, err := proto.Marshal(recap)
+	if err != nil {
+		return db.YearlyRecap{}, nil, fmt.Errorf("marshalling recap: %w", err)
+	}
+	stored, err := queries.CreateYearlyRecap(ctx, db.CreateYearlyRecapParams{
+		UserID:        userID,
+		Year:          year,
+		FormatVersion: yearlyRecapFormatVersion,
+		TimeZone:      loc.String(),
+		Data:          data,
+		GeneratedAt:   time.Now(),
+	})
+	if err != nil {
+		return db.YearlyRecap{}, nil, fmt.Errorf("storing recap: %w", err)
+	}
+	return stored, recap, nil
+}
+
+// YearlyRecapGenerator generates the recap of the previous year for each user
+// who listened to anything in it, once the year is over everywhere.
+type YearlyRecapGenerator struct {
+	queries db.TXQuerier
+	log     *slog.Logger
+}
+
+func NewYearlyRecapGenerator(
+	log *slog.Logger, queries db.TXQuerier,
+) *YearlyRecapGenerator {
+	return &YearlyRecapGenerator{
+		log:     log,
+		queries: queries,
+	}
+}
+
+func (yg *YearlyRecapGenerator) Run(ctx context.Context) error {
+	yg.log.Info("starting yearly recap generator")
+
+	// Each pass pages through the users due a recap by ID, so that users whose
+	// recaps keep failing are skipped over rather than filling every batch.
+	// They're retried on the next pass.
+	var passYear int32
+	afterID := ""
+	for {
+		year := recapYear(time.Now())
+		if year != passYear {
+			passYear = year
+			afterID = ""
+		}
+		start, end := yearBounds(year, time.UTC)
+		userIDs, err := yg.queries.ListUsersDueYearlyRecap(ctx, db.ListUsersDueYearlyRecapParams{
+			ListenedSince:  start.Add(-maxTimeZoneOffset),
+			ListenedBefore: end.Add(maxTimeZoneOffset),
+			Year:           year,
+			AfterID:        afterID,
+			RowLimit:       yearlyRecapBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("fetching users due recap: %w", err)
+		}
+
+		for _, userID := range userIDs {
+			// A failure for one user shouldn't hold up everyone else's
+			// recap, they'll be retried on the next pass.
+			if _, _, err := generateYearlyRecap(ctx, yg.queries, userID, year); err != nil {
+				yg.log.Error("failed to generate yearly recap", err,
+					slog.String("user_id", userID),
+					slog.Int("year", int(year)),
+				)
+			}
+		}
+
+		// Carry on with the next batch soon if there may be more to do,
+		// otherwise start the next pass from the beginning later on.
+		wait := time.Hour
+		afterID = ""
+		if len(userIDs) == yearlyRecapBatchSize {
+			wait = time.Minute
+			afterID = userIDs[len(userIDs)-1]
+		}
+		select {
+		case <-time.After(wait):
+			continue
+		case <-ctx.Done():
+			yg.log.Info("context cancelled, stopping yearly recap generator")
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,318 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mootslive/mono/backend/db"
+	"github.com/mootslive/mono/backend/trace"
+	mootslivepbv1 "github.com/mootslive/mono/proto/mootslive/v1"
+)
+
+// rollupDay returns the UTC day that a listen counts towards in rollups.
+func rollupDay(listenedAt time.Time) time.Time {
+	return listenedAt.UTC().Truncate(time.Hour * 24)
+}
+
+// lockRollups takes the rollups lock of a user, so that their listens and
+// rollups can't be changed from under the caller. The rollups of every user
+// are shared with upsertTrack, which moves plays between artists and albums
+// when a track changes.
+func lockRollups(ctx context.Context, queries db.Querier, userID string) error {
+	if err := queries.LockAllRollupsShared(ctx); err != nil {
+		return fmt.Errorf("locking rollups: %w", err)
+	}
+	if err := queries.LockRollupsForUser(ctx, userID); err != nil {
+		return fmt.Errorf("locking rollups: %w", err)
+	}
+	return nil
+}
+
+// recordListen creates a listen and counts it in the rollups. It should be
+// called within a transaction holding the rollups lock of the user, so the
+// two can't disagree.
+func recordListen(
+	ctx context.Context,
+	queries db.Querier,
+	policy *privacyPolicy,
+	listen db.CreateListenParams,
+) error {
+	if err := queries.CreateListen(ctx, listen); err != nil {
+		return fmt.Errorf("creating listen: %w", err)
+	}
+	return countListen(ctx, queries, policy, db.Listen{
+		ID:         listen.ID,
+		UserID:     listen.UserID,
+		CreatedAt:  listen.CreatedAt,
+		ListenedAt: listen.ListenedAt,
+		Isrc:       listen.Isrc,
+		Source:     listen.Source,
+	})
+}
+
+// publicPlays is how many of the public plays in the rollups a listen
+// accounts for, given the owner's privacy policy.
+func publicPlays(policy *privacyPolicy, listen db.Listen) int32 {
+	if policy.hides(listen) {
+		return 0
+	}
+	return 1
+}
+
+// countListen counts a listen in the rollups. Every listen is counted in the
+// plays the owner sees, but only those the owner hasn't hidden are counted in
+// the public plays others see.
+func countListen(
+	ctx context.Context,
+	queries db.Querier,
+	policy *privacyPolicy,
+	listen db.Listen,
+) error {
+	return addListenPlays(ctx, queries, listen, 1, publicPlays(policy, listen))
+}
+
+func uncountListen(
+	ctx context.Context,
+	queries db.Querier,
+	policy *privacyPolicy,
+	listen db.Listen,
+) error {
+	return addListenPlays(ctx, queries, listen, -1, -publicPlays(policy, listen))
+}
+
+// addListenPlays adds to the plays of the track, artists and album of a
+// listen on the day it was listened to. Listens of tracks we hold no metadata
+// for only count towards the track, and are added to its artists and album by
+// upsertTrack once the metadata arrives.
+func addListenPlays(
+	ctx context.Context,
+	queries db.Querier,
+	listen db.Listen,
+	plays, publicPlays int32,
+) error {
+	day := rollupDay(listen.ListenedAt)
+	err := queries.AddDailyTrackPlays(ctx, db.AddDailyTrackPlaysParams{
+		UserID:      listen.UserID,
+		Day:         day,
+		Isrc:        listen.Isrc,
+		Plays:       plays,
+		PublicPlays: publicPlays,
+	})
+	if err != nil {
+		return fmt.Errorf("updating daily track plays: %w", err)
+	}
+	err = queries.AddDailyArtistPlays(ctx, db.AddDailyArtistPlaysParams{
+		UserID:      listen.UserID,
+		Day:         day,
+		Plays:       plays,
+		PublicPlays: publicPlays,
+		Isrc:        listen.Isrc,
+	})
+	if err != nil {
+		return fmt.Errorf("updating daily artist plays: %w", err)
+	}
+	err = queries.AddDailyAlbumPlays(ctx, db.AddDailyAlbumPlaysParams{
+		UserID:      listen.UserID,
+		Day:         day,
+		Plays:       plays,
+		PublicPlays: publicPlays,
+		Isrc:        listen.Isrc,
+	})
+	if err != nil {
+		return fmt.Errorf("updating daily album plays: %w", err)
+	}
+	if publicPlays != 0 {
+		err := addCompatibilityPlays(ctx, queries, listen.UserID, listen.Isrc, day, publicPlays)
+		if err != nil {
+			return err
+		}
+	}
+	if plays >= 0 {
+		return nil
+	}
+
+	err = queries.DeleteEmptyDailyTrackPlays(ctx, db.DeleteEmptyDailyTrackPlaysParams{
+		UserID: listen.UserID,
+		Day:    day,
+		Isrc:   listen.Isrc,
+	})
+	if err != nil {
+		return fmt.Errorf("deleting empty daily track plays: %w", err)
+	}
+	err = queries.DeleteEmptyDailyArtistPlays(ctx, db.DeleteEmptyDailyArtistPlaysParams{
+		UserID: listen.UserID,
+		Day:    day,
+	})
+	if err != nil {
+		return fmt.Errorf("deleting empty daily artist plays: %w", err)
+	}
+	err = queries.DeleteEmptyDailyAlbumPlays(ctx, db.DeleteEmptyDailyAlbumPlaysParams{
+		UserID: listen.UserID,
+		Day:    day,
+	})
+	if err != nil {
+		return fmt.Errorf("deleting empty daily album plays: %w", err)
+	}
+	return nil
+}
+
+// addCompatibilityPlays adjusts the counts of a user's compatibility scores
+// for public plays of a track on a day having just been added to their
+// rollups. Scores that are stale are left alone, as they'll be recomputed
+// anyway.
+func addCompatibilityPlays(
+	ctx context.Context,
+	queries db.Querier,
+	userID string,
+	isrc string,
+	day time.Time,
+	delta int32,
+) error {
+	// The other user of a score may be counting a play of the same track at
+	// the same time, so the scores are locked first, in a statement of its
+	// own, so that the plays read by the statements adjusting them include
+	// anything counted by whoever held them before us.
+	if err := queries.LockCompatibilityScoresForUser(ctx, userID); err != nil {
+		return fmt.Errorf("locking compatibility scores: %w", err)
+	}
+	err := queries.AddTrackPlaysToCompatibilityScores(ctx, db.AddTrackPlaysToCompatibilityScoresParams{
+		Delta:  delta,
+		UserID: userID,
+		Isrc:   isrc,
+		Day:    day,
+	})
+	if err != nil {
+		return fmt.Errorf("updating compatibility track plays: %w", err)
+	}
+	err = queries.AddArtistPlaysToCompatibilityScores(ctx, db.AddArtistPlaysToCompatibilityScoresParams{
+		Delta:  delta,
+		Isrc:   isrc,
+		UserID: userID,
+		Day:    day,
+	})
+	if err != nil {
+		return fmt.Errorf("updating compatibility artist plays: %w", err)
+	}
+	return nil
+}
+
+// rebuildRollups recounts the rollups of a user for the days covering
+// listens from start up to end, for when a change to their privacy settings
+// affects which of those listens are public. It should be called within a
+// transaction.
+func rebuildRollups(
+	ctx context.Context,
+	queries db.Querier,
+	userID string,
+	start, end time.Time,
+) error {
+	ctx, span := trace.Start(ctx, "backend/rebuildRollups")
+	defer span.End()
+
+	if err := lockRollups(ctx, queries, userID); err != nil {
+		return err
+	}
+	// Whole days are recounted, as that's what the rollups are split into.
+	startDay := rollupDay(start)
+	endDay := rollupDay(end)
+	if endDay.Before(end) {
+		endDay = endDay.AddDate(0, 0, 1)
+	}
+	err := queries.DeleteDailyTrackPlaysBetween(ctx, db.DeleteDailyTrackPlaysBetweenParams{
+		UserID:   userID,
+		StartDay: startDay,
+		EndDay:   endDay,
+	})
+	if err != nil {
+		return fmt.Errorf("deleting daily track plays: %w", err)
+	}
+	err = queries.InsertDailyTrackPlaysBetween(ctx, db.InsertDailyTrackPlaysBetweenParams{
+		UserID:   userID,
+		StartDay: startDay,
+		EndDay:   endDay,
+	})
+	if err != nil {
+		return fmt.Errorf("inserting daily track plays: %w", err)
+	}
+	// The artist and album rollups are summed from the rebuilt track
+	// rollups, rather than from the listens again.
+	if err := rebuildArtistAndAlbumRollups(ctx, queries, userID, startDay, endDay); err != nil {
+		return err
+	}
+	if err := queries.MarkCompatibilityScoresStale(ctx, userID); err != nil {
+		return fmt.Errorf("marking compatibility stale: %w", err)
+	}
+	return nil
+}
+
+// rebuildArtistAndAlbumRollups sums the artist and album rollups of a user
+// for the days from startDay up to endDay from their track rollups. It should
+// be called within a transaction holding the rollups lock of the user.
+func rebuildArtistAndAlbumRollups(
+	ctx context.Context,
+	queries db.Querier,
+	userID string,
+	startDay, endDay time.Time,
+) error {
+	err := queries.DeleteDailyArtistPlaysBetween(ctx, db.DeleteDailyArtistPlaysBetweenParams{
+		UserID:   userID,
+		StartDay: startDay,
+		EndDay:   endDay,
+	})
+	if err != nil {
+		return fmt.Errorf("deleting daily artist plays: %w", err)
+	}
+	err = queries.InsertDailyArtistPlaysBetween(ctx, db.InsertDailyArtistPlaysBetweenParams{
+		UserID:   userID,
+		StartDay: startDay,
+		EndDay:   endDay,
+	})
+	if err != nil {
+		return fmt.Errorf("inserting daily artist plays: %w", err)
+	}
+	err = queries.DeleteDailyAlbumPlaysBetween(ctx, db.DeleteDailyAlbumPlaysBetweenParams{
+		UserID:   userID,
+		StartDay: startDay,
+		EndDay:   endDay,
+	})
+	if err != nil {
+		return fmt.Errorf("deleting daily album plays: %w", err)
+	}
+	err = queries.InsertDailyAlbumPlaysBetween(ctx, db.InsertDailyAlbumPlaysBetweenParams{
+		UserID:   userID,
+		StartDay: startDay,
+		EndDay:   endDay,
+	})
+	if err != nil {
+		return fmt.Errorf("inserting daily album plays: %w", err)
+	}
+	return nil
+}
+
+// rebuildAllRollups recounts every rollup of a user, for changes that can
+// affect listens from any time.
+func rebuildAllRollups(ctx context.Context, queries db.Querier, userID string) error {
+	return rebuildRollups(ctx, queries, userID, time.Time{}, pageStart.Time)
+}
+
+// timeWindowStart returns the first day included in a window ending on
+// today, along with a key identifying the window.
+func timeWindowStart(
+	window mootslivepbv1.TimeWindow, today time.Time,
+) (time.Time, string, error) {
+	switch window {
+	case mootslivepbv1.TimeWindow_TIME_WINDOW_LAST_7_DAYS:
+		return today.AddDate(0, 0, -6), "7d", nil
+	case mootslivepbv1.TimeWindow_TIME_WINDOW_LAST_30_DAYS:
+		return today.AddDate(0, 0, -29), "30d", nil
+	case mootslivepbv1.TimeWindow_TIME_WINDOW_LAST_90_DAYS:
+		return today.AddDate(0, 0, -89), "90d", nil
+	case mootslivepbv1.TimeWindow_TIME_WINDOW_LAST_365_DAYS:
+		return today.AddDate(0, 0, -364), "365d", nil
+	case mootslivepbv1.TimeWindow_TIME_WINDOW_ALL_TIME:
+		return time.Time{}, "all", nil
+	default:
+		return time.Time{}, "", fmt.Errorf("unsupported time window %s", window)
+	}
+}
@@ -0,0 +1,77 @@
+package backend
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mootslive/mono/backend/db"
+	mootslivepbv1 "github.com/mootslive/mono/proto/mootslive/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// loadTimeZone loads a time zone by its IANA name. The server's own time
+// zone, "Local", isn't accepted as it means nothing to users.
+func loadTimeZone(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("time zone %q is not supported", name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("loading time zone: %w", err)
+	}
+	return loc, nil
+}
+
+// userTimeZone returns the time zone of a user, falling back to UTC if it
+// can no longer be loaded.
+func userTimeZone(user db.User) *time.Location {
+	loc, err := loadTimeZone(user.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func timeRangeToProto(startDay, endDay time.Time) *mootslivepbv1.TimeRange {
+	r := &mootslivepbv1.TimeRange{
+		EndTime: timestamppb.New(endDay),
+	}
+	if !startDay.IsZero() {
+		r.StartTime = timestamppb.New(startDay)
+	}
+	return r
+}
+
+// listeningStreaks returns the current and longest runs of consecutive days
+// in days, which must be distinct dates in ascending order. A run counts as
+// current if it includes today or yesterday, as today may not be over yet.
+func listeningStreaks(days []time.Time, today time.Time) (int32, int32) {
+	var run, longest int32
+	var last time.Time
+	for _, day := range days {
+		if !last.IsZero() && day.Equal(last.AddDate(0, 0, 1)) {
+			run++
+		} else {
+			run = 1
+		}
+		if run > longest {
+			longest = run
+		}
+		last = day
+	}
+	if last.IsZero() || last.Before(today.AddDate(0, 0, -1)) {
+		return 0, longest
+	}
+	return run, longest
+}
+
+// estimateListeningMs estimates the total time spent listening from the
+// durations of the tracks we hold metadata for, assuming plays of other tracks
+// are of average length.
+func estimateListeningMs(totals db.GetListeningTotalsRow) int64 {
+	if totals.PlaysWithDuration == 0 {
+		return 0
+	}
+	unknown := totals.Plays - totals.PlaysWithDuration
+	return totals.DurationMs + unknown*(totals.DurationMs/totals.PlaysWithDuration)
+}
@@ -0,0 +1,84 @@
+package backend
+
+import (
+	"testing"
+	"time"
+)
+
+func TestListeningStreaks(t *testing.T) {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2023, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	today := date(time.March, 10)
+
+	tests := []struct {
+		name        string
+		days        []time.Time
+		wantCurrent int32
+		wantLongest int32
+	}{
+		{
+			name: "no listening",
+		},
+		{
+			name:        "only today",
+			days:        []time.Time{today},
+			wantCurrent: 1,
+			wantLongest: 1,
+		},
+		{
+			name: "run ending today",
+			days: []time.Time{
+				date(time.March, 8), date(time.March, 9), today,
+			},
+			wantCurrent: 3,
+			wantLongest: 3,
+		},
+		{
+			name: "run ending yesterday is current",
+			days: []time.Time{
+				date(time.March, 7), date(time.March, 8), date(time.March, 9),
+			},
+			wantCurrent: 3,
+			wantLongest: 3,
+		},
+		{
+			name: "run ending the day before yesterday is over",
+			days: []time.Time{
+				date(time.March, 7), date(time.March, 8),
+			},
+			wantCurrent: 0,
+			wantLongest: 2,
+		},
+		{
+			name: "gap breaks the run",
+			days: []time.Time{
+				date(time.March, 1), date(time.March, 2), date(time.March, 3),
+				date(time.March, 5), date(time.March, 6),
+				date(time.March, 9), today,
+			},
+			wantCurrent: 2,
+			wantLongest: 3,
+		},
+		{
+			name: "run across the end of a month",
+			days: []time.Time{
+				date(time.February, 27), date(time.February, 28),
+				date(time.March, 1), date(time.March, 2),
+			},
+			wantCurrent: 0,
+			wantLongest: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, longest := listeningStreaks(tt.days, today)
+			if current != tt.wantCurrent {
+				t.Errorf("expected current streak %d, got %d", tt.wantCurrent, current)
+			}
+			if longest != tt.wantLongest {
+				t.Errorf("expected longest streak %d, got %d", tt.wantLongest, longest)
+			}
+		})
+	}
+}
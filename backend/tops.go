@@ -0,0 +1,224 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/jackc/pgx/v4"
+	"github.com/mootslive/mono/backend/db"
+	mootslivepbv1 "github.com/mootslive/mono/proto/mootslive/v1"
+)
+
+const (
+	defaultTopLimit = 10
+	maxTopLimit     = 100
+)
+
+// topsQuery is what a request for a user's top tracks, artists or albums asks
+// for, once validated.
+type topsQuery struct {
+	userID string
+	// startDay and endDay are the range of days to count plays over. endDay
+	// is excluded.
+	startDay time.Time
+	endDay   time.Time
+	limit    int32
+	// includeHidden is whether listens the user has hidden from others are
+	// counted, which they are when the user is looking at their own.
+	includeHidden bool
+}
+
+// localDay returns the start of the day of t in loc.
+func localDay(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
+
+// timeRangeDays returns the days in loc covered by a window ending today, or
+// by a custom range if the window is TIME_WINDOW_CUSTOM.
+func timeRangeDays(
+	window mootslivepbv1.TimeWindow,
+	custom *mootslivepbv1.TimeRange,
+	now time.Time,
+	loc *time.Location,
+) (time.Time, time.Time, error) {
+	if window != mootslivepbv1.TimeWindow_TIME_WINDOW_CUSTOM {
+		today := localDay(now, loc)
+		startDay, _, err := timeWindowStart(window, today)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		return startDay, today.AddDate(0, 0, 1), nil
+	}
+
+	if custom == nil || custom.StartTime == nil || custom.EndTime == nil {
+		return time.Time{}, time.Time{}, fmt.Errorf(
+			"custom_range must have a start_time and end_time",
+		)
+	}
+	startDay := localDay(custom.StartTime.AsTime(), loc)
+	endDay := localDay(custom.EndTime.AsTime(), loc)
+	if !startDay.Before(endDay) {
+		return time.Time{}, time.Time{}, fmt.Errorf(
+			"custom_range must cover at least one whole day",
+		)
+	}
+	return startDay, endDay, nil
+}
+
+// newTopsQuery validates a request for a user's top tracks, artists or
+// albums, checking that the viewer can see the user's listens.
+func newTopsQuery(
+	ctx context.Context,
+	queries db.Querier,
+	viewerID, userID string,
+	window mootslivepbv1.TimeWindow,
+	custom *mootslivepbv1.TimeRange,
+	limit int32,
+) (topsQuery, error) {
+	if userID == "" {
+		userID = viewerID
+	}
+	if window == mootslivepbv1.TimeWindow_TIME_WINDOW_UNSPECIFIED {
+		window = mootslivepbv1.TimeWindow_TIME_WINDOW_LAST_30_DAYS
+	}
+	startDay, endDay, err := timeRangeDays(window, custom, time.Now(), time.UTC)
+	if err != nil {
+		return topsQuery{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	switch {
+	case limit < 0:
+		return topsQuery{}, connect.NewError(
+			connect.CodeInvalidArgument, fmt.Errorf("limit must not be negative"),
+		)
+	case limit == 0:
+		limit = defaultTopLimit
+	case limit > maxTopLimit:
+		limit = maxTopLimit
+	}
+
+	user, err := queries.GetUser(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) || user.DeletionRequestedAt.Valid {
+		return topsQuery{}, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("user not found"),
+		)
+	} else if err != nil {
+		return topsQuery{}, fmt.Errorf("fetching user: %w", err)
+	}
+	policy, err := loadPrivacyPolicy(ctx, queries, viewerID, user.ID)
+	if err != nil {
+		return topsQuery{}, err
+	}
+	if policy.blocked {
+		return topsQuery{}, connect.NewError(
+			connect.CodeNotFound, fmt.Errorf("user not found"),
+		)
+	}
+	if !policy.canView() {
+		return topsQuery{}, connect.NewError(
+			connect.CodePermissionDenied,
+			fmt.Errorf("user's listens are not visible to the caller"),
+		)
+	}
+
+	return topsQuery{
+		userID:        userID,
+		startDay:      startDay,
+		endDay:        endDay,
+		limit:         limit,
+		includeHidden: policy.self,
+	}, nil
+}
+
+func listTopTracks(
+	ctx context.Context, queries db.Querier, q topsQuery,
+) ([]*mootslivepbv1.TopTrack, error) {
+	rows, err := queries.ListTopTracks(ctx, db.ListTopTracksParams{
+		IncludeHidden: q.includeHidden,
+		UserID:        q.userID,
+		StartDay:      q.startDay,
+		EndDay:        q.endDay,
+		RowLimit:      q.limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching top tracks: %w", err)
+	}
+	isrcs := make([]string, 0, len(rows))
+	for _, row := range rows {
+		isrcs = append(isrcs, row.Isrc)
+	}
+	tracks, err := listTracks(ctx, queries, isrcs)
+	if err != nil {
+		return nil, err
+	}
+
+	top := make([]*mootslivepbv1.TopTrack, 0, len(rows))
+	for _, row := range rows {
+		t := &mootslivepbv1.TopTrack{
+			Track: &mootslivepbv1.Track{Isrc: row.Isrc},
+			Plays: row.Plays,
+		}
+		if track, ok := tracks[row.Isrc]; ok {
+			t.Track = trackToProto(track)
+		}
+		top = append(top, t)
+	}
+	return top, nil
+}
+
+// listTopArtists returns the artists a user played most over a range of days.
+func listTopArtists(
+	ctx context.Context, queries db.Querier, q topsQuery,
+) ([]*mootslivepbv1.TopArtist, error) {
+	rows, err := queries.ListTopArtists(ctx, db.ListTopArtistsParams{
+		IncludeHidden: q.includeHidden,
+		UserID:        q.userID,
+		StartDay:      q.startDay,
+		EndDay:        q.endDay,
+		RowLimit:      q.limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching top artists: %w", err)
+	}
+	top := make([]*mootslivepbv1.TopArtist, 0, len(rows))
+	for _, row := range rows {
+		top = append(top, &mootslivepbv1.TopArtist{
+			Artist: &mootslivepbv1.Artist{
+				Id:   row.ArtistID,
+				Name: row.ArtistName,
+			},
+			Plays: row.Plays,
+		})
+	}
+	return top, nil
+}
+
+func listTopAlbums(
+	ctx context.Context, queries db.Querier, q topsQuery,
+) ([]*mootslivepbv1.TopAlbum, error) {
+	rows, err := queries.ListTopAlbums(ctx, db.ListTopAlbumsParams{
+		IncludeHidden: q.includeHidden,
+		UserID:        q.userID,
+		StartDay:      q.startDay,
+		EndDay:        q.endDay,
+		RowLimit:      q.limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetching top albums: %w", err)
+	}
+	top := make([]*mootslivepbv1.TopAlbum, 0, len(rows))
+	for _, row := range rows {
+		top = append(top, &mootslivepbv1.TopAlbum{
+			Album: &mootslivepbv1.Album{
+				Id:       row.AlbumID,
+				Name:     row.AlbumName,
+				ImageUrl: row.AlbumImageUrl,
+			},
+			Plays: row.Plays,
+		})
+	}
+	return top, nil
+}
@@ -0,0 +1,40 @@
+package trace
+
+import (
+	"context"
+	"sync"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// MonitoredExporter wraps a span exporter, remembering whether the last batch
+// of spans it exported made it so health checks can report on it.
+type MonitoredExporter struct {
+	sdktrace.SpanExporter
+
+	mu      sync.Mutex
+	lastErr error
+}
+
+func NewMonitoredExporter(exporter sdktrace.SpanExporter) *MonitoredExporter {
+	return &MonitoredExporter{
+		SpanExporter: exporter,
+	}
+}
+
+func (e *MonitoredExporter) ExportSpans(
+	ctx context.Context, spans []sdktrace.ReadOnlySpan,
+) error {
+	err := e.SpanExporter.ExportSpans(ctx, spans)
+	e.mu.Lock()
+	e.lastErr = err
+	e.mu.Unlock()
+	return err
+}
+
+// Err returns the error the last export failed with, or nil if it succeeded.
+func (e *MonitoredExporter) Err() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.lastErr
+}
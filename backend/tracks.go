@@ -0,0 +1,334 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/mootslive/mono/backend/db"
+	"github.com/mootslive/mono/backend/trace"
+	"github.com/zmb3/spotify/v2"
+)
+
+// spotifyMaxTracksPerRequest is the most tracks that can be fetched from
+// Spotify in a single request.
+const spotifyMaxTracksPerRequest = 50
+
+// upsertTrack stores the metadata of a track. When a track is new, its plays
+// are counted towards its artists and album, and when its artists or album
+// change, its plays are moved to the new ones.
+func upsertTrack(
+	ctx context.Context, queries db.TXQuerier, track spotify.FullTrack,
+) error {
+	isrc := track.ExternalIDs["isrc"]
+	// Listens are keyed by ISRC, so metadata without one is of no use.
+	if isrc == "" {
+		return nil
+	}
+
+	artistIDs := make([]string, 0, len(track.Artists))
+	artistNames := make([]string, 0, len(track.Artists))
+	for _, artist := range track.Artists {
+		artistIDs = append(artistIDs, artist.ID.String())
+		artistNames = append(artistNames, artist.Name)
+	}
+	imageURL := ""
+	if len(track.Album.Images) > 0 {
+		imageURL = track.Album.Images[0].URL
+	}
+	params := db.UpsertTrackParams{
+		Isrc:           isrc,
+		SpotifyTrackID: track.ID.String(),
+		Name:           track.Name,
+		ArtistIds:      artistIDs,
+		ArtistNames:    artistNames,
+		AlbumID:        track.Album.ID.String(),
+		AlbumName:      track.Album.Name,
+		AlbumImageUrl:  imageURL,
+		DurationMs:     int32(track.Duration),
+		UpdatedAt:      time.Now(),
+	}
+
+	old, err := queries.GetTrack(ctx, isrc)
+	if errors.Is(err, pgx.ErrNoRows) {
+		if err := queries.UpsertTrack(ctx, params); err != nil {
+			return fmt.Errorf("upserting track %s: %w", isrc, err)
+		}
+		return countNewTrackPlays(ctx, queries, isrc)
+	} else if err != nil {
+		return fmt.Errorf("fetching track %s: %w", isrc, err)
+	}
+	if !trackPlaysMoved(old, params) {
+		if err := queries.UpsertTrack(ctx, params); err != nil {
+			return fmt.Errorf("upserting track %s: %w", isrc, err)
+		}
+		return nil
+	}
+
+	commit, rollback, tx, err := queries.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		// Any failure that led to a rollback has already been returned.
+		_ = rollback(context.Background())
+	}()
+
+	// This moves plays in the rollups of everyone who has played the track,
+	// so nobody's plays can be counted until it's done. Metadata rarely
+	// changes once we have it, so this is rare.
+	if err := tx.LockAllRollups(ctx); err != nil {
+		return fmt.Errorf("locking rollups: %w", err)
+	}
+	old, err = tx.GetTrack(ctx, isrc)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Plays of a track we had no metadata for weren't counted towards
+		// any artist or album, so there's nothing to take away.
+		old = db.Track{}
+	} else if err != nil {
+		return fmt.Errorf("fetching track %s: %w", isrc, err)
+	}
+	if err := addTrackPlays(ctx, tx, old.Isrc, old.ArtistIds, old.AlbumID, -1); err != nil {
+		return err
+	}
+	if err := tx.UpsertTrack(ctx, params); err != nil {
+		return fmt.Errorf("upserting track %s: %w", isrc, err)
+	}
+	if err := addTrackPlays(ctx, tx, isrc, params.ArtistIds, params.AlbumID, 1); err != nil {
+		return err
+	}
+	// The artist counts of the compatibility scores of everyone who has
+	// played the track no longer match their rollups.
+	if err := tx.MarkCompatibilityScoresStaleForTrack(ctx, isrc); err != nil {
+		return fmt.Errorf("marking compatibility stale: %w", err)
+	}
+	if old.Isrc != "" {
+		err := tx.DeleteEmptyDailyArtistPlaysForTrack(ctx, db.DeleteEmptyDailyArtistPlaysForTrackParams{
+			Isrc:      isrc,
+			ArtistIds: old.ArtistIds,
+		})
+		if err != nil {
+			return fmt.Errorf("deleting empty daily artist plays: %w", err)
+		}
+		err = tx.DeleteEmptyDailyAlbumPlaysForTrack(ctx, db.DeleteEmptyDailyAlbumPlaysForTrackParams{
+			Isrc:    isrc,
+			AlbumID: old.AlbumID,
+		})
+		if err != nil {
+			return fmt.Errorf("deleting empty daily album plays: %w", err)
+		}
+	}
+
+	if err := commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// countNewTrackPlays counts the plays of a track we've just stored the
+// metadata of towards its artists and album. Listens counted before the
+// metadata arrived only counted towards the track, so the artist and album
+// rollups of each user who has played it are summed again over the days they
+// did, one user at a time.
+func countNewTrackPlays(
+	ctx context.Context, queries db.TXQuerier, isrc string,
+) error {
+	users, err := queries.ListUsersWithTrackPlays(ctx, isrc)
+	if err != nil {
+		return fmt.Errorf("fetching users with plays of %s: %w", isrc, err)
+	}
+	for _, user := range users {
+		err := countNewTrackPlaysForUser(
+			ctx, queries, user.UserID, user.FirstDay, user.LastDay.AddDate(0, 0, 1),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func countNewTrackPlaysForUser(
+	ctx context.Context,
+	queries db.TXQuerier,
+	userID string,
+	startDay, endDay time.Time,
+) error {
+	commit, rollback, tx, err := queries.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("opening tx: %w", err)
+	}
+	defer func() {
+		// Any failure that led to a rollback has already been returned.
+		_ = rollback(context.Background())
+	}()
+
+	// Once we hold the lock, any listen of the track counted before the
+	// metadata was stored has been committed, and any counted after it
+	// includes the artists and album itself.
+	if err := lockRollups(ctx, tx, userID); err != nil {
+		return err
+	}
+	if err := rebuildArtistAndAlbumRollups(ctx, tx, userID, startDay, endDay); err != nil {
+		return err
+	}
+	if err := tx.MarkCompatibilityScoresStale(ctx, userID); err != nil {
+		return fmt.Errorf("marking compatibility stale: %w", err)
+	}
+	if err := commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// trackPlaysMoved returns whether an update to a track changes which artists
+// and album its plays count towards.
+func trackPlaysMoved(old db.Track, updated db.UpsertTrackParams) bool {
+	return old.AlbumID != updated.AlbumID ||
+		!sameStrings(old.ArtistIds, updated.ArtistIds)
+}
+
+// addTrackPlays adds every user's plays of a track, multiplied by sign, to
+// the rollups of the given artists and album. It does nothing for an empty
+// isrc, which stands for a track we held no metadata for.
+func addTrackPlays(
+	ctx context.Context,
+	queries db.Querier,
+	isrc string,
+	artistIDs []string,
+	albumID string,
+	sign int32,
+) error {
+	if isrc == "" {
+		return nil
+	}
+	err := queries.AddTrackToDailyArtistPlays(ctx, db.AddTrackToDailyArtistPlaysParams{
+		Sign: sign,
+		// A track crediting an artist twice is still one play of theirs.
+		ArtistIds: uniqueStrings(artistIDs),
+		Isrc:      isrc,
+	})
+	if err != nil {
+		return fmt.Errorf("updating daily artist plays: %w", err)
+	}
+	err = queries.AddTrackToDailyAlbumPlays(ctx, db.AddTrackToDailyAlbumPlaysParams{
+		AlbumID: albumID,
+		Sign:    sign,
+		Isrc:    isrc,
+	})
+	if err != nil {
+		return fmt.Errorf("updating daily album plays: %w", err)
+	}
+	return nil
+}
+
+// recordTracks stores the metadata of any recently played tracks that we
+// don't already know of. Recently played items don't include the album of
+// each track, so the full tracks are fetched from Spotify.
+func recordTracks(
+	ctx context.Context,
+	queries db.TXQuerier,
+	client *spotify.Client,
+	played []spotify.RecentlyPlayedItem,
+) error {
+	ctx, span := trace.Start(ctx, "backend/recordTracks")
+	defer span.End()
+
+	trackIDs := map[string]spotify.ID{}
+	isrcs := make([]string, 0, len(played))
+	for _, item := range played {
+		isrc := item.Track.ExternalIDs.ISRC
+		if isrc == "" {
+			continue
+		}
+		if _, ok := trackIDs[isrc]; ok {
+			continue
+		}
+		trackIDs[isrc] = item.Track.ID
+		isrcs = append(isrcs, isrc)
+	}
+	if len(isrcs) == 0 {
+		return nil
+	}
+
+	known, err := queries.ListKnownTrackISRCs(ctx, isrcs)
+	if err != nil {
+		return fmt.Errorf("fetching known tracks: %w", err)
+	}
+	for _, isrc := range known {
+		delete(trackIDs, isrc)
+	}
+
+	unknown := make([]spotify.ID, 0, len(trackIDs))
+	for _, id := range trackIDs {
+		unknown = append(unknown, id)
+	}
+	for len(unknown) > 0 {
+		batch := unknown
+		if len(batch) > spotifyMaxTracksPerRequest {
+			batch = batch[:spotifyMaxTracksPerRequest]
+		}
+		unknown = unknown[len(batch):]
+
+		tracks, err := client.GetTracks(ctx, batch)
+		if err != nil {
+			return fmt.Errorf("fetching tracks: %w", err)
+		}
+		for _, track := range tracks {
+			// Tracks Spotify couldn't find are returned as nil.
+			if track == nil {
+				continue
+			}
+			if err := upsertTrack(ctx, queries, *track); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// listTracks fetches the metadata we hold for the given ISRCs, keyed by ISRC.
+func listTracks(
+	ctx context.Context, queries db.Querier, isrcs []string,
+) (map[string]db.Track, error) {
+	tracks, err := queries.ListTracks(ctx, uniqueStrings(isrcs))
+	if err != nil {
+		return nil, fmt.Errorf("fetching tracks: %w", err)
+	}
+	byISRC := make(map[string]db.Track, len(tracks))
+	for _, track := range tracks {
+		byISRC[track.Isrc] = track
+	}
+	return byISRC, nil
+}
+
+func uniqueStrings(in []string) []string {
+	seen := make(map[string]bool, len(in))
+	out := make([]string, 0, len(in))
+	for _, s := range in {
+		if seen[s] {
+			continue
+		}
+		seen[s] = true
+		out = append(out, s)
+	}
+	return out
+}
+
+// sameStrings returns whether a and b hold the same strings, ignoring order
+// and duplicates.
+func sameStrings(a, b []string) bool {
+	set := make(map[string]bool, len(a))
+	for _, s := range a {
+		set[s] = true
+	}
+	for _, s := range b {
+		if !set[s] {
+			return false
+		}
+	}
+	return len(set) == len(uniqueStrings(b))
+}
@@ -0,0 +1,338 @@
+package twitter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"golang.org/x/oauth2"
+)
+
+// DefaultBaseURL is the base URL of the Twitter API used unless overridden
+// with WithBaseURL.
+const DefaultBaseURL = "https://api.twitter.com"
+
+const (
+	// defaultMaxRateLimitWait is the longest the client will wait for a rate
+	// limit to reset before giving up. Twitter rate limit windows are fifteen
+	// minutes long, so this covers waiting out an entire window.
+	defaultMaxRateLimitWait = time.Minute * 15
+	// maxRateLimitRetries is how many times a request is retried after being
+	// rejected for exceeding a rate limit.
+	maxRateLimitRetries = 3
+	// rateLimitFallbackBackoff is how long to back off when Twitter rejects a
+	// request for exceeding a rate limit, but doesn't tell us when it resets.
+	rateLimitFallbackBackoff = time.Minute
+)
+
+type Client struct {
+	http             *http.Client
+	tokenSource      oauth2.TokenSource
+	baseURL          string
+	maxRateLimitWait time.Duration
+
+	rateLimitsMu sync.Mutex
+	// rateLimits holds the most recently reported rate limit, keyed by the
+	// path of the endpoint it applies to.
+	rateLimits map[string]RateLimit
+
+	// now and sleep are overridden in tests.
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+type ClientOption func(c *Client)
+
+// WithBaseURL overrides the base URL that API requests are made against.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		c.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithMaxRateLimitWait sets the longest the client will wait for a rate limit
+// to reset before returning a RateLimitError.
+func WithMaxRateLimitWait(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.maxRateLimitWait = d
+	}
+}
+
+func NewClient(
+	ctx context.Context, tok *oauth2.Token, opts ...ClientOption,
+) *Client {
+	tokenSource := OAuthConfig().TokenSource(ctx, tok)
+	httpClient := oauth2.NewClient(ctx, tokenSource)
+	httpClient.Transport = otelhttp.NewTransport(httpClient.Transport)
+	c := &Client{
+		http:             httpClient,
+		tokenSource:      tokenSource,
+		baseURL:          DefaultBaseURL,
+		maxRateLimitWait: defaultMaxRateLimitWait,
+		rateLimits:       map[string]RateLimit{},
+		now:              time.Now,
+		sleep:            sleepContext,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Token returns the token currently in use by the client. Twitter refresh
+// tokens can only be used once, so if this differs from the token the client
+// was created with, it must be persisted in place of the original.
+func (c *Client) Token() (*oauth2.Token, error) {
+	return c.tokenSource.Token()
+}
+
+// response is the envelope common to all Twitter API v2 responses.
+type response struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []Error         `json:"errors"`
+}
+
+// do makes a request to the Twitter API, waiting for rate limits to reset if
+// necessary, and unmarshals the response into out. The request body, if not
+// nil, is sent as JSON.
+func (c *Client) do(
+	ctx context.Context, method, path string, query map[string]string, body, out interface{},
+) error {
+	var reqBody []byte
+	if body != nil {
+		var err error
+		reqBody, err = json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshalling request: %w", err)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := c.waitForRateLimit(ctx, path); err != nil {
+			return err
+		}
+
+		req, err := http.NewRequestWithContext(
+			ctx, method, c.baseURL+path, bytes.NewReader(reqBody),
+		)
+		if err != nil {
+			return fmt.Errorf("creating request: %w", err)
+		}
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		if len(query) > 0 {
+			q := req.URL.Query()
+			for k, v := range query {
+				q.Set(k, v)
+			}
+			req.URL.RawQuery = q.Encode()
+		}
+
+		resp, err := c.http.Do(req)
+		if err != nil {
+			return fmt.Errorf("%s request: %w", strings.ToLower(method), err)
+		}
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("reading response: %w", err)
+		}
+		c.recordRateLimit(path, resp)
+
+		if resp.StatusCode == http.StatusTooManyRequests &&
+			attempt < maxRateLimitRetries {
+			continue
+		}
+
+		return decodeResponse(resp.StatusCode, respBody, out)
+	}
+}
+
+func decodeResponse(statusCode int, body []byte, out interface{}) error {
+	if statusCode < 200 || statusCode > 299 {
+		apiErr := &APIError{StatusCode: statusCode}
+		// Error bodies aren't guaranteed to be JSON, in which case the status
+		// code is all we have to go on.
+		_ = json.Unmarshal(body, apiErr)
+		return apiErr
+	}
+
+	env := response{}
+	if err := json.Unmarshal(body, &env); err != nil {
+		return fmt.Errorf("unmarshalling response: %w", err)
+	}
+	// Twitter reports some failures, such as a requested resource not
+	// existing, with a success status but no data.
+	if len(env.Errors) > 0 && (len(env.Data) == 0 || string(env.Data) == "null") {
+		return &APIError{
+			StatusCode: statusCode,
+			Errors:     env.Errors,
+		}
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(body, out); err != nil {
+			return fmt.Errorf("unmarshalling response: %w", err)
+		}
+	}
+	return nil
+}
+
+// userFields are the optional fields requested when fetching users.
+const userFields = "profile_image_url"
+
+// GetMeResponse is the structure of the response from
+// https://api.twitter.com/2/users/me
+//
+//	{
+//	  "data": {
+//	    "id": "2244994945",
+//	    "name": "TwitterDev",
+//	    "username": "Twitter Dev",
+//	    "profile_image_url": "https://pbs.twimg.com/profile_images/.../normal.jpg"
+//	  }
+//	}
+type GetMeResponse struct {
+	Data User `json:"data"`
+}
+
+func (c *Client) GetMe(ctx context.Context) (*GetMeResponse, error) {
+	obj := GetMeResponse{}
+	query := map[string]string{
+		"user.fields": userFields,
+	}
+	if err := c.do(ctx, http.MethodGet, "/2/users/me", query, nil, &obj); err != nil {
+		return nil, err
+	}
+	if obj.Data.ID == "" {
+		return nil, errors.New("response did not include a user id")
+	}
+
+	return &obj, nil
+}
+
+type postTweetRequest struct {
+	Text string `json:"text"`
+}
+
+// PostTweetResponse is the structure of the response from
+// https://api.twitter.com/2/tweets
+//
+//	{
+//	  "data": {
+//	    "id": "1445880548472328192",
+//	    "text": "Are you excited for the weekend?"
+//	  }
+//	}
+type PostTweetResponse struct {
+	Data struct {
+		ID   string `json:"id"`
+		Text string `json:"text"`
+	} `json:"data"`
+}
+
+func (c *Client) PostTweet(ctx context.Context, text string) (*PostTweetResponse, error) {
+	obj := PostTweetResponse{}
+	err := c.do(
+		ctx, http.MethodPost, "/2/tweets", nil, postTweetRequest{Text: text}, &obj,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if obj.Data.ID == "" {
+		return nil, errors.New("response did not include a tweet id")
+	}
+
+	return &obj, nil
+}
+
+// User is a Twitter user, as returned by the Twitter API v2.
+type User struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Username        string `json:"username"`
+	ProfileImageURL string `json:"profile_image_url"`
+}
+
+// ListUsersResponse is the structure of a page of users returned by endpoints
+// such as https://api.twitter.com/2/users/:id/following
+//
+//	{
+//	  "data": [
+//	    {
+//	      "id": "6253282",
+//	      "name": "Twitter API",
+//	      "username": "TwitterAPI"
+//	    }
+//	  ],
+//	  "meta": {
+//	    "result_count": 1,
+//	    "next_token": "DFEDBNRFT3MHCZZZ"
+//	  }
+//	}
+type ListUsersResponse struct {
+	Data []User `json:"data"`
+	Meta struct {
+		ResultCount int    `json:"result_count"`
+		NextToken   string `json:"next_token"`
+	} `json:"meta"`
+}
+
+// listUsersPageSize is the largest page size Twitter allows when listing
+// follows.
+const listUsersPageSize = "1000"
+
+func (c *Client) listUsers(
+	ctx context.Context, path, paginationToken string,
+) (*ListUsersResponse, error) {
+	query := map[string]string{
+		"max_results": listUsersPageSize,
+	}
+	if paginationToken != "" {
+		query["pagination_token"] = paginationToken
+	}
+
+	obj := ListUsersResponse{}
+	if err := c.do(ctx, http.MethodGet, path, query, nil, &obj); err != nil {
+		return nil, err
+	}
+
+	return &obj, nil
+}
+
+// ListFollowing returns a page of the users followed by the user with the
+// given ID. An empty paginationToken fetches the first page, and the
+// NextToken of the response is empty once the last page has been reached.
+func (c *Client) ListFollowing(
+	ctx context.Context, userID, paginationToken string,
+) (*ListUsersResponse, error) {
+	return c.listUsers(ctx, "/2/users/"+userID+"/following", paginationToken)
+}
+
+// ListFollowers returns a page of the users following the user with the given
+// ID, paginated in the same way as ListFollowing.
+func (c *Client) ListFollowers(
+	ctx context.Context, userID, paginationToken string,
+) (*ListUsersResponse, error) {
+	return c.listUsers(ctx, "/2/users/"+userID+"/followers", paginationToken)
+}
@@ -0,0 +1,380 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// fakeTwitter is a stand-in for the Twitter API that serves canned responses
+// in order, recording the requests it receives.
+type fakeTwitter struct {
+	t         *testing.T
+	responses []fakeResponse
+	requests  []*http.Request
+	bodies    [][]byte
+}
+
+type fakeResponse struct {
+	status  int
+	headers map[string]string
+	body    string
+}
+
+func (f *fakeTwitter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if len(f.requests) >= len(f.responses) {
+		f.t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		http.Error(w, "unexpected request", http.StatusInternalServerError)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		f.t.Errorf("reading request body: %s", err)
+	}
+	f.bodies = append(f.bodies, body)
+	res := f.responses[len(f.requests)]
+	f.requests = append(f.requests, r)
+
+	for k, v := range res.headers {
+		w.Header().Set(k, v)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(res.status)
+	_, _ = w.Write([]byte(res.body))
+}
+
+// fakeClock lets tests control the time seen by the client and records how
+// long the client attempted to sleep for.
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (fc *fakeClock) Now() time.Time {
+	return fc.now
+}
+
+func (fc *fakeClock) Sleep(_ context.Context, d time.Duration) error {
+	fc.sleeps = append(fc.sleeps, d)
+	fc.now = fc.now.Add(d)
+	return nil
+}
+
+func newTestClient(
+	t *testing.T, responses ...fakeResponse,
+) (*Client, *fakeTwitter, *fakeClock) {
+	t.Helper()
+	fake := &fakeTwitter{t: t, responses: responses}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	clock := &fakeClock{now: time.Unix(1_700_000_000, 0)}
+	c := NewClient(
+		context.Background(),
+		&oauth2.Token{AccessToken: "access-token"},
+		WithBaseURL(srv.URL),
+	)
+	c.now = clock.Now
+	c.sleep = clock.Sleep
+	return c, fake, clock
+}
+
+func rateLimitHeaders(remaining int, reset time.Time) map[string]string {
+	return map[string]string{
+		"x-rate-limit-limit":     "75",
+		"x-rate-limit-remaining": strconv.Itoa(remaining),
+		"x-rate-limit-reset":     strconv.FormatInt(reset.Unix(), 10),
+	}
+}
+
+func TestGetMe(t *testing.T) {
+	c, fake, _ := newTestClient(t, fakeResponse{
+		status: http.StatusOK,
+		body:   `{"data":{"id":"2244994945","name":"TwitterDev","username":"TwitterDev","profile_image_url":"https://pbs.twimg.com/profile_images/1/a_normal.jpg"}}`,
+	})
+
+	me, err := c.GetMe(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if me.Data.ID != "2244994945" {
+		t.Errorf("expected id 2244994945, got %q", me.Data.ID)
+	}
+	if me.Data.Username != "TwitterDev" {
+		t.Errorf("expected username TwitterDev, got %q", me.Data.Username)
+	}
+	if me.Data.ProfileImageURL != "https://pbs.twimg.com/profile_images/1/a_normal.jpg" {
+		t.Errorf("unexpected profile image url %q", me.Data.ProfileImageURL)
+	}
+
+	req := fake.requests[0]
+	if req.URL.Path != "/2/users/me" {
+		t.Errorf("expected request to /2/users/me, got %s", req.URL.Path)
+	}
+	if got := req.URL.Query().Get("user.fields"); got != "profile_image_url" {
+		t.Errorf("expected user.fields=profile_image_url, got %q", got)
+	}
+	if got := req.Header.Get("Authorization"); got != "Bearer access-token" {
+		t.Errorf("unexpected authorization header %q", got)
+	}
+}
+
+func TestGetMeErrorStatus(t *testing.T) {
+	c, _, _ := newTestClient(t, fakeResponse{
+		status: http.StatusUnauthorized,
+		body:   `{"title":"Unauthorized","type":"about:blank","status":401,"detail":"Unauthorized"}`,
+	})
+
+	_, err := c.GetMe(context.Background())
+	apiErr := &APIError{}
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if apiErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected status 401, got %d", apiErr.StatusCode)
+	}
+	if apiErr.Title != "Unauthorized" {
+		t.Errorf("expected title Unauthorized, got %q", apiErr.Title)
+	}
+}
+
+func TestGetMeNonJSONError(t *testing.T) {
+	c, _, _ := newTestClient(t, fakeResponse{
+		status: http.StatusBadGateway,
+		body:   `<html>bad gateway</html>`,
+	})
+
+	_, err := c.GetMe(context.Background())
+	apiErr := &APIError{}
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if apiErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("expected status 502, got %d", apiErr.StatusCode)
+	}
+}
+
+func TestGetMeErrorsArray(t *testing.T) {
+	c, _, _ := newTestClient(t, fakeResponse{
+		status: http.StatusOK,
+		body: `{"errors":[{
+			"value":"1234",
+			"detail":"Could not find user with id: [1234].",
+			"title":"Not Found Error",
+			"resource_type":"user",
+			"parameter":"id",
+			"resource_id":"1234",
+			"type":"https://api.twitter.com/2/problems/resource-not-found"
+		}]}`,
+	})
+
+	_, err := c.GetMe(context.Background())
+	apiErr := &APIError{}
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if len(apiErr.Errors) != 1 {
+		t.Fatalf("expected 1 error, got %d", len(apiErr.Errors))
+	}
+	if apiErr.Errors[0].ResourceID != "1234" {
+		t.Errorf("expected resource id 1234, got %q", apiErr.Errors[0].ResourceID)
+	}
+}
+
+func TestGetMeMissingID(t *testing.T) {
+	c, _, _ := newTestClient(t, fakeResponse{
+		status: http.StatusOK,
+		body:   `{"data":{}}`,
+	})
+
+	if _, err := c.GetMe(context.Background()); err == nil {
+		t.Fatal("expected error for response without id")
+	}
+}
+
+func TestPostTweet(t *testing.T) {
+	c, fake, _ := newTestClient(t, fakeResponse{
+		status: http.StatusCreated,
+		body:   `{"data":{"id":"1445880548472328192","text":"hello"}}`,
+	})
+
+	res, err := c.PostTweet(context.Background(), "hello")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if res.Data.ID != "1445880548472328192" {
+		t.Errorf("unexpected tweet id %q", res.Data.ID)
+	}
+
+	req := fake.requests[0]
+	if req.Method != http.MethodPost || req.URL.Path != "/2/tweets" {
+		t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+	}
+	body := postTweetRequest{}
+	if err := json.Unmarshal(fake.bodies[0], &body); err != nil {
+		t.Fatalf("unmarshalling request body: %s", err)
+	}
+	if body.Text != "hello" {
+		t.Errorf("expected text hello, got %q", body.Text)
+	}
+}
+
+func TestRateLimitRetriesAfterReset(t *testing.T) {
+	reset := time.Unix(1_700_000_060, 0)
+	c, fake, clock := newTestClient(t,
+		fakeResponse{
+			status:  http.StatusTooManyRequests,
+			headers: rateLimitHeaders(0, reset),
+			body:    `{"title":"Too Many Requests","detail":"Too Many Requests","type":"about:blank","status":429}`,
+		},
+		fakeResponse{
+			status:  http.StatusOK,
+			headers: rateLimitHeaders(74, reset.Add(time.Minute*15)),
+			body:    `{"data":{"id":"2244994945"}}`,
+		},
+	)
+
+	if _, err := c.GetMe(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(fake.requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(fake.requests))
+	}
+	if len(clock.sleeps) != 1 || clock.sleeps[0] != time.Minute {
+		t.Errorf("expected a single one minute sleep, got %v", clock.sleeps)
+	}
+
+	rl, ok := c.RateLimit("/2/users/me")
+	if !ok || rl.Remaining != 74 || rl.Limit != 75 {
+		t.Errorf("unexpected recorded rate limit %+v", rl)
+	}
+}
+
+func TestRateLimitWaitsBeforeExhaustedEndpoint(t *testing.T) {
+	reset := time.Unix(1_700_000_030, 0)
+	c, _, clock := newTestClient(t,
+		fakeResponse{
+			status:  http.StatusOK,
+			headers: rateLimitHeaders(0, reset),
+			body:    `{"data":{"id":"2244994945"}}`,
+		},
+		fakeResponse{
+			status:  http.StatusOK,
+			headers: rateLimitHeaders(74, reset.Add(time.Minute*15)),
+			body:    `{"data":{"id":"2244994945"}}`,
+		},
+	)
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.GetMe(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if len(clock.sleeps) != 1 || clock.sleeps[0] != time.Second*30 {
+		t.Errorf("expected a single thirty second sleep, got %v", clock.sleeps)
+	}
+}
+
+func TestRateLimitTooLongToWait(t *testing.T) {
+	reset := time.Unix(1_700_000_000, 0).Add(time.Hour)
+	c, fake, clock := newTestClient(t,
+		fakeResponse{
+			status:  http.StatusTooManyRequests,
+			headers: rateLimitHeaders(0, reset),
+			body:    `{"title":"Too Many Requests","type":"about:blank","status":429}`,
+		},
+	)
+
+	_, err := c.GetMe(context.Background())
+	rlErr := &RateLimitError{}
+	if !errors.As(err, &rlErr) {
+		t.Fatalf("expected RateLimitError, got %v", err)
+	}
+	if !rlErr.RateLimit.Reset.Equal(reset) {
+		t.Errorf("expected reset %s, got %s", reset, rlErr.RateLimit.Reset)
+	}
+	if len(fake.requests) != 1 {
+		t.Errorf("expected 1 request, got %d", len(fake.requests))
+	}
+	if len(clock.sleeps) != 0 {
+		t.Errorf("expected no sleeps, got %v", clock.sleeps)
+	}
+}
+
+func TestRateLimitGivesUpAfterRetries(t *testing.T) {
+	var responses []fakeResponse
+	for i := 0; i <= maxRateLimitRetries; i++ {
+		responses = append(responses, fakeResponse{
+			status: http.StatusTooManyRequests,
+			body:   `{"title":"Too Many Requests","type":"about:blank","status":429}`,
+		})
+	}
+	c, fake, clock := newTestClient(t, responses...)
+
+	_, err := c.GetMe(context.Background())
+	apiErr := &APIError{}
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected 429 APIError, got %v", err)
+	}
+	if len(fake.requests) != maxRateLimitRetries+1 {
+		t.Errorf("expected %d requests, got %d", maxRateLimitRetries+1, len(fake.requests))
+	}
+	for _, d := range clock.sleeps {
+		if d != rateLimitFallbackBackoff {
+			t.Errorf("expected fallback backoff sleeps, got %v", clock.sleeps)
+		}
+	}
+}
+
+func TestListFollowingPagination(t *testing.T) {
+	c, fake, _ := newTestClient(t,
+		fakeResponse{
+			status: http.StatusOK,
+			body:   `{"data":[{"id":"1","name":"One","username":"one"}],"meta":{"result_count":1,"next_token":"NEXT"}}`,
+		},
+		fakeResponse{
+			status: http.StatusOK,
+			body:   `{"meta":{"result_count":0}}`,
+		},
+	)
+
+	first, err := c.ListFollowing(context.Background(), "2244994945", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(first.Data) != 1 || first.Data[0].Username != "one" {
+		t.Errorf("unexpected first page %+v", first.Data)
+	}
+	if first.Meta.NextToken != "NEXT" {
+		t.Errorf("expected next token NEXT, got %q", first.Meta.NextToken)
+	}
+
+	second, err := c.ListFollowing(context.Background(), "2244994945", first.Meta.NextToken)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(second.Data) != 0 || second.Meta.NextToken != "" {
+		t.Errorf("expected empty final page, got %+v", second)
+	}
+
+	if fake.requests[0].URL.Path != "/2/users/2244994945/following" {
+		t.Errorf("unexpected path %s", fake.requests[0].URL.Path)
+	}
+	if got := fake.requests[0].URL.Query().Get("pagination_token"); got != "" {
+		t.Errorf("expected no pagination token on first request, got %q", got)
+	}
+	if got := fake.requests[1].URL.Query().Get("pagination_token"); got != "NEXT" {
+		t.Errorf("expected pagination token NEXT, got %q", got)
+	}
+	if got := fake.requests[1].URL.Query().Get("max_results"); got != "1000" {
+		t.Errorf("expected max_results 1000, got %q", got)
+	}
+}
@@ -0,0 +1,76 @@
+package twitter
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Error is a single entry from the errors array of a Twitter API v2
+// response.
+//
+//	{
+//	  "value": "1234",
+//	  "detail": "Could not find user with id: [1234].",
+//	  "title": "Not Found Error",
+//	  "resource_type": "user",
+//	  "parameter": "id",
+//	  "resource_id": "1234",
+//	  "type": "https://api.twitter.com/2/problems/resource-not-found"
+//	}
+type Error struct {
+	Title        string `json:"title"`
+	Detail       string `json:"detail"`
+	Type         string `json:"type"`
+	Message      string `json:"message"`
+	Parameter    string `json:"parameter"`
+	Value        string `json:"value"`
+	ResourceType string `json:"resource_type"`
+	ResourceID   string `json:"resource_id"`
+}
+
+func (e Error) String() string {
+	switch {
+	case e.Detail != "":
+		return e.Detail
+	case e.Message != "":
+		return e.Message
+	}
+	return e.Title
+}
+
+// APIError is returned when Twitter responds to a request with an error.
+// Twitter uses problem details for the top level of an error response, with
+// individual errors listed in Errors.
+//
+//	{
+//	  "errors": [{"parameters": {"id": ["abc"]}, "message": "..."}],
+//	  "title": "Invalid Request",
+//	  "detail": "One or more parameters to your request was invalid.",
+//	  "type": "https://api.twitter.com/2/problems/invalid-request"
+//	}
+type APIError struct {
+	StatusCode int     `json:"-"`
+	Title      string  `json:"title"`
+	Detail     string  `json:"detail"`
+	Type       string  `json:"type"`
+	Errors     []Error `json:"errors"`
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("twitter api: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Title != "" {
+		msg += ": " + e.Title
+	}
+	if e.Detail != "" && e.Detail != e.Title {
+		msg += ": " + e.Detail
+	}
+	if len(e.Errors) > 0 {
+		details := make([]string, 0, len(e.Errors))
+		for _, err := range e.Errors {
+			details = append(details, err.String())
+		}
+		msg += " (" + strings.Join(details, "; ") + ")"
+	}
+	return msg
+}
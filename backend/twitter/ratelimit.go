@@ -0,0 +1,96 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimit is the state of the rate limit for an endpoint, as reported by
+// the x-rate-limit-* headers on a response.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+func parseRateLimit(h http.Header) (RateLimit, bool) {
+	remaining, err := strconv.Atoi(h.Get("x-rate-limit-remaining"))
+	if err != nil {
+		return RateLimit{}, false
+	}
+	reset, err := strconv.ParseInt(h.Get("x-rate-limit-reset"), 10, 64)
+	if err != nil {
+		return RateLimit{}, false
+	}
+	// The limit is informational, so its absence isn't worth failing over.
+	limit, _ := strconv.Atoi(h.Get("x-rate-limit-limit"))
+
+	return RateLimit{
+		Limit:     limit,
+		Remaining: remaining,
+		Reset:     time.Unix(reset, 0),
+	}, true
+}
+
+// RateLimitError is returned when a request can't be made because the rate
+// limit for the endpoint is exhausted, and won't reset soon enough to be
+// worth waiting for.
+type RateLimitError struct {
+	RateLimit RateLimit
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf(
+		"twitter api: rate limit exhausted until %s",
+		e.RateLimit.Reset.Format(time.RFC3339),
+	)
+}
+
+// RateLimit returns the rate limit most recently reported by Twitter for the
+// endpoint at path, and whether one has been reported at all.
+func (c *Client) RateLimit(path string) (RateLimit, bool) {
+	c.rateLimitsMu.Lock()
+	defer c.rateLimitsMu.Unlock()
+	rl, ok := c.rateLimits[path]
+	return rl, ok
+}
+
+// waitForRateLimit blocks until a request can be made to the endpoint at path
+// without exceeding its rate limit.
+func (c *Client) waitForRateLimit(ctx context.Context, path string) error {
+	rl, ok := c.RateLimit(path)
+	if !ok || rl.Remaining > 0 {
+		return nil
+	}
+
+	wait := rl.Reset.Sub(c.now())
+	if wait <= 0 {
+		return nil
+	}
+	if wait > c.maxRateLimitWait {
+		return &RateLimitError{RateLimit: rl}
+	}
+
+	return c.sleep(ctx, wait)
+}
+
+func (c *Client) recordRateLimit(path string, resp *http.Response) {
+	rl, ok := parseRateLimit(resp.Header)
+	if !ok && resp.StatusCode == http.StatusTooManyRequests {
+		rl = RateLimit{
+			Remaining: 0,
+			Reset:     c.now().Add(rateLimitFallbackBackoff),
+		}
+		ok = true
+	}
+	if !ok {
+		return
+	}
+
+	c.rateLimitsMu.Lock()
+	defer c.rateLimitsMu.Unlock()
+	c.rateLimits[path] = rl
+}
@@ -4,13 +4,14 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"golang.org/x/oauth2"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
+	"strings"
 )
 
 var Endpoint = oauth2.Endpoint{
@@ -19,6 +20,9 @@ var Endpoint = oauth2.Endpoint{
 	TokenURL:  "https://api.twitter.com/2/oauth2/token",
 }
 
+// RevokeURL is the endpoint used to invalidate tokens we have been issued.
+var RevokeURL = "https://api.twitter.com/2/oauth2/revoke"
+
 var (
 	ScopeOfflineAccess = "offline.access"
 	ScopeTweetWrite    = "tweet.write"
@@ -49,58 +53,54 @@ func OAuthConfig() *oauth2.Config {
 	}
 }
 
-type Client struct {
-	http *http.Client
-}
-
-func NewClient(ctx context.Context, tok *oauth2.Token) *Client {
-	httpClient := OAuthConfig().Client(ctx, tok)
-	httpClient.Transport = otelhttp.NewTransport(httpClient.Transport)
-	return &Client{
-		http: httpClient,
+// RevokeToken invalidates the access and refresh tokens contained in tok, so
+// that they can no longer be used to act on behalf of the user.
+func RevokeToken(ctx context.Context, tok *oauth2.Token) error {
+	cfg := OAuthConfig()
+	httpClient := &http.Client{
+		Transport: otelhttp.NewTransport(http.DefaultTransport),
 	}
-}
-
-// GetMeResponse is the structure of the response from
-// https://api.twitter.com/2/users/me
-//
-//	{
-//	  "data": {
-//	    "id": "2244994945",
-//	    "name": "TwitterDev",
-//	    "username": "Twitter Dev"
-//	  }
-//	}
-type GetMeResponse struct {
-	Data struct {
-		ID       string `json:"id"`
-		Name     string `json:"name"`
-		Username string `json:"username"`
-	} `json:"data"`
-}
 
-func (c *Client) GetMe(ctx context.Context) (*GetMeResponse, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.twitter.com/2/users/me", nil)
-	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
+	revoke := func(token, hint string) error {
+		form := url.Values{
+			"token":           {token},
+			"token_type_hint": {hint},
+		}
+		req, err := http.NewRequestWithContext(
+			ctx, http.MethodPost, RevokeURL, strings.NewReader(form.Encode()),
+		)
+		if err != nil {
+			return fmt.Errorf("creating request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth(cfg.ClientID, cfg.ClientSecret)
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("post request: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(resp.Body)
+			return fmt.Errorf(
+				"unexpected status %d revoking %s: %s", resp.StatusCode, hint, body,
+			)
+		}
+		return nil
 	}
 
-	resp, err := c.http.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("get request: %w", err)
+	if tok.RefreshToken != "" {
+		if err := revoke(tok.RefreshToken, "refresh_token"); err != nil {
+			return err
+		}
 	}
-	defer resp.Body.Close()
-	bytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	obj := GetMeResponse{}
-	if err := json.Unmarshal(bytes, &obj); err != nil {
-		return nil, fmt.Errorf("unmarshalling response: %w", err)
+	if tok.AccessToken != "" {
+		if err := revoke(tok.AccessToken, "access_token"); err != nil {
+			return err
+		}
 	}
 
-	return &obj, nil
+	return nil
 }
 
 func generateRandomString(n int) (string, error) {
@@ -0,0 +1,155 @@
+package backend
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/mootslive/mono/backend/db"
+	"github.com/mootslive/mono/backend/trace"
+	"github.com/mootslive/mono/backend/twitter"
+	"golang.org/x/exp/slog"
+	"golang.org/x/oauth2"
+)
+
+func clientForTwitterAccount(
+	ctx context.Context, account db.TwitterAccount, opts ...twitter.ClientOption,
+) *twitter.Client {
+	token := oauth2.Token(account.OauthToken)
+	return twitter.NewClient(ctx, &token, opts...)
+}
+
+// saveRefreshedTwitterToken persists the token in use by client, if it has
+// been refreshed since the client was created for account. This must be done
+// after using a client, as Twitter refresh tokens can only be used once.
+func saveRefreshedTwitterToken(
+	ctx context.Context,
+	queries db.Querier,
+	account db.TwitterAccount,
+	client *twitter.Client,
+) error {
+	tok, err := client.Token()
+	if err != nil {
+		return fmt.Errorf("fetching token: %w", err)
+	}
+	if tok.AccessToken == account.OauthToken.AccessToken {
+		return nil
+	}
+
+	err = queries.UpdateTwitterAccountToken(ctx, db.UpdateTwitterAccountTokenParams{
+		TwitterUserID: account.TwitterUserID,
+		OauthToken:    db.OAuth2Token(*tok),
+	})
+	if err != nil {
+		return fmt.Errorf("saving refreshed token: %w", err)
+	}
+
+	return nil
+}
+
+// twitterProfileRefreshInterval is how often the cached profile of each linked
+// Twitter account is refreshed, in addition to whenever the user logs in.
+const twitterProfileRefreshInterval = time.Hour * 24
+
+func updateTwitterProfile(
+	ctx context.Context, queries db.Querier, user twitter.User,
+) error {
+	err := queries.UpdateTwitterAccountProfile(ctx, db.UpdateTwitterAccountProfileParams{
+		TwitterUserID:   user.ID,
+		Name:            user.Name,
+		Username:        user.Username,
+		ProfileImageUrl: user.ProfileImageURL,
+		ProfileUpdatedAt: sql.NullTime{
+			Valid: true,
+			Time:  time.Now(),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("updating twitter profile: %w", err)
+	}
+	return nil
+}
+
+// TwitterProfileRefresher keeps the cached display name, username and avatar
+// of linked Twitter accounts up to date.
+type TwitterProfileRefresher struct {
+	queries db.TXQuerier
+	log     *slog.Logger
+}
+
+func NewTwitterProfileRefresher(
+	log *slog.Logger, queries db.TXQuerier,
+) *TwitterProfileRefresher {
+	return &TwitterProfileRefresher{
+		log:     log,
+		queries: queries,
+	}
+}
+
+func (tp *TwitterProfileRefresher) Run(ctx context.Context) error {
+	tp.log.Info("starting twitter profile refresher")
+
+	for {
+		accounts, err := tp.queries.ListTwitterAccountsDueProfileRefresh(
+			ctx, time.Now().Add(-twitterProfileRefreshInterval),
+		)
+		if err != nil {
+			return fmt.Errorf("fetching twitter accounts: %w", err)
+		}
+
+		for _, account := range accounts {
+			// A stale profile isn't worth stopping for, it'll be retried on
+			// the next pass.
+			if err := tp.Refresh(ctx, account); err != nil {
+				tp.log.Error("failed to refresh twitter profile", err,
+					slog.String("twitter_user_id", account.TwitterUserID),
+				)
+			}
+		}
+
+		select {
+		case <-time.After(time.Hour):
+			continue
+		case <-ctx.Done():
+			tp.log.Info("context cancelled, stopping twitter profile refresher")
+			return ctx.Err()
+		}
+	}
+}
+
+func (tp *TwitterProfileRefresher) Refresh(
+	ctx context.Context, account db.TwitterAccount,
+) error {
+	ctx, span := trace.Start(ctx, "backend/TwitterProfileRefresher.Refresh")
+	defer span.End()
+
+	client := clientForTwitterAccount(
+		ctx, account, twitter.WithMaxRateLimitWait(0),
+	)
+	// Twitter's refresh tokens can only be used once, so a token refreshed
+	// during the refresh has to be saved however the refresh ends.
+	defer func() {
+		err := saveRefreshedTwitterToken(
+			context.Background(), tp.queries, account, client,
+		)
+		if err != nil {
+			tp.log.Error("failed to save refreshed twitter token", err,
+				slog.String("twitter_user_id", account.TwitterUserID),
+			)
+		}
+	}()
+
+	me, err := client.GetMe(ctx)
+	if err != nil {
+		return fmt.Errorf("requesting me: %w", err)
+	}
+	if me.Data.ID != account.TwitterUserID {
+		return fmt.Errorf(
+			"token belongs to twitter user %s, not %s",
+			me.Data.ID, account.TwitterUserID,
+		)
+	}
+
+	return updateTwitterProfile(ctx, tp.queries, me.Data)
+}
@@ -0,0 +1,322 @@
+package backend
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/mootslive/mono/backend/db"
+	"github.com/mootslive/mono/backend/trace"
+	"github.com/segmentio/ksuid"
+	"github.com/zmb3/spotify/v2"
+	"golang.org/x/exp/slog"
+)
+
+const (
+	tweetKindListen      = "listen"
+	tweetKindWeeklyRecap = "weekly_recap"
+
+	// maxTweetLength is the number of characters Twitter allows in a tweet.
+	maxTweetLength = 280
+	// weeklyRecapTrackCount is how many tracks are listed in a weekly recap.
+	weeklyRecapTrackCount = 5
+)
+
+var errAlreadyTweeted = errors.New("this has already been tweeted")
+
+// defaultTwitterSharingSettings are the settings of a user who has never
+// changed them.
+var defaultTwitterSharingSettings = db.TwitterSharingSetting{
+	ListenSharingEnabled: true,
+	WeeklyRecapEnabled:   false,
+}
+
+func getTwitterSharingSettings(
+	ctx context.Context, queries db.Querier, userID string,
+) (db.TwitterSharingSetting, error) {
+	settings, err := queries.GetTwitterSharingSettings(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		settings = defaultTwitterSharingSettings
+		settings.UserID = userID
+		return settings, nil
+	}
+	return settings, err
+}
+
+// tweeter posts tweets on behalf of users. Every tweet is recorded, keyed by
+// its kind and a dedupe key, and a tweet is only sent if no tweet with that
+// key has been recorded before.
+type tweeter struct {
+	queries db.TXQuerier
+	log     *slog.Logger
+}
+
+func (t *tweeter) tweet(
+	ctx context.Context, userID, kind, dedupeKey, text string,
+) (string, error) {
+	ctx, span := trace.Start(ctx, "backend/tweeter.tweet")
+	defer span.End()
+
+	accounts, err := t.queries.ListTwitterAccountsForUser(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("fetching twitter accounts: %w", err)
+	}
+	if len(accounts) == 0 {
+		return "", fmt.Errorf("user has no linked twitter account")
+	}
+	account := accounts[0]
+
+	// Claim the tweet before sending it, so that concurrent or retried
+	// attempts can't send it twice. If sending fails we release the claim,
+	// but if recording the result fails the claim is left in place: a
+	// missing tweet is better than a duplicate one.
+	id := ksuid.New().String()
+	claimed, err := t.queries.CreateTweet(ctx, db.CreateTweetParams{
+		ID:            id,
+		UserID:        userID,
+		TwitterUserID: account.TwitterUserID,
+		Kind:          kind,
+		DedupeKey:     dedupeKey,
+		Text:          text,
+		CreatedAt:     time.Now(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("recording tweet: %w", err)
+	}
+	if claimed == 0 {
+		return "", errAlreadyTweeted
+	}
+
+	client := clientForTwitterAccount(ctx, account)
+	// Twitter's refresh tokens can only be used once, so a token refreshed
+	// while posting has to be saved whether or not posting succeeds.
+	defer func() {
+		err := saveRefreshedTwitterToken(
+			context.Background(), t.queries, account, client,
+		)
+		if err != nil {
+			t.log.Error("failed to save refreshed twitter token", err,
+				slog.String("twitter_user_id", account.TwitterUserID),
+			)
+		}
+	}()
+
+	posted, err := client.PostTweet(ctx, text)
+	if err != nil {
+		if err := t.queries.DeleteTweet(context.Background(), id); err != nil {
+			t.log.Error("failed to release tweet claim", err, slog.String("id", id))
+		}
+		return "", fmt.Errorf("posting tweet: %w", err)
+	}
+
+	err = t.queries.SetTweetPosted(ctx, db.SetTweetPostedParams{
+		ID: id,
+		TweetID: sql.NullString{
+			Valid:  true,
+			String: posted.Data.ID,
+		},
+		PostedAt: sql.NullTime{
+			Valid: true,
+			Time:  time.Now(),
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("recording posted tweet: %w", err)
+	}
+
+	return posted.Data.ID, nil
+}
+
+// lookupTracksByISRC fetches track details from Spotify, using the first
+// Spotify account linked by the user. ISRCs that Spotify doesn't know of are
+// omitted from the result.
+func lookupTracksByISRC(
+	ctx context.Context, queries db.TXQuerier, userID string, isrcs []string,
+) (map[string]spotify.FullTrack, error) {
+	ctx, span := trace.Start(ctx, "backend/lookupTracksByISRC")
+	defer span.End()
+
+	accounts, err := queries.ListSpotifyAccountsForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching spotify accounts: %w", err)
+	}
+	if len(accounts) == 0 {
+		return nil, fmt.Errorf("user has no linked spotify account")
+	}
+	client := clientForSpotifyAccount(ctx, accounts[0])
+
+	tracks := make(map[string]spotify.FullTrack, len(isrcs))
+	for _, isrc := range isrcs {
+		res, err := client.Search(ctx, "isrc:"+isrc, spotify.SearchTypeTrack)
+		if err != nil {
+			return nil, fmt.Errorf("searching for isrc %s: %w", isrc, err)
+		}
+		if res.Tracks == nil || len(res.Tracks.Tracks) == 0 {
+			continue
+		}
+		tracks[isrc] = res.Tracks.Tracks[0]
+		if err := upsertTrack(ctx, queries, res.Tracks.Tracks[0]); err != nil {
+			return nil, err
+		}
+	}
+
+	return tracks, nil
+}
+
+func formatArtists(artists []spotify.SimpleArtist) string {
+	names := make([]string, 0, len(artists))
+	for _, artist := range artists {
+		names = append(names, artist.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func formatListenTweet(track spotify.FullTrack) string {
+	text := fmt.Sprintf(
+		"🎶 Listening to %s by %s", track.Name, formatArtists(track.Artists),
+	)
+	if url, ok := track.ExternalURLs["spotify"]; ok {
+		text += "\n" + url
+	}
+	return truncateTweet(text)
+}
+
+func formatWeeklyRecapTweet(tracks []spotify.FullTrack) string {
+	var sb strings.Builder
+	sb.WriteString("🎧 My top tracks this week on moots.live:")
+	for i, track := range tracks {
+		line := fmt.Sprintf(
+			"\n%d. %s - %s", i+1, track.Name, formatArtists(track.Artists),
+		)
+		// Drop whole lines rather than cutting one off mid-way.
+		if utf8.RuneCountInString(sb.String()+line) > maxTweetLength {
+			break
+		}
+		sb.WriteString(line)
+	}
+	return sb.String()
+}
+
+func truncateTweet(text string) string {
+	if utf8.RuneCountInString(text) <= maxTweetLength {
+		return text
+	}
+	runes := []rune(text)
+	return string(runes[:maxTweetLength-1]) + "…"
+}
+
+// recapWeek returns the start of the most recently completed week, which
+// begins on a Monday in UTC, along with the ISO week identifier for it.
+func recapWeek(now time.Time) (time.Time, string) {
+	today := now.UTC().Truncate(time.Hour * 24)
+	daysSinceMonday := (int(today.Weekday()) + 6) % 7
+	start := today.AddDate(0, 0, -daysSinceMonday-7)
+	year, week := start.ISOWeek()
+	return start, fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// WeeklyRecapTweeter tweets the top tracks of each user who has opted into
+// weekly recaps, once the week has ended.
+type WeeklyRecapTweeter struct {
+	queries db.TXQuerier
+	log     *slog.Logger
+	tweeter *tweeter
+}
+
+func NewWeeklyRecapTweeter(
+	log *slog.Logger, queries db.TXQuerier,
+) *WeeklyRecapTweeter {
+	return &WeeklyRecapTweeter{
+		log:     log,
+		queries: queries,
+		tweeter: &tweeter{
+			log:     log,
+			queries: queries,
+		},
+	}
+}
+
+func (wr *WeeklyRecapTweeter) Run(ctx context.Context) error {
+	wr.log.Info("starting weekly recap tweeter")
+
+	for {
+		start, week := recapWeek(time.Now())
+		userIDs, err := wr.queries.ListUsersDueWeeklyRecap(ctx, week)
+		if err != nil {
+			return fmt.Errorf("fetching users due recap: %w", err)
+		}
+
+		for _, userID := range userIDs {
+			// A failure for one user shouldn't hold up everyone else's
+			// recap, they'll be retried on the next pass.
+			if err := wr.TweetRecap(ctx, userID, start, week); err != nil {
+				wr.log.Error("failed to tweet weekly recap", err,
+					slog.String("user_id", userID),
+					slog.String("week", week),
+				)
+			}
+		}
+
+		select {
+		case <-time.After(time.Minute * 10):
+			continue
+		case <-ctx.Done():
+			wr.log.Info("context cancelled, stopping weekly recap tweeter")
+			return ctx.Err()
+		}
+	}
+}
+
+func (wr *WeeklyRecapTweeter) TweetRecap(
+	ctx context.Context, userID string, start time.Time, week string,
+) error {
+	ctx, span := trace.Start(ctx, "backend/WeeklyRecapTweeter.TweetRecap")
+	defer span.End()
+
+	top, err := wr.queries.ListTopISRCsForUser(ctx, db.ListTopISRCsForUserParams{
+		UserID:    userID,
+		StartTime: start,
+		EndTime:   start.AddDate(0, 0, 7),
+		RowLimit:  weeklyRecapTrackCount,
+	})
+	if err != nil {
+		return fmt.Errorf("fetching top tracks: %w", err)
+	}
+	// Nothing worth tweeting about, and no claim is made so that a late
+	// import can still produce a recap.
+	if len(top) == 0 {
+		return nil
+	}
+
+	isrcs := make([]string, 0, len(top))
+	for _, row := range top {
+		isrcs = append(isrcs, row.Isrc)
+	}
+	found, err := lookupTracksByISRC(ctx, wr.queries, userID, isrcs)
+	if err != nil {
+		return fmt.Errorf("looking up tracks: %w", err)
+	}
+	tracks := make([]spotify.FullTrack, 0, len(isrcs))
+	for _, isrc := range isrcs {
+		if track, ok := found[isrc]; ok {
+			tracks = append(tracks, track)
+		}
+	}
+	if len(tracks) == 0 {
+		return nil
+	}
+
+	_, err = wr.tweeter.tweet(
+		ctx, userID, tweetKindWeeklyRecap, week, formatWeeklyRecapTweet(tracks),
+	)
+	if err != nil && !errors.Is(err, errAlreadyTweeted) {
+		return fmt.Errorf("tweeting recap: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,396 @@
+package backend
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/mootslive/mono/backend/db"
+	mootslivepbv1 "github.com/mootslive/mono/proto/mootslive/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// watchReplayOverlap is how far before the latest listen sent to a
+	// watcher that listens are fetched again. Listens become visible when
+	// the transaction recording them commits, which can be after listens
+	// with a later created_at have already been sent.
+	watchReplayOverlap = time.Minute
+	watchPageSize      = 500
+	// presenceListeningWindow is how long after their latest listen that a
+	// user is considered to be listening.
+	presenceListeningWindow = time.Minute * 10
+	// watchPresenceInterval is how often watchers check whether users have
+	// stopped listening.
+	watchPresenceInterval = time.Second * 30
+	// watchUsersInterval is how often watchers pick up changes to the moots
+	// of the caller, and to the privacy settings of those being watched.
+	watchUsersInterval = time.Minute * 5
+)
+
+// watchCursor is the position of a watcher in the listens it is watching.
+// Clients receive it as an opaque resume token, which they can use to pick
+// up where they left off if they reconnect.
+type watchCursor struct {
+	CreatedAt time.Time `json:"t"`
+	// NotificationsUpdatedAt is unset in tokens issued before notifications
+	// were sent to watchers, in which case none are caught up on.
+	NotificationsUpdatedAt time.Time `json:"n,omitempty"`
+}
+
+func (c watchCursor) encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeWatchCursor(token string) (watchCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return watchCursor{}, fmt.Errorf("decoding resume token: %w", err)
+	}
+	c := watchCursor{}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return watchCursor{}, fmt.Errorf("unmarshalling resume token: %w", err)
+	}
+	return c, nil
+}
+
+// watchedUsers returns the users whose listens are sent to a user watching
+// listens, which is themselves and those of their moots whose listens they
+// can see, along with the privacy policy covering each.
+func watchedUsers(
+	ctx context.Context, queries db.Querier, userID string,
+) ([]string, map[string]*privacyPolicy, error) {
+	mootIDs, err := queries.ListMootIDsForUser(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fetching moots: %w", err)
+	}
+	candidates := append([]string{userID}, mootIDs...)
+	policies, err := loadPrivacyPolicies(ctx, queries, userID, candidates)
+	if err != nil {
+		return nil, nil, err
+	}
+	userIDs := make([]string, 0, len(candidates))
+	for _, id := range candidates {
+		if policies[id].canView() {
+			userIDs = append(userIDs, id)
+		}
+	}
+	return userIDs, policies, nil
+}
+
+// listenWatcher sends listens and presence changes down a WatchListens
+// stream. Listens are delivered at least once: a listen may be sent again
+// after a reconnect, so clients should de-duplicate them by ID.
+type listenWatcher struct {
+	queries db.Querier
+	stream  *connect.ServerStream[mootslivepbv1.WatchListensResponse]
+	sub     *subscription
+	// userID is the user doing the watching.
+	userID string
+
+	userIDs  []string
+	policies map[string]*privacyPolicy
+	cursor   watchCursor
+	// seen holds the IDs of listens sent within watchReplayOverlap of the
+	// cursor, along with when they were created, so they aren't sent twice.
+	seen map[string]time.Time
+	// lastListened and listening hold the presence of each watched user, as
+	// last sent to the client.
+	lastListened map[string]time.Time
+	listening    map[string]bool
+	// notified holds when each notification updated within
+	// watchReplayOverlap of the cursor was last updated, as sent to the
+	// client, so unchanged notifications aren't sent twice.
+	notified map[string]time.Time
+	// unread is the unread notification count last sent to the client, or
+	// -1 if none has been sent.
+	unread int64
+}
+
+func (lw *listenWatcher) send(res *mootslivepbv1.WatchListensResponse) error {
+	res.ResumeToken = lw.cursor.encode()
+	if err := lw.stream.Send(res); err != nil {
+		return fmt.Errorf("sending: %w", err)
+	}
+	return nil
+}
+
+// skipExisting marks the listens created shortly before the cursor as seen,
+// so a watcher starting afresh only sends listens recorded after it started.
+func (lw *listenWatcher) skipExisting(ctx context.Context) error {
+	// Paged through in the same way as catchUp, as a scan may have created
+	// more than a page of listens within the overlap.
+	since := lw.cursor.CreatedAt.Add(-watchReplayOverlap)
+	afterID := ""
+	for {
+		listens, err := lw.queries.ListListensCreatedSince(ctx, db.ListListensCreatedSinceParams{
+			UserIds:      lw.userIDs,
+			CreatedSince: since,
+			AfterID:      afterID,
+			RowLimit:     watchPageSize,
+		})
+		if err != nil {
+			return fmt.Errorf("fetching listens: %w", err)
+		}
+		for _, listen := range listens {
+			lw.seen[listen.ID] = listen.CreatedAt
+		}
+
+		if len(listens) < watchPageSize {
+			return nil
+		}
+		last := listens[len(listens)-1]
+		since, afterID = last.CreatedAt, last.ID
+	}
+}
+
+// catchUp sends any listens recorded since the cursor that haven't already
+// been sent. When resync is set, events may have been missed, so the users
+// being watched, their privacy settings and their presence are all fetched
+// afresh, and every listen within watchReplayOverlap of the cursor is sent
+// again in case it has since been edited.
+func (lw *listenWatcher) catchUp(ctx context.Context, resync bool) error {
+	if resync {
+		lw.seen = map[string]time.Time{}
+		userIDs, policies, err := watchedUsers(ctx, lw.queries, lw.userID)
+		if err != nil {
+			return err
+		}
+		if err := lw.refreshUsers(ctx, userIDs, policies, true); err != nil {
+			return err
+		}
+	}
+
+	// Listens are paged through by when they were created and then by ID,
+	// as many can be created at the same instant when a scan records them.
+	since := lw.cursor.CreatedAt.Add(-watchReplayOverlap)
+	afterID := ""
+	for {
+		listens, err := lw.queries.ListListensCreatedSince(ctx, db.ListListensCreatedSinceParams{
+			UserIds:      lw.userIDs,
+			CreatedSince: since,
+			AfterID:      afterID,
+			RowLimit:     watchPageSize,
+		})
+		if err != nil {
+			return fmt.Errorf("fetching listens: %w", err)
+		}
+
+		for _, listen := range listens {
+			if _, ok := lw.seen[listen.ID]; ok {
+				continue
+			}
+			lw.seen[listen.ID] = listen.CreatedAt
+			if listen.CreatedAt.After(lw.cursor.CreatedAt) {
+				lw.cursor.CreatedAt = listen.CreatedAt
+			}
+			if !lw.policies[listen.UserID].allows(listen) {
+				continue
+			}
+
+			err := lw.send(&mootslivepbv1.WatchListensResponse{
+				Event: &mootslivepbv1.WatchListensResponse_Listen{
+					Listen: listenToProto(listen),
+				},
+			})
+			if err != nil {
+				return err
+			}
+			if err := lw.observeListen(listen.UserID, listen.ListenedAt); err != nil {
+				return err
+			}
+		}
+
+		if len(listens) < watchPageSize {
+			break
+		}
+		last := listens[len(listens)-1]
+		since, afterID = last.CreatedAt, last.ID
+	}
+
+	for id, createdAt := range lw.seen {
+		if createdAt.Before(lw.cursor.CreatedAt.Add(-watchReplayOverlap)) {
+			delete(lw.seen, id)
+		}
+	}
+	return nil
+}
+
+func (lw *listenWatcher) sendPresence(userID string) error {
+	presence := &mootslivepbv1.Presence{
+		UserId: userID,
+		Status: mootslivepbv1.PresenceStatus_PRESENCE_STATUS_IDLE,
+	}
+	if lw.listening[userID] {
+		presence.Status = mootslivepbv1.PresenceStatus_PRESENCE_STATUS_LISTENING
+	}
+	if last, ok := lw.lastListened[userID]; ok {
+		presence.LastListenedAt = timestamppb.New(last)
+	}
+	return lw.send(&mootslivepbv1.WatchListensResponse{
+		Event: &mootslivepbv1.WatchListensResponse_Presence{
+			Presence: presence,
+		},
+	})
+}
+
+// observeListen updates the presence of a user following a listen, sending
+// the change if it means they're now listening.
+func (lw *listenWatcher) observeListen(userID string, listenedAt time.Time) error {
+	if listenedAt.After(lw.lastListened[userID]) {
+		lw.lastListened[userID] = listenedAt
+	}
+	listening := time.Since(lw.lastListened[userID]) < presenceListeningWindow
+	if listening == lw.listening[userID] {
+		return nil
+	}
+	lw.listening[userID] = listening
+	return lw.sendPresence(userID)
+}
+
+// expirePresence sends the change in presence of users who were listening,
+// but haven't listened to anything recently.
+func (lw *listenWatcher) expirePresence() error {
+	for userID, listening := range lw.listening {
+		if !listening {
+			continue
+		}
+		if time.Since(lw.lastListened[userID]) < presenceListeningWindow {
+			continue
+		}
+		lw.listening[userID] = false
+		if err := lw.sendPresence(userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// watch starts watching the given users, sending their current presence.
+func (lw *listenWatcher) watch(ctx context.Context, userIDs []string) error {
+	latest, err := lw.queries.ListLatestListens(ctx, userIDs)
+	if err != nil {
+		return fmt.Errorf("fetching latest listens: %w", err)
+	}
+	for _, listen := range latest {
+		// Falling back to an earlier listen would still give away when the
+		// hidden one happened, so the user's presence is left unknown until
+		// they next listen to something that isn't hidden.
+		if lw.policies[listen.UserID].allows(listen) {
+			lw.lastListened[listen.UserID] = listen.ListenedAt
+		}
+	}
+	for _, userID := range userIDs {
+		lw.listening[userID] = time.Since(lw.lastListened[userID]) < presenceListeningWindow
+		if err := lw.sendPresence(userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// refreshUsers picks up changes to the users being watched, and their privacy
+// settings. The presence of users who were already being watched is only sent
+// again when all is set.
+func (lw *listenWatcher) refreshUsers(
+	ctx context.Context,
+	userIDs []string,
+	policies map[string]*privacyPolicy,
+	all bool,
+) error {
+	current := make(map[string]bool, len(userIDs))
+	added := []string{}
+	for _, id := range userIDs {
+		current[id] = true
+		if _, ok := lw.listening[id]; !ok || all {
+			added = append(added, id)
+		}
+	}
+	for id := range lw.listening {
+		if !current[id] {
+			delete(lw.listening, id)
+			delete(lw.lastListened, id)
+		}
+	}
+
+	lw.userIDs = userIDs
+	lw.policies = policies
+	lw.sub.setUserIDs(eventKindListens, userIDs)
+	if len(added) == 0 {
+		return nil
+	}
+	return lw.watch(ctx, added)
+}
+
+// catchUpNotifications sends the caller's notifications that have changed
+// since the cursor, along with their unread count if it has changed.
+func (lw *listenWatcher) catchUpNotifications(ctx context.Context) error {
+	changed := []db.Notification{}
+	since := lw.cursor.NotificationsUpdatedAt.Add(-watchReplayOverlap)
+	for {
+		notifications, err := lw.queries.ListNotificationsUpdatedSince(
+			ctx, db.ListNotificationsUpdatedSinceParams{
+				UserID:       lw.userID,
+				UpdatedSince: since,
+				RowLimit:     watchPageSize,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("fetching notifications: %w", err)
+		}
+
+		for _, n := range notifications {
+			if sent, ok := lw.notified[n.ID]; ok && !n.UpdatedAt.After(sent) {
+				continue
+			}
+			lw.notified[n.ID] = n.UpdatedAt
+			if n.UpdatedAt.After(lw.cursor.NotificationsUpdatedAt) {
+				lw.cursor.NotificationsUpdatedAt = n.UpdatedAt
+			}
+			changed = append(changed, n)
+		}
+
+		if len(notifications) < watchPageSize {
+			break
+		}
+		next := notifications[len(notifications)-1].UpdatedAt
+		if !next.After(since) {
+			break
+		}
+		since = next
+	}
+
+	for id, updatedAt := range lw.notified {
+		if updatedAt.Before(lw.cursor.NotificationsUpdatedAt.Add(-watchReplayOverlap)) {
+			delete(lw.notified, id)
+		}
+	}
+
+	// Marking notifications as read changes the count without changing any
+	// notifications.
+	unread, err := lw.queries.CountUnreadNotifications(ctx, lw.userID)
+	if err != nil {
+		return fmt.Errorf("counting unread notifications: %w", err)
+	}
+	if len(changed) == 0 && unread == lw.unread {
+		return nil
+	}
+	lw.unread = unread
+
+	pbs, err := notificationsToProto(ctx, lw.queries, changed)
+	if err != nil {
+		return err
+	}
+	return lw.send(&mootslivepbv1.WatchListensResponse{
+		Event: &mootslivepbv1.WatchListensResponse_Notifications{
+			Notifications: &mootslivepbv1.NotificationsUpdate{
+				Notifications: pbs,
+				UnreadCount:   unread,
+			},
+		},
+	})
+}
@@ -9,6 +9,7 @@ package mootslivepbv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -21,364 +22,833 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type GetStatusRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
+type SpotifyScanResult int32
 
-func (x *GetStatusRequest) Reset() {
-	*x = GetStatusRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mootslive_v1_mootslive_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+const (
+	// SPOTIFY_SCAN_RESULT_UNSPECIFIED scans are still running, or were
+	// interrupted.
+	SpotifyScanResult_SPOTIFY_SCAN_RESULT_UNSPECIFIED SpotifyScanResult = 0
+	SpotifyScanResult_SPOTIFY_SCAN_RESULT_SUCCEEDED   SpotifyScanResult = 1
+	SpotifyScanResult_SPOTIFY_SCAN_RESULT_FAILED      SpotifyScanResult = 2
+)
+
+// Enum value maps for SpotifyScanResult.
+var (
+	SpotifyScanResult_name = map[int32]string{
+		0: "SPOTIFY_SCAN_RESULT_UNSPECIFIED",
+		1: "SPOTIFY_SCAN_RESULT_SUCCEEDED",
+		2: "SPOTIFY_SCAN_RESULT_FAILED",
+	}
+	SpotifyScanResult_value = map[string]int32{
+		"SPOTIFY_SCAN_RESULT_UNSPECIFIED": 0,
+		"SPOTIFY_SCAN_RESULT_SUCCEEDED":   1,
+		"SPOTIFY_SCAN_RESULT_FAILED":      2,
 	}
+)
+
+func (x SpotifyScanResult) Enum() *SpotifyScanResult {
+	p := new(SpotifyScanResult)
+	*p = x
+	return p
 }
 
-func (x *GetStatusRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x SpotifyScanResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetStatusRequest) ProtoMessage() {}
+func (SpotifyScanResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_mootslive_v1_mootslive_proto_enumTypes[0].Descriptor()
+}
 
-func (x *GetStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mootslive_v1_mootslive_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (SpotifyScanResult) Type() protoreflect.EnumType {
+	return &file_mootslive_v1_mootslive_proto_enumTypes[0]
 }
 
-// Deprecated: Use GetStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetStatusRequest) Descriptor() ([]byte, []int) {
+func (x SpotifyScanResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SpotifyScanResult.Descriptor instead.
+func (SpotifyScanResult) EnumDescriptor() ([]byte, []int) {
 	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{0}
 }
 
-type GetStatusResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type AuditEventKind int32
 
-	XClacksOverhead string `protobuf:"bytes,1,opt,name=x_clacks_overhead,json=xClacksOverhead,proto3" json:"x_clacks_overhead,omitempty"`
-}
+const (
+	AuditEventKind_AUDIT_EVENT_KIND_UNSPECIFIED AuditEventKind = 0
+	AuditEventKind_AUDIT_EVENT_KIND_SIGNUP      AuditEventKind = 1
+	AuditEventKind_AUDIT_EVENT_KIND_LOGIN       AuditEventKind = 2
+	// AUDIT_EVENT_KIND_TOKEN_ISSUED events are recorded when a user is issued an
+	// ID token, which happens as part of signing up or logging in.
+	AuditEventKind_AUDIT_EVENT_KIND_TOKEN_ISSUED AuditEventKind = 3
+	// AUDIT_EVENT_KIND_SESSIONS_REVOKED events are recorded when every token
+	// issued to a user is revoked.
+	AuditEventKind_AUDIT_EVENT_KIND_SESSIONS_REVOKED           AuditEventKind = 4
+	AuditEventKind_AUDIT_EVENT_KIND_PROVIDER_LINKED            AuditEventKind = 5
+	AuditEventKind_AUDIT_EVENT_KIND_PROVIDER_UNLINKED          AuditEventKind = 6
+	AuditEventKind_AUDIT_EVENT_KIND_ACCOUNT_DELETION_REQUESTED AuditEventKind = 7
+	AuditEventKind_AUDIT_EVENT_KIND_ACCOUNT_DELETION_CANCELLED AuditEventKind = 8
+	AuditEventKind_AUDIT_EVENT_KIND_ACCOUNT_DELETED            AuditEventKind = 9
+	AuditEventKind_AUDIT_EVENT_KIND_USER_DISABLED              AuditEventKind = 10
+	AuditEventKind_AUDIT_EVENT_KIND_USER_ENABLED               AuditEventKind = 11
+	AuditEventKind_AUDIT_EVENT_KIND_RESCAN_FORCED              AuditEventKind = 12
+	AuditEventKind_AUDIT_EVENT_KIND_RECAP_GENERATED            AuditEventKind = 13
+)
 
-func (x *GetStatusResponse) Reset() {
-	*x = GetStatusResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mootslive_v1_mootslive_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for AuditEventKind.
+var (
+	AuditEventKind_name = map[int32]string{
+		0:  "AUDIT_EVENT_KIND_UNSPECIFIED",
+		1:  "AUDIT_EVENT_KIND_SIGNUP",
+		2:  "AUDIT_EVENT_KIND_LOGIN",
+		3:  "AUDIT_EVENT_KIND_TOKEN_ISSUED",
+		4:  "AUDIT_EVENT_KIND_SESSIONS_REVOKED",
+		5:  "AUDIT_EVENT_KIND_PROVIDER_LINKED",
+		6:  "AUDIT_EVENT_KIND_PROVIDER_UNLINKED",
+		7:  "AUDIT_EVENT_KIND_ACCOUNT_DELETION_REQUESTED",
+		8:  "AUDIT_EVENT_KIND_ACCOUNT_DELETION_CANCELLED",
+		9:  "AUDIT_EVENT_KIND_ACCOUNT_DELETED",
+		10: "AUDIT_EVENT_KIND_USER_DISABLED",
+		11: "AUDIT_EVENT_KIND_USER_ENABLED",
+		12: "AUDIT_EVENT_KIND_RESCAN_FORCED",
+		13: "AUDIT_EVENT_KIND_RECAP_GENERATED",
 	}
+	AuditEventKind_value = map[string]int32{
+		"AUDIT_EVENT_KIND_UNSPECIFIED":                0,
+		"AUDIT_EVENT_KIND_SIGNUP":                     1,
+		"AUDIT_EVENT_KIND_LOGIN":                      2,
+		"AUDIT_EVENT_KIND_TOKEN_ISSUED":               3,
+		"AUDIT_EVENT_KIND_SESSIONS_REVOKED":           4,
+		"AUDIT_EVENT_KIND_PROVIDER_LINKED":            5,
+		"AUDIT_EVENT_KIND_PROVIDER_UNLINKED":          6,
+		"AUDIT_EVENT_KIND_ACCOUNT_DELETION_REQUESTED": 7,
+		"AUDIT_EVENT_KIND_ACCOUNT_DELETION_CANCELLED": 8,
+		"AUDIT_EVENT_KIND_ACCOUNT_DELETED":            9,
+		"AUDIT_EVENT_KIND_USER_DISABLED":              10,
+		"AUDIT_EVENT_KIND_USER_ENABLED":               11,
+		"AUDIT_EVENT_KIND_RESCAN_FORCED":              12,
+		"AUDIT_EVENT_KIND_RECAP_GENERATED":            13,
+	}
+)
+
+func (x AuditEventKind) Enum() *AuditEventKind {
+	p := new(AuditEventKind)
+	*p = x
+	return p
 }
 
-func (x *GetStatusResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x AuditEventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetStatusResponse) ProtoMessage() {}
+func (AuditEventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_mootslive_v1_mootslive_proto_enumTypes[1].Descriptor()
+}
 
-func (x *GetStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mootslive_v1_mootslive_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (AuditEventKind) Type() protoreflect.EnumType {
+	return &file_mootslive_v1_mootslive_proto_enumTypes[1]
 }
 
-// Deprecated: Use GetStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetStatusResponse) Descriptor() ([]byte, []int) {
+func (x AuditEventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditEventKind.Descriptor instead.
+func (AuditEventKind) EnumDescriptor() ([]byte, []int) {
 	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GetStatusResponse) GetXClacksOverhead() string {
-	if x != nil {
-		return x.XClacksOverhead
+type Provider int32
+
+const (
+	Provider_PROVIDER_UNSPECIFIED Provider = 0
+	Provider_PROVIDER_TWITTER     Provider = 1
+	Provider_PROVIDER_SPOTIFY     Provider = 2
+)
+
+// Enum value maps for Provider.
+var (
+	Provider_name = map[int32]string{
+		0: "PROVIDER_UNSPECIFIED",
+		1: "PROVIDER_TWITTER",
+		2: "PROVIDER_SPOTIFY",
 	}
-	return ""
-}
+	Provider_value = map[string]int32{
+		"PROVIDER_UNSPECIFIED": 0,
+		"PROVIDER_TWITTER":     1,
+		"PROVIDER_SPOTIFY":     2,
+	}
+)
 
-type GetMeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x Provider) Enum() *Provider {
+	p := new(Provider)
+	*p = x
+	return p
 }
 
-func (x *GetMeRequest) Reset() {
-	*x = GetMeRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mootslive_v1_mootslive_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *GetMeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_mootslive_v1_mootslive_proto_enumTypes[2].Descriptor()
 }
 
-func (*GetMeRequest) ProtoMessage() {}
+func (Provider) Type() protoreflect.EnumType {
+	return &file_mootslive_v1_mootslive_proto_enumTypes[2]
+}
 
-func (x *GetMeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mootslive_v1_mootslive_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use GetMeRequest.ProtoReflect.Descriptor instead.
-func (*GetMeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Provider.Descriptor instead.
+func (Provider) EnumDescriptor() ([]byte, []int) {
 	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{2}
 }
 
-type GetMeResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type ListenOrder int32
 
-	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-}
+const (
+	// LISTEN_ORDER_UNSPECIFIED is treated as LISTEN_ORDER_NEWEST_FIRST.
+	ListenOrder_LISTEN_ORDER_UNSPECIFIED  ListenOrder = 0
+	ListenOrder_LISTEN_ORDER_NEWEST_FIRST ListenOrder = 1
+	ListenOrder_LISTEN_ORDER_OLDEST_FIRST ListenOrder = 2
+)
 
-func (x *GetMeResponse) Reset() {
-	*x = GetMeResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mootslive_v1_mootslive_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for ListenOrder.
+var (
+	ListenOrder_name = map[int32]string{
+		0: "LISTEN_ORDER_UNSPECIFIED",
+		1: "LISTEN_ORDER_NEWEST_FIRST",
+		2: "LISTEN_ORDER_OLDEST_FIRST",
 	}
+	ListenOrder_value = map[string]int32{
+		"LISTEN_ORDER_UNSPECIFIED":  0,
+		"LISTEN_ORDER_NEWEST_FIRST": 1,
+		"LISTEN_ORDER_OLDEST_FIRST": 2,
+	}
+)
+
+func (x ListenOrder) Enum() *ListenOrder {
+	p := new(ListenOrder)
+	*p = x
+	return p
 }
 
-func (x *GetMeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ListenOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetMeResponse) ProtoMessage() {}
+func (ListenOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_mootslive_v1_mootslive_proto_enumTypes[3].Descriptor()
+}
 
-func (x *GetMeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mootslive_v1_mootslive_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (ListenOrder) Type() protoreflect.EnumType {
+	return &file_mootslive_v1_mootslive_proto_enumTypes[3]
 }
 
-// Deprecated: Use GetMeResponse.ProtoReflect.Descriptor instead.
-func (*GetMeResponse) Descriptor() ([]byte, []int) {
+func (x ListenOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListenOrder.Descriptor instead.
+func (ListenOrder) EnumDescriptor() ([]byte, []int) {
 	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetMeResponse) GetId() string {
-	if x != nil {
-		return x.Id
+type FollowStatus int32
+
+const (
+	FollowStatus_FOLLOW_STATUS_UNSPECIFIED FollowStatus = 0
+	// FOLLOW_STATUS_PENDING follows are requests awaiting the approval of the
+	// followee.
+	FollowStatus_FOLLOW_STATUS_PENDING  FollowStatus = 1
+	FollowStatus_FOLLOW_STATUS_ACCEPTED FollowStatus = 2
+)
+
+// Enum value maps for FollowStatus.
+var (
+	FollowStatus_name = map[int32]string{
+		0: "FOLLOW_STATUS_UNSPECIFIED",
+		1: "FOLLOW_STATUS_PENDING",
+		2: "FOLLOW_STATUS_ACCEPTED",
 	}
-	return ""
+	FollowStatus_value = map[string]int32{
+		"FOLLOW_STATUS_UNSPECIFIED": 0,
+		"FOLLOW_STATUS_PENDING":     1,
+		"FOLLOW_STATUS_ACCEPTED":    2,
+	}
+)
+
+func (x FollowStatus) Enum() *FollowStatus {
+	p := new(FollowStatus)
+	*p = x
+	return p
 }
 
-func (x *GetMeResponse) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+func (x FollowStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// OAuth2State contains bits of state we need the client to hold during the
-// OAuth2 3-legged flow. These values aren't something that need to be kept
-// secret from the client.
-type OAuth2State struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (FollowStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_mootslive_v1_mootslive_proto_enumTypes[4].Descriptor()
+}
 
-	State            string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	PkceCodeVerifier string `protobuf:"bytes,2,opt,name=pkce_code_verifier,json=pkceCodeVerifier,proto3" json:"pkce_code_verifier,omitempty"`
+func (FollowStatus) Type() protoreflect.EnumType {
+	return &file_mootslive_v1_mootslive_proto_enumTypes[4]
 }
 
-func (x *OAuth2State) Reset() {
-	*x = OAuth2State{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mootslive_v1_mootslive_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x FollowStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *OAuth2State) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use FollowStatus.Descriptor instead.
+func (FollowStatus) EnumDescriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{4}
 }
 
-func (*OAuth2State) ProtoMessage() {}
+type PresenceStatus int32
 
-func (x *OAuth2State) ProtoReflect() protoreflect.Message {
-	mi := &file_mootslive_v1_mootslive_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	PresenceStatus_PRESENCE_STATUS_UNSPECIFIED PresenceStatus = 0
+	PresenceStatus_PRESENCE_STATUS_IDLE        PresenceStatus = 1
+	// PRESENCE_STATUS_LISTENING users have listened to something in the last
+	// ten minutes.
+	PresenceStatus_PRESENCE_STATUS_LISTENING PresenceStatus = 2
+)
+
+// Enum value maps for PresenceStatus.
+var (
+	PresenceStatus_name = map[int32]string{
+		0: "PRESENCE_STATUS_UNSPECIFIED",
+		1: "PRESENCE_STATUS_IDLE",
+		2: "PRESENCE_STATUS_LISTENING",
 	}
-	return mi.MessageOf(x)
-}
+	PresenceStatus_value = map[string]int32{
+		"PRESENCE_STATUS_UNSPECIFIED": 0,
+		"PRESENCE_STATUS_IDLE":        1,
+		"PRESENCE_STATUS_LISTENING":   2,
+	}
+)
 
-// Deprecated: Use OAuth2State.ProtoReflect.Descriptor instead.
-func (*OAuth2State) Descriptor() ([]byte, []int) {
-	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{4}
+func (x PresenceStatus) Enum() *PresenceStatus {
+	p := new(PresenceStatus)
+	*p = x
+	return p
 }
 
-func (x *OAuth2State) GetState() string {
-	if x != nil {
-		return x.State
-	}
-	return ""
+func (x PresenceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *OAuth2State) GetPkceCodeVerifier() string {
-	if x != nil {
-		return x.PkceCodeVerifier
-	}
-	return ""
+func (PresenceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_mootslive_v1_mootslive_proto_enumTypes[5].Descriptor()
 }
 
-type BeginTwitterAuthRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (PresenceStatus) Type() protoreflect.EnumType {
+	return &file_mootslive_v1_mootslive_proto_enumTypes[5]
 }
 
-func (x *BeginTwitterAuthRequest) Reset() {
-	*x = BeginTwitterAuthRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mootslive_v1_mootslive_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x PresenceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *BeginTwitterAuthRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use PresenceStatus.Descriptor instead.
+func (PresenceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{5}
 }
 
-func (*BeginTwitterAuthRequest) ProtoMessage() {}
+// TimeWindow is a period of time ending today.
+type TimeWindow int32
 
-func (x *BeginTwitterAuthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mootslive_v1_mootslive_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	TimeWindow_TIME_WINDOW_UNSPECIFIED   TimeWindow = 0
+	TimeWindow_TIME_WINDOW_LAST_7_DAYS   TimeWindow = 1
+	TimeWindow_TIME_WINDOW_LAST_30_DAYS  TimeWindow = 2
+	TimeWindow_TIME_WINDOW_LAST_90_DAYS  TimeWindow = 3
+	TimeWindow_TIME_WINDOW_LAST_365_DAYS TimeWindow = 4
+	TimeWindow_TIME_WINDOW_ALL_TIME      TimeWindow = 5
+	// TIME_WINDOW_CUSTOM is a range of days given alongside the window, where
+	// supported.
+	TimeWindow_TIME_WINDOW_CUSTOM TimeWindow = 6
+)
+
+// Enum value maps for TimeWindow.
+var (
+	TimeWindow_name = map[int32]string{
+		0: "TIME_WINDOW_UNSPECIFIED",
+		1: "TIME_WINDOW_LAST_7_DAYS",
+		2: "TIME_WINDOW_LAST_30_DAYS",
+		3: "TIME_WINDOW_LAST_90_DAYS",
+		4: "TIME_WINDOW_LAST_365_DAYS",
+		5: "TIME_WINDOW_ALL_TIME",
+		6: "TIME_WINDOW_CUSTOM",
 	}
-	return mi.MessageOf(x)
+	TimeWindow_value = map[string]int32{
+		"TIME_WINDOW_UNSPECIFIED":   0,
+		"TIME_WINDOW_LAST_7_DAYS":   1,
+		"TIME_WINDOW_LAST_30_DAYS":  2,
+		"TIME_WINDOW_LAST_90_DAYS":  3,
+		"TIME_WINDOW_LAST_365_DAYS": 4,
+		"TIME_WINDOW_ALL_TIME":      5,
+		"TIME_WINDOW_CUSTOM":        6,
+	}
+)
+
+func (x TimeWindow) Enum() *TimeWindow {
+	p := new(TimeWindow)
+	*p = x
+	return p
 }
 
-// Deprecated: Use BeginTwitterAuthRequest.ProtoReflect.Descriptor instead.
-func (*BeginTwitterAuthRequest) Descriptor() ([]byte, []int) {
-	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{5}
+func (x TimeWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type BeginTwitterAuthResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (TimeWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_mootslive_v1_mootslive_proto_enumTypes[6].Descriptor()
+}
 
-	RedirectUrl string       `protobuf:"bytes,1,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
-	State       *OAuth2State `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+func (TimeWindow) Type() protoreflect.EnumType {
+	return &file_mootslive_v1_mootslive_proto_enumTypes[6]
 }
 
-func (x *BeginTwitterAuthResponse) Reset() {
-	*x = BeginTwitterAuthResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mootslive_v1_mootslive_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x TimeWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeWindow.Descriptor instead.
+func (TimeWindow) EnumDescriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{6}
+}
+
+type Visibility int32
+
+const (
+	Visibility_VISIBILITY_UNSPECIFIED Visibility = 0
+	// VISIBILITY_PUBLIC listens can be seen by anyone.
+	Visibility_VISIBILITY_PUBLIC Visibility = 1
+	// VISIBILITY_MOOTS listens can only be seen by the user's moots.
+	Visibility_VISIBILITY_MOOTS Visibility = 2
+	// VISIBILITY_PRIVATE listens can only be seen by the user.
+	Visibility_VISIBILITY_PRIVATE Visibility = 3
+)
+
+// Enum value maps for Visibility.
+var (
+	Visibility_name = map[int32]string{
+		0: "VISIBILITY_UNSPECIFIED",
+		1: "VISIBILITY_PUBLIC",
+		2: "VISIBILITY_MOOTS",
+		3: "VISIBILITY_PRIVATE",
+	}
+	Visibility_value = map[string]int32{
+		"VISIBILITY_UNSPECIFIED": 0,
+		"VISIBILITY_PUBLIC":      1,
+		"VISIBILITY_MOOTS":       2,
+		"VISIBILITY_PRIVATE":     3,
 	}
+)
+
+func (x Visibility) Enum() *Visibility {
+	p := new(Visibility)
+	*p = x
+	return p
 }
 
-func (x *BeginTwitterAuthResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*BeginTwitterAuthResponse) ProtoMessage() {}
+func (Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_mootslive_v1_mootslive_proto_enumTypes[7].Descriptor()
+}
 
-func (x *BeginTwitterAuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mootslive_v1_mootslive_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (Visibility) Type() protoreflect.EnumType {
+	return &file_mootslive_v1_mootslive_proto_enumTypes[7]
+}
+
+func (x Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Visibility.Descriptor instead.
+func (Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{7}
+}
+
+type NotificationKind int32
+
+const (
+	NotificationKind_NOTIFICATION_KIND_UNSPECIFIED     NotificationKind = 0
+	NotificationKind_NOTIFICATION_KIND_NEW_FOLLOWER    NotificationKind = 1
+	NotificationKind_NOTIFICATION_KIND_FOLLOW_REQUEST  NotificationKind = 2
+	NotificationKind_NOTIFICATION_KIND_FOLLOW_ACCEPTED NotificationKind = 3
+	// NOTIFICATION_KIND_REACTION targets the listen that was reacted to.
+	NotificationKind_NOTIFICATION_KIND_REACTION NotificationKind = 4
+	// NOTIFICATION_KIND_COMMENT targets the listen that was commented on.
+	NotificationKind_NOTIFICATION_KIND_COMMENT NotificationKind = 5
+	// NOTIFICATION_KIND_SAME_SONG targets the ISRC of a song that the user and
+	// a moot are listening to at the same time.
+	NotificationKind_NOTIFICATION_KIND_SAME_SONG NotificationKind = 6
+)
+
+// Enum value maps for NotificationKind.
+var (
+	NotificationKind_name = map[int32]string{
+		0: "NOTIFICATION_KIND_UNSPECIFIED",
+		1: "NOTIFICATION_KIND_NEW_FOLLOWER",
+		2: "NOTIFICATION_KIND_FOLLOW_REQUEST",
+		3: "NOTIFICATION_KIND_FOLLOW_ACCEPTED",
+		4: "NOTIFICATION_KIND_REACTION",
+		5: "NOTIFICATION_KIND_COMMENT",
+		6: "NOTIFICATION_KIND_SAME_SONG",
 	}
-	return mi.MessageOf(x)
+	NotificationKind_value = map[string]int32{
+		"NOTIFICATION_KIND_UNSPECIFIED":     0,
+		"NOTIFICATION_KIND_NEW_FOLLOWER":    1,
+		"NOTIFICATION_KIND_FOLLOW_REQUEST":  2,
+		"NOTIFICATION_KIND_FOLLOW_ACCEPTED": 3,
+		"NOTIFICATION_KIND_REACTION":        4,
+		"NOTIFICATION_KIND_COMMENT":         5,
+		"NOTIFICATION_KIND_SAME_SONG":       6,
+	}
+)
+
+func (x NotificationKind) Enum() *NotificationKind {
+	p := new(NotificationKind)
+	*p = x
+	return p
 }
 
-// Deprecated: Use BeginTwitterAuthResponse.ProtoReflect.Descriptor instead.
-func (*BeginTwitterAuthResponse) Descriptor() ([]byte, []int) {
-	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{6}
+func (x NotificationKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *BeginTwitterAuthResponse) GetRedirectUrl() string {
-	if x != nil {
-		return x.RedirectUrl
+func (NotificationKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_mootslive_v1_mootslive_proto_enumTypes[8].Descriptor()
+}
+
+func (NotificationKind) Type() protoreflect.EnumType {
+	return &file_mootslive_v1_mootslive_proto_enumTypes[8]
+}
+
+func (x NotificationKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationKind.Descriptor instead.
+func (NotificationKind) EnumDescriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{8}
+}
+
+type ChartScope int32
+
+const (
+	ChartScope_CHART_SCOPE_UNSPECIFIED ChartScope = 0
+	ChartScope_CHART_SCOPE_GLOBAL      ChartScope = 1
+	// CHART_SCOPE_MOOTS counts the listening of the caller's moots.
+	ChartScope_CHART_SCOPE_MOOTS ChartScope = 2
+)
+
+// Enum value maps for ChartScope.
+var (
+	ChartScope_name = map[int32]string{
+		0: "CHART_SCOPE_UNSPECIFIED",
+		1: "CHART_SCOPE_GLOBAL",
+		2: "CHART_SCOPE_MOOTS",
 	}
-	return ""
+	ChartScope_value = map[string]int32{
+		"CHART_SCOPE_UNSPECIFIED": 0,
+		"CHART_SCOPE_GLOBAL":      1,
+		"CHART_SCOPE_MOOTS":       2,
+	}
+)
+
+func (x ChartScope) Enum() *ChartScope {
+	p := new(ChartScope)
+	*p = x
+	return p
 }
 
-func (x *BeginTwitterAuthResponse) GetState() *OAuth2State {
-	if x != nil {
-		return x.State
+func (x ChartScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChartScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_mootslive_v1_mootslive_proto_enumTypes[9].Descriptor()
+}
+
+func (ChartScope) Type() protoreflect.EnumType {
+	return &file_mootslive_v1_mootslive_proto_enumTypes[9]
+}
+
+func (x ChartScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChartScope.Descriptor instead.
+func (ChartScope) EnumDescriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{9}
+}
+
+// ChartPeriod is the length of time a chart covers. Periods are in UTC, with
+// weeks starting on Monday.
+type ChartPeriod int32
+
+const (
+	ChartPeriod_CHART_PERIOD_UNSPECIFIED ChartPeriod = 0
+	ChartPeriod_CHART_PERIOD_DAILY       ChartPeriod = 1
+	ChartPeriod_CHART_PERIOD_WEEKLY      ChartPeriod = 2
+	ChartPeriod_CHART_PERIOD_MONTHLY     ChartPeriod = 3
+)
+
+// Enum value maps for ChartPeriod.
+var (
+	ChartPeriod_name = map[int32]string{
+		0: "CHART_PERIOD_UNSPECIFIED",
+		1: "CHART_PERIOD_DAILY",
+		2: "CHART_PERIOD_WEEKLY",
+		3: "CHART_PERIOD_MONTHLY",
 	}
-	return nil
+	ChartPeriod_value = map[string]int32{
+		"CHART_PERIOD_UNSPECIFIED": 0,
+		"CHART_PERIOD_DAILY":       1,
+		"CHART_PERIOD_WEEKLY":      2,
+		"CHART_PERIOD_MONTHLY":     3,
+	}
+)
+
+func (x ChartPeriod) Enum() *ChartPeriod {
+	p := new(ChartPeriod)
+	*p = x
+	return p
 }
 
-type FinishTwitterAuthRequest struct {
+func (x ChartPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChartPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_mootslive_v1_mootslive_proto_enumTypes[10].Descriptor()
+}
+
+func (ChartPeriod) Type() protoreflect.EnumType {
+	return &file_mootslive_v1_mootslive_proto_enumTypes[10]
+}
+
+func (x ChartPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChartPeriod.Descriptor instead.
+func (ChartPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{10}
+}
+
+// ChartMovement is how an entry has moved since the chart of the previous
+// period.
+type ChartMovement int32
+
+const (
+	ChartMovement_CHART_MOVEMENT_UNSPECIFIED ChartMovement = 0
+	ChartMovement_CHART_MOVEMENT_UP          ChartMovement = 1
+	ChartMovement_CHART_MOVEMENT_DOWN        ChartMovement = 2
+	// CHART_MOVEMENT_NONE is an entry at the same rank as before.
+	ChartMovement_CHART_MOVEMENT_NONE ChartMovement = 3
+	// CHART_MOVEMENT_NEW is an entry that has never been in the chart before.
+	ChartMovement_CHART_MOVEMENT_NEW ChartMovement = 4
+	// CHART_MOVEMENT_RE_ENTRY is an entry that wasn't in the previous chart,
+	// but has been in an earlier one.
+	ChartMovement_CHART_MOVEMENT_RE_ENTRY ChartMovement = 5
+)
+
+// Enum value maps for ChartMovement.
+var (
+	ChartMovement_name = map[int32]string{
+		0: "CHART_MOVEMENT_UNSPECIFIED",
+		1: "CHART_MOVEMENT_UP",
+		2: "CHART_MOVEMENT_DOWN",
+		3: "CHART_MOVEMENT_NONE",
+		4: "CHART_MOVEMENT_NEW",
+		5: "CHART_MOVEMENT_RE_ENTRY",
+	}
+	ChartMovement_value = map[string]int32{
+		"CHART_MOVEMENT_UNSPECIFIED": 0,
+		"CHART_MOVEMENT_UP":          1,
+		"CHART_MOVEMENT_DOWN":        2,
+		"CHART_MOVEMENT_NONE":        3,
+		"CHART_MOVEMENT_NEW":         4,
+		"CHART_MOVEMENT_RE_ENTRY":    5,
+	}
+)
+
+func (x ChartMovement) Enum() *ChartMovement {
+	p := new(ChartMovement)
+	*p = x
+	return p
+}
+
+func (x ChartMovement) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChartMovement) Descriptor() protoreflect.EnumDescriptor {
+	return file_mootslive_v1_mootslive_proto_enumTypes[11].Descriptor()
+}
+
+func (ChartMovement) Type() protoreflect.EnumType {
+	return &file_mootslive_v1_mootslive_proto_enumTypes[11]
+}
+
+func (x ChartMovement) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChartMovement.Descriptor instead.
+func (ChartMovement) EnumDescriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{11}
+}
+
+type ListenEditAction int32
+
+const (
+	ListenEditAction_LISTEN_EDIT_ACTION_UNSPECIFIED ListenEditAction = 0
+	ListenEditAction_LISTEN_EDIT_ACTION_CREATE      ListenEditAction = 1
+	ListenEditAction_LISTEN_EDIT_ACTION_UPDATE      ListenEditAction = 2
+	ListenEditAction_LISTEN_EDIT_ACTION_DELETE      ListenEditAction = 3
+)
+
+// Enum value maps for ListenEditAction.
+var (
+	ListenEditAction_name = map[int32]string{
+		0: "LISTEN_EDIT_ACTION_UNSPECIFIED",
+		1: "LISTEN_EDIT_ACTION_CREATE",
+		2: "LISTEN_EDIT_ACTION_UPDATE",
+		3: "LISTEN_EDIT_ACTION_DELETE",
+	}
+	ListenEditAction_value = map[string]int32{
+		"LISTEN_EDIT_ACTION_UNSPECIFIED": 0,
+		"LISTEN_EDIT_ACTION_CREATE":      1,
+		"LISTEN_EDIT_ACTION_UPDATE":      2,
+		"LISTEN_EDIT_ACTION_DELETE":      3,
+	}
+)
+
+func (x ListenEditAction) Enum() *ListenEditAction {
+	p := new(ListenEditAction)
+	*p = x
+	return p
+}
+
+func (x ListenEditAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListenEditAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_mootslive_v1_mootslive_proto_enumTypes[12].Descriptor()
+}
+
+func (ListenEditAction) Type() protoreflect.EnumType {
+	return &file_mootslive_v1_mootslive_proto_enumTypes[12]
+}
+
+func (x ListenEditAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListenEditAction.Descriptor instead.
+func (ListenEditAction) EnumDescriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{12}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 1
+	// EXPORT_FORMAT_JSONL is a JSON object per line.
+	ExportFormat_EXPORT_FORMAT_JSONL ExportFormat = 2
+	// EXPORT_FORMAT_LISTENBRAINZ is a JSON array of listens, as ListenBrainz
+	// imports. Listens of tracks we don't hold metadata for are left out, as
+	// ListenBrainz requires a track and artist name.
+	ExportFormat_EXPORT_FORMAT_LISTENBRAINZ ExportFormat = 3
+	// EXPORT_FORMAT_SCROBBLER_LOG is an Audioscrobbler .scrobbler.log, which
+	// Last.fm scrobblers can submit. Listens of tracks we don't hold metadata
+	// for are left out.
+	ExportFormat_EXPORT_FORMAT_SCROBBLER_LOG ExportFormat = 4
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_JSONL",
+		3: "EXPORT_FORMAT_LISTENBRAINZ",
+		4: "EXPORT_FORMAT_SCROBBLER_LOG",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED":   0,
+		"EXPORT_FORMAT_CSV":           1,
+		"EXPORT_FORMAT_JSONL":         2,
+		"EXPORT_FORMAT_LISTENBRAINZ":  3,
+		"EXPORT_FORMAT_SCROBBLER_LOG": 4,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_mootslive_v1_mootslive_proto_enumTypes[13].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_mootslive_v1_mootslive_proto_enumTypes[13]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{13}
+}
+
+type GetStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	State *OAuth2State `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	// received_state is the state url parameter received during the callback to
-	// the webapp from the relying party.
-	ReceivedState string `protobuf:"bytes,2,opt,name=received_state,json=receivedState,proto3" json:"received_state,omitempty"`
-	ReceivedCode  string `protobuf:"bytes,3,opt,name=received_code,json=receivedCode,proto3" json:"received_code,omitempty"`
 }
 
-func (x *FinishTwitterAuthRequest) Reset() {
-	*x = FinishTwitterAuthRequest{}
+func (x *GetStatusRequest) Reset() {
+	*x = GetStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mootslive_v1_mootslive_proto_msgTypes[7]
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FinishTwitterAuthRequest) String() string {
+func (x *GetStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FinishTwitterAuthRequest) ProtoMessage() {}
+func (*GetStatusRequest) ProtoMessage() {}
 
-func (x *FinishTwitterAuthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mootslive_v1_mootslive_proto_msgTypes[7]
+func (x *GetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -389,57 +859,37 @@ func (x *FinishTwitterAuthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FinishTwitterAuthRequest.ProtoReflect.Descriptor instead.
-func (*FinishTwitterAuthRequest) Descriptor() ([]byte, []int) {
-	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *FinishTwitterAuthRequest) GetState() *OAuth2State {
-	if x != nil {
-		return x.State
-	}
-	return nil
-}
-
-func (x *FinishTwitterAuthRequest) GetReceivedState() string {
-	if x != nil {
-		return x.ReceivedState
-	}
-	return ""
-}
-
-func (x *FinishTwitterAuthRequest) GetReceivedCode() string {
-	if x != nil {
-		return x.ReceivedCode
-	}
-	return ""
+// Deprecated: Use GetStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{0}
 }
 
-type FinishTwitterAuthResponse struct {
+type StatusCheck struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IdToken string `protobuf:"bytes,1,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Healthy bool   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
 }
 
-func (x *FinishTwitterAuthResponse) Reset() {
-	*x = FinishTwitterAuthResponse{}
+func (x *StatusCheck) Reset() {
+	*x = StatusCheck{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mootslive_v1_mootslive_proto_msgTypes[8]
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FinishTwitterAuthResponse) String() string {
+func (x *StatusCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FinishTwitterAuthResponse) ProtoMessage() {}
+func (*StatusCheck) ProtoMessage() {}
 
-func (x *FinishTwitterAuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mootslive_v1_mootslive_proto_msgTypes[8]
+func (x *StatusCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,343 +900,15151 @@ func (x *FinishTwitterAuthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FinishTwitterAuthResponse.ProtoReflect.Descriptor instead.
-func (*FinishTwitterAuthResponse) Descriptor() ([]byte, []int) {
-	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use StatusCheck.ProtoReflect.Descriptor instead.
+func (*StatusCheck) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *FinishTwitterAuthResponse) GetIdToken() string {
+func (x *StatusCheck) GetName() string {
 	if x != nil {
-		return x.IdToken
+		return x.Name
 	}
 	return ""
 }
 
-type Listen struct {
+func (x *StatusCheck) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+type GetStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Source     string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
-	Isrc       string                 `protobuf:"bytes,4,opt,name=isrc,proto3" json:"isrc,omitempty"`
-	ListenedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=listened_at,json=listenedAt,proto3" json:"listened_at,omitempty"`
+	// healthy is whether the replica that served the request is ready to serve
+	// requests, as reported by the gRPC health service.
+	Healthy bool `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// checks are the health checks that were run. Why any failed isn't given,
+	// as anyone can call GetStatus.
+	Checks []*StatusCheck `protobuf:"bytes,3,rep,name=checks,proto3" json:"checks,omitempty"`
 }
 
-func (x *Listen) Reset() {
-	*x = Listen{}
+func (x *GetStatusResponse) Reset() {
+	*x = GetStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mootslive_v1_mootslive_proto_msgTypes[9]
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Listen) String() string {
+func (x *GetStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Listen) ProtoMessage() {}
+func (*GetStatusResponse) ProtoMessage() {}
 
-func (x *Listen) ProtoReflect() protoreflect.Message {
-	mi := &file_mootslive_v1_mootslive_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
+func (x *GetStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetStatusResponse) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *GetStatusResponse) GetChecks() []*StatusCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+type GenerateRecapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Year   int32  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+}
+
+func (x *GenerateRecapRequest) Reset() {
+	*x = GenerateRecapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateRecapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRecapRequest) ProtoMessage() {}
+
+func (x *GenerateRecapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRecapRequest.ProtoReflect.Descriptor instead.
+func (*GenerateRecapRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GenerateRecapRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GenerateRecapRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type GenerateRecapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recap   *YearlyRecap `protobuf:"bytes,1,opt,name=recap,proto3" json:"recap,omitempty"`
+	Version int32        `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *GenerateRecapResponse) Reset() {
+	*x = GenerateRecapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateRecapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRecapResponse) ProtoMessage() {}
+
+func (x *GenerateRecapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRecapResponse.ProtoReflect.Descriptor instead.
+func (*GenerateRecapResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GenerateRecapResponse) GetRecap() *YearlyRecap {
+	if x != nil {
+		return x.Recap
+	}
+	return nil
+}
+
+func (x *GenerateRecapResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// AdminUser is a user as seen by admins.
+type AdminUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Handle      string                 `protobuf:"bytes,3,opt,name=handle,proto3" json:"handle,omitempty"`
+	DisplayName string                 `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	IsAdmin     bool                   `protobuf:"varint,5,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	// disabled_at is set while the user is disabled.
+	DisabledAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=disabled_at,json=disabledAt,proto3" json:"disabled_at,omitempty"`
+	DeletionRequestedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deletion_requested_at,json=deletionRequestedAt,proto3" json:"deletion_requested_at,omitempty"`
+	// sessions_revoked_at is when the user's sessions were last revoked.
+	SessionsRevokedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=sessions_revoked_at,json=sessionsRevokedAt,proto3" json:"sessions_revoked_at,omitempty"`
+}
+
+func (x *AdminUser) Reset() {
+	*x = AdminUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUser) ProtoMessage() {}
+
+func (x *AdminUser) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUser.ProtoReflect.Descriptor instead.
+func (*AdminUser) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AdminUser) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AdminUser) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AdminUser) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *AdminUser) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *AdminUser) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+func (x *AdminUser) GetDisabledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DisabledAt
+	}
+	return nil
+}
+
+func (x *AdminUser) GetDeletionRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletionRequestedAt
+	}
+	return nil
+}
+
+func (x *AdminUser) GetSessionsRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SessionsRevokedAt
+	}
+	return nil
+}
+
+type ListUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// query matches users by their ID, the start of their handle, or the ID or
+	// username of an account they've linked. All users are listed when empty.
+	Query string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type ListUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users         []*AdminUser `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string       `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Listen.ProtoReflect.Descriptor instead.
-func (*Listen) Descriptor() ([]byte, []int) {
-	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *Listen) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Listen) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *Listen) GetSource() string {
-	if x != nil {
-		return x.Source
-	}
-	return ""
-}
-
-func (x *Listen) GetIsrc() string {
-	if x != nil {
-		return x.Isrc
-	}
-	return ""
-}
-
-func (x *Listen) GetListenedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ListenedAt
-	}
-	return nil
-}
-
-type ListListensRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *ListListensRequest) Reset() {
-	*x = ListListensRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mootslive_v1_mootslive_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListListensRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListListensRequest) ProtoMessage() {}
-
-func (x *ListListensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mootslive_v1_mootslive_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListUsersResponse) GetUsers() []*AdminUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// SpotifyScan is a scan of a Spotify account for new listens by the poller.
+type SpotifyScan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// replica is the host name of the replica that ran the scan.
+	Replica      string                 `protobuf:"bytes,2,opt,name=replica,proto3" json:"replica,omitempty"`
+	StartedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Result       SpotifyScanResult      `protobuf:"varint,5,opt,name=result,proto3,enum=mootslive.v1.SpotifyScanResult" json:"result,omitempty"`
+	ListensFound int32                  `protobuf:"varint,6,opt,name=listens_found,json=listensFound,proto3" json:"listens_found,omitempty"`
+	Error        string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SpotifyScan) Reset() {
+	*x = SpotifyScan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpotifyScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpotifyScan) ProtoMessage() {}
+
+func (x *SpotifyScan) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpotifyScan.ProtoReflect.Descriptor instead.
+func (*SpotifyScan) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SpotifyScan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SpotifyScan) GetReplica() string {
+	if x != nil {
+		return x.Replica
+	}
+	return ""
+}
+
+func (x *SpotifyScan) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *SpotifyScan) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *SpotifyScan) GetResult() SpotifyScanResult {
+	if x != nil {
+		return x.Result
+	}
+	return SpotifyScanResult_SPOTIFY_SCAN_RESULT_UNSPECIFIED
+}
+
+func (x *SpotifyScan) GetListensFound() int32 {
+	if x != nil {
+		return x.ListensFound
+	}
+	return 0
+}
+
+func (x *SpotifyScan) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// SpotifyAccountHealth describes how the poller is getting on with a linked
+// Spotify account.
+type SpotifyAccountHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SpotifyUserId string `protobuf:"bytes,1,opt,name=spotify_user_id,json=spotifyUserId,proto3" json:"spotify_user_id,omitempty"`
+	// polled is false when the poller is skipping the account, because its
+	// user is disabled or being deleted.
+	Polled bool `protobuf:"varint,2,opt,name=polled,proto3" json:"polled,omitempty"`
+	// last_listened_at is the time of the most recent listen the poller has
+	// found.
+	LastListenedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_listened_at,json=lastListenedAt,proto3" json:"last_listened_at,omitempty"`
+	LastScannedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_scanned_at,json=lastScannedAt,proto3" json:"last_scanned_at,omitempty"`
+	LastSucceededAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_succeeded_at,json=lastSucceededAt,proto3" json:"last_succeeded_at,omitempty"`
+	// failure_streak is how many scans in a row have failed.
+	FailureStreak int32 `protobuf:"varint,6,opt,name=failure_streak,json=failureStreak,proto3" json:"failure_streak,omitempty"`
+	// last_error is the error of the latest scan, if it failed.
+	LastError string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// lag is how long it's been since the account was last scanned
+	// successfully, or since it was linked if it never has been.
+	Lag *durationpb.Duration `protobuf:"bytes,8,opt,name=lag,proto3" json:"lag,omitempty"`
+	// overdue is set when the lag is longer than the poller should ever take
+	// to get round to the account.
+	Overdue bool   `protobuf:"varint,9,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	UserId  string `protobuf:"bytes,10,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// recent_scans are the latest scans of the account, newest first. They're
+	// only included by GetUser.
+	RecentScans []*SpotifyScan `protobuf:"bytes,11,rep,name=recent_scans,json=recentScans,proto3" json:"recent_scans,omitempty"`
+}
+
+func (x *SpotifyAccountHealth) Reset() {
+	*x = SpotifyAccountHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpotifyAccountHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpotifyAccountHealth) ProtoMessage() {}
+
+func (x *SpotifyAccountHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpotifyAccountHealth.ProtoReflect.Descriptor instead.
+func (*SpotifyAccountHealth) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SpotifyAccountHealth) GetSpotifyUserId() string {
+	if x != nil {
+		return x.SpotifyUserId
+	}
+	return ""
+}
+
+func (x *SpotifyAccountHealth) GetPolled() bool {
+	if x != nil {
+		return x.Polled
+	}
+	return false
+}
+
+func (x *SpotifyAccountHealth) GetLastListenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastListenedAt
+	}
+	return nil
+}
+
+func (x *SpotifyAccountHealth) GetLastScannedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastScannedAt
+	}
+	return nil
+}
+
+func (x *SpotifyAccountHealth) GetLastSucceededAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSucceededAt
+	}
+	return nil
+}
+
+func (x *SpotifyAccountHealth) GetFailureStreak() int32 {
+	if x != nil {
+		return x.FailureStreak
+	}
+	return 0
+}
+
+func (x *SpotifyAccountHealth) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *SpotifyAccountHealth) GetLag() *durationpb.Duration {
+	if x != nil {
+		return x.Lag
+	}
+	return nil
+}
+
+func (x *SpotifyAccountHealth) GetOverdue() bool {
+	if x != nil {
+		return x.Overdue
+	}
+	return false
+}
+
+func (x *SpotifyAccountHealth) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SpotifyAccountHealth) GetRecentScans() []*SpotifyScan {
+	if x != nil {
+		return x.RecentScans
+	}
+	return nil
+}
+
+type GetUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User            *AdminUser              `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	LinkedAccounts  []*LinkedAccount        `protobuf:"bytes,2,rep,name=linked_accounts,json=linkedAccounts,proto3" json:"linked_accounts,omitempty"`
+	SpotifyAccounts []*SpotifyAccountHealth `protobuf:"bytes,3,rep,name=spotify_accounts,json=spotifyAccounts,proto3" json:"spotify_accounts,omitempty"`
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetUserResponse) GetUser() *AdminUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetUserResponse) GetLinkedAccounts() []*LinkedAccount {
+	if x != nil {
+		return x.LinkedAccounts
+	}
+	return nil
+}
+
+func (x *GetUserResponse) GetSpotifyAccounts() []*SpotifyAccountHealth {
+	if x != nil {
+		return x.SpotifyAccounts
+	}
+	return nil
+}
+
+type DisableUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *DisableUserRequest) Reset() {
+	*x = DisableUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableUserRequest) ProtoMessage() {}
+
+func (x *DisableUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableUserRequest.ProtoReflect.Descriptor instead.
+func (*DisableUserRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DisableUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DisableUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *AdminUser `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *DisableUserResponse) Reset() {
+	*x = DisableUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableUserResponse) ProtoMessage() {}
+
+func (x *DisableUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableUserResponse.ProtoReflect.Descriptor instead.
+func (*DisableUserResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DisableUserResponse) GetUser() *AdminUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type EnableUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *EnableUserRequest) Reset() {
+	*x = EnableUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableUserRequest) ProtoMessage() {}
+
+func (x *EnableUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableUserRequest.ProtoReflect.Descriptor instead.
+func (*EnableUserRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EnableUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type EnableUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *AdminUser `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *EnableUserResponse) Reset() {
+	*x = EnableUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableUserResponse) ProtoMessage() {}
+
+func (x *EnableUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableUserResponse.ProtoReflect.Descriptor instead.
+func (*EnableUserResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EnableUserResponse) GetUser() *AdminUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ForceRescanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SpotifyUserId string `protobuf:"bytes,1,opt,name=spotify_user_id,json=spotifyUserId,proto3" json:"spotify_user_id,omitempty"`
+}
+
+func (x *ForceRescanRequest) Reset() {
+	*x = ForceRescanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceRescanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceRescanRequest) ProtoMessage() {}
+
+func (x *ForceRescanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceRescanRequest.ProtoReflect.Descriptor instead.
+func (*ForceRescanRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ForceRescanRequest) GetSpotifyUserId() string {
+	if x != nil {
+		return x.SpotifyUserId
+	}
+	return ""
+}
+
+type ForceRescanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ForceRescanResponse) Reset() {
+	*x = ForceRescanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceRescanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceRescanResponse) ProtoMessage() {}
+
+func (x *ForceRescanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceRescanResponse.ProtoReflect.Descriptor instead.
+func (*ForceRescanResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{17}
+}
+
+type RevokeUserSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *RevokeUserSessionsRequest) Reset() {
+	*x = RevokeUserSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeUserSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeUserSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeUserSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RevokeUserSessionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RevokeUserSessionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *AdminUser `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *RevokeUserSessionsResponse) Reset() {
+	*x = RevokeUserSessionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeUserSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeUserSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeUserSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RevokeUserSessionsResponse) GetUser() *AdminUser {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetPollerStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// account_limit is how many of the most lagging accounts to include.
+	AccountLimit int32 `protobuf:"varint,1,opt,name=account_limit,json=accountLimit,proto3" json:"account_limit,omitempty"`
+}
+
+func (x *GetPollerStatusRequest) Reset() {
+	*x = GetPollerStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPollerStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPollerStatusRequest) ProtoMessage() {}
+
+func (x *GetPollerStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPollerStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPollerStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetPollerStatusRequest) GetAccountLimit() int32 {
+	if x != nil {
+		return x.AccountLimit
+	}
+	return 0
+}
+
+// PollerReplicaThroughput is how much scanning a replica has done over the
+// throughput window.
+type PollerReplicaThroughput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Replica        string  `protobuf:"bytes,1,opt,name=replica,proto3" json:"replica,omitempty"`
+	Scans          int64   `protobuf:"varint,2,opt,name=scans,proto3" json:"scans,omitempty"`
+	FailedScans    int64   `protobuf:"varint,3,opt,name=failed_scans,json=failedScans,proto3" json:"failed_scans,omitempty"`
+	ListensFound   int64   `protobuf:"varint,4,opt,name=listens_found,json=listensFound,proto3" json:"listens_found,omitempty"`
+	ScansPerMinute float64 `protobuf:"fixed64,5,opt,name=scans_per_minute,json=scansPerMinute,proto3" json:"scans_per_minute,omitempty"`
+}
+
+func (x *PollerReplicaThroughput) Reset() {
+	*x = PollerReplicaThroughput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollerReplicaThroughput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollerReplicaThroughput) ProtoMessage() {}
+
+func (x *PollerReplicaThroughput) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollerReplicaThroughput.ProtoReflect.Descriptor instead.
+func (*PollerReplicaThroughput) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PollerReplicaThroughput) GetReplica() string {
+	if x != nil {
+		return x.Replica
+	}
+	return ""
+}
+
+func (x *PollerReplicaThroughput) GetScans() int64 {
+	if x != nil {
+		return x.Scans
+	}
+	return 0
+}
+
+func (x *PollerReplicaThroughput) GetFailedScans() int64 {
+	if x != nil {
+		return x.FailedScans
+	}
+	return 0
+}
+
+func (x *PollerReplicaThroughput) GetListensFound() int64 {
+	if x != nil {
+		return x.ListensFound
+	}
+	return 0
+}
+
+func (x *PollerReplicaThroughput) GetScansPerMinute() float64 {
+	if x != nil {
+		return x.ScansPerMinute
+	}
+	return 0
+}
+
+type GetPollerStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// accounts is how many Spotify accounts are being polled.
+	Accounts        int32 `protobuf:"varint,1,opt,name=accounts,proto3" json:"accounts,omitempty"`
+	OverdueAccounts int32 `protobuf:"varint,2,opt,name=overdue_accounts,json=overdueAccounts,proto3" json:"overdue_accounts,omitempty"`
+	// failing_accounts is how many accounts' latest scan failed.
+	FailingAccounts int32                `protobuf:"varint,3,opt,name=failing_accounts,json=failingAccounts,proto3" json:"failing_accounts,omitempty"`
+	MaxLag          *durationpb.Duration `protobuf:"bytes,4,opt,name=max_lag,json=maxLag,proto3" json:"max_lag,omitempty"`
+	// lagging_accounts are the accounts that have gone longest without a
+	// successful scan, most lagging first.
+	LaggingAccounts  []*SpotifyAccountHealth    `protobuf:"bytes,5,rep,name=lagging_accounts,json=laggingAccounts,proto3" json:"lagging_accounts,omitempty"`
+	Replicas         []*PollerReplicaThroughput `protobuf:"bytes,6,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	ThroughputWindow *durationpb.Duration       `protobuf:"bytes,7,opt,name=throughput_window,json=throughputWindow,proto3" json:"throughput_window,omitempty"`
+}
+
+func (x *GetPollerStatusResponse) Reset() {
+	*x = GetPollerStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPollerStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPollerStatusResponse) ProtoMessage() {}
+
+func (x *GetPollerStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPollerStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetPollerStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPollerStatusResponse) GetAccounts() int32 {
+	if x != nil {
+		return x.Accounts
+	}
+	return 0
+}
+
+func (x *GetPollerStatusResponse) GetOverdueAccounts() int32 {
+	if x != nil {
+		return x.OverdueAccounts
+	}
+	return 0
+}
+
+func (x *GetPollerStatusResponse) GetFailingAccounts() int32 {
+	if x != nil {
+		return x.FailingAccounts
+	}
+	return 0
+}
+
+func (x *GetPollerStatusResponse) GetMaxLag() *durationpb.Duration {
+	if x != nil {
+		return x.MaxLag
+	}
+	return nil
+}
+
+func (x *GetPollerStatusResponse) GetLaggingAccounts() []*SpotifyAccountHealth {
+	if x != nil {
+		return x.LaggingAccounts
+	}
+	return nil
+}
+
+func (x *GetPollerStatusResponse) GetReplicas() []*PollerReplicaThroughput {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+func (x *GetPollerStatusResponse) GetThroughputWindow() *durationpb.Duration {
+	if x != nil {
+		return x.ThroughputWindow
+	}
+	return nil
+}
+
+// AuditEvent is a security relevant event, as seen by admins.
+type AuditEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind AuditEventKind `protobuf:"varint,2,opt,name=kind,proto3,enum=mootslive.v1.AuditEventKind" json:"kind,omitempty"`
+	// actor_user_id is the user who caused the event. It's empty for events
+	// caused by mootslive itself.
+	ActorUserId string `protobuf:"bytes,3,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	// target_user_id is the user the event affected.
+	TargetUserId string `protobuf:"bytes,4,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	// provider and provider_user_id identify the linked account involved in
+	// the event, if there was one.
+	Provider       Provider               `protobuf:"varint,5,opt,name=provider,proto3,enum=mootslive.v1.Provider" json:"provider,omitempty"`
+	ProviderUserId string                 `protobuf:"bytes,6,opt,name=provider_user_id,json=providerUserId,proto3" json:"provider_user_id,omitempty"`
+	IpAddress      string                 `protobuf:"bytes,7,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent      string                 `protobuf:"bytes,8,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	TraceId        string                 `protobuf:"bytes,9,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetKind() AuditEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AuditEventKind_AUDIT_EVENT_KIND_UNSPECIFIED
+}
+
+func (x *AuditEvent) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_PROVIDER_UNSPECIFIED
+}
+
+func (x *AuditEvent) GetProviderUserId() string {
+	if x != nil {
+		return x.ProviderUserId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The remaining fields filter the events, and are ignored when unset.
+	ActorUserId  string                 `protobuf:"bytes,3,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	TargetUserId string                 `protobuf:"bytes,4,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	Kinds        []AuditEventKind       `protobuf:"varint,5,rep,packed,name=kinds,proto3,enum=mootslive.v1.AuditEventKind" json:"kinds,omitempty"`
+	StartTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetKinds() []AuditEventKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events        []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetMeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMeRequest) Reset() {
+	*x = GetMeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeRequest) ProtoMessage() {}
+
+func (x *GetMeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeRequest.ProtoReflect.Descriptor instead.
+func (*GetMeRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{26}
+}
+
+// LinkedAccount is an account with a third party that a user has linked to
+// their mootslive account.
+type LinkedAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider Provider `protobuf:"varint,1,opt,name=provider,proto3,enum=mootslive.v1.Provider" json:"provider,omitempty"`
+	// provider_user_id is the ID of the user with the provider.
+	ProviderUserId string `protobuf:"bytes,2,opt,name=provider_user_id,json=providerUserId,proto3" json:"provider_user_id,omitempty"`
+	// username is the user's username with the provider, where the provider has
+	// such a concept.
+	Username string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	LinkedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"`
+}
+
+func (x *LinkedAccount) Reset() {
+	*x = LinkedAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkedAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkedAccount) ProtoMessage() {}
+
+func (x *LinkedAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkedAccount.ProtoReflect.Descriptor instead.
+func (*LinkedAccount) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LinkedAccount) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_PROVIDER_UNSPECIFIED
+}
+
+func (x *LinkedAccount) GetProviderUserId() string {
+	if x != nil {
+		return x.ProviderUserId
+	}
+	return ""
+}
+
+func (x *LinkedAccount) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LinkedAccount) GetLinkedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkedAt
+	}
+	return nil
+}
+
+type Profile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DisplayName string `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// handle is unique to the user, ignoring case. It's empty if the user
+	// hasn't chosen one and one couldn't be chosen for them.
+	Handle         string           `protobuf:"bytes,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	AvatarUrl      string           `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	LinkedAccounts []*LinkedAccount `protobuf:"bytes,4,rep,name=linked_accounts,json=linkedAccounts,proto3" json:"linked_accounts,omitempty"`
+	Bio            string           `protobuf:"bytes,5,opt,name=bio,proto3" json:"bio,omitempty"`
+	// time_zone is the IANA name of the time zone the user's listening stats
+	// are computed in, e.g "Europe/London".
+	TimeZone string `protobuf:"bytes,6,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Profile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Profile) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *Profile) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *Profile) GetLinkedAccounts() []*LinkedAccount {
+	if x != nil {
+		return x.LinkedAccounts
+	}
+	return nil
+}
+
+func (x *Profile) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *Profile) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type GetMeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Profile   *Profile               `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *GetMeResponse) Reset() {
+	*x = GetMeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMeResponse) ProtoMessage() {}
+
+func (x *GetMeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMeResponse.ProtoReflect.Descriptor instead.
+func (*GetMeResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetMeResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetMeResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GetMeResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// OAuth2State contains bits of state we need the client to hold during the
+// OAuth2 3-legged flow. These values aren't something that need to be kept
+// secret from the client.
+type OAuth2State struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State            string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	PkceCodeVerifier string `protobuf:"bytes,2,opt,name=pkce_code_verifier,json=pkceCodeVerifier,proto3" json:"pkce_code_verifier,omitempty"`
+}
+
+func (x *OAuth2State) Reset() {
+	*x = OAuth2State{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OAuth2State) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuth2State) ProtoMessage() {}
+
+func (x *OAuth2State) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuth2State.ProtoReflect.Descriptor instead.
+func (*OAuth2State) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *OAuth2State) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *OAuth2State) GetPkceCodeVerifier() string {
+	if x != nil {
+		return x.PkceCodeVerifier
+	}
+	return ""
+}
+
+type BeginTwitterAuthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BeginTwitterAuthRequest) Reset() {
+	*x = BeginTwitterAuthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeginTwitterAuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginTwitterAuthRequest) ProtoMessage() {}
+
+func (x *BeginTwitterAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginTwitterAuthRequest.ProtoReflect.Descriptor instead.
+func (*BeginTwitterAuthRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{31}
+}
+
+type BeginTwitterAuthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RedirectUrl string       `protobuf:"bytes,1,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	State       *OAuth2State `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *BeginTwitterAuthResponse) Reset() {
+	*x = BeginTwitterAuthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeginTwitterAuthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginTwitterAuthResponse) ProtoMessage() {}
+
+func (x *BeginTwitterAuthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginTwitterAuthResponse.ProtoReflect.Descriptor instead.
+func (*BeginTwitterAuthResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BeginTwitterAuthResponse) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *BeginTwitterAuthResponse) GetState() *OAuth2State {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type FinishTwitterAuthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State *OAuth2State `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// received_state is the state url parameter received during the callback to
+	// the webapp from the relying party.
+	ReceivedState string `protobuf:"bytes,2,opt,name=received_state,json=receivedState,proto3" json:"received_state,omitempty"`
+	ReceivedCode  string `protobuf:"bytes,3,opt,name=received_code,json=receivedCode,proto3" json:"received_code,omitempty"`
+}
+
+func (x *FinishTwitterAuthRequest) Reset() {
+	*x = FinishTwitterAuthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinishTwitterAuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishTwitterAuthRequest) ProtoMessage() {}
+
+func (x *FinishTwitterAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishTwitterAuthRequest.ProtoReflect.Descriptor instead.
+func (*FinishTwitterAuthRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FinishTwitterAuthRequest) GetState() *OAuth2State {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *FinishTwitterAuthRequest) GetReceivedState() string {
+	if x != nil {
+		return x.ReceivedState
+	}
+	return ""
+}
+
+func (x *FinishTwitterAuthRequest) GetReceivedCode() string {
+	if x != nil {
+		return x.ReceivedCode
+	}
+	return ""
+}
+
+type FinishTwitterAuthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdToken string `protobuf:"bytes,1,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+}
+
+func (x *FinishTwitterAuthResponse) Reset() {
+	*x = FinishTwitterAuthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinishTwitterAuthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishTwitterAuthResponse) ProtoMessage() {}
+
+func (x *FinishTwitterAuthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishTwitterAuthResponse.ProtoReflect.Descriptor instead.
+func (*FinishTwitterAuthResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FinishTwitterAuthResponse) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+type Listen struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Source     string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Isrc       string                 `protobuf:"bytes,4,opt,name=isrc,proto3" json:"isrc,omitempty"`
+	ListenedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=listened_at,json=listenedAt,proto3" json:"listened_at,omitempty"`
+	UserId     string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// hidden listens can only be seen by the user who listened to them.
+	Hidden bool `protobuf:"varint,7,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// reactions are the reactions to the listen, most popular first.
+	Reactions []*ReactionCount `protobuf:"bytes,8,rep,name=reactions,proto3" json:"reactions,omitempty"`
+}
+
+func (x *Listen) Reset() {
+	*x = Listen{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Listen) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Listen) ProtoMessage() {}
+
+func (x *Listen) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Listen.ProtoReflect.Descriptor instead.
+func (*Listen) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Listen) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Listen) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Listen) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Listen) GetIsrc() string {
+	if x != nil {
+		return x.Isrc
+	}
+	return ""
+}
+
+func (x *Listen) GetListenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ListenedAt
+	}
+	return nil
+}
+
+func (x *Listen) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Listen) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Listen) GetReactions() []*ReactionCount {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+type ReactionCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Emoji string `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// reacted is whether the caller is one of the users who reacted.
+	Reacted bool `protobuf:"varint,3,opt,name=reacted,proto3" json:"reacted,omitempty"`
+}
+
+func (x *ReactionCount) Reset() {
+	*x = ReactionCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReactionCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionCount) ProtoMessage() {}
+
+func (x *ReactionCount) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionCount.ProtoReflect.Descriptor instead.
+func (*ReactionCount) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReactionCount) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *ReactionCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReactionCount) GetReacted() bool {
+	if x != nil {
+		return x.Reacted
+	}
+	return false
+}
+
+type ListListensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token of the previous page. The other fields
+	// of the request must be the same as for the previous page.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// start_time and end_time limit the listens to those listened to within
+	// them. end_time is excluded. Either can be left unset.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// source, isrc and artist_id limit the listens to those with the source,
+	// of the track or of a track by the artist, if set.
+	Source   string      `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	Isrc     string      `protobuf:"bytes,6,opt,name=isrc,proto3" json:"isrc,omitempty"`
+	ArtistId string      `protobuf:"bytes,7,opt,name=artist_id,json=artistId,proto3" json:"artist_id,omitempty"`
+	Order    ListenOrder `protobuf:"varint,8,opt,name=order,proto3,enum=mootslive.v1.ListenOrder" json:"order,omitempty"`
+}
+
+func (x *ListListensRequest) Reset() {
+	*x = ListListensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListListensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListListensRequest) ProtoMessage() {}
+
+func (x *ListListensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListListensRequest.ProtoReflect.Descriptor instead.
+func (*ListListensRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListListensRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListListensRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListListensRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListListensRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListListensRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ListListensRequest) GetIsrc() string {
+	if x != nil {
+		return x.Isrc
+	}
+	return ""
+}
+
+func (x *ListListensRequest) GetArtistId() string {
+	if x != nil {
+		return x.ArtistId
+	}
+	return ""
+}
+
+func (x *ListListensRequest) GetOrder() ListenOrder {
+	if x != nil {
+		return x.Order
+	}
+	return ListenOrder_LISTEN_ORDER_UNSPECIFIED
+}
+
+type ListListensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listens []*Listen `protobuf:"bytes,1,rep,name=listens,proto3" json:"listens,omitempty"`
+	// next_page_token is empty if there are no more listens.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListListensResponse) Reset() {
+	*x = ListListensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListListensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListListensResponse) ProtoMessage() {}
+
+func (x *ListListensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListListensResponse.ProtoReflect.Descriptor instead.
+func (*ListListensResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListListensResponse) GetListens() []*Listen {
+	if x != nil {
+		return x.Listens
+	}
+	return nil
+}
+
+func (x *ListListensResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ExportMyDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExportMyDataRequest) Reset() {
+	*x = ExportMyDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportMyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataRequest) ProtoMessage() {}
+
+func (x *ExportMyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportMyDataRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{39}
+}
+
+// ExportMyDataResponse carries a chunk of the caller's data export. Joining
+// the data of every message in the stream produces a single JSON document.
+type ExportMyDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportMyDataResponse) Reset() {
+	*x = ExportMyDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportMyDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataResponse) ProtoMessage() {}
+
+func (x *ExportMyDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportMyDataResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ExportMyDataResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteMyAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteMyAccountRequest) Reset() {
+	*x = DeleteMyAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMyAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMyAccountRequest) ProtoMessage() {}
+
+func (x *DeleteMyAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMyAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMyAccountRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{41}
+}
+
+type DeleteMyAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// delete_time is when the account and all of its data will be permanently
+	// deleted, unless the deletion is cancelled before then.
+	DeleteTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=delete_time,json=deleteTime,proto3" json:"delete_time,omitempty"`
+}
+
+func (x *DeleteMyAccountResponse) Reset() {
+	*x = DeleteMyAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMyAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMyAccountResponse) ProtoMessage() {}
+
+func (x *DeleteMyAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMyAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMyAccountResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteMyAccountResponse) GetDeleteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeleteTime
+	}
+	return nil
+}
+
+type CancelAccountDeletionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelAccountDeletionRequest) Reset() {
+	*x = CancelAccountDeletionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelAccountDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAccountDeletionRequest) ProtoMessage() {}
+
+func (x *CancelAccountDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAccountDeletionRequest.ProtoReflect.Descriptor instead.
+func (*CancelAccountDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{43}
+}
+
+type CancelAccountDeletionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelAccountDeletionResponse) Reset() {
+	*x = CancelAccountDeletionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelAccountDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAccountDeletionResponse) ProtoMessage() {}
+
+func (x *CancelAccountDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAccountDeletionResponse.ProtoReflect.Descriptor instead.
+func (*CancelAccountDeletionResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{44}
+}
+
+// TwitterSharingSettings controls what a user allows us to tweet on their
+// behalf.
+type TwitterSharingSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// listen_sharing_enabled allows listens to be tweeted with ShareListen.
+	ListenSharingEnabled bool `protobuf:"varint,1,opt,name=listen_sharing_enabled,json=listenSharingEnabled,proto3" json:"listen_sharing_enabled,omitempty"`
+	// weekly_recap_enabled opts the user into a tweet of their top tracks at
+	// the end of each week.
+	WeeklyRecapEnabled bool `protobuf:"varint,2,opt,name=weekly_recap_enabled,json=weeklyRecapEnabled,proto3" json:"weekly_recap_enabled,omitempty"`
+}
+
+func (x *TwitterSharingSettings) Reset() {
+	*x = TwitterSharingSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TwitterSharingSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwitterSharingSettings) ProtoMessage() {}
+
+func (x *TwitterSharingSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwitterSharingSettings.ProtoReflect.Descriptor instead.
+func (*TwitterSharingSettings) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TwitterSharingSettings) GetListenSharingEnabled() bool {
+	if x != nil {
+		return x.ListenSharingEnabled
+	}
+	return false
+}
+
+func (x *TwitterSharingSettings) GetWeeklyRecapEnabled() bool {
+	if x != nil {
+		return x.WeeklyRecapEnabled
+	}
+	return false
+}
+
+type GetTwitterSharingSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetTwitterSharingSettingsRequest) Reset() {
+	*x = GetTwitterSharingSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTwitterSharingSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTwitterSharingSettingsRequest) ProtoMessage() {}
+
+func (x *GetTwitterSharingSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTwitterSharingSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetTwitterSharingSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{46}
+}
+
+type GetTwitterSharingSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *TwitterSharingSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *GetTwitterSharingSettingsResponse) Reset() {
+	*x = GetTwitterSharingSettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTwitterSharingSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTwitterSharingSettingsResponse) ProtoMessage() {}
+
+func (x *GetTwitterSharingSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTwitterSharingSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetTwitterSharingSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetTwitterSharingSettingsResponse) GetSettings() *TwitterSharingSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateTwitterSharingSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *TwitterSharingSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UpdateTwitterSharingSettingsRequest) Reset() {
+	*x = UpdateTwitterSharingSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTwitterSharingSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTwitterSharingSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateTwitterSharingSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTwitterSharingSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTwitterSharingSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateTwitterSharingSettingsRequest) GetSettings() *TwitterSharingSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateTwitterSharingSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *TwitterSharingSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UpdateTwitterSharingSettingsResponse) Reset() {
+	*x = UpdateTwitterSharingSettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTwitterSharingSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTwitterSharingSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateTwitterSharingSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTwitterSharingSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTwitterSharingSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateTwitterSharingSettingsResponse) GetSettings() *TwitterSharingSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type ShareListenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListenId string `protobuf:"bytes,1,opt,name=listen_id,json=listenId,proto3" json:"listen_id,omitempty"`
+}
+
+func (x *ShareListenRequest) Reset() {
+	*x = ShareListenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareListenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareListenRequest) ProtoMessage() {}
+
+func (x *ShareListenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareListenRequest.ProtoReflect.Descriptor instead.
+func (*ShareListenRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ShareListenRequest) GetListenId() string {
+	if x != nil {
+		return x.ListenId
+	}
+	return ""
+}
+
+type ShareListenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TweetId string `protobuf:"bytes,1,opt,name=tweet_id,json=tweetId,proto3" json:"tweet_id,omitempty"`
+}
+
+func (x *ShareListenResponse) Reset() {
+	*x = ShareListenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareListenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareListenResponse) ProtoMessage() {}
+
+func (x *ShareListenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareListenResponse.ProtoReflect.Descriptor instead.
+func (*ShareListenResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ShareListenResponse) GetTweetId() string {
+	if x != nil {
+		return x.TweetId
+	}
+	return ""
+}
+
+// Moot is a mootslive user who the caller follows, and who follows the caller
+// back, either on Twitter or on mootslive.
+type Moot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// twitter_user_id is set if the users are mutuals on Twitter.
+	TwitterUserId string `protobuf:"bytes,2,opt,name=twitter_user_id,json=twitterUserId,proto3" json:"twitter_user_id,omitempty"`
+}
+
+func (x *Moot) Reset() {
+	*x = Moot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Moot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Moot) ProtoMessage() {}
+
+func (x *Moot) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Moot.ProtoReflect.Descriptor instead.
+func (*Moot) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Moot) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Moot) GetTwitterUserId() string {
+	if x != nil {
+		return x.TwitterUserId
+	}
+	return ""
+}
+
+type SyncMootsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SyncMootsRequest) Reset() {
+	*x = SyncMootsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncMootsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncMootsRequest) ProtoMessage() {}
+
+func (x *SyncMootsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncMootsRequest.ProtoReflect.Descriptor instead.
+func (*SyncMootsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{53}
+}
+
+type SyncMootsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SyncMootsResponse) Reset() {
+	*x = SyncMootsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncMootsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncMootsResponse) ProtoMessage() {}
+
+func (x *SyncMootsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncMootsResponse.ProtoReflect.Descriptor instead.
+func (*SyncMootsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{54}
+}
+
+type ListMootsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListMootsRequest) Reset() {
+	*x = ListMootsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMootsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMootsRequest) ProtoMessage() {}
+
+func (x *ListMootsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMootsRequest.ProtoReflect.Descriptor instead.
+func (*ListMootsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{55}
+}
+
+type ListMootsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Moots []*Moot `protobuf:"bytes,1,rep,name=moots,proto3" json:"moots,omitempty"`
+}
+
+func (x *ListMootsResponse) Reset() {
+	*x = ListMootsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMootsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMootsResponse) ProtoMessage() {}
+
+func (x *ListMootsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMootsResponse.ProtoReflect.Descriptor instead.
+func (*ListMootsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListMootsResponse) GetMoots() []*Moot {
+	if x != nil {
+		return x.Moots
+	}
+	return nil
+}
+
+type Follow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FollowerId string                 `protobuf:"bytes,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	FolloweeId string                 `protobuf:"bytes,2,opt,name=followee_id,json=followeeId,proto3" json:"followee_id,omitempty"`
+	Status     FollowStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=mootslive.v1.FollowStatus" json:"status,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AcceptedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+}
+
+func (x *Follow) Reset() {
+	*x = Follow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Follow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Follow) ProtoMessage() {}
+
+func (x *Follow) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Follow.ProtoReflect.Descriptor instead.
+func (*Follow) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Follow) GetFollowerId() string {
+	if x != nil {
+		return x.FollowerId
+	}
+	return ""
+}
+
+func (x *Follow) GetFolloweeId() string {
+	if x != nil {
+		return x.FolloweeId
+	}
+	return ""
+}
+
+func (x *Follow) GetStatus() FollowStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FollowStatus_FOLLOW_STATUS_UNSPECIFIED
+}
+
+func (x *Follow) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Follow) GetAcceptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return nil
+}
+
+type FollowUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *FollowUserRequest) Reset() {
+	*x = FollowUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserRequest) ProtoMessage() {}
+
+func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
+func (*FollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *FollowUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type FollowUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Follow *Follow `protobuf:"bytes,1,opt,name=follow,proto3" json:"follow,omitempty"`
+}
+
+func (x *FollowUserResponse) Reset() {
+	*x = FollowUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserResponse) ProtoMessage() {}
+
+func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserResponse.ProtoReflect.Descriptor instead.
+func (*FollowUserResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *FollowUserResponse) GetFollow() *Follow {
+	if x != nil {
+		return x.Follow
+	}
+	return nil
+}
+
+type UnfollowUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *UnfollowUserRequest) Reset() {
+	*x = UnfollowUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfollowUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowUserRequest) ProtoMessage() {}
+
+func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowUserRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UnfollowUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnfollowUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnfollowUserResponse) Reset() {
+	*x = UnfollowUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfollowUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowUserResponse) ProtoMessage() {}
+
+func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowUserResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowUserResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{61}
+}
+
+type ListFollowersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListFollowersRequest) Reset() {
+	*x = ListFollowersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFollowersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowersRequest) ProtoMessage() {}
+
+func (x *ListFollowersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowersRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowersRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{62}
+}
+
+type ListFollowersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Follows []*Follow `protobuf:"bytes,1,rep,name=follows,proto3" json:"follows,omitempty"`
+}
+
+func (x *ListFollowersResponse) Reset() {
+	*x = ListFollowersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFollowersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowersResponse) ProtoMessage() {}
+
+func (x *ListFollowersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowersResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowersResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListFollowersResponse) GetFollows() []*Follow {
+	if x != nil {
+		return x.Follows
+	}
+	return nil
+}
+
+type ListFollowingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListFollowingRequest) Reset() {
+	*x = ListFollowingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFollowingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowingRequest) ProtoMessage() {}
+
+func (x *ListFollowingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowingRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowingRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{64}
+}
+
+type ListFollowingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Follows []*Follow `protobuf:"bytes,1,rep,name=follows,proto3" json:"follows,omitempty"`
+}
+
+func (x *ListFollowingResponse) Reset() {
+	*x = ListFollowingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFollowingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowingResponse) ProtoMessage() {}
+
+func (x *ListFollowingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowingResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowingResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListFollowingResponse) GetFollows() []*Follow {
+	if x != nil {
+		return x.Follows
+	}
+	return nil
+}
+
+type ListFollowRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListFollowRequestsRequest) Reset() {
+	*x = ListFollowRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFollowRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowRequestsRequest) ProtoMessage() {}
+
+func (x *ListFollowRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{66}
+}
+
+type ListFollowRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Follows []*Follow `protobuf:"bytes,1,rep,name=follows,proto3" json:"follows,omitempty"`
+}
+
+func (x *ListFollowRequestsResponse) Reset() {
+	*x = ListFollowRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFollowRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowRequestsResponse) ProtoMessage() {}
+
+func (x *ListFollowRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListFollowRequestsResponse) GetFollows() []*Follow {
+	if x != nil {
+		return x.Follows
+	}
+	return nil
+}
+
+type ApproveFollowRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user_id is the ID of the user who requested to follow the caller.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ApproveFollowRequestRequest) Reset() {
+	*x = ApproveFollowRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFollowRequestRequest) ProtoMessage() {}
+
+func (x *ApproveFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ApproveFollowRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ApproveFollowRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Follow *Follow `protobuf:"bytes,1,opt,name=follow,proto3" json:"follow,omitempty"`
+}
+
+func (x *ApproveFollowRequestResponse) Reset() {
+	*x = ApproveFollowRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveFollowRequestResponse) ProtoMessage() {}
+
+func (x *ApproveFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ApproveFollowRequestResponse) GetFollow() *Follow {
+	if x != nil {
+		return x.Follow
+	}
+	return nil
+}
+
+type DenyFollowRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user_id is the ID of the user who requested to follow the caller.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *DenyFollowRequestRequest) Reset() {
+	*x = DenyFollowRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenyFollowRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyFollowRequestRequest) ProtoMessage() {}
+
+func (x *DenyFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DenyFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*DenyFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DenyFollowRequestRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DenyFollowRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DenyFollowRequestResponse) Reset() {
+	*x = DenyFollowRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenyFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenyFollowRequestResponse) ProtoMessage() {}
+
+func (x *DenyFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DenyFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*DenyFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{71}
+}
+
+type FollowSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// require_approval makes new follows of the user pending until they approve
+	// them.
+	RequireApproval bool `protobuf:"varint,1,opt,name=require_approval,json=requireApproval,proto3" json:"require_approval,omitempty"`
+}
+
+func (x *FollowSettings) Reset() {
+	*x = FollowSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowSettings) ProtoMessage() {}
+
+func (x *FollowSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowSettings.ProtoReflect.Descriptor instead.
+func (*FollowSettings) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *FollowSettings) GetRequireApproval() bool {
+	if x != nil {
+		return x.RequireApproval
+	}
+	return false
+}
+
+type GetFollowSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetFollowSettingsRequest) Reset() {
+	*x = GetFollowSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFollowSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowSettingsRequest) ProtoMessage() {}
+
+func (x *GetFollowSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetFollowSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{73}
+}
+
+type GetFollowSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *FollowSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *GetFollowSettingsResponse) Reset() {
+	*x = GetFollowSettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFollowSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowSettingsResponse) ProtoMessage() {}
+
+func (x *GetFollowSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetFollowSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetFollowSettingsResponse) GetSettings() *FollowSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateFollowSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *FollowSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UpdateFollowSettingsRequest) Reset() {
+	*x = UpdateFollowSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFollowSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFollowSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateFollowSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFollowSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFollowSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UpdateFollowSettingsRequest) GetSettings() *FollowSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateFollowSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *FollowSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UpdateFollowSettingsResponse) Reset() {
+	*x = UpdateFollowSettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFollowSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFollowSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateFollowSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFollowSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFollowSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UpdateFollowSettingsResponse) GetSettings() *FollowSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type Artist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Artist) Reset() {
+	*x = Artist{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Artist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Artist) ProtoMessage() {}
+
+func (x *Artist) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Artist.ProtoReflect.Descriptor instead.
+func (*Artist) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Artist) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Artist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Album struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ImageUrl string `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+}
+
+func (x *Album) Reset() {
+	*x = Album{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Album) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Album) ProtoMessage() {}
+
+func (x *Album) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Album.ProtoReflect.Descriptor instead.
+func (*Album) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *Album) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Album) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Album) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+// Track is the metadata of a track, as provided by Spotify.
+type Track struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Isrc           string    `protobuf:"bytes,1,opt,name=isrc,proto3" json:"isrc,omitempty"`
+	Name           string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Artists        []*Artist `protobuf:"bytes,3,rep,name=artists,proto3" json:"artists,omitempty"`
+	Album          *Album    `protobuf:"bytes,4,opt,name=album,proto3" json:"album,omitempty"`
+	DurationMs     int32     `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	SpotifyTrackId string    `protobuf:"bytes,6,opt,name=spotify_track_id,json=spotifyTrackId,proto3" json:"spotify_track_id,omitempty"`
+}
+
+func (x *Track) Reset() {
+	*x = Track{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Track) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Track) ProtoMessage() {}
+
+func (x *Track) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Track.ProtoReflect.Descriptor instead.
+func (*Track) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Track) GetIsrc() string {
+	if x != nil {
+		return x.Isrc
+	}
+	return ""
+}
+
+func (x *Track) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Track) GetArtists() []*Artist {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
+}
+
+func (x *Track) GetAlbum() *Album {
+	if x != nil {
+		return x.Album
+	}
+	return nil
+}
+
+func (x *Track) GetDurationMs() int32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *Track) GetSpotifyTrackId() string {
+	if x != nil {
+		return x.SpotifyTrackId
+	}
+	return ""
+}
+
+// UserSummary is the public profile of a user, as shown alongside their
+// activity.
+type UserSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Handle      string `protobuf:"bytes,3,opt,name=handle,proto3" json:"handle,omitempty"`
+	AvatarUrl   string `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+}
+
+func (x *UserSummary) Reset() {
+	*x = UserSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSummary) ProtoMessage() {}
+
+func (x *UserSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSummary.ProtoReflect.Descriptor instead.
+func (*UserSummary) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UserSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserSummary) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UserSummary) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *UserSummary) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+// FeedEntry is a listen, or a run of consecutive listens to the same album,
+// by a user the caller follows.
+type FeedEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *UserSummary `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// track is the track of the most recent listen in the entry. It's unset if
+	// we don't hold metadata for the track.
+	Track *Track `protobuf:"bytes,2,opt,name=track,proto3" json:"track,omitempty"`
+	// listens are ordered newest first.
+	Listens []*Listen `protobuf:"bytes,3,rep,name=listens,proto3" json:"listens,omitempty"`
+}
+
+func (x *FeedEntry) Reset() {
+	*x = FeedEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedEntry) ProtoMessage() {}
+
+func (x *FeedEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedEntry.ProtoReflect.Descriptor instead.
+func (*FeedEntry) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *FeedEntry) GetUser() *UserSummary {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *FeedEntry) GetTrack() *Track {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+func (x *FeedEntry) GetListens() []*Listen {
+	if x != nil {
+		return x.Listens
+	}
+	return nil
+}
+
+type ListFeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// page_size is the maximum number of listens to return, which may be
+	// collapsed into fewer entries. Defaults to 50, and is capped at 100.
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListFeedRequest) Reset() {
+	*x = ListFeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeedRequest) ProtoMessage() {}
+
+func (x *ListFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeedRequest.ProtoReflect.Descriptor instead.
+func (*ListFeedRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListFeedRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFeedRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListFeedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*FeedEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// next_page_token is empty once the end of the feed has been reached.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListFeedResponse) Reset() {
+	*x = ListFeedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeedResponse) ProtoMessage() {}
+
+func (x *ListFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeedResponse.ProtoReflect.Descriptor instead.
+func (*ListFeedResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListFeedResponse) GetEntries() []*FeedEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListFeedResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Presence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string         `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status PresenceStatus `protobuf:"varint,2,opt,name=status,proto3,enum=mootslive.v1.PresenceStatus" json:"status,omitempty"`
+	// last_listened_at is unset if the user has never listened to anything.
+	LastListenedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_listened_at,json=lastListenedAt,proto3" json:"last_listened_at,omitempty"`
+}
+
+func (x *Presence) Reset() {
+	*x = Presence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Presence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Presence) ProtoMessage() {}
+
+func (x *Presence) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Presence.ProtoReflect.Descriptor instead.
+func (*Presence) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Presence) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Presence) GetStatus() PresenceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PresenceStatus_PRESENCE_STATUS_UNSPECIFIED
+}
+
+func (x *Presence) GetLastListenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastListenedAt
+	}
+	return nil
+}
+
+type WatchListensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resume_token is the resume_token of the last message received on a
+	// previous stream, used to receive any listens missed since it ended.
+	ResumeToken string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *WatchListensRequest) Reset() {
+	*x = WatchListensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchListensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchListensRequest) ProtoMessage() {}
+
+func (x *WatchListensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchListensRequest.ProtoReflect.Descriptor instead.
+func (*WatchListensRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *WatchListensRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// NotificationsUpdate is sent when the caller's notifications change.
+type NotificationsUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// notifications are those that are new or have changed, such as by having
+	// another actor grouped into them.
+	Notifications []*Notification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	UnreadCount   int64           `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+}
+
+func (x *NotificationsUpdate) Reset() {
+	*x = NotificationsUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationsUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationsUpdate) ProtoMessage() {}
+
+func (x *NotificationsUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationsUpdate.ProtoReflect.Descriptor instead.
+func (*NotificationsUpdate) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *NotificationsUpdate) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *NotificationsUpdate) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type WatchListensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*WatchListensResponse_Listen
+	//	*WatchListensResponse_Presence
+	//	*WatchListensResponse_Notifications
+	Event       isWatchListensResponse_Event `protobuf_oneof:"event"`
+	ResumeToken string                       `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *WatchListensResponse) Reset() {
+	*x = WatchListensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchListensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchListensResponse) ProtoMessage() {}
+
+func (x *WatchListensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchListensResponse.ProtoReflect.Descriptor instead.
+func (*WatchListensResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{87}
+}
+
+func (m *WatchListensResponse) GetEvent() isWatchListensResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *WatchListensResponse) GetListen() *Listen {
+	if x, ok := x.GetEvent().(*WatchListensResponse_Listen); ok {
+		return x.Listen
+	}
+	return nil
+}
+
+func (x *WatchListensResponse) GetPresence() *Presence {
+	if x, ok := x.GetEvent().(*WatchListensResponse_Presence); ok {
+		return x.Presence
+	}
+	return nil
+}
+
+func (x *WatchListensResponse) GetNotifications() *NotificationsUpdate {
+	if x, ok := x.GetEvent().(*WatchListensResponse_Notifications); ok {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *WatchListensResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type isWatchListensResponse_Event interface {
+	isWatchListensResponse_Event()
+}
+
+type WatchListensResponse_Listen struct {
+	Listen *Listen `protobuf:"bytes,1,opt,name=listen,proto3,oneof"`
+}
+
+type WatchListensResponse_Presence struct {
+	Presence *Presence `protobuf:"bytes,2,opt,name=presence,proto3,oneof"`
+}
+
+type WatchListensResponse_Notifications struct {
+	Notifications *NotificationsUpdate `protobuf:"bytes,4,opt,name=notifications,proto3,oneof"`
+}
+
+func (*WatchListensResponse_Listen) isWatchListensResponse_Event() {}
+
+func (*WatchListensResponse_Presence) isWatchListensResponse_Event() {}
+
+func (*WatchListensResponse_Notifications) isWatchListensResponse_Event() {}
+
+// TimeRange is a range of whole UTC days. start_time and end_time are each
+// rounded down to the start of their day, and the day of end_time is
+// excluded.
+type TimeRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *TimeRange) Reset() {
+	*x = TimeRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRange) ProtoMessage() {}
+
+func (x *TimeRange) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRange.ProtoReflect.Descriptor instead.
+func (*TimeRange) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *TimeRange) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *TimeRange) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type GetCompatibilityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OtherUserId string `protobuf:"bytes,1,opt,name=other_user_id,json=otherUserId,proto3" json:"other_user_id,omitempty"`
+	// window defaults to the last 90 days.
+	Window TimeWindow `protobuf:"varint,2,opt,name=window,proto3,enum=mootslive.v1.TimeWindow" json:"window,omitempty"`
+}
+
+func (x *GetCompatibilityRequest) Reset() {
+	*x = GetCompatibilityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompatibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompatibilityRequest) ProtoMessage() {}
+
+func (x *GetCompatibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompatibilityRequest.ProtoReflect.Descriptor instead.
+func (*GetCompatibilityRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetCompatibilityRequest) GetOtherUserId() string {
+	if x != nil {
+		return x.OtherUserId
+	}
+	return ""
+}
+
+func (x *GetCompatibilityRequest) GetWindow() TimeWindow {
+	if x != nil {
+		return x.Window
+	}
+	return TimeWindow_TIME_WINDOW_UNSPECIFIED
+}
+
+type GetCompatibilityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// score is between 0 and 100, where 100 is identical listening.
+	Score float64 `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+	// shared_tracks are the tracks both users listen to most, best first.
+	SharedTracks []*Track `protobuf:"bytes,2,rep,name=shared_tracks,json=sharedTracks,proto3" json:"shared_tracks,omitempty"`
+	// shared_artists are the artists both users listen to most, best first.
+	SharedArtists []*Artist              `protobuf:"bytes,3,rep,name=shared_artists,json=sharedArtists,proto3" json:"shared_artists,omitempty"`
+	ComputedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+}
+
+func (x *GetCompatibilityResponse) Reset() {
+	*x = GetCompatibilityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompatibilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompatibilityResponse) ProtoMessage() {}
+
+func (x *GetCompatibilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompatibilityResponse.ProtoReflect.Descriptor instead.
+func (*GetCompatibilityResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetCompatibilityResponse) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GetCompatibilityResponse) GetSharedTracks() []*Track {
+	if x != nil {
+		return x.SharedTracks
+	}
+	return nil
+}
+
+func (x *GetCompatibilityResponse) GetSharedArtists() []*Artist {
+	if x != nil {
+		return x.SharedArtists
+	}
+	return nil
+}
+
+func (x *GetCompatibilityResponse) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+type PrivacySettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// visibility is who can see the user's listens.
+	Visibility Visibility `protobuf:"varint,1,opt,name=visibility,proto3,enum=mootslive.v1.Visibility" json:"visibility,omitempty"`
+	// hidden_sources are the sources of listens, e.g "spotify", that are
+	// hidden from everyone else.
+	HiddenSources []string `protobuf:"bytes,2,rep,name=hidden_sources,json=hiddenSources,proto3" json:"hidden_sources,omitempty"`
+}
+
+func (x *PrivacySettings) Reset() {
+	*x = PrivacySettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrivacySettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacySettings) ProtoMessage() {}
+
+func (x *PrivacySettings) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacySettings.ProtoReflect.Descriptor instead.
+func (*PrivacySettings) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *PrivacySettings) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *PrivacySettings) GetHiddenSources() []string {
+	if x != nil {
+		return x.HiddenSources
+	}
+	return nil
+}
+
+type GetPrivacySettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPrivacySettingsRequest) Reset() {
+	*x = GetPrivacySettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrivacySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivacySettingsRequest) ProtoMessage() {}
+
+func (x *GetPrivacySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivacySettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetPrivacySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{92}
+}
+
+type GetPrivacySettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *PrivacySettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *GetPrivacySettingsResponse) Reset() {
+	*x = GetPrivacySettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPrivacySettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivacySettingsResponse) ProtoMessage() {}
+
+func (x *GetPrivacySettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivacySettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetPrivacySettingsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetPrivacySettingsResponse) GetSettings() *PrivacySettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdatePrivacySettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *PrivacySettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UpdatePrivacySettingsRequest) Reset() {
+	*x = UpdatePrivacySettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePrivacySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrivacySettingsRequest) ProtoMessage() {}
+
+func (x *UpdatePrivacySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrivacySettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePrivacySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *UpdatePrivacySettingsRequest) GetSettings() *PrivacySettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdatePrivacySettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *PrivacySettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UpdatePrivacySettingsResponse) Reset() {
+	*x = UpdatePrivacySettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePrivacySettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrivacySettingsResponse) ProtoMessage() {}
+
+func (x *UpdatePrivacySettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrivacySettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePrivacySettingsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *UpdatePrivacySettingsResponse) GetSettings() *PrivacySettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type SetListenHiddenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListenId string `protobuf:"bytes,1,opt,name=listen_id,json=listenId,proto3" json:"listen_id,omitempty"`
+	Hidden   bool   `protobuf:"varint,2,opt,name=hidden,proto3" json:"hidden,omitempty"`
+}
+
+func (x *SetListenHiddenRequest) Reset() {
+	*x = SetListenHiddenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetListenHiddenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetListenHiddenRequest) ProtoMessage() {}
+
+func (x *SetListenHiddenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetListenHiddenRequest.ProtoReflect.Descriptor instead.
+func (*SetListenHiddenRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SetListenHiddenRequest) GetListenId() string {
+	if x != nil {
+		return x.ListenId
+	}
+	return ""
+}
+
+func (x *SetListenHiddenRequest) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+type SetListenHiddenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listen *Listen `protobuf:"bytes,1,opt,name=listen,proto3" json:"listen,omitempty"`
+}
+
+func (x *SetListenHiddenResponse) Reset() {
+	*x = SetListenHiddenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetListenHiddenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetListenHiddenResponse) ProtoMessage() {}
+
+func (x *SetListenHiddenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetListenHiddenResponse.ProtoReflect.Descriptor instead.
+func (*SetListenHiddenResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SetListenHiddenResponse) GetListen() *Listen {
+	if x != nil {
+		return x.Listen
+	}
+	return nil
+}
+
+// IncognitoWindow is a period of time during which a user's listens are
+// hidden from everyone else.
+type IncognitoWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *IncognitoWindow) Reset() {
+	*x = IncognitoWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncognitoWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncognitoWindow) ProtoMessage() {}
+
+func (x *IncognitoWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncognitoWindow.ProtoReflect.Descriptor instead.
+func (*IncognitoWindow) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *IncognitoWindow) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IncognitoWindow) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *IncognitoWindow) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *IncognitoWindow) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateIncognitoWindowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *CreateIncognitoWindowRequest) Reset() {
+	*x = CreateIncognitoWindowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateIncognitoWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIncognitoWindowRequest) ProtoMessage() {}
+
+func (x *CreateIncognitoWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIncognitoWindowRequest.ProtoReflect.Descriptor instead.
+func (*CreateIncognitoWindowRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *CreateIncognitoWindowRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateIncognitoWindowRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type CreateIncognitoWindowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Window *IncognitoWindow `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (x *CreateIncognitoWindowResponse) Reset() {
+	*x = CreateIncognitoWindowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateIncognitoWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIncognitoWindowResponse) ProtoMessage() {}
+
+func (x *CreateIncognitoWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIncognitoWindowResponse.ProtoReflect.Descriptor instead.
+func (*CreateIncognitoWindowResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CreateIncognitoWindowResponse) GetWindow() *IncognitoWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type ListIncognitoWindowsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListIncognitoWindowsRequest) Reset() {
+	*x = ListIncognitoWindowsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIncognitoWindowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncognitoWindowsRequest) ProtoMessage() {}
+
+func (x *ListIncognitoWindowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncognitoWindowsRequest.ProtoReflect.Descriptor instead.
+func (*ListIncognitoWindowsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{101}
+}
+
+type ListIncognitoWindowsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Windows []*IncognitoWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (x *ListIncognitoWindowsResponse) Reset() {
+	*x = ListIncognitoWindowsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIncognitoWindowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncognitoWindowsResponse) ProtoMessage() {}
+
+func (x *ListIncognitoWindowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncognitoWindowsResponse.ProtoReflect.Descriptor instead.
+func (*ListIncognitoWindowsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListIncognitoWindowsResponse) GetWindows() []*IncognitoWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type DeleteIncognitoWindowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteIncognitoWindowRequest) Reset() {
+	*x = DeleteIncognitoWindowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteIncognitoWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIncognitoWindowRequest) ProtoMessage() {}
+
+func (x *DeleteIncognitoWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIncognitoWindowRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIncognitoWindowRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *DeleteIncognitoWindowRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteIncognitoWindowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteIncognitoWindowResponse) Reset() {
+	*x = DeleteIncognitoWindowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteIncognitoWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIncognitoWindowResponse) ProtoMessage() {}
+
+func (x *DeleteIncognitoWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIncognitoWindowResponse.ProtoReflect.Descriptor instead.
+func (*DeleteIncognitoWindowResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{104}
+}
+
+type AddReactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListenId string `protobuf:"bytes,1,opt,name=listen_id,json=listenId,proto3" json:"listen_id,omitempty"`
+	Emoji    string `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+}
+
+func (x *AddReactionRequest) Reset() {
+	*x = AddReactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionRequest) ProtoMessage() {}
+
+func (x *AddReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionRequest.ProtoReflect.Descriptor instead.
+func (*AddReactionRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *AddReactionRequest) GetListenId() string {
+	if x != nil {
+		return x.ListenId
+	}
+	return ""
+}
+
+func (x *AddReactionRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type AddReactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reactions []*ReactionCount `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+}
+
+func (x *AddReactionResponse) Reset() {
+	*x = AddReactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionResponse) ProtoMessage() {}
+
+func (x *AddReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionResponse.ProtoReflect.Descriptor instead.
+func (*AddReactionResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *AddReactionResponse) GetReactions() []*ReactionCount {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+type RemoveReactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListenId string `protobuf:"bytes,1,opt,name=listen_id,json=listenId,proto3" json:"listen_id,omitempty"`
+	Emoji    string `protobuf:"bytes,2,opt,name=emoji,proto3" json:"emoji,omitempty"`
+}
+
+func (x *RemoveReactionRequest) Reset() {
+	*x = RemoveReactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionRequest) ProtoMessage() {}
+
+func (x *RemoveReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveReactionRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *RemoveReactionRequest) GetListenId() string {
+	if x != nil {
+		return x.ListenId
+	}
+	return ""
+}
+
+func (x *RemoveReactionRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type RemoveReactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reactions []*ReactionCount `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+}
+
+func (x *RemoveReactionResponse) Reset() {
+	*x = RemoveReactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionResponse) ProtoMessage() {}
+
+func (x *RemoveReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveReactionResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *RemoveReactionResponse) GetReactions() []*ReactionCount {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+type Comment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ListenId  string                 `protobuf:"bytes,2,opt,name=listen_id,json=listenId,proto3" json:"listen_id,omitempty"`
+	Author    *UserSummary           `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Text      string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// hidden comments have been flagged by enough users that they are only
+	// shown to their author.
+	Hidden bool `protobuf:"varint,6,opt,name=hidden,proto3" json:"hidden,omitempty"`
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *Comment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Comment) GetListenId() string {
+	if x != nil {
+		return x.ListenId
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthor() *UserSummary {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *Comment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+type AddCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListenId string `protobuf:"bytes,1,opt,name=listen_id,json=listenId,proto3" json:"listen_id,omitempty"`
+	// text is at most 500 characters.
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *AddCommentRequest) Reset() {
+	*x = AddCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentRequest) ProtoMessage() {}
+
+func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddCommentRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *AddCommentRequest) GetListenId() string {
+	if x != nil {
+		return x.ListenId
+	}
+	return ""
+}
+
+func (x *AddCommentRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type AddCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *AddCommentResponse) Reset() {
+	*x = AddCommentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentResponse) ProtoMessage() {}
+
+func (x *AddCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentResponse.ProtoReflect.Descriptor instead.
+func (*AddCommentResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *AddCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *DeleteCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentResponse) ProtoMessage() {}
+
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{113}
+}
+
+type ListCommentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListenId string `protobuf:"bytes,1,opt,name=listen_id,json=listenId,proto3" json:"listen_id,omitempty"`
+	// page_size defaults to 50, and is at most 100.
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ListCommentsRequest) GetListenId() string {
+	if x != nil {
+		return x.ListenId
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// comments are ordered oldest first.
+	Comments []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	// next_page_token is empty when there are no more comments.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type FlagCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *FlagCommentRequest) Reset() {
+	*x = FlagCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlagCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagCommentRequest) ProtoMessage() {}
+
+func (x *FlagCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagCommentRequest.ProtoReflect.Descriptor instead.
+func (*FlagCommentRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *FlagCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type FlagCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FlagCommentResponse) Reset() {
+	*x = FlagCommentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlagCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlagCommentResponse) ProtoMessage() {}
+
+func (x *FlagCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlagCommentResponse.ProtoReflect.Descriptor instead.
+func (*FlagCommentResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{117}
+}
+
+// Notification tells a user that something concerning them has happened.
+// Unread notifications of the same kind and target are grouped together.
+type Notification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind     NotificationKind `protobuf:"varint,2,opt,name=kind,proto3,enum=mootslive.v1.NotificationKind" json:"kind,omitempty"`
+	TargetId string           `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// actors are the most recent of the users who caused the notification,
+	// most recent first.
+	Actors []*UserSummary `protobuf:"bytes,4,rep,name=actors,proto3" json:"actors,omitempty"`
+	// actor_count is the total number of users who caused the notification.
+	ActorCount int32                  `protobuf:"varint,5,opt,name=actor_count,json=actorCount,proto3" json:"actor_count,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Read       bool                   `protobuf:"varint,8,opt,name=read,proto3" json:"read,omitempty"`
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetKind() NotificationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return NotificationKind_NOTIFICATION_KIND_UNSPECIFIED
+}
+
+func (x *Notification) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *Notification) GetActors() []*UserSummary {
+	if x != nil {
+		return x.Actors
+	}
+	return nil
+}
+
+func (x *Notification) GetActorCount() int32 {
+	if x != nil {
+		return x.ActorCount
+	}
+	return 0
+}
+
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Notification) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// page_size defaults to 50, and is at most 100.
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListNotificationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListNotificationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// notifications are ordered by when they were last updated, most recent
+	// first.
+	Notifications []*Notification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	NextPageToken string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	UnreadCount   int64           `protobuf:"varint,3,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListNotificationsResponse) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type MarkNotificationsReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// all marks every notification updated before the time given as read, in
+	// addition to any given by ID. This avoids marking notifications the user
+	// hasn't seen yet as read.
+	AllUpdatedBefore *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=all_updated_before,json=allUpdatedBefore,proto3" json:"all_updated_before,omitempty"`
+}
+
+func (x *MarkNotificationsReadRequest) Reset() {
+	*x = MarkNotificationsReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkNotificationsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadRequest) ProtoMessage() {}
+
+func (x *MarkNotificationsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *MarkNotificationsReadRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *MarkNotificationsReadRequest) GetAllUpdatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AllUpdatedBefore
+	}
+	return nil
+}
+
+type MarkNotificationsReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnreadCount int64 `protobuf:"varint,1,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+}
+
+func (x *MarkNotificationsReadResponse) Reset() {
+	*x = MarkNotificationsReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkNotificationsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadResponse) ProtoMessage() {}
+
+func (x *MarkNotificationsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *MarkNotificationsReadResponse) GetUnreadCount() int64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+type NotificationPreference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind    NotificationKind `protobuf:"varint,1,opt,name=kind,proto3,enum=mootslive.v1.NotificationKind" json:"kind,omitempty"`
+	Enabled bool             `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *NotificationPreference) Reset() {
+	*x = NotificationPreference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreference) ProtoMessage() {}
+
+func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreference.ProtoReflect.Descriptor instead.
+func (*NotificationPreference) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *NotificationPreference) GetKind() NotificationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return NotificationKind_NOTIFICATION_KIND_UNSPECIFIED
+}
+
+func (x *NotificationPreference) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{124}
+}
+
+type GetNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preferences []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+}
+
+func (x *GetNotificationPreferencesResponse) Reset() {
+	*x = GetNotificationPreferencesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetNotificationPreferencesResponse) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdateNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// preferences are the preferences to change, others are left as they are.
+	Preferences []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+}
+
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdateNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preferences []*NotificationPreference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+}
+
+func (x *UpdateNotificationPreferencesResponse) Reset() {
+	*x = UpdateNotificationPreferencesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *UpdateNotificationPreferencesResponse) GetPreferences() []*NotificationPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdateProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// handle must be 3 to 30 letters, numbers or underscores.
+	Handle string `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	// display_name is at most 64 characters. If empty, the name of the user's
+	// Twitter account is used.
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// bio is at most 160 characters.
+	Bio string `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	// time_zone is an IANA time zone name. It's left unchanged if empty.
+	TimeZone string `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *UpdateProfileRequest) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *UpdateProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type TopArtist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Artist *Artist `protobuf:"bytes,1,opt,name=artist,proto3" json:"artist,omitempty"`
+	Plays  int64   `protobuf:"varint,2,opt,name=plays,proto3" json:"plays,omitempty"`
+}
+
+func (x *TopArtist) Reset() {
+	*x = TopArtist{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopArtist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopArtist) ProtoMessage() {}
+
+func (x *TopArtist) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopArtist.ProtoReflect.Descriptor instead.
+func (*TopArtist) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *TopArtist) GetArtist() *Artist {
+	if x != nil {
+		return x.Artist
+	}
+	return nil
+}
+
+func (x *TopArtist) GetPlays() int64 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+type RecentListen struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listen *Listen `protobuf:"bytes,1,opt,name=listen,proto3" json:"listen,omitempty"`
+	// track is unset if we don't hold metadata for the track.
+	Track *Track `protobuf:"bytes,2,opt,name=track,proto3" json:"track,omitempty"`
+}
+
+func (x *RecentListen) Reset() {
+	*x = RecentListen{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecentListen) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecentListen) ProtoMessage() {}
+
+func (x *RecentListen) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecentListen.ProtoReflect.Descriptor instead.
+func (*RecentListen) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *RecentListen) GetListen() *Listen {
+	if x != nil {
+		return x.Listen
+	}
+	return nil
+}
+
+func (x *RecentListen) GetTrack() *Track {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+type GetProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Handle string `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *GetProfileRequest) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+type GetProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User     *UserSummary           `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Bio      string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	JoinedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	// listens_visible is whether the caller can see the user's listens. If
+	// not, recent_listens and top_artists are empty.
+	ListensVisible bool `protobuf:"varint,4,opt,name=listens_visible,json=listensVisible,proto3" json:"listens_visible,omitempty"`
+	// recent_listens are newest first.
+	RecentListens []*RecentListen `protobuf:"bytes,5,rep,name=recent_listens,json=recentListens,proto3" json:"recent_listens,omitempty"`
+	// top_artists are the artists the user has listened to most over the last
+	// 90 days, most played first.
+	TopArtists []*TopArtist `protobuf:"bytes,6,rep,name=top_artists,json=topArtists,proto3" json:"top_artists,omitempty"`
+}
+
+func (x *GetProfileResponse) Reset() {
+	*x = GetProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileResponse) ProtoMessage() {}
+
+func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *GetProfileResponse) GetUser() *UserSummary {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetProfileResponse) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *GetProfileResponse) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+func (x *GetProfileResponse) GetListensVisible() bool {
+	if x != nil {
+		return x.ListensVisible
+	}
+	return false
+}
+
+func (x *GetProfileResponse) GetRecentListens() []*RecentListen {
+	if x != nil {
+		return x.RecentListens
+	}
+	return nil
+}
+
+func (x *GetProfileResponse) GetTopArtists() []*TopArtist {
+	if x != nil {
+		return x.TopArtists
+	}
+	return nil
+}
+
+type BlockUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *BlockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type BlockUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResponse) ProtoMessage() {}
+
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{135}
+}
+
+type UnblockUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserRequest) ProtoMessage() {}
+
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *UnblockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnblockUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnblockUserResponse) Reset() {
+	*x = UnblockUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserResponse) ProtoMessage() {}
+
+func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{137}
+}
+
+type ListBlockedUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListBlockedUsersRequest) Reset() {
+	*x = ListBlockedUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBlockedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersRequest) ProtoMessage() {}
+
+func (x *ListBlockedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{138}
+}
+
+type ListBlockedUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// users are ordered most recently blocked first.
+	Users []*UserSummary `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *ListBlockedUsersResponse) Reset() {
+	*x = ListBlockedUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBlockedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersResponse) ProtoMessage() {}
+
+func (x *ListBlockedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ListBlockedUsersResponse) GetUsers() []*UserSummary {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type MuteUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *MuteUserRequest) Reset() {
+	*x = MuteUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteUserRequest) ProtoMessage() {}
+
+func (x *MuteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteUserRequest.ProtoReflect.Descriptor instead.
+func (*MuteUserRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *MuteUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type MuteUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MuteUserResponse) Reset() {
+	*x = MuteUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteUserResponse) ProtoMessage() {}
+
+func (x *MuteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteUserResponse.ProtoReflect.Descriptor instead.
+func (*MuteUserResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{141}
+}
+
+type UnmuteUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *UnmuteUserRequest) Reset() {
+	*x = UnmuteUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnmuteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteUserRequest) ProtoMessage() {}
+
+func (x *UnmuteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteUserRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteUserRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *UnmuteUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnmuteUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnmuteUserResponse) Reset() {
+	*x = UnmuteUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnmuteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnmuteUserResponse) ProtoMessage() {}
+
+func (x *UnmuteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnmuteUserResponse.ProtoReflect.Descriptor instead.
+func (*UnmuteUserResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{143}
+}
+
+type ListMutedUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListMutedUsersRequest) Reset() {
+	*x = ListMutedUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMutedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMutedUsersRequest) ProtoMessage() {}
+
+func (x *ListMutedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMutedUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListMutedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{144}
+}
+
+type ListMutedUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// users are ordered most recently muted first.
+	Users []*UserSummary `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *ListMutedUsersResponse) Reset() {
+	*x = ListMutedUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMutedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMutedUsersResponse) ProtoMessage() {}
+
+func (x *ListMutedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMutedUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListMutedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *ListMutedUsersResponse) GetUsers() []*UserSummary {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type TopTrack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// track only has its ISRC set if we don't hold metadata for it.
+	Track *Track `protobuf:"bytes,1,opt,name=track,proto3" json:"track,omitempty"`
+	Plays int64  `protobuf:"varint,2,opt,name=plays,proto3" json:"plays,omitempty"`
+}
+
+func (x *TopTrack) Reset() {
+	*x = TopTrack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopTrack) ProtoMessage() {}
+
+func (x *TopTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopTrack.ProtoReflect.Descriptor instead.
+func (*TopTrack) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *TopTrack) GetTrack() *Track {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+func (x *TopTrack) GetPlays() int64 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+type TopAlbum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Album *Album `protobuf:"bytes,1,opt,name=album,proto3" json:"album,omitempty"`
+	Plays int64  `protobuf:"varint,2,opt,name=plays,proto3" json:"plays,omitempty"`
+}
+
+func (x *TopAlbum) Reset() {
+	*x = TopAlbum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopAlbum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopAlbum) ProtoMessage() {}
+
+func (x *TopAlbum) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopAlbum.ProtoReflect.Descriptor instead.
+func (*TopAlbum) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *TopAlbum) GetAlbum() *Album {
+	if x != nil {
+		return x.Album
+	}
+	return nil
+}
+
+func (x *TopAlbum) GetPlays() int64 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+type GetTopTracksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user_id defaults to the caller.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// window defaults to the last 30 days.
+	Window TimeWindow `protobuf:"varint,2,opt,name=window,proto3,enum=mootslive.v1.TimeWindow" json:"window,omitempty"`
+	// custom_range must be set if window is TIME_WINDOW_CUSTOM.
+	CustomRange *TimeRange `protobuf:"bytes,3,opt,name=custom_range,json=customRange,proto3" json:"custom_range,omitempty"`
+	// limit defaults to 10, and is capped at 100.
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetTopTracksRequest) Reset() {
+	*x = GetTopTracksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTopTracksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopTracksRequest) ProtoMessage() {}
+
+func (x *GetTopTracksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopTracksRequest.ProtoReflect.Descriptor instead.
+func (*GetTopTracksRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *GetTopTracksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTopTracksRequest) GetWindow() TimeWindow {
+	if x != nil {
+		return x.Window
+	}
+	return TimeWindow_TIME_WINDOW_UNSPECIFIED
+}
+
+func (x *GetTopTracksRequest) GetCustomRange() *TimeRange {
+	if x != nil {
+		return x.CustomRange
+	}
+	return nil
+}
+
+func (x *GetTopTracksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetTopTracksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// tracks are the most played first.
+	Tracks []*TopTrack `protobuf:"bytes,1,rep,name=tracks,proto3" json:"tracks,omitempty"`
+	// range is the days plays were counted over. Its start_time is unset for
+	// TIME_WINDOW_ALL_TIME.
+	Range *TimeRange `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+}
+
+func (x *GetTopTracksResponse) Reset() {
+	*x = GetTopTracksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTopTracksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopTracksResponse) ProtoMessage() {}
+
+func (x *GetTopTracksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopTracksResponse.ProtoReflect.Descriptor instead.
+func (*GetTopTracksResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *GetTopTracksResponse) GetTracks() []*TopTrack {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+func (x *GetTopTracksResponse) GetRange() *TimeRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+type GetTopArtistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user_id defaults to the caller.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// window defaults to the last 30 days.
+	Window TimeWindow `protobuf:"varint,2,opt,name=window,proto3,enum=mootslive.v1.TimeWindow" json:"window,omitempty"`
+	// custom_range must be set if window is TIME_WINDOW_CUSTOM.
+	CustomRange *TimeRange `protobuf:"bytes,3,opt,name=custom_range,json=customRange,proto3" json:"custom_range,omitempty"`
+	// limit defaults to 10, and is capped at 100.
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetTopArtistsRequest) Reset() {
+	*x = GetTopArtistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTopArtistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopArtistsRequest) ProtoMessage() {}
+
+func (x *GetTopArtistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopArtistsRequest.ProtoReflect.Descriptor instead.
+func (*GetTopArtistsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *GetTopArtistsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTopArtistsRequest) GetWindow() TimeWindow {
+	if x != nil {
+		return x.Window
+	}
+	return TimeWindow_TIME_WINDOW_UNSPECIFIED
+}
+
+func (x *GetTopArtistsRequest) GetCustomRange() *TimeRange {
+	if x != nil {
+		return x.CustomRange
+	}
+	return nil
+}
+
+func (x *GetTopArtistsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetTopArtistsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// artists are the most played first. Plays of tracks we don't hold
+	// metadata for aren't counted.
+	Artists []*TopArtist `protobuf:"bytes,1,rep,name=artists,proto3" json:"artists,omitempty"`
+	// range is the days plays were counted over. Its start_time is unset for
+	// TIME_WINDOW_ALL_TIME.
+	Range *TimeRange `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+}
+
+func (x *GetTopArtistsResponse) Reset() {
+	*x = GetTopArtistsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTopArtistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopArtistsResponse) ProtoMessage() {}
+
+func (x *GetTopArtistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopArtistsResponse.ProtoReflect.Descriptor instead.
+func (*GetTopArtistsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *GetTopArtistsResponse) GetArtists() []*TopArtist {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
+}
+
+func (x *GetTopArtistsResponse) GetRange() *TimeRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+type GetTopAlbumsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user_id defaults to the caller.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// window defaults to the last 30 days.
+	Window TimeWindow `protobuf:"varint,2,opt,name=window,proto3,enum=mootslive.v1.TimeWindow" json:"window,omitempty"`
+	// custom_range must be set if window is TIME_WINDOW_CUSTOM.
+	CustomRange *TimeRange `protobuf:"bytes,3,opt,name=custom_range,json=customRange,proto3" json:"custom_range,omitempty"`
+	// limit defaults to 10, and is capped at 100.
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetTopAlbumsRequest) Reset() {
+	*x = GetTopAlbumsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTopAlbumsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopAlbumsRequest) ProtoMessage() {}
+
+func (x *GetTopAlbumsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopAlbumsRequest.ProtoReflect.Descriptor instead.
+func (*GetTopAlbumsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *GetTopAlbumsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTopAlbumsRequest) GetWindow() TimeWindow {
+	if x != nil {
+		return x.Window
+	}
+	return TimeWindow_TIME_WINDOW_UNSPECIFIED
+}
+
+func (x *GetTopAlbumsRequest) GetCustomRange() *TimeRange {
+	if x != nil {
+		return x.CustomRange
+	}
+	return nil
+}
+
+func (x *GetTopAlbumsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetTopAlbumsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// albums are the most played first. Plays of tracks we don't hold
+	// metadata for aren't counted.
+	Albums []*TopAlbum `protobuf:"bytes,1,rep,name=albums,proto3" json:"albums,omitempty"`
+	// range is the days plays were counted over. Its start_time is unset for
+	// TIME_WINDOW_ALL_TIME.
+	Range *TimeRange `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+}
+
+func (x *GetTopAlbumsResponse) Reset() {
+	*x = GetTopAlbumsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTopAlbumsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopAlbumsResponse) ProtoMessage() {}
+
+func (x *GetTopAlbumsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopAlbumsResponse.ProtoReflect.Descriptor instead.
+func (*GetTopAlbumsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *GetTopAlbumsResponse) GetAlbums() []*TopAlbum {
+	if x != nil {
+		return x.Albums
+	}
+	return nil
+}
+
+func (x *GetTopAlbumsResponse) GetRange() *TimeRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+type HeatmapCell struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// day_of_week is from 1 for Monday to 7 for Sunday.
+	DayOfWeek int32 `protobuf:"varint,1,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	// hour is from 0 to 23.
+	Hour  int32 `protobuf:"varint,2,opt,name=hour,proto3" json:"hour,omitempty"`
+	Plays int64 `protobuf:"varint,3,opt,name=plays,proto3" json:"plays,omitempty"`
+}
+
+func (x *HeatmapCell) Reset() {
+	*x = HeatmapCell{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeatmapCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeatmapCell) ProtoMessage() {}
+
+func (x *HeatmapCell) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeatmapCell.ProtoReflect.Descriptor instead.
+func (*HeatmapCell) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *HeatmapCell) GetDayOfWeek() int32 {
+	if x != nil {
+		return x.DayOfWeek
+	}
+	return 0
+}
+
+func (x *HeatmapCell) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *HeatmapCell) GetPlays() int64 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+type DailyPlays struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// date is formatted as YYYY-MM-DD.
+	Date  string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Plays int64  `protobuf:"varint,2,opt,name=plays,proto3" json:"plays,omitempty"`
+}
+
+func (x *DailyPlays) Reset() {
+	*x = DailyPlays{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DailyPlays) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPlays) ProtoMessage() {}
+
+func (x *DailyPlays) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPlays.ProtoReflect.Descriptor instead.
+func (*DailyPlays) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *DailyPlays) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyPlays) GetPlays() int64 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+type GetListeningStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// window defaults to the last 30 days. Days are those of the caller's time
+	// zone, rather than UTC.
+	Window TimeWindow `protobuf:"varint,1,opt,name=window,proto3,enum=mootslive.v1.TimeWindow" json:"window,omitempty"`
+	// custom_range must be set if window is TIME_WINDOW_CUSTOM.
+	CustomRange *TimeRange `protobuf:"bytes,2,opt,name=custom_range,json=customRange,proto3" json:"custom_range,omitempty"`
+}
+
+func (x *GetListeningStatsRequest) Reset() {
+	*x = GetListeningStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListeningStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListeningStatsRequest) ProtoMessage() {}
+
+func (x *GetListeningStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListeningStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetListeningStatsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *GetListeningStatsRequest) GetWindow() TimeWindow {
+	if x != nil {
+		return x.Window
+	}
+	return TimeWindow_TIME_WINDOW_UNSPECIFIED
+}
+
+func (x *GetListeningStatsRequest) GetCustomRange() *TimeRange {
+	if x != nil {
+		return x.CustomRange
+	}
+	return nil
+}
+
+// GetListeningStatsResponse describes the caller's listening over a window,
+// in their time zone.
+type GetListeningStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeZone string     `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Range    *TimeRange `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+	// heatmap counts plays by hour of the day and day of the week. Cells
+	// without plays are left out.
+	Heatmap []*HeatmapCell `protobuf:"bytes,3,rep,name=heatmap,proto3" json:"heatmap,omitempty"`
+	// daily_plays are oldest first. Days without plays are left out.
+	DailyPlays []*DailyPlays `protobuf:"bytes,4,rep,name=daily_plays,json=dailyPlays,proto3" json:"daily_plays,omitempty"`
+	// current_streak_days is how many days in a row, up to today or
+	// yesterday, the caller has listened to something on. Unlike everything
+	// else, streaks aren't limited to the window.
+	CurrentStreakDays int32 `protobuf:"varint,5,opt,name=current_streak_days,json=currentStreakDays,proto3" json:"current_streak_days,omitempty"`
+	LongestStreakDays int32 `protobuf:"varint,6,opt,name=longest_streak_days,json=longestStreakDays,proto3" json:"longest_streak_days,omitempty"`
+	Plays             int64 `protobuf:"varint,7,opt,name=plays,proto3" json:"plays,omitempty"`
+	// estimated_listening_ms adds up the durations of the tracks played. Plays
+	// of tracks we don't hold metadata for are assumed to be of average
+	// length.
+	EstimatedListeningMs int64 `protobuf:"varint,8,opt,name=estimated_listening_ms,json=estimatedListeningMs,proto3" json:"estimated_listening_ms,omitempty"`
+	// new_track_plays counts the plays of tracks first played within the
+	// range, and repeat_plays those of tracks played before it.
+	NewTrackPlays int64 `protobuf:"varint,9,opt,name=new_track_plays,json=newTrackPlays,proto3" json:"new_track_plays,omitempty"`
+	RepeatPlays   int64 `protobuf:"varint,10,opt,name=repeat_plays,json=repeatPlays,proto3" json:"repeat_plays,omitempty"`
+}
+
+func (x *GetListeningStatsResponse) Reset() {
+	*x = GetListeningStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListeningStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListeningStatsResponse) ProtoMessage() {}
+
+func (x *GetListeningStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListeningStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetListeningStatsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *GetListeningStatsResponse) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *GetListeningStatsResponse) GetRange() *TimeRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *GetListeningStatsResponse) GetHeatmap() []*HeatmapCell {
+	if x != nil {
+		return x.Heatmap
+	}
+	return nil
+}
+
+func (x *GetListeningStatsResponse) GetDailyPlays() []*DailyPlays {
+	if x != nil {
+		return x.DailyPlays
+	}
+	return nil
+}
+
+func (x *GetListeningStatsResponse) GetCurrentStreakDays() int32 {
+	if x != nil {
+		return x.CurrentStreakDays
+	}
+	return 0
+}
+
+func (x *GetListeningStatsResponse) GetLongestStreakDays() int32 {
+	if x != nil {
+		return x.LongestStreakDays
+	}
+	return 0
+}
+
+func (x *GetListeningStatsResponse) GetPlays() int64 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+func (x *GetListeningStatsResponse) GetEstimatedListeningMs() int64 {
+	if x != nil {
+		return x.EstimatedListeningMs
+	}
+	return 0
+}
+
+func (x *GetListeningStatsResponse) GetNewTrackPlays() int64 {
+	if x != nil {
+		return x.NewTrackPlays
+	}
+	return 0
+}
+
+func (x *GetListeningStatsResponse) GetRepeatPlays() int64 {
+	if x != nil {
+		return x.RepeatPlays
+	}
+	return 0
+}
+
+type LoopedTrack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Track *Track `protobuf:"bytes,1,opt,name=track,proto3" json:"track,omitempty"`
+	// plays is how many times in a row the track was played.
+	Plays     int32                  `protobuf:"varint,2,opt,name=plays,proto3" json:"plays,omitempty"`
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+}
+
+func (x *LoopedTrack) Reset() {
+	*x = LoopedTrack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoopedTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoopedTrack) ProtoMessage() {}
+
+func (x *LoopedTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoopedTrack.ProtoReflect.Descriptor instead.
+func (*LoopedTrack) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *LoopedTrack) GetTrack() *Track {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+func (x *LoopedTrack) GetPlays() int32 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+func (x *LoopedTrack) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+// MootsComparison compares a user's year with those of the moots whose
+// listens they can see.
+type MootsComparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MootsCompared int32 `protobuf:"varint,1,opt,name=moots_compared,json=mootsCompared,proto3" json:"moots_compared,omitempty"`
+	// rank is 1 if the user played more than any of their moots.
+	Rank int32 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// percentile is the percentage of moots the user played more than.
+	Percentile float64 `protobuf:"fixed64,3,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	// top_artist_moots is how many moots also have the user's top artist among
+	// their own top artists of the year.
+	TopArtistMoots int32 `protobuf:"varint,4,opt,name=top_artist_moots,json=topArtistMoots,proto3" json:"top_artist_moots,omitempty"`
+}
+
+func (x *MootsComparison) Reset() {
+	*x = MootsComparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MootsComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MootsComparison) ProtoMessage() {}
+
+func (x *MootsComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MootsComparison.ProtoReflect.Descriptor instead.
+func (*MootsComparison) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *MootsComparison) GetMootsCompared() int32 {
+	if x != nil {
+		return x.MootsCompared
+	}
+	return 0
+}
+
+func (x *MootsComparison) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *MootsComparison) GetPercentile() float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
+func (x *MootsComparison) GetTopArtistMoots() int32 {
+	if x != nil {
+		return x.TopArtistMoots
+	}
+	return 0
+}
+
+// YearlyRecap is a snapshot of a user's listening over a year in their time
+// zone, taken once the year is over.
+type YearlyRecap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year       int32        `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	TimeZone   string       `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	TopTracks  []*TopTrack  `protobuf:"bytes,3,rep,name=top_tracks,json=topTracks,proto3" json:"top_tracks,omitempty"`
+	TopArtists []*TopArtist `protobuf:"bytes,4,rep,name=top_artists,json=topArtists,proto3" json:"top_artists,omitempty"`
+	Plays      int64        `protobuf:"varint,5,opt,name=plays,proto3" json:"plays,omitempty"`
+	// estimated_listening_minutes assumes plays of tracks we don't hold
+	// metadata for are of average length.
+	EstimatedListeningMinutes int64 `protobuf:"varint,6,opt,name=estimated_listening_minutes,json=estimatedListeningMinutes,proto3" json:"estimated_listening_minutes,omitempty"`
+	// top_month is from 1 for January to 12 for December.
+	TopMonth      int32 `protobuf:"varint,7,opt,name=top_month,json=topMonth,proto3" json:"top_month,omitempty"`
+	TopMonthPlays int64 `protobuf:"varint,8,opt,name=top_month_plays,json=topMonthPlays,proto3" json:"top_month_plays,omitempty"`
+	// most_looped is the track played the most times in a row.
+	MostLooped  *LoopedTrack     `protobuf:"bytes,9,opt,name=most_looped,json=mostLooped,proto3" json:"most_looped,omitempty"`
+	FirstListen *RecentListen    `protobuf:"bytes,10,opt,name=first_listen,json=firstListen,proto3" json:"first_listen,omitempty"`
+	Moots       *MootsComparison `protobuf:"bytes,11,opt,name=moots,proto3" json:"moots,omitempty"`
+}
+
+func (x *YearlyRecap) Reset() {
+	*x = YearlyRecap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *YearlyRecap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*YearlyRecap) ProtoMessage() {}
+
+func (x *YearlyRecap) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use YearlyRecap.ProtoReflect.Descriptor instead.
+func (*YearlyRecap) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *YearlyRecap) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *YearlyRecap) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *YearlyRecap) GetTopTracks() []*TopTrack {
+	if x != nil {
+		return x.TopTracks
+	}
+	return nil
+}
+
+func (x *YearlyRecap) GetTopArtists() []*TopArtist {
+	if x != nil {
+		return x.TopArtists
+	}
+	return nil
+}
+
+func (x *YearlyRecap) GetPlays() int64 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+func (x *YearlyRecap) GetEstimatedListeningMinutes() int64 {
+	if x != nil {
+		return x.EstimatedListeningMinutes
+	}
+	return 0
+}
+
+func (x *YearlyRecap) GetTopMonth() int32 {
+	if x != nil {
+		return x.TopMonth
+	}
+	return 0
+}
+
+func (x *YearlyRecap) GetTopMonthPlays() int64 {
+	if x != nil {
+		return x.TopMonthPlays
+	}
+	return 0
+}
+
+func (x *YearlyRecap) GetMostLooped() *LoopedTrack {
+	if x != nil {
+		return x.MostLooped
+	}
+	return nil
+}
+
+func (x *YearlyRecap) GetFirstListen() *RecentListen {
+	if x != nil {
+		return x.FirstListen
+	}
+	return nil
+}
+
+func (x *YearlyRecap) GetMoots() *MootsComparison {
+	if x != nil {
+		return x.Moots
+	}
+	return nil
+}
+
+type GetRecapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+}
+
+func (x *GetRecapRequest) Reset() {
+	*x = GetRecapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecapRequest) ProtoMessage() {}
+
+func (x *GetRecapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecapRequest.ProtoReflect.Descriptor instead.
+func (*GetRecapRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *GetRecapRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type GetRecapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recap *YearlyRecap `protobuf:"bytes,1,opt,name=recap,proto3" json:"recap,omitempty"`
+	// version increases each time the recap is regenerated.
+	Version     int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	GeneratedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+}
+
+func (x *GetRecapResponse) Reset() {
+	*x = GetRecapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecapResponse) ProtoMessage() {}
+
+func (x *GetRecapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecapResponse.ProtoReflect.Descriptor instead.
+func (*GetRecapResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *GetRecapResponse) GetRecap() *YearlyRecap {
+	if x != nil {
+		return x.Recap
+	}
+	return nil
+}
+
+func (x *GetRecapResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetRecapResponse) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+type ChartTrack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank int32 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	// track only has its ISRC set if we don't hold metadata for it.
+	Track *Track `protobuf:"bytes,2,opt,name=track,proto3" json:"track,omitempty"`
+	Plays int64  `protobuf:"varint,3,opt,name=plays,proto3" json:"plays,omitempty"`
+	// listeners is how many users played the track.
+	Listeners int32 `protobuf:"varint,4,opt,name=listeners,proto3" json:"listeners,omitempty"`
+	// previous_rank is zero if the track wasn't in the previous chart.
+	PreviousRank int32         `protobuf:"varint,5,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"`
+	Movement     ChartMovement `protobuf:"varint,6,opt,name=movement,proto3,enum=mootslive.v1.ChartMovement" json:"movement,omitempty"`
+}
+
+func (x *ChartTrack) Reset() {
+	*x = ChartTrack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChartTrack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChartTrack) ProtoMessage() {}
+
+func (x *ChartTrack) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChartTrack.ProtoReflect.Descriptor instead.
+func (*ChartTrack) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *ChartTrack) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *ChartTrack) GetTrack() *Track {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+func (x *ChartTrack) GetPlays() int64 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+func (x *ChartTrack) GetListeners() int32 {
+	if x != nil {
+		return x.Listeners
+	}
+	return 0
+}
+
+func (x *ChartTrack) GetPreviousRank() int32 {
+	if x != nil {
+		return x.PreviousRank
+	}
+	return 0
+}
+
+func (x *ChartTrack) GetMovement() ChartMovement {
+	if x != nil {
+		return x.Movement
+	}
+	return ChartMovement_CHART_MOVEMENT_UNSPECIFIED
+}
+
+type ChartArtist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank         int32         `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Artist       *Artist       `protobuf:"bytes,2,opt,name=artist,proto3" json:"artist,omitempty"`
+	Plays        int64         `protobuf:"varint,3,opt,name=plays,proto3" json:"plays,omitempty"`
+	Listeners    int32         `protobuf:"varint,4,opt,name=listeners,proto3" json:"listeners,omitempty"`
+	PreviousRank int32         `protobuf:"varint,5,opt,name=previous_rank,json=previousRank,proto3" json:"previous_rank,omitempty"`
+	Movement     ChartMovement `protobuf:"varint,6,opt,name=movement,proto3,enum=mootslive.v1.ChartMovement" json:"movement,omitempty"`
+}
+
+func (x *ChartArtist) Reset() {
+	*x = ChartArtist{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChartArtist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChartArtist) ProtoMessage() {}
+
+func (x *ChartArtist) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChartArtist.ProtoReflect.Descriptor instead.
+func (*ChartArtist) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *ChartArtist) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *ChartArtist) GetArtist() *Artist {
+	if x != nil {
+		return x.Artist
+	}
+	return nil
+}
+
+func (x *ChartArtist) GetPlays() int64 {
+	if x != nil {
+		return x.Plays
+	}
+	return 0
+}
+
+func (x *ChartArtist) GetListeners() int32 {
+	if x != nil {
+		return x.Listeners
+	}
+	return 0
+}
+
+func (x *ChartArtist) GetPreviousRank() int32 {
+	if x != nil {
+		return x.PreviousRank
+	}
+	return 0
+}
+
+func (x *ChartArtist) GetMovement() ChartMovement {
+	if x != nil {
+		return x.Movement
+	}
+	return ChartMovement_CHART_MOVEMENT_UNSPECIFIED
+}
+
+type GetChartsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope  ChartScope  `protobuf:"varint,1,opt,name=scope,proto3,enum=mootslive.v1.ChartScope" json:"scope,omitempty"`
+	Period ChartPeriod `protobuf:"varint,2,opt,name=period,proto3,enum=mootslive.v1.ChartPeriod" json:"period,omitempty"`
+	// time picks the chart of the period containing it, for browsing past
+	// charts. The latest chart is returned if it isn't set.
+	Time *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *GetChartsRequest) Reset() {
+	*x = GetChartsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChartsRequest) ProtoMessage() {}
+
+func (x *GetChartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChartsRequest.ProtoReflect.Descriptor instead.
+func (*GetChartsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *GetChartsRequest) GetScope() ChartScope {
+	if x != nil {
+		return x.Scope
+	}
+	return ChartScope_CHART_SCOPE_UNSPECIFIED
+}
+
+func (x *GetChartsRequest) GetPeriod() ChartPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return ChartPeriod_CHART_PERIOD_UNSPECIFIED
+}
+
+func (x *GetChartsRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type GetChartsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope      ChartScope             `protobuf:"varint,1,opt,name=scope,proto3,enum=mootslive.v1.ChartScope" json:"scope,omitempty"`
+	Period     ChartPeriod            `protobuf:"varint,2,opt,name=period,proto3,enum=mootslive.v1.ChartPeriod" json:"period,omitempty"`
+	Range      *TimeRange             `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
+	Tracks     []*ChartTrack          `protobuf:"bytes,4,rep,name=tracks,proto3" json:"tracks,omitempty"`
+	Artists    []*ChartArtist         `protobuf:"bytes,5,rep,name=artists,proto3" json:"artists,omitempty"`
+	ComputedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+}
+
+func (x *GetChartsResponse) Reset() {
+	*x = GetChartsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChartsResponse) ProtoMessage() {}
+
+func (x *GetChartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChartsResponse.ProtoReflect.Descriptor instead.
+func (*GetChartsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *GetChartsResponse) GetScope() ChartScope {
+	if x != nil {
+		return x.Scope
+	}
+	return ChartScope_CHART_SCOPE_UNSPECIFIED
+}
+
+func (x *GetChartsResponse) GetPeriod() ChartPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return ChartPeriod_CHART_PERIOD_UNSPECIFIED
+}
+
+func (x *GetChartsResponse) GetRange() *TimeRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *GetChartsResponse) GetTracks() []*ChartTrack {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+func (x *GetChartsResponse) GetArtists() []*ChartArtist {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
+}
+
+func (x *GetChartsResponse) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+type CreateListenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Isrc       string                 `protobuf:"bytes,1,opt,name=isrc,proto3" json:"isrc,omitempty"`
+	ListenedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=listened_at,json=listenedAt,proto3" json:"listened_at,omitempty"`
+}
+
+func (x *CreateListenRequest) Reset() {
+	*x = CreateListenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateListenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateListenRequest) ProtoMessage() {}
+
+func (x *CreateListenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateListenRequest.ProtoReflect.Descriptor instead.
+func (*CreateListenRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *CreateListenRequest) GetIsrc() string {
+	if x != nil {
+		return x.Isrc
+	}
+	return ""
+}
+
+func (x *CreateListenRequest) GetListenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ListenedAt
+	}
+	return nil
+}
+
+type CreateListenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listen *Listen `protobuf:"bytes,1,opt,name=listen,proto3" json:"listen,omitempty"`
+}
+
+func (x *CreateListenResponse) Reset() {
+	*x = CreateListenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateListenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateListenResponse) ProtoMessage() {}
+
+func (x *CreateListenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateListenResponse.ProtoReflect.Descriptor instead.
+func (*CreateListenResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *CreateListenResponse) GetListen() *Listen {
+	if x != nil {
+		return x.Listen
+	}
+	return nil
+}
+
+type UpdateListenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListenId string `protobuf:"bytes,1,opt,name=listen_id,json=listenId,proto3" json:"listen_id,omitempty"`
+	// isrc and listened_at are left unchanged if they aren't set.
+	Isrc       string                 `protobuf:"bytes,2,opt,name=isrc,proto3" json:"isrc,omitempty"`
+	ListenedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=listened_at,json=listenedAt,proto3" json:"listened_at,omitempty"`
+}
+
+func (x *UpdateListenRequest) Reset() {
+	*x = UpdateListenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateListenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateListenRequest) ProtoMessage() {}
+
+func (x *UpdateListenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateListenRequest.ProtoReflect.Descriptor instead.
+func (*UpdateListenRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *UpdateListenRequest) GetListenId() string {
+	if x != nil {
+		return x.ListenId
+	}
+	return ""
+}
+
+func (x *UpdateListenRequest) GetIsrc() string {
+	if x != nil {
+		return x.Isrc
+	}
+	return ""
+}
+
+func (x *UpdateListenRequest) GetListenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ListenedAt
+	}
+	return nil
+}
+
+type UpdateListenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listen *Listen `protobuf:"bytes,1,opt,name=listen,proto3" json:"listen,omitempty"`
+}
+
+func (x *UpdateListenResponse) Reset() {
+	*x = UpdateListenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateListenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateListenResponse) ProtoMessage() {}
+
+func (x *UpdateListenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateListenResponse.ProtoReflect.Descriptor instead.
+func (*UpdateListenResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *UpdateListenResponse) GetListen() *Listen {
+	if x != nil {
+		return x.Listen
+	}
+	return nil
+}
+
+type DeleteListenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListenId string `protobuf:"bytes,1,opt,name=listen_id,json=listenId,proto3" json:"listen_id,omitempty"`
+}
+
+func (x *DeleteListenRequest) Reset() {
+	*x = DeleteListenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteListenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteListenRequest) ProtoMessage() {}
+
+func (x *DeleteListenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteListenRequest.ProtoReflect.Descriptor instead.
+func (*DeleteListenRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *DeleteListenRequest) GetListenId() string {
+	if x != nil {
+		return x.ListenId
+	}
+	return ""
+}
+
+type DeleteListenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteListenResponse) Reset() {
+	*x = DeleteListenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteListenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteListenResponse) ProtoMessage() {}
+
+func (x *DeleteListenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteListenResponse.ProtoReflect.Descriptor instead.
+func (*DeleteListenResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{172}
+}
+
+type DeleteListensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start_time and end_time are the range of listens to delete. end_time is
+	// excluded.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *DeleteListensRequest) Reset() {
+	*x = DeleteListensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteListensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteListensRequest) ProtoMessage() {}
+
+func (x *DeleteListensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteListensRequest.ProtoReflect.Descriptor instead.
+func (*DeleteListensRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *DeleteListensRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *DeleteListensRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type DeleteListensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deleted int64 `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *DeleteListensResponse) Reset() {
+	*x = DeleteListensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteListensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteListensResponse) ProtoMessage() {}
+
+func (x *DeleteListensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteListensResponse.ProtoReflect.Descriptor instead.
+func (*DeleteListensResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *DeleteListensResponse) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+// ListenEdit is a change a user made to their listens by hand. The old values
+// are unset for a listen being created, and the new ones for a listen being
+// deleted.
+type ListenEdit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ListenId      string                 `protobuf:"bytes,2,opt,name=listen_id,json=listenId,proto3" json:"listen_id,omitempty"`
+	Action        ListenEditAction       `protobuf:"varint,3,opt,name=action,proto3,enum=mootslive.v1.ListenEditAction" json:"action,omitempty"`
+	OldIsrc       string                 `protobuf:"bytes,4,opt,name=old_isrc,json=oldIsrc,proto3" json:"old_isrc,omitempty"`
+	OldListenedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=old_listened_at,json=oldListenedAt,proto3" json:"old_listened_at,omitempty"`
+	NewIsrc       string                 `protobuf:"bytes,6,opt,name=new_isrc,json=newIsrc,proto3" json:"new_isrc,omitempty"`
+	NewListenedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=new_listened_at,json=newListenedAt,proto3" json:"new_listened_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ListenEdit) Reset() {
+	*x = ListenEdit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListenEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListenEdit) ProtoMessage() {}
+
+func (x *ListenEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListenEdit.ProtoReflect.Descriptor instead.
+func (*ListenEdit) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *ListenEdit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListenEdit) GetListenId() string {
+	if x != nil {
+		return x.ListenId
+	}
+	return ""
+}
+
+func (x *ListenEdit) GetAction() ListenEditAction {
+	if x != nil {
+		return x.Action
+	}
+	return ListenEditAction_LISTEN_EDIT_ACTION_UNSPECIFIED
+}
+
+func (x *ListenEdit) GetOldIsrc() string {
+	if x != nil {
+		return x.OldIsrc
+	}
+	return ""
+}
+
+func (x *ListenEdit) GetOldListenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OldListenedAt
+	}
+	return nil
+}
+
+func (x *ListenEdit) GetNewIsrc() string {
+	if x != nil {
+		return x.NewIsrc
+	}
+	return ""
+}
+
+func (x *ListenEdit) GetNewListenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NewListenedAt
+	}
+	return nil
+}
+
+func (x *ListenEdit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListListenEditsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListListenEditsRequest) Reset() {
+	*x = ListListenEditsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListListenEditsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListListenEditsRequest) ProtoMessage() {}
+
+func (x *ListListenEditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListListenEditsRequest.ProtoReflect.Descriptor instead.
+func (*ListListenEditsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *ListListenEditsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListListenEditsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListListenEditsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Edits         []*ListenEdit `protobuf:"bytes,1,rep,name=edits,proto3" json:"edits,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListListenEditsResponse) Reset() {
+	*x = ListListenEditsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListListenEditsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListListenEditsResponse) ProtoMessage() {}
+
+func (x *ListListenEditsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListListenEditsResponse.ProtoReflect.Descriptor instead.
+func (*ListListenEditsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *ListListenEditsResponse) GetEdits() []*ListenEdit {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
+func (x *ListListenEditsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ExportListensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format ExportFormat `protobuf:"varint,1,opt,name=format,proto3,enum=mootslive.v1.ExportFormat" json:"format,omitempty"`
+	// start_time and end_time limit the export to listens within them.
+	// end_time is excluded. Either can be left unset.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *ExportListensRequest) Reset() {
+	*x = ExportListensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportListensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportListensRequest) ProtoMessage() {}
+
+func (x *ExportListensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportListensRequest.ProtoReflect.Descriptor instead.
+func (*ExportListensRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *ExportListensRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportListensRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ExportListensRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+// ExportListensResponse carries a chunk of an export of the caller's listens.
+// Joining the data of every message in the stream produces the file.
+type ExportListensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// content_type and filename are only set on the first message.
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename    string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+}
+
+func (x *ExportListensResponse) Reset() {
+	*x = ExportListensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportListensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportListensResponse) ProtoMessage() {}
+
+func (x *ExportListensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportListensResponse.ProtoReflect.Descriptor instead.
+func (*ExportListensResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *ExportListensResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportListensResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportListensResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type GetSyncStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSyncStatusRequest) Reset() {
+	*x = GetSyncStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSyncStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncStatusRequest) ProtoMessage() {}
+
+func (x *GetSyncStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSyncStatusRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{180}
+}
+
+// AccountSyncStatus is how up to date the listens synced from one of a user's
+// linked accounts are.
+type AccountSyncStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider       Provider `protobuf:"varint,1,opt,name=provider,proto3,enum=mootslive.v1.Provider" json:"provider,omitempty"`
+	ProviderUserId string   `protobuf:"bytes,2,opt,name=provider_user_id,json=providerUserId,proto3" json:"provider_user_id,omitempty"`
+	// last_synced_at is when the account was last synced successfully. It's
+	// unset if it never has been.
+	LastSyncedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_synced_at,json=lastSyncedAt,proto3" json:"last_synced_at,omitempty"`
+	// last_listened_at is the time of the most recent listen synced from the
+	// account.
+	LastListenedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_listened_at,json=lastListenedAt,proto3" json:"last_listened_at,omitempty"`
+	// failing is set when the latest attempt to sync the account failed.
+	Failing bool `protobuf:"varint,5,opt,name=failing,proto3" json:"failing,omitempty"`
+}
+
+func (x *AccountSyncStatus) Reset() {
+	*x = AccountSyncStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountSyncStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountSyncStatus) ProtoMessage() {}
+
+func (x *AccountSyncStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountSyncStatus.ProtoReflect.Descriptor instead.
+func (*AccountSyncStatus) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *AccountSyncStatus) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_PROVIDER_UNSPECIFIED
+}
+
+func (x *AccountSyncStatus) GetProviderUserId() string {
+	if x != nil {
+		return x.ProviderUserId
+	}
+	return ""
+}
+
+func (x *AccountSyncStatus) GetLastSyncedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncedAt
+	}
+	return nil
+}
+
+func (x *AccountSyncStatus) GetLastListenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastListenedAt
+	}
+	return nil
+}
+
+func (x *AccountSyncStatus) GetFailing() bool {
+	if x != nil {
+		return x.Failing
+	}
+	return false
+}
+
+type GetSyncStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accounts []*AccountSyncStatus `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (x *GetSyncStatusResponse) Reset() {
+	*x = GetSyncStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSyncStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncStatusResponse) ProtoMessage() {}
+
+func (x *GetSyncStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSyncStatusResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *GetSyncStatusResponse) GetAccounts() []*AccountSyncStatus {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+// SecurityEvent is a security relevant event affecting the caller's account.
+type SecurityEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind AuditEventKind `protobuf:"varint,2,opt,name=kind,proto3,enum=mootslive.v1.AuditEventKind" json:"kind,omitempty"`
+	// by_admin is set for events caused by an admin rather than the user, in
+	// which case the client the admin used isn't shown.
+	ByAdmin        bool                   `protobuf:"varint,3,opt,name=by_admin,json=byAdmin,proto3" json:"by_admin,omitempty"`
+	Provider       Provider               `protobuf:"varint,4,opt,name=provider,proto3,enum=mootslive.v1.Provider" json:"provider,omitempty"`
+	ProviderUserId string                 `protobuf:"bytes,5,opt,name=provider_user_id,json=providerUserId,proto3" json:"provider_user_id,omitempty"`
+	IpAddress      string                 `protobuf:"bytes,6,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent      string                 `protobuf:"bytes,7,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *SecurityEvent) Reset() {
+	*x = SecurityEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecurityEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityEvent) ProtoMessage() {}
+
+func (x *SecurityEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityEvent.ProtoReflect.Descriptor instead.
+func (*SecurityEvent) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *SecurityEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetKind() AuditEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AuditEventKind_AUDIT_EVENT_KIND_UNSPECIFIED
+}
+
+func (x *SecurityEvent) GetByAdmin() bool {
+	if x != nil {
+		return x.ByAdmin
+	}
+	return false
+}
+
+func (x *SecurityEvent) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_PROVIDER_UNSPECIFIED
+}
+
+func (x *SecurityEvent) GetProviderUserId() string {
+	if x != nil {
+		return x.ProviderUserId
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *SecurityEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListMySecurityEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListMySecurityEventsRequest) Reset() {
+	*x = ListMySecurityEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMySecurityEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMySecurityEventsRequest) ProtoMessage() {}
+
+func (x *ListMySecurityEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMySecurityEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListMySecurityEventsRequest) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *ListMySecurityEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMySecurityEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMySecurityEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events        []*SecurityEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string           `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListMySecurityEventsResponse) Reset() {
+	*x = ListMySecurityEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mootslive_v1_mootslive_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMySecurityEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMySecurityEventsResponse) ProtoMessage() {}
+
+func (x *ListMySecurityEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mootslive_v1_mootslive_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMySecurityEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListMySecurityEventsResponse) Descriptor() ([]byte, []int) {
+	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *ListMySecurityEventsResponse) GetEvents() []*SecurityEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListMySecurityEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_mootslive_v1_mootslive_proto protoreflect.FileDescriptor
+
+var file_mootslive_v1_mootslive_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x12, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x3b, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x22, 0x79,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x31, 0x0a,
+	0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x52, 0x11, 0x78, 0x5f, 0x63, 0x6c, 0x61, 0x63, 0x6b, 0x73,
+	0x5f, 0x6f, 0x76, 0x65, 0x72, 0x68, 0x65, 0x61, 0x64, 0x22, 0x43, 0x0a, 0x14, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65,
+	0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x22, 0x62,
+	0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x61, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x72, 0x65, 0x63, 0x61, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x59, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x61,
+	0x70, 0x52, 0x05, 0x72, 0x65, 0x63, 0x61, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x85, 0x03, 0x0a, 0x09, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4e,
+	0x0a, 0x15, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4a,
+	0x0a, 0x13, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22, 0x64, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x22, 0x6a, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x29, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa3, 0x02, 0x0a, 0x0b, 0x53, 0x70, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x6f, 0x74,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x5f, 0x66, 0x6f,
+	0x75, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x73, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x8c, 0x04,
+	0x0a, 0x14, 0x53, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x70, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x61,
+	0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x42, 0x0a, 0x0f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x46, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2b,
+	0x0a, 0x03, 0x6c, 0x61, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x6c, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x76,
+	0x65, 0x72, 0x64, 0x75, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3c,
+	0x0a, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x63, 0x61, 0x6e, 0x52,
+	0x0b, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x73, 0x22, 0xd3, 0x01, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x44, 0x0a,
+	0x0f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x10, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x52, 0x0f, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x22, 0x2d, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x42, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x11, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x12, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x12, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f,
+	0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x19, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x49, 0x0a, 0x1a, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x3d, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x17,
+	0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x54, 0x68, 0x72,
+	0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x63, 0x61, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x28, 0x0a, 0x10, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x73, 0x63, 0x61, 0x6e, 0x73,
+	0x50, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x22, 0x99, 0x03, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6f, 0x76, 0x65,
+	0x72, 0x64, 0x75, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x66, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x6c,
+	0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x67, 0x12, 0x4d, 0x0a, 0x10, 0x6c,
+	0x61, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x0f, 0x6c, 0x61, 0x67, 0x67, 0x69,
+	0x6e, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x08, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68,
+	0x70, 0x75, 0x74, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x46, 0x0a,
+	0x11, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x10, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x8a, 0x03, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x32, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xc4, 0x02, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a,
+	0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x73, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x0e,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc2,
+	0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x32, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x69,
+	0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xd8, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x44, 0x0a, 0x0f, 0x6c, 0x69, 0x6e,
+	0x6b, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69,
+	0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x8b,
+	0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x51, 0x0a, 0x0b,
+	0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x6b, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70,
+	0x6b, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22,
+	0x19, 0x0a, 0x17, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x41,
+	0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6e, 0x0a, 0x18, 0x42, 0x65,
+	0x67, 0x69, 0x6e, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x18, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x43, 0x6f, 0x64, 0x65, 0x22, 0x36, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x77,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa8, 0x02, 0x0a,
+	0x06, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73,
+	0x72, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x72, 0x63, 0x12, 0x3b,
+	0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x39, 0x0a, 0x09,
+	0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x55, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x63, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x61, 0x63, 0x74, 0x65, 0x64, 0x22, 0xbc,
+	0x02, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69,
+	0x73, 0x72, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x72, 0x63, 0x12,
+	0x1b, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x6f,
+	0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x6d, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x07, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x15, 0x0a, 0x13,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x79, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x56, 0x0a, 0x17, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x16, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x68,
+	0x61, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x34, 0x0a,
+	0x16, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x5f, 0x72, 0x65,
+	0x63, 0x61, 0x70, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x61, 0x70, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x22, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x54, 0x77, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x65, 0x0a, 0x21, 0x47, 0x65, 0x74,
+	0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
+	0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x22, 0x67, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x6f, 0x6f, 0x74,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x53, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x68, 0x0a, 0x24, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e,
+	0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x40, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e,
+	0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0x31, 0x0a, 0x12, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x13, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x77, 0x65, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x77, 0x65, 0x65, 0x74, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x04, 0x4d, 0x6f, 0x6f, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x77, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x74, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x6f, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x6f,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x6f, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x6f, 0x74, 0x52, 0x05, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x22, 0xf6, 0x01,
+	0x0a, 0x06, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2c, 0x0a, 0x11, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x12, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x2e, 0x0a, 0x13, 0x55, 0x6e, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x6e, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x47, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x07, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x07, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x73, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x47, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x07, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x4c, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x07, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x07, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x22, 0x36, 0x0a,
+	0x1b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x06, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x22, 0x33, 0x0a, 0x18, 0x44, 0x65, 0x6e, 0x79, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x44, 0x65, 0x6e, 0x79,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x0e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x55,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x57, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x58,
+	0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38,
+	0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x2c, 0x0a, 0x06, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x05, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c,
+	0x22, 0xd5, 0x01, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73,
+	0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x72, 0x63, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x28,
+	0x0a, 0x10, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x70, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x22, 0x77, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x72,
+	0x6c, 0x22, 0x95, 0x01, 0x0a, 0x09, 0x46, 0x65, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x2d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x29,
+	0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x2e, 0x0a, 0x07, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x52, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x22, 0x4d, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6d, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65,
+	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x9f, 0x01, 0x0a, 0x08, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x34, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x22, 0x38, 0x0a, 0x13, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x7a, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xf3, 0x01, 0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x48, 0x00,
+	0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e,
+	0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x49,
+	0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x07, 0x0a, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x7d, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x6f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x22, 0x0a, 0x0d, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0xe4, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x52, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x73, 0x12, 0x3b, 0x0a, 0x0e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x52, 0x0d, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12,
+	0x3b, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x72, 0x0a, 0x0f,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x38, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x57, 0x0a,
+	0x1a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x63, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x59, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x22, 0x5a, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x63, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x4d, 0x0a,
+	0x16, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x47, 0x0a, 0x17,
+	0x53, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x06, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x22, 0xce, 0x01, 0x0a, 0x0f, 0x49, 0x6e, 0x63, 0x6f, 0x67, 0x6e,
+	0x69, 0x74, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x74, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x56, 0x0a, 0x1d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x74, 0x6f, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x67, 0x6e,
+	0x69, 0x74, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x22, 0x1d, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x67, 0x6e, 0x69,
+	0x74, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x57, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x74,
+	0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x37, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x74, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22, 0x2e, 0x0a, 0x1c, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x74, 0x6f, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x74, 0x6f, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x12, 0x41, 0x64,
+	0x64, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x6f, 0x6a, 0x69, 0x22, 0x50, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4a, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a,
+	0x69, 0x22, 0x53, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x72,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x49, 0x64, 0x12,
+	0x31, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x44, 0x0a, 0x11, 0x41, 0x64, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22,
+	0x45, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17,
+	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6e, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x71, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x31, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x24, 0x0a, 0x12, 0x46, 0x6c,
+	0x61, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x15, 0x0a, 0x13, 0x46, 0x6c, 0x61, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcd, 0x02, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x74,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x52, 0x06, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x72, 0x65, 0x61, 0x64, 0x22, 0x56, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xa8, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
+	0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x75,
+	0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7a, 0x0a, 0x1c, 0x4d, 0x61,
+	0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x48, 0x0a, 0x12,
+	0x61, 0x6c, 0x6c, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x42, 0x0a, 0x1d, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x75,
+	0x6e, 0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x66, 0x0a, 0x16, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x22, 0x23, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6c, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a,
+	0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x6e, 0x0a, 0x24, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a,
+	0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x6f, 0x0a, 0x25, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46,
+	0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x48, 0x0a, 0x15, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x22, 0x4f, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x12, 0x2c, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70,
+	0x6c, 0x61, 0x79, 0x73, 0x22, 0x67, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x22, 0x2b, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0xb4, 0x02, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62,
+	0x69, 0x6f, 0x12, 0x37, 0x0a, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x5f, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x56, 0x69, 0x73,
+	0x69, 0x62, 0x6c, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65,
+	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x74, 0x6f, 0x70, 0x5f, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x74, 0x6f, 0x70, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x73, 0x22, 0x2b, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x13,
+	0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2d, 0x0a, 0x12, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2f, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x22, 0x2a, 0x0a, 0x0f, 0x4d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x12, 0x0a,
+	0x10, 0x4d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2c, 0x0a, 0x11, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x14, 0x0a, 0x12, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74,
+	0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49,
+	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x4b, 0x0a, 0x08, 0x54, 0x6f, 0x70,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x22, 0x4b, 0x0a, 0x08, 0x54, 0x6f, 0x70, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x12, 0x29, 0x0a, 0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6c,
+	0x61, 0x79, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x3a, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x75, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54,
+	0x6f, 0x70, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73,
+	0x12, 0x2d, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22,
+	0xb3, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x30, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x3a, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x6f, 0x6f, 0x74,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x79, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x6f, 0x70, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x73, 0x12, 0x2d, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x22, 0xb2, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x41, 0x6c, 0x62, 0x75, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x30, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x3a, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x6f, 0x6f, 0x74,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x75, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x41,
+	0x6c, 0x62, 0x75, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x06, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x06, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x2d, 0x0a,
+	0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x0b,
+	0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x1e, 0x0a, 0x0b, 0x64,
+	0x61, 0x79, 0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x64, 0x61, 0x79, 0x4f, 0x66, 0x57, 0x65, 0x65, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x6f, 0x75, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x70, 0x6c, 0x61, 0x79, 0x73, 0x22, 0x36, 0x0a, 0x0a, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x50, 0x6c,
+	0x61, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x22, 0x88, 0x01,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x3a, 0x0a, 0x0c,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xce, 0x03, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a,
+	0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a,
+	0x6f, 0x6e, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x07,
+	0x68, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x39, 0x0a, 0x0b, 0x64, 0x61, 0x69, 0x6c, 0x79,
+	0x5f, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x69, 0x6c,
+	0x79, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x50, 0x6c, 0x61,
+	0x79, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6b, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x44, 0x61,
+	0x79, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6b, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x11, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x44, 0x61,
+	0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x70, 0x6c, 0x61, 0x79,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x5f, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x0b, 0x4c, 0x6f,
+	0x6f, 0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x0f, 0x4d, 0x6f, 0x6f, 0x74, 0x73, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x6f,
+	0x74, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x70, 0x5f, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x74, 0x6f, 0x70, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x6f, 0x74, 0x73, 0x22, 0xfa,
+	0x03, 0x0a, 0x0b, 0x59, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x61, 0x70, 0x12, 0x12,
+	0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65,
+	0x61, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12,
+	0x35, 0x0a, 0x0a, 0x74, 0x6f, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x09, 0x74, 0x6f, 0x70,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x74, 0x6f, 0x70, 0x5f, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x6f,
+	0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x74, 0x6f, 0x70, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x65, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x4d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x5f, 0x6d, 0x6f,
+	0x6e, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x4d, 0x6f,
+	0x6e, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x70, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x5f, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f,
+	0x70, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x6d,
+	0x6f, 0x73, 0x74, 0x5f, 0x6c, 0x6f, 0x6f, 0x70, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x6f, 0x70, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x0a, 0x6d, 0x6f, 0x73,
+	0x74, 0x4c, 0x6f, 0x6f, 0x70, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63,
+	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x33, 0x0a, 0x05, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6f, 0x74, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x69, 0x73, 0x6f, 0x6e, 0x52, 0x05, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x22, 0x25, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65,
+	0x61, 0x72, 0x22, 0x9c, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x61, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x72, 0x65, 0x63, 0x61, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x59, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x52, 0x65, 0x63, 0x61,
+	0x70, 0x52, 0x05, 0x72, 0x65, 0x63, 0x61, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xdd, 0x01, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x70, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x72, 0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x4d,
+	0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0xe1, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x72, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x06, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x37, 0x0a, 0x08,
+	0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x61, 0x72, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x6d, 0x6f, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61,
+	0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x6f, 0x74,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x53, 0x63,
+	0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2e, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xc9, 0x02,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52,
+	0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x74, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63,
+	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x66, 0x0a, 0x13, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x69, 0x73, 0x72, 0x63, 0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x44, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x6f, 0x74,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52,
+	0x06, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x69, 0x73, 0x72, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x72, 0x63,
+	0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x22, 0x44, 0x0a,
+	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x06, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x22, 0x32, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x88, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x31, 0x0a, 0x15, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xea, 0x02,
+	0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x45, 0x64, 0x69, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x6f, 0x74,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x45,
+	0x64, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x69, 0x73, 0x72, 0x63, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x49, 0x73, 0x72, 0x63, 0x12, 0x42, 0x0a, 0x0f,
+	0x6f, 0x6c, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0d, 0x6f, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x69, 0x73, 0x72, 0x63, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x49, 0x73, 0x72, 0x63, 0x12, 0x42, 0x0a, 0x0f, 0x6e,
+	0x65, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0d, 0x6e, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x54, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x45, 0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x71, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x45, 0x64,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x65,
+	0x64, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x45, 0x64, 0x69, 0x74, 0x52, 0x05, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0xbc, 0x01, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x6a, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x16,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x93, 0x02, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x28, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0e, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x41, 0x74, 0x12, 0x44, 0x0a, 0x10,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x54, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x79,
+	0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x22, 0xc3, 0x02, 0x0a, 0x0d, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x79, 0x5f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x79, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x59, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x79, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x7b, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x2a, 0x7b, 0x0a, 0x11, 0x53, 0x70, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x63, 0x61, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x50, 0x4f, 0x54, 0x49, 0x46, 0x59,
+	0x5f, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x50,
+	0x4f, 0x54, 0x49, 0x46, 0x59, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c,
+	0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1e, 0x0a,
+	0x1a, 0x53, 0x50, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x52, 0x45,
+	0x53, 0x55, 0x4c, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x9c, 0x04,
+	0x0a, 0x0e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64,
+	0x12, 0x20, 0x0a, 0x1c, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x49, 0x47, 0x4e, 0x55, 0x50, 0x10, 0x01, 0x12,
+	0x1a, 0x0a, 0x16, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x41,
+	0x55, 0x44, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x44, 0x10, 0x03, 0x12, 0x25,
+	0x0a, 0x21, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x52, 0x45, 0x56, 0x4f,
+	0x4b, 0x45, 0x44, 0x10, 0x04, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44,
+	0x45, 0x52, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x45, 0x44, 0x10, 0x05, 0x12, 0x26, 0x0a, 0x22, 0x41,
+	0x55, 0x44, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x4c, 0x49, 0x4e, 0x4b, 0x45,
+	0x44, 0x10, 0x06, 0x12, 0x2f, 0x0a, 0x2b, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f,
+	0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x45, 0x44, 0x10, 0x07, 0x12, 0x2f, 0x0a, 0x2b, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c,
+	0x4c, 0x45, 0x44, 0x10, 0x08, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e,
+	0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x09, 0x12, 0x22, 0x0a, 0x1e, 0x41,
+	0x55, 0x44, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x0a, 0x12,
+	0x21, 0x0a, 0x1d, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44,
+	0x10, 0x0b, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x46, 0x4f,
+	0x52, 0x43, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x43, 0x41, 0x50,
+	0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x44, 0x10, 0x0d, 0x2a, 0x50, 0x0a, 0x08,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f, 0x56,
+	0x49, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x4f, 0x56, 0x49, 0x44, 0x45, 0x52, 0x5f, 0x54,
+	0x57, 0x49, 0x54, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x4f, 0x56,
+	0x49, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x50, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x10, 0x02, 0x2a, 0x69,
+	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x18, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x4c,
+	0x49, 0x53, 0x54, 0x45, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4e, 0x45, 0x57, 0x45,
+	0x53, 0x54, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49,
+	0x53, 0x54, 0x45, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4f, 0x4c, 0x44, 0x45, 0x53,
+	0x54, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x02, 0x2a, 0x64, 0x0a, 0x0c, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x46, 0x4f, 0x4c,
+	0x4c, 0x4f, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x46, 0x4f, 0x4c, 0x4c,
+	0x4f, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x02, 0x2a,
+	0x6a, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19,
+	0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x2a, 0xd3, 0x01, 0x0a, 0x0a,
+	0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x49,
+	0x4d, 0x45, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x49, 0x4d, 0x45, 0x5f,
+	0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x37, 0x5f, 0x44, 0x41,
+	0x59, 0x53, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x57, 0x49, 0x4e,
+	0x44, 0x4f, 0x57, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x33, 0x30, 0x5f, 0x44, 0x41, 0x59, 0x53,
+	0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f,
+	0x57, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x39, 0x30, 0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x03,
+	0x12, 0x1d, 0x0a, 0x19, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f,
+	0x4c, 0x41, 0x53, 0x54, 0x5f, 0x33, 0x36, 0x35, 0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x04, 0x12,
+	0x18, 0x0a, 0x14, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x41,
+	0x4c, 0x4c, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x49, 0x4d,
+	0x45, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10,
+	0x06, 0x2a, 0x6d, 0x0a, 0x0a, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x1a, 0x0a, 0x16, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x56,
+	0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43,
+	0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x53, 0x49, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59,
+	0x5f, 0x4d, 0x4f, 0x4f, 0x54, 0x53, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x49, 0x53, 0x49,
+	0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x03,
+	0x2a, 0x86, 0x02, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x1d, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x4f, 0x54, 0x49,
+	0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4e, 0x45,
+	0x57, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20,
+	0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x5f, 0x41,
+	0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x4e, 0x4f, 0x54,
+	0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52,
+	0x45, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x4e, 0x4f, 0x54,
+	0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43,
+	0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x4f, 0x54, 0x49,
+	0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x41,
+	0x4d, 0x45, 0x5f, 0x53, 0x4f, 0x4e, 0x47, 0x10, 0x06, 0x2a, 0x58, 0x0a, 0x0a, 0x43, 0x68, 0x61,
+	0x72, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x52, 0x54,
+	0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x52, 0x54, 0x5f, 0x53, 0x43,
+	0x4f, 0x50, 0x45, 0x5f, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
+	0x43, 0x48, 0x41, 0x52, 0x54, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x4f, 0x54,
+	0x53, 0x10, 0x02, 0x2a, 0x76, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x72, 0x74, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x48, 0x41, 0x52, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x49,
+	0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x52, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44,
+	0x5f, 0x44, 0x41, 0x49, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x41, 0x52,
+	0x54, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10,
+	0x02, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x52, 0x54, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f,
+	0x44, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x4c, 0x59, 0x10, 0x03, 0x2a, 0xad, 0x01, 0x0a, 0x0d,
+	0x43, 0x68, 0x61, 0x72, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a,
+	0x1a, 0x43, 0x48, 0x41, 0x52, 0x54, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a,
+	0x11, 0x43, 0x48, 0x41, 0x52, 0x54, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x55, 0x50, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x41, 0x52, 0x54, 0x5f, 0x4d, 0x4f,
+	0x56, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12, 0x17, 0x0a,
+	0x13, 0x43, 0x48, 0x41, 0x52, 0x54, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x52, 0x54, 0x5f,
+	0x4d, 0x4f, 0x56, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x45, 0x57, 0x10, 0x04, 0x12, 0x1b,
+	0x0a, 0x17, 0x43, 0x48, 0x41, 0x52, 0x54, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x52, 0x45, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x10, 0x05, 0x2a, 0x93, 0x01, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x45, 0x64, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x5f, 0x45, 0x44, 0x49, 0x54, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x5f, 0x45,
+	0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54,
+	0x45, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x5f, 0x45, 0x44,
+	0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
+	0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x5f, 0x45, 0x44, 0x49,
+	0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10,
+	0x03, 0x2a, 0x9e, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x58, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x10,
+	0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x42, 0x52, 0x41, 0x49, 0x4e, 0x5a, 0x10,
+	0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x53, 0x43, 0x52, 0x4f, 0x42, 0x42, 0x4c, 0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x47,
+	0x10, 0x04, 0x32, 0x82, 0x07, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1e, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x63, 0x61, 0x70, 0x12, 0x22, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x61,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x63, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4e, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x48, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x51, 0x0a, 0x0a, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x63, 0x61,
+	0x6e, 0x12, 0x20, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x12, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x8d, 0x2f, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x12, 0x1a, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x10, 0x42,
+	0x65, 0x67, 0x69, 0x6e, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12,
+	0x25, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x65, 0x67, 0x69, 0x6e, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x77, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x66, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x26, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x77, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x6f, 0x6f, 0x74,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59,
+	0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x21,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x4d, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x60, 0x0a, 0x0f, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4d, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x15, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x7e, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x68, 0x61,
+	0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2e, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x87, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x53, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x31, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x68, 0x61,
+	0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x53, 0x68, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x20, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4e, 0x0a, 0x09, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63,
+	0x4d, 0x6f, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63,
+	0x4d, 0x6f, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4e, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x6f, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x51, 0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x21, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x22, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x6d, 0x6f, 0x6f, 0x74,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f,
+	0x0a, 0x14, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x66, 0x0a, 0x11, 0x44, 0x65, 0x6e, 0x79, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6e, 0x79, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6e, 0x79,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x6f, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x29, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4b, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x12, 0x1d, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x6f,
+	0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a,
+	0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x63, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x27, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x2a, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0f,
+	0x53, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12,
+	0x24, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x48, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72,
+	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x74,
+	0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x2a, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63,
+	0x6f, 0x67, 0x6e, 0x69, 0x74, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x67, 0x6e, 0x69,
+	0x74, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x6f, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x67, 0x6e,
+	0x69, 0x74, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x29, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
+	0x63, 0x6f, 0x67, 0x6e, 0x69, 0x74, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x67, 0x6e, 0x69,
+	0x74, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x63,
+	0x6f, 0x67, 0x6e, 0x69, 0x74, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x2a, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x74, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e,
+	0x63, 0x6f, 0x67, 0x6e, 0x69, 0x74, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a,
+	0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x23, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0a,
+	0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x6f,
+	0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x5a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x22, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x6d, 0x6f,
+	0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x46, 0x6c, 0x61, 0x67, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x26, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x72, 0x0a, 0x15, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x2a, 0x2e, 0x6d, 0x6f,
+	0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x81, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2f, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8a, 0x01, 0x0a, 0x1d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x33, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x1f, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x12, 0x25, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4b, 0x0a, 0x08, 0x4d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51,
+	0x0a, 0x0a, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x6d, 0x75,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x6d,
+	0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5d, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x75, 0x74, 0x65,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x57, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
+	0x12, 0x21, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x54, 0x6f, 0x70, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x70, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x41,
+	0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x21, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x41, 0x6c, 0x62, 0x75, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x41, 0x6c,
+	0x62, 0x75, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x6f,
+	0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x61, 0x70, 0x12, 0x1d, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x73,
+	0x12, 0x1e, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x12, 0x21, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x21, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x21, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a,
+	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x12,
+	0x22, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0f, 0x4c, 0x69,
+	0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x45, 0x64, 0x69, 0x74, 0x73, 0x12, 0x24, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x45, 0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x45, 0x64, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x0d,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x12, 0x22, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5a, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x2e, 0x6d, 0x6f,
+	0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79,
+	0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79,
+	0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x79, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x6f, 0x6f, 0x74,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x53,
+	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2f,
+	0x6d, 0x6f, 0x6e, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x70, 0x62, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mootslive_v1_mootslive_proto_rawDescOnce sync.Once
+	file_mootslive_v1_mootslive_proto_rawDescData = file_mootslive_v1_mootslive_proto_rawDesc
+)
+
+func file_mootslive_v1_mootslive_proto_rawDescGZIP() []byte {
+	file_mootslive_v1_mootslive_proto_rawDescOnce.Do(func() {
+		file_mootslive_v1_mootslive_proto_rawDescData = protoimpl.X.CompressGZIP(file_mootslive_v1_mootslive_proto_rawDescData)
+	})
+	return file_mootslive_v1_mootslive_proto_rawDescData
+}
+
+var file_mootslive_v1_mootslive_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
+var file_mootslive_v1_mootslive_proto_msgTypes = make([]protoimpl.MessageInfo, 186)
+var file_mootslive_v1_mootslive_proto_goTypes = []interface{}{
+	(SpotifyScanResult)(0),                        // 0: mootslive.v1.SpotifyScanResult
+	(AuditEventKind)(0),                           // 1: mootslive.v1.AuditEventKind
+	(Provider)(0),                                 // 2: mootslive.v1.Provider
+	(ListenOrder)(0),                              // 3: mootslive.v1.ListenOrder
+	(FollowStatus)(0),                             // 4: mootslive.v1.FollowStatus
+	(PresenceStatus)(0),                           // 5: mootslive.v1.PresenceStatus
+	(TimeWindow)(0),                               // 6: mootslive.v1.TimeWindow
+	(Visibility)(0),                               // 7: mootslive.v1.Visibility
+	(NotificationKind)(0),                         // 8: mootslive.v1.NotificationKind
+	(ChartScope)(0),                               // 9: mootslive.v1.ChartScope
+	(ChartPeriod)(0),                              // 10: mootslive.v1.ChartPeriod
+	(ChartMovement)(0),                            // 11: mootslive.v1.ChartMovement
+	(ListenEditAction)(0),                         // 12: mootslive.v1.ListenEditAction
+	(ExportFormat)(0),                             // 13: mootslive.v1.ExportFormat
+	(*GetStatusRequest)(nil),                      // 14: mootslive.v1.GetStatusRequest
+	(*StatusCheck)(nil),                           // 15: mootslive.v1.StatusCheck
+	(*GetStatusResponse)(nil),                     // 16: mootslive.v1.GetStatusResponse
+	(*GenerateRecapRequest)(nil),                  // 17: mootslive.v1.GenerateRecapRequest
+	(*GenerateRecapResponse)(nil),                 // 18: mootslive.v1.GenerateRecapResponse
+	(*AdminUser)(nil),                             // 19: mootslive.v1.AdminUser
+	(*ListUsersRequest)(nil),                      // 20: mootslive.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                     // 21: mootslive.v1.ListUsersResponse
+	(*GetUserRequest)(nil),                        // 22: mootslive.v1.GetUserRequest
+	(*SpotifyScan)(nil),                           // 23: mootslive.v1.SpotifyScan
+	(*SpotifyAccountHealth)(nil),                  // 24: mootslive.v1.SpotifyAccountHealth
+	(*GetUserResponse)(nil),                       // 25: mootslive.v1.GetUserResponse
+	(*DisableUserRequest)(nil),                    // 26: mootslive.v1.DisableUserRequest
+	(*DisableUserResponse)(nil),                   // 27: mootslive.v1.DisableUserResponse
+	(*EnableUserRequest)(nil),                     // 28: mootslive.v1.EnableUserRequest
+	(*EnableUserResponse)(nil),                    // 29: mootslive.v1.EnableUserResponse
+	(*ForceRescanRequest)(nil),                    // 30: mootslive.v1.ForceRescanRequest
+	(*ForceRescanResponse)(nil),                   // 31: mootslive.v1.ForceRescanResponse
+	(*RevokeUserSessionsRequest)(nil),             // 32: mootslive.v1.RevokeUserSessionsRequest
+	(*RevokeUserSessionsResponse)(nil),            // 33: mootslive.v1.RevokeUserSessionsResponse
+	(*GetPollerStatusRequest)(nil),                // 34: mootslive.v1.GetPollerStatusRequest
+	(*PollerReplicaThroughput)(nil),               // 35: mootslive.v1.PollerReplicaThroughput
+	(*GetPollerStatusResponse)(nil),               // 36: mootslive.v1.GetPollerStatusResponse
+	(*AuditEvent)(nil),                            // 37: mootslive.v1.AuditEvent
+	(*ListAuditEventsRequest)(nil),                // 38: mootslive.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),               // 39: mootslive.v1.ListAuditEventsResponse
+	(*GetMeRequest)(nil),                          // 40: mootslive.v1.GetMeRequest
+	(*LinkedAccount)(nil),                         // 41: mootslive.v1.LinkedAccount
+	(*Profile)(nil),                               // 42: mootslive.v1.Profile
+	(*GetMeResponse)(nil),                         // 43: mootslive.v1.GetMeResponse
+	(*OAuth2State)(nil),                           // 44: mootslive.v1.OAuth2State
+	(*BeginTwitterAuthRequest)(nil),               // 45: mootslive.v1.BeginTwitterAuthRequest
+	(*BeginTwitterAuthResponse)(nil),              // 46: mootslive.v1.BeginTwitterAuthResponse
+	(*FinishTwitterAuthRequest)(nil),              // 47: mootslive.v1.FinishTwitterAuthRequest
+	(*FinishTwitterAuthResponse)(nil),             // 48: mootslive.v1.FinishTwitterAuthResponse
+	(*Listen)(nil),                                // 49: mootslive.v1.Listen
+	(*ReactionCount)(nil),                         // 50: mootslive.v1.ReactionCount
+	(*ListListensRequest)(nil),                    // 51: mootslive.v1.ListListensRequest
+	(*ListListensResponse)(nil),                   // 52: mootslive.v1.ListListensResponse
+	(*ExportMyDataRequest)(nil),                   // 53: mootslive.v1.ExportMyDataRequest
+	(*ExportMyDataResponse)(nil),                  // 54: mootslive.v1.ExportMyDataResponse
+	(*DeleteMyAccountRequest)(nil),                // 55: mootslive.v1.DeleteMyAccountRequest
+	(*DeleteMyAccountResponse)(nil),               // 56: mootslive.v1.DeleteMyAccountResponse
+	(*CancelAccountDeletionRequest)(nil),          // 57: mootslive.v1.CancelAccountDeletionRequest
+	(*CancelAccountDeletionResponse)(nil),         // 58: mootslive.v1.CancelAccountDeletionResponse
+	(*TwitterSharingSettings)(nil),                // 59: mootslive.v1.TwitterSharingSettings
+	(*GetTwitterSharingSettingsRequest)(nil),      // 60: mootslive.v1.GetTwitterSharingSettingsRequest
+	(*GetTwitterSharingSettingsResponse)(nil),     // 61: mootslive.v1.GetTwitterSharingSettingsResponse
+	(*UpdateTwitterSharingSettingsRequest)(nil),   // 62: mootslive.v1.UpdateTwitterSharingSettingsRequest
+	(*UpdateTwitterSharingSettingsResponse)(nil),  // 63: mootslive.v1.UpdateTwitterSharingSettingsResponse
+	(*ShareListenRequest)(nil),                    // 64: mootslive.v1.ShareListenRequest
+	(*ShareListenResponse)(nil),                   // 65: mootslive.v1.ShareListenResponse
+	(*Moot)(nil),                                  // 66: mootslive.v1.Moot
+	(*SyncMootsRequest)(nil),                      // 67: mootslive.v1.SyncMootsRequest
+	(*SyncMootsResponse)(nil),                     // 68: mootslive.v1.SyncMootsResponse
+	(*ListMootsRequest)(nil),                      // 69: mootslive.v1.ListMootsRequest
+	(*ListMootsResponse)(nil),                     // 70: mootslive.v1.ListMootsResponse
+	(*Follow)(nil),                                // 71: mootslive.v1.Follow
+	(*FollowUserRequest)(nil),                     // 72: mootslive.v1.FollowUserRequest
+	(*FollowUserResponse)(nil),                    // 73: mootslive.v1.FollowUserResponse
+	(*UnfollowUserRequest)(nil),                   // 74: mootslive.v1.UnfollowUserRequest
+	(*UnfollowUserResponse)(nil),                  // 75: mootslive.v1.UnfollowUserResponse
+	(*ListFollowersRequest)(nil),                  // 76: mootslive.v1.ListFollowersRequest
+	(*ListFollowersResponse)(nil),                 // 77: mootslive.v1.ListFollowersResponse
+	(*ListFollowingRequest)(nil),                  // 78: mootslive.v1.ListFollowingRequest
+	(*ListFollowingResponse)(nil),                 // 79: mootslive.v1.ListFollowingResponse
+	(*ListFollowRequestsRequest)(nil),             // 80: mootslive.v1.ListFollowRequestsRequest
+	(*ListFollowRequestsResponse)(nil),            // 81: mootslive.v1.ListFollowRequestsResponse
+	(*ApproveFollowRequestRequest)(nil),           // 82: mootslive.v1.ApproveFollowRequestRequest
+	(*ApproveFollowRequestResponse)(nil),          // 83: mootslive.v1.ApproveFollowRequestResponse
+	(*DenyFollowRequestRequest)(nil),              // 84: mootslive.v1.DenyFollowRequestRequest
+	(*DenyFollowRequestResponse)(nil),             // 85: mootslive.v1.DenyFollowRequestResponse
+	(*FollowSettings)(nil),                        // 86: mootslive.v1.FollowSettings
+	(*GetFollowSettingsRequest)(nil),              // 87: mootslive.v1.GetFollowSettingsRequest
+	(*GetFollowSettingsResponse)(nil),             // 88: mootslive.v1.GetFollowSettingsResponse
+	(*UpdateFollowSettingsRequest)(nil),           // 89: mootslive.v1.UpdateFollowSettingsRequest
+	(*UpdateFollowSettingsResponse)(nil),          // 90: mootslive.v1.UpdateFollowSettingsResponse
+	(*Artist)(nil),                                // 91: mootslive.v1.Artist
+	(*Album)(nil),                                 // 92: mootslive.v1.Album
+	(*Track)(nil),                                 // 93: mootslive.v1.Track
+	(*UserSummary)(nil),                           // 94: mootslive.v1.UserSummary
+	(*FeedEntry)(nil),                             // 95: mootslive.v1.FeedEntry
+	(*ListFeedRequest)(nil),                       // 96: mootslive.v1.ListFeedRequest
+	(*ListFeedResponse)(nil),                      // 97: mootslive.v1.ListFeedResponse
+	(*Presence)(nil),                              // 98: mootslive.v1.Presence
+	(*WatchListensRequest)(nil),                   // 99: mootslive.v1.WatchListensRequest
+	(*NotificationsUpdate)(nil),                   // 100: mootslive.v1.NotificationsUpdate
+	(*WatchListensResponse)(nil),                  // 101: mootslive.v1.WatchListensResponse
+	(*TimeRange)(nil),                             // 102: mootslive.v1.TimeRange
+	(*GetCompatibilityRequest)(nil),               // 103: mootslive.v1.GetCompatibilityRequest
+	(*GetCompatibilityResponse)(nil),              // 104: mootslive.v1.GetCompatibilityResponse
+	(*PrivacySettings)(nil),                       // 105: mootslive.v1.PrivacySettings
+	(*GetPrivacySettingsRequest)(nil),             // 106: mootslive.v1.GetPrivacySettingsRequest
+	(*GetPrivacySettingsResponse)(nil),            // 107: mootslive.v1.GetPrivacySettingsResponse
+	(*UpdatePrivacySettingsRequest)(nil),          // 108: mootslive.v1.UpdatePrivacySettingsRequest
+	(*UpdatePrivacySettingsResponse)(nil),         // 109: mootslive.v1.UpdatePrivacySettingsResponse
+	(*SetListenHiddenRequest)(nil),                // 110: mootslive.v1.SetListenHiddenRequest
+	(*SetListenHiddenResponse)(nil),               // 111: mootslive.v1.SetListenHiddenResponse
+	(*IncognitoWindow)(nil),                       // 112: mootslive.v1.IncognitoWindow
+	(*CreateIncognitoWindowRequest)(nil),          // 113: mootslive.v1.CreateIncognitoWindowRequest
+	(*CreateIncognitoWindowResponse)(nil),         // 114: mootslive.v1.CreateIncognitoWindowResponse
+	(*ListIncognitoWindowsRequest)(nil),           // 115: mootslive.v1.ListIncognitoWindowsRequest
+	(*ListIncognitoWindowsResponse)(nil),          // 116: mootslive.v1.ListIncognitoWindowsResponse
+	(*DeleteIncognitoWindowRequest)(nil),          // 117: mootslive.v1.DeleteIncognitoWindowRequest
+	(*DeleteIncognitoWindowResponse)(nil),         // 118: mootslive.v1.DeleteIncognitoWindowResponse
+	(*AddReactionRequest)(nil),                    // 119: mootslive.v1.AddReactionRequest
+	(*AddReactionResponse)(nil),                   // 120: mootslive.v1.AddReactionResponse
+	(*RemoveReactionRequest)(nil),                 // 121: mootslive.v1.RemoveReactionRequest
+	(*RemoveReactionResponse)(nil),                // 122: mootslive.v1.RemoveReactionResponse
+	(*Comment)(nil),                               // 123: mootslive.v1.Comment
+	(*AddCommentRequest)(nil),                     // 124: mootslive.v1.AddCommentRequest
+	(*AddCommentResponse)(nil),                    // 125: mootslive.v1.AddCommentResponse
+	(*DeleteCommentRequest)(nil),                  // 126: mootslive.v1.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),                 // 127: mootslive.v1.DeleteCommentResponse
+	(*ListCommentsRequest)(nil),                   // 128: mootslive.v1.ListCommentsRequest
+	(*ListCommentsResponse)(nil),                  // 129: mootslive.v1.ListCommentsResponse
+	(*FlagCommentRequest)(nil),                    // 130: mootslive.v1.FlagCommentRequest
+	(*FlagCommentResponse)(nil),                   // 131: mootslive.v1.FlagCommentResponse
+	(*Notification)(nil),                          // 132: mootslive.v1.Notification
+	(*ListNotificationsRequest)(nil),              // 133: mootslive.v1.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),             // 134: mootslive.v1.ListNotificationsResponse
+	(*MarkNotificationsReadRequest)(nil),          // 135: mootslive.v1.MarkNotificationsReadRequest
+	(*MarkNotificationsReadResponse)(nil),         // 136: mootslive.v1.MarkNotificationsReadResponse
+	(*NotificationPreference)(nil),                // 137: mootslive.v1.NotificationPreference
+	(*GetNotificationPreferencesRequest)(nil),     // 138: mootslive.v1.GetNotificationPreferencesRequest
+	(*GetNotificationPreferencesResponse)(nil),    // 139: mootslive.v1.GetNotificationPreferencesResponse
+	(*UpdateNotificationPreferencesRequest)(nil),  // 140: mootslive.v1.UpdateNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesResponse)(nil), // 141: mootslive.v1.UpdateNotificationPreferencesResponse
+	(*UpdateProfileRequest)(nil),                  // 142: mootslive.v1.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),                 // 143: mootslive.v1.UpdateProfileResponse
+	(*TopArtist)(nil),                             // 144: mootslive.v1.TopArtist
+	(*RecentListen)(nil),                          // 145: mootslive.v1.RecentListen
+	(*GetProfileRequest)(nil),                     // 146: mootslive.v1.GetProfileRequest
+	(*GetProfileResponse)(nil),                    // 147: mootslive.v1.GetProfileResponse
+	(*BlockUserRequest)(nil),                      // 148: mootslive.v1.BlockUserRequest
+	(*BlockUserResponse)(nil),                     // 149: mootslive.v1.BlockUserResponse
+	(*UnblockUserRequest)(nil),                    // 150: mootslive.v1.UnblockUserRequest
+	(*UnblockUserResponse)(nil),                   // 151: mootslive.v1.UnblockUserResponse
+	(*ListBlockedUsersRequest)(nil),               // 152: mootslive.v1.ListBlockedUsersRequest
+	(*ListBlockedUsersResponse)(nil),              // 153: mootslive.v1.ListBlockedUsersResponse
+	(*MuteUserRequest)(nil),                       // 154: mootslive.v1.MuteUserRequest
+	(*MuteUserResponse)(nil),                      // 155: mootslive.v1.MuteUserResponse
+	(*UnmuteUserRequest)(nil),                     // 156: mootslive.v1.UnmuteUserRequest
+	(*UnmuteUserResponse)(nil),                    // 157: mootslive.v1.UnmuteUserResponse
+	(*ListMutedUsersRequest)(nil),                 // 158: mootslive.v1.ListMutedUsersRequest
+	(*ListMutedUsersResponse)(nil),                // 159: mootslive.v1.ListMutedUsersResponse
+	(*TopTrack)(nil),                              // 160: mootslive.v1.TopTrack
+	(*TopAlbum)(nil),                              // 161: mootslive.v1.TopAlbum
+	(*GetTopTracksRequest)(nil),                   // 162: mootslive.v1.GetTopTracksRequest
+	(*GetTopTracksResponse)(nil),                  // 163: mootslive.v1.GetTopTracksResponse
+	(*GetTopArtistsRequest)(nil),                  // 164: mootslive.v1.GetTopArtistsRequest
+	(*GetTopArtistsResponse)(nil),                 // 165: mootslive.v1.GetTopArtistsResponse
+	(*GetTopAlbumsRequest)(nil),                   // 166: mootslive.v1.GetTopAlbumsRequest
+	(*GetTopAlbumsResponse)(nil),                  // 167: mootslive.v1.GetTopAlbumsResponse
+	(*HeatmapCell)(nil),                           // 168: mootslive.v1.HeatmapCell
+	(*DailyPlays)(nil),                            // 169: mootslive.v1.DailyPlays
+	(*GetListeningStatsRequest)(nil),              // 170: mootslive.v1.GetListeningStatsRequest
+	(*GetListeningStatsResponse)(nil),             // 171: mootslive.v1.GetListeningStatsResponse
+	(*LoopedTrack)(nil),                           // 172: mootslive.v1.LoopedTrack
+	(*MootsComparison)(nil),                       // 173: mootslive.v1.MootsComparison
+	(*YearlyRecap)(nil),                           // 174: mootslive.v1.YearlyRecap
+	(*GetRecapRequest)(nil),                       // 175: mootslive.v1.GetRecapRequest
+	(*GetRecapResponse)(nil),                      // 176: mootslive.v1.GetRecapResponse
+	(*ChartTrack)(nil),                            // 177: mootslive.v1.ChartTrack
+	(*ChartArtist)(nil),                           // 178: mootslive.v1.ChartArtist
+	(*GetChartsRequest)(nil),                      // 179: mootslive.v1.GetChartsRequest
+	(*GetChartsResponse)(nil),                     // 180: mootslive.v1.GetChartsResponse
+	(*CreateListenRequest)(nil),                   // 181: mootslive.v1.CreateListenRequest
+	(*CreateListenResponse)(nil),                  // 182: mootslive.v1.CreateListenResponse
+	(*UpdateListenRequest)(nil),                   // 183: mootslive.v1.UpdateListenRequest
+	(*UpdateListenResponse)(nil),                  // 184: mootslive.v1.UpdateListenResponse
+	(*DeleteListenRequest)(nil),                   // 185: mootslive.v1.DeleteListenRequest
+	(*DeleteListenResponse)(nil),                  // 186: mootslive.v1.DeleteListenResponse
+	(*DeleteListensRequest)(nil),                  // 187: mootslive.v1.DeleteListensRequest
+	(*DeleteListensResponse)(nil),                 // 188: mootslive.v1.DeleteListensResponse
+	(*ListenEdit)(nil),                            // 189: mootslive.v1.ListenEdit
+	(*ListListenEditsRequest)(nil),                // 190: mootslive.v1.ListListenEditsRequest
+	(*ListListenEditsResponse)(nil),               // 191: mootslive.v1.ListListenEditsResponse
+	(*ExportListensRequest)(nil),                  // 192: mootslive.v1.ExportListensRequest
+	(*ExportListensResponse)(nil),                 // 193: mootslive.v1.ExportListensResponse
+	(*GetSyncStatusRequest)(nil),                  // 194: mootslive.v1.GetSyncStatusRequest
+	(*AccountSyncStatus)(nil),                     // 195: mootslive.v1.AccountSyncStatus
+	(*GetSyncStatusResponse)(nil),                 // 196: mootslive.v1.GetSyncStatusResponse
+	(*SecurityEvent)(nil),                         // 197: mootslive.v1.SecurityEvent
+	(*ListMySecurityEventsRequest)(nil),           // 198: mootslive.v1.ListMySecurityEventsRequest
+	(*ListMySecurityEventsResponse)(nil),          // 199: mootslive.v1.ListMySecurityEventsResponse
+	(*timestamppb.Timestamp)(nil),                 // 200: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                   // 201: google.protobuf.Duration
+}
+var file_mootslive_v1_mootslive_proto_depIdxs = []int32{
+	15,  // 0: mootslive.v1.GetStatusResponse.checks:type_name -> mootslive.v1.StatusCheck
+	174, // 1: mootslive.v1.GenerateRecapResponse.recap:type_name -> mootslive.v1.YearlyRecap
+	200, // 2: mootslive.v1.AdminUser.created_at:type_name -> google.protobuf.Timestamp
+	200, // 3: mootslive.v1.AdminUser.disabled_at:type_name -> google.protobuf.Timestamp
+	200, // 4: mootslive.v1.AdminUser.deletion_requested_at:type_name -> google.protobuf.Timestamp
+	200, // 5: mootslive.v1.AdminUser.sessions_revoked_at:type_name -> google.protobuf.Timestamp
+	19,  // 6: mootslive.v1.ListUsersResponse.users:type_name -> mootslive.v1.AdminUser
+	200, // 7: mootslive.v1.SpotifyScan.started_at:type_name -> google.protobuf.Timestamp
+	200, // 8: mootslive.v1.SpotifyScan.finished_at:type_name -> google.protobuf.Timestamp
+	0,   // 9: mootslive.v1.SpotifyScan.result:type_name -> mootslive.v1.SpotifyScanResult
+	200, // 10: mootslive.v1.SpotifyAccountHealth.last_listened_at:type_name -> google.protobuf.Timestamp
+	200, // 11: mootslive.v1.SpotifyAccountHealth.last_scanned_at:type_name -> google.protobuf.Timestamp
+	200, // 12: mootslive.v1.SpotifyAccountHealth.last_succeeded_at:type_name -> google.protobuf.Timestamp
+	201, // 13: mootslive.v1.SpotifyAccountHealth.lag:type_name -> google.protobuf.Duration
+	23,  // 14: mootslive.v1.SpotifyAccountHealth.recent_scans:type_name -> mootslive.v1.SpotifyScan
+	19,  // 15: mootslive.v1.GetUserResponse.user:type_name -> mootslive.v1.AdminUser
+	41,  // 16: mootslive.v1.GetUserResponse.linked_accounts:type_name -> mootslive.v1.LinkedAccount
+	24,  // 17: mootslive.v1.GetUserResponse.spotify_accounts:type_name -> mootslive.v1.SpotifyAccountHealth
+	19,  // 18: mootslive.v1.DisableUserResponse.user:type_name -> mootslive.v1.AdminUser
+	19,  // 19: mootslive.v1.EnableUserResponse.user:type_name -> mootslive.v1.AdminUser
+	19,  // 20: mootslive.v1.RevokeUserSessionsResponse.user:type_name -> mootslive.v1.AdminUser
+	201, // 21: mootslive.v1.GetPollerStatusResponse.max_lag:type_name -> google.protobuf.Duration
+	24,  // 22: mootslive.v1.GetPollerStatusResponse.lagging_accounts:type_name -> mootslive.v1.SpotifyAccountHealth
+	35,  // 23: mootslive.v1.GetPollerStatusResponse.replicas:type_name -> mootslive.v1.PollerReplicaThroughput
+	201, // 24: mootslive.v1.GetPollerStatusResponse.throughput_window:type_name -> google.protobuf.Duration
+	1,   // 25: mootslive.v1.AuditEvent.kind:type_name -> mootslive.v1.AuditEventKind
+	2,   // 26: mootslive.v1.AuditEvent.provider:type_name -> mootslive.v1.Provider
+	200, // 27: mootslive.v1.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	1,   // 28: mootslive.v1.ListAuditEventsRequest.kinds:type_name -> mootslive.v1.AuditEventKind
+	200, // 29: mootslive.v1.ListAuditEventsRequest.start_time:type_name -> google.protobuf.Timestamp
+	200, // 30: mootslive.v1.ListAuditEventsRequest.end_time:type_name -> google.protobuf.Timestamp
+	37,  // 31: mootslive.v1.ListAuditEventsResponse.events:type_name -> mootslive.v1.AuditEvent
+	2,   // 32: mootslive.v1.LinkedAccount.provider:type_name -> mootslive.v1.Provider
+	200, // 33: mootslive.v1.LinkedAccount.linked_at:type_name -> google.protobuf.Timestamp
+	41,  // 34: mootslive.v1.Profile.linked_accounts:type_name -> mootslive.v1.LinkedAccount
+	200, // 35: mootslive.v1.GetMeResponse.created_at:type_name -> google.protobuf.Timestamp
+	42,  // 36: mootslive.v1.GetMeResponse.profile:type_name -> mootslive.v1.Profile
+	44,  // 37: mootslive.v1.BeginTwitterAuthResponse.state:type_name -> mootslive.v1.OAuth2State
+	44,  // 38: mootslive.v1.FinishTwitterAuthRequest.state:type_name -> mootslive.v1.OAuth2State
+	200, // 39: mootslive.v1.Listen.created_at:type_name -> google.protobuf.Timestamp
+	200, // 40: mootslive.v1.Listen.listened_at:type_name -> google.protobuf.Timestamp
+	50,  // 41: mootslive.v1.Listen.reactions:type_name -> mootslive.v1.ReactionCount
+	200, // 42: mootslive.v1.ListListensRequest.start_time:type_name -> google.protobuf.Timestamp
+	200, // 43: mootslive.v1.ListListensRequest.end_time:type_name -> google.protobuf.Timestamp
+	3,   // 44: mootslive.v1.ListListensRequest.order:type_name -> mootslive.v1.ListenOrder
+	49,  // 45: mootslive.v1.ListListensResponse.listens:type_name -> mootslive.v1.Listen
+	200, // 46: mootslive.v1.DeleteMyAccountResponse.delete_time:type_name -> google.protobuf.Timestamp
+	59,  // 47: mootslive.v1.GetTwitterSharingSettingsResponse.settings:type_name -> mootslive.v1.TwitterSharingSettings
+	59,  // 48: mootslive.v1.UpdateTwitterSharingSettingsRequest.settings:type_name -> mootslive.v1.TwitterSharingSettings
+	59,  // 49: mootslive.v1.UpdateTwitterSharingSettingsResponse.settings:type_name -> mootslive.v1.TwitterSharingSettings
+	66,  // 50: mootslive.v1.ListMootsResponse.moots:type_name -> mootslive.v1.Moot
+	4,   // 51: mootslive.v1.Follow.status:type_name -> mootslive.v1.FollowStatus
+	200, // 52: mootslive.v1.Follow.created_at:type_name -> google.protobuf.Timestamp
+	200, // 53: mootslive.v1.Follow.accepted_at:type_name -> google.protobuf.Timestamp
+	71,  // 54: mootslive.v1.FollowUserResponse.follow:type_name -> mootslive.v1.Follow
+	71,  // 55: mootslive.v1.ListFollowersResponse.follows:type_name -> mootslive.v1.Follow
+	71,  // 56: mootslive.v1.ListFollowingResponse.follows:type_name -> mootslive.v1.Follow
+	71,  // 57: mootslive.v1.ListFollowRequestsResponse.follows:type_name -> mootslive.v1.Follow
+	71,  // 58: mootslive.v1.ApproveFollowRequestResponse.follow:type_name -> mootslive.v1.Follow
+	86,  // 59: mootslive.v1.GetFollowSettingsResponse.settings:type_name -> mootslive.v1.FollowSettings
+	86,  // 60: mootslive.v1.UpdateFollowSettingsRequest.settings:type_name -> mootslive.v1.FollowSettings
+	86,  // 61: mootslive.v1.UpdateFollowSettingsResponse.settings:type_name -> mootslive.v1.FollowSettings
+	91,  // 62: mootslive.v1.Track.artists:type_name -> mootslive.v1.Artist
+	92,  // 63: mootslive.v1.Track.album:type_name -> mootslive.v1.Album
+	94,  // 64: mootslive.v1.FeedEntry.user:type_name -> mootslive.v1.UserSummary
+	93,  // 65: mootslive.v1.FeedEntry.track:type_name -> mootslive.v1.Track
+	49,  // 66: mootslive.v1.FeedEntry.listens:type_name -> mootslive.v1.Listen
+	95,  // 67: mootslive.v1.ListFeedResponse.entries:type_name -> mootslive.v1.FeedEntry
+	5,   // 68: mootslive.v1.Presence.status:type_name -> mootslive.v1.PresenceStatus
+	200, // 69: mootslive.v1.Presence.last_listened_at:type_name -> google.protobuf.Timestamp
+	132, // 70: mootslive.v1.NotificationsUpdate.notifications:type_name -> mootslive.v1.Notification
+	49,  // 71: mootslive.v1.WatchListensResponse.listen:type_name -> mootslive.v1.Listen
+	98,  // 72: mootslive.v1.WatchListensResponse.presence:type_name -> mootslive.v1.Presence
+	100, // 73: mootslive.v1.WatchListensResponse.notifications:type_name -> mootslive.v1.NotificationsUpdate
+	200, // 74: mootslive.v1.TimeRange.start_time:type_name -> google.protobuf.Timestamp
+	200, // 75: mootslive.v1.TimeRange.end_time:type_name -> google.protobuf.Timestamp
+	6,   // 76: mootslive.v1.GetCompatibilityRequest.window:type_name -> mootslive.v1.TimeWindow
+	93,  // 77: mootslive.v1.GetCompatibilityResponse.shared_tracks:type_name -> mootslive.v1.Track
+	91,  // 78: mootslive.v1.GetCompatibilityResponse.shared_artists:type_name -> mootslive.v1.Artist
+	200, // 79: mootslive.v1.GetCompatibilityResponse.computed_at:type_name -> google.protobuf.Timestamp
+	7,   // 80: mootslive.v1.PrivacySettings.visibility:type_name -> mootslive.v1.Visibility
+	105, // 81: mootslive.v1.GetPrivacySettingsResponse.settings:type_name -> mootslive.v1.PrivacySettings
+	105, // 82: mootslive.v1.UpdatePrivacySettingsRequest.settings:type_name -> mootslive.v1.PrivacySettings
+	105, // 83: mootslive.v1.UpdatePrivacySettingsResponse.settings:type_name -> mootslive.v1.PrivacySettings
+	49,  // 84: mootslive.v1.SetListenHiddenResponse.listen:type_name -> mootslive.v1.Listen
+	200, // 85: mootslive.v1.IncognitoWindow.start_time:type_name -> google.protobuf.Timestamp
+	200, // 86: mootslive.v1.IncognitoWindow.end_time:type_name -> google.protobuf.Timestamp
+	200, // 87: mootslive.v1.IncognitoWindow.created_at:type_name -> google.protobuf.Timestamp
+	200, // 88: mootslive.v1.CreateIncognitoWindowRequest.start_time:type_name -> google.protobuf.Timestamp
+	200, // 89: mootslive.v1.CreateIncognitoWindowRequest.end_time:type_name -> google.protobuf.Timestamp
+	112, // 90: mootslive.v1.CreateIncognitoWindowResponse.window:type_name -> mootslive.v1.IncognitoWindow
+	112, // 91: mootslive.v1.ListIncognitoWindowsResponse.windows:type_name -> mootslive.v1.IncognitoWindow
+	50,  // 92: mootslive.v1.AddReactionResponse.reactions:type_name -> mootslive.v1.ReactionCount
+	50,  // 93: mootslive.v1.RemoveReactionResponse.reactions:type_name -> mootslive.v1.ReactionCount
+	94,  // 94: mootslive.v1.Comment.author:type_name -> mootslive.v1.UserSummary
+	200, // 95: mootslive.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
+	123, // 96: mootslive.v1.AddCommentResponse.comment:type_name -> mootslive.v1.Comment
+	123, // 97: mootslive.v1.ListCommentsResponse.comments:type_name -> mootslive.v1.Comment
+	8,   // 98: mootslive.v1.Notification.kind:type_name -> mootslive.v1.NotificationKind
+	94,  // 99: mootslive.v1.Notification.actors:type_name -> mootslive.v1.UserSummary
+	200, // 100: mootslive.v1.Notification.created_at:type_name -> google.protobuf.Timestamp
+	200, // 101: mootslive.v1.Notification.updated_at:type_name -> google.protobuf.Timestamp
+	132, // 102: mootslive.v1.ListNotificationsResponse.notifications:type_name -> mootslive.v1.Notification
+	200, // 103: mootslive.v1.MarkNotificationsReadRequest.all_updated_before:type_name -> google.protobuf.Timestamp
+	8,   // 104: mootslive.v1.NotificationPreference.kind:type_name -> mootslive.v1.NotificationKind
+	137, // 105: mootslive.v1.GetNotificationPreferencesResponse.preferences:type_name -> mootslive.v1.NotificationPreference
+	137, // 106: mootslive.v1.UpdateNotificationPreferencesRequest.preferences:type_name -> mootslive.v1.NotificationPreference
+	137, // 107: mootslive.v1.UpdateNotificationPreferencesResponse.preferences:type_name -> mootslive.v1.NotificationPreference
+	42,  // 108: mootslive.v1.UpdateProfileResponse.profile:type_name -> mootslive.v1.Profile
+	91,  // 109: mootslive.v1.TopArtist.artist:type_name -> mootslive.v1.Artist
+	49,  // 110: mootslive.v1.RecentListen.listen:type_name -> mootslive.v1.Listen
+	93,  // 111: mootslive.v1.RecentListen.track:type_name -> mootslive.v1.Track
+	94,  // 112: mootslive.v1.GetProfileResponse.user:type_name -> mootslive.v1.UserSummary
+	200, // 113: mootslive.v1.GetProfileResponse.joined_at:type_name -> google.protobuf.Timestamp
+	145, // 114: mootslive.v1.GetProfileResponse.recent_listens:type_name -> mootslive.v1.RecentListen
+	144, // 115: mootslive.v1.GetProfileResponse.top_artists:type_name -> mootslive.v1.TopArtist
+	94,  // 116: mootslive.v1.ListBlockedUsersResponse.users:type_name -> mootslive.v1.UserSummary
+	94,  // 117: mootslive.v1.ListMutedUsersResponse.users:type_name -> mootslive.v1.UserSummary
+	93,  // 118: mootslive.v1.TopTrack.track:type_name -> mootslive.v1.Track
+	92,  // 119: mootslive.v1.TopAlbum.album:type_name -> mootslive.v1.Album
+	6,   // 120: mootslive.v1.GetTopTracksRequest.window:type_name -> mootslive.v1.TimeWindow
+	102, // 121: mootslive.v1.GetTopTracksRequest.custom_range:type_name -> mootslive.v1.TimeRange
+	160, // 122: mootslive.v1.GetTopTracksResponse.tracks:type_name -> mootslive.v1.TopTrack
+	102, // 123: mootslive.v1.GetTopTracksResponse.range:type_name -> mootslive.v1.TimeRange
+	6,   // 124: mootslive.v1.GetTopArtistsRequest.window:type_name -> mootslive.v1.TimeWindow
+	102, // 125: mootslive.v1.GetTopArtistsRequest.custom_range:type_name -> mootslive.v1.TimeRange
+	144, // 126: mootslive.v1.GetTopArtistsResponse.artists:type_name -> mootslive.v1.TopArtist
+	102, // 127: mootslive.v1.GetTopArtistsResponse.range:type_name -> mootslive.v1.TimeRange
+	6,   // 128: mootslive.v1.GetTopAlbumsRequest.window:type_name -> mootslive.v1.TimeWindow
+	102, // 129: mootslive.v1.GetTopAlbumsRequest.custom_range:type_name -> mootslive.v1.TimeRange
+	161, // 130: mootslive.v1.GetTopAlbumsResponse.albums:type_name -> mootslive.v1.TopAlbum
+	102, // 131: mootslive.v1.GetTopAlbumsResponse.range:type_name -> mootslive.v1.TimeRange
+	6,   // 132: mootslive.v1.GetListeningStatsRequest.window:type_name -> mootslive.v1.TimeWindow
+	102, // 133: mootslive.v1.GetListeningStatsRequest.custom_range:type_name -> mootslive.v1.TimeRange
+	102, // 134: mootslive.v1.GetListeningStatsResponse.range:type_name -> mootslive.v1.TimeRange
+	168, // 135: mootslive.v1.GetListeningStatsResponse.heatmap:type_name -> mootslive.v1.HeatmapCell
+	169, // 136: mootslive.v1.GetListeningStatsResponse.daily_plays:type_name -> mootslive.v1.DailyPlays
+	93,  // 137: mootslive.v1.LoopedTrack.track:type_name -> mootslive.v1.Track
+	200, // 138: mootslive.v1.LoopedTrack.started_at:type_name -> google.protobuf.Timestamp
+	160, // 139: mootslive.v1.YearlyRecap.top_tracks:type_name -> mootslive.v1.TopTrack
+	144, // 140: mootslive.v1.YearlyRecap.top_artists:type_name -> mootslive.v1.TopArtist
+	172, // 141: mootslive.v1.YearlyRecap.most_looped:type_name -> mootslive.v1.LoopedTrack
+	145, // 142: mootslive.v1.YearlyRecap.first_listen:type_name -> mootslive.v1.RecentListen
+	173, // 143: mootslive.v1.YearlyRecap.moots:type_name -> mootslive.v1.MootsComparison
+	174, // 144: mootslive.v1.GetRecapResponse.recap:type_name -> mootslive.v1.YearlyRecap
+	200, // 145: mootslive.v1.GetRecapResponse.generated_at:type_name -> google.protobuf.Timestamp
+	93,  // 146: mootslive.v1.ChartTrack.track:type_name -> mootslive.v1.Track
+	11,  // 147: mootslive.v1.ChartTrack.movement:type_name -> mootslive.v1.ChartMovement
+	91,  // 148: mootslive.v1.ChartArtist.artist:type_name -> mootslive.v1.Artist
+	11,  // 149: mootslive.v1.ChartArtist.movement:type_name -> mootslive.v1.ChartMovement
+	9,   // 150: mootslive.v1.GetChartsRequest.scope:type_name -> mootslive.v1.ChartScope
+	10,  // 151: mootslive.v1.GetChartsRequest.period:type_name -> mootslive.v1.ChartPeriod
+	200, // 152: mootslive.v1.GetChartsRequest.time:type_name -> google.protobuf.Timestamp
+	9,   // 153: mootslive.v1.GetChartsResponse.scope:type_name -> mootslive.v1.ChartScope
+	10,  // 154: mootslive.v1.GetChartsResponse.period:type_name -> mootslive.v1.ChartPeriod
+	102, // 155: mootslive.v1.GetChartsResponse.range:type_name -> mootslive.v1.TimeRange
+	177, // 156: mootslive.v1.GetChartsResponse.tracks:type_name -> mootslive.v1.ChartTrack
+	178, // 157: mootslive.v1.GetChartsResponse.artists:type_name -> mootslive.v1.ChartArtist
+	200, // 158: mootslive.v1.GetChartsResponse.computed_at:type_name -> google.protobuf.Timestamp
+	200, // 159: mootslive.v1.CreateListenRequest.listened_at:type_name -> google.protobuf.Timestamp
+	49,  // 160: mootslive.v1.CreateListenResponse.listen:type_name -> mootslive.v1.Listen
+	200, // 161: mootslive.v1.UpdateListenRequest.listened_at:type_name -> google.protobuf.Timestamp
+	49,  // 162: mootslive.v1.UpdateListenResponse.listen:type_name -> mootslive.v1.Listen
+	200, // 163: mootslive.v1.DeleteListensRequest.start_time:type_name -> google.protobuf.Timestamp
+	200, // 164: mootslive.v1.DeleteListensRequest.end_time:type_name -> google.protobuf.Timestamp
+	12,  // 165: mootslive.v1.ListenEdit.action:type_name -> mootslive.v1.ListenEditAction
+	200, // 166: mootslive.v1.ListenEdit.old_listened_at:type_name -> google.protobuf.Timestamp
+	200, // 167: mootslive.v1.ListenEdit.new_listened_at:type_name -> google.protobuf.Timestamp
+	200, // 168: mootslive.v1.ListenEdit.created_at:type_name -> google.protobuf.Timestamp
+	189, // 169: mootslive.v1.ListListenEditsResponse.edits:type_name -> mootslive.v1.ListenEdit
+	13,  // 170: mootslive.v1.ExportListensRequest.format:type_name -> mootslive.v1.ExportFormat
+	200, // 171: mootslive.v1.ExportListensRequest.start_time:type_name -> google.protobuf.Timestamp
+	200, // 172: mootslive.v1.ExportListensRequest.end_time:type_name -> google.protobuf.Timestamp
+	2,   // 173: mootslive.v1.AccountSyncStatus.provider:type_name -> mootslive.v1.Provider
+	200, // 174: mootslive.v1.AccountSyncStatus.last_synced_at:type_name -> google.protobuf.Timestamp
+	200, // 175: mootslive.v1.AccountSyncStatus.last_listened_at:type_name -> google.protobuf.Timestamp
+	195, // 176: mootslive.v1.GetSyncStatusResponse.accounts:type_name -> mootslive.v1.AccountSyncStatus
+	1,   // 177: mootslive.v1.SecurityEvent.kind:type_name -> mootslive.v1.AuditEventKind
+	2,   // 178: mootslive.v1.SecurityEvent.provider:type_name -> mootslive.v1.Provider
+	200, // 179: mootslive.v1.SecurityEvent.created_at:type_name -> google.protobuf.Timestamp
+	197, // 180: mootslive.v1.ListMySecurityEventsResponse.events:type_name -> mootslive.v1.SecurityEvent
+	14,  // 181: mootslive.v1.AdminService.GetStatus:input_type -> mootslive.v1.GetStatusRequest
+	17,  // 182: mootslive.v1.AdminService.GenerateRecap:input_type -> mootslive.v1.GenerateRecapRequest
+	20,  // 183: mootslive.v1.AdminService.ListUsers:input_type -> mootslive.v1.ListUsersRequest
+	22,  // 184: mootslive.v1.AdminService.GetUser:input_type -> mootslive.v1.GetUserRequest
+	26,  // 185: mootslive.v1.AdminService.DisableUser:input_type -> mootslive.v1.DisableUserRequest
+	28,  // 186: mootslive.v1.AdminService.EnableUser:input_type -> mootslive.v1.EnableUserRequest
+	30,  // 187: mootslive.v1.AdminService.ForceRescan:input_type -> mootslive.v1.ForceRescanRequest
+	32,  // 188: mootslive.v1.AdminService.RevokeUserSessions:input_type -> mootslive.v1.RevokeUserSessionsRequest
+	34,  // 189: mootslive.v1.AdminService.GetPollerStatus:input_type -> mootslive.v1.GetPollerStatusRequest
+	38,  // 190: mootslive.v1.AdminService.ListAuditEvents:input_type -> mootslive.v1.ListAuditEventsRequest
+	40,  // 191: mootslive.v1.UserService.GetMe:input_type -> mootslive.v1.GetMeRequest
+	45,  // 192: mootslive.v1.UserService.BeginTwitterAuth:input_type -> mootslive.v1.BeginTwitterAuthRequest
+	47,  // 193: mootslive.v1.UserService.FinishTwitterAuth:input_type -> mootslive.v1.FinishTwitterAuthRequest
+	51,  // 194: mootslive.v1.UserService.ListListens:input_type -> mootslive.v1.ListListensRequest
+	53,  // 195: mootslive.v1.UserService.ExportMyData:input_type -> mootslive.v1.ExportMyDataRequest
+	55,  // 196: mootslive.v1.UserService.DeleteMyAccount:input_type -> mootslive.v1.DeleteMyAccountRequest
+	57,  // 197: mootslive.v1.UserService.CancelAccountDeletion:input_type -> mootslive.v1.CancelAccountDeletionRequest
+	60,  // 198: mootslive.v1.UserService.GetTwitterSharingSettings:input_type -> mootslive.v1.GetTwitterSharingSettingsRequest
+	62,  // 199: mootslive.v1.UserService.UpdateTwitterSharingSettings:input_type -> mootslive.v1.UpdateTwitterSharingSettingsRequest
+	64,  // 200: mootslive.v1.UserService.ShareListen:input_type -> mootslive.v1.ShareListenRequest
+	67,  // 201: mootslive.v1.UserService.SyncMoots:input_type -> mootslive.v1.SyncMootsRequest
+	69,  // 202: mootslive.v1.UserService.ListMoots:input_type -> mootslive.v1.ListMootsRequest
+	72,  // 203: mootslive.v1.UserService.FollowUser:input_type -> mootslive.v1.FollowUserRequest
+	74,  // 204: mootslive.v1.UserService.UnfollowUser:input_type -> mootslive.v1.UnfollowUserRequest
+	76,  // 205: mootslive.v1.UserService.ListFollowers:input_type -> mootslive.v1.ListFollowersRequest
+	78,  // 206: mootslive.v1.UserService.ListFollowing:input_type -> mootslive.v1.ListFollowingRequest
+	80,  // 207: mootslive.v1.UserService.ListFollowRequests:input_type -> mootslive.v1.ListFollowRequestsRequest
+	82,  // 208: mootslive.v1.UserService.ApproveFollowRequest:input_type -> mootslive.v1.ApproveFollowRequestRequest
+	84,  // 209: mootslive.v1.UserService.DenyFollowRequest:input_type -> mootslive.v1.DenyFollowRequestRequest
+	87,  // 210: mootslive.v1.UserService.GetFollowSettings:input_type -> mootslive.v1.GetFollowSettingsRequest
+	89,  // 211: mootslive.v1.UserService.UpdateFollowSettings:input_type -> mootslive.v1.UpdateFollowSettingsRequest
+	96,  // 212: mootslive.v1.UserService.ListFeed:input_type -> mootslive.v1.ListFeedRequest
+	99,  // 213: mootslive.v1.UserService.WatchListens:input_type -> mootslive.v1.WatchListensRequest
+	103, // 214: mootslive.v1.UserService.GetCompatibility:input_type -> mootslive.v1.GetCompatibilityRequest
+	106, // 215: mootslive.v1.UserService.GetPrivacySettings:input_type -> mootslive.v1.GetPrivacySettingsRequest
+	108, // 216: mootslive.v1.UserService.UpdatePrivacySettings:input_type -> mootslive.v1.UpdatePrivacySettingsRequest
+	110, // 217: mootslive.v1.UserService.SetListenHidden:input_type -> mootslive.v1.SetListenHiddenRequest
+	113, // 218: mootslive.v1.UserService.CreateIncognitoWindow:input_type -> mootslive.v1.CreateIncognitoWindowRequest
+	115, // 219: mootslive.v1.UserService.ListIncognitoWindows:input_type -> mootslive.v1.ListIncognitoWindowsRequest
+	117, // 220: mootslive.v1.UserService.DeleteIncognitoWindow:input_type -> mootslive.v1.DeleteIncognitoWindowRequest
+	119, // 221: mootslive.v1.UserService.AddReaction:input_type -> mootslive.v1.AddReactionRequest
+	121, // 222: mootslive.v1.UserService.RemoveReaction:input_type -> mootslive.v1.RemoveReactionRequest
+	124, // 223: mootslive.v1.UserService.AddComment:input_type -> mootslive.v1.AddCommentRequest
+	126, // 224: mootslive.v1.UserService.DeleteComment:input_type -> mootslive.v1.DeleteCommentRequest
+	128, // 225: mootslive.v1.UserService.ListComments:input_type -> mootslive.v1.ListCommentsRequest
+	130, // 226: mootslive.v1.UserService.FlagComment:input_type -> mootslive.v1.FlagCommentRequest
+	133, // 227: mootslive.v1.UserService.ListNotifications:input_type -> mootslive.v1.ListNotificationsRequest
+	135, // 228: mootslive.v1.UserService.MarkNotificationsRead:input_type -> mootslive.v1.MarkNotificationsReadRequest
+	138, // 229: mootslive.v1.UserService.GetNotificationPreferences:input_type -> mootslive.v1.GetNotificationPreferencesRequest
+	140, // 230: mootslive.v1.UserService.UpdateNotificationPreferences:input_type -> mootslive.v1.UpdateNotificationPreferencesRequest
+	142, // 231: mootslive.v1.UserService.UpdateProfile:input_type -> mootslive.v1.UpdateProfileRequest
+	146, // 232: mootslive.v1.UserService.GetProfile:input_type -> mootslive.v1.GetProfileRequest
+	148, // 233: mootslive.v1.UserService.BlockUser:input_type -> mootslive.v1.BlockUserRequest
+	150, // 234: mootslive.v1.UserService.UnblockUser:input_type -> mootslive.v1.UnblockUserRequest
+	152, // 235: mootslive.v1.UserService.ListBlockedUsers:input_type -> mootslive.v1.ListBlockedUsersRequest
+	154, // 236: mootslive.v1.UserService.MuteUser:input_type -> mootslive.v1.MuteUserRequest
+	156, // 237: mootslive.v1.UserService.UnmuteUser:input_type -> mootslive.v1.UnmuteUserRequest
+	158, // 238: mootslive.v1.UserService.ListMutedUsers:input_type -> mootslive.v1.ListMutedUsersRequest
+	162, // 239: mootslive.v1.UserService.GetTopTracks:input_type -> mootslive.v1.GetTopTracksRequest
+	164, // 240: mootslive.v1.UserService.GetTopArtists:input_type -> mootslive.v1.GetTopArtistsRequest
+	166, // 241: mootslive.v1.UserService.GetTopAlbums:input_type -> mootslive.v1.GetTopAlbumsRequest
+	170, // 242: mootslive.v1.UserService.GetListeningStats:input_type -> mootslive.v1.GetListeningStatsRequest
+	175, // 243: mootslive.v1.UserService.GetRecap:input_type -> mootslive.v1.GetRecapRequest
+	179, // 244: mootslive.v1.UserService.GetCharts:input_type -> mootslive.v1.GetChartsRequest
+	181, // 245: mootslive.v1.UserService.CreateListen:input_type -> mootslive.v1.CreateListenRequest
+	183, // 246: mootslive.v1.UserService.UpdateListen:input_type -> mootslive.v1.UpdateListenRequest
+	185, // 247: mootslive.v1.UserService.DeleteListen:input_type -> mootslive.v1.DeleteListenRequest
+	187, // 248: mootslive.v1.UserService.DeleteListens:input_type -> mootslive.v1.DeleteListensRequest
+	190, // 249: mootslive.v1.UserService.ListListenEdits:input_type -> mootslive.v1.ListListenEditsRequest
+	192, // 250: mootslive.v1.UserService.ExportListens:input_type -> mootslive.v1.ExportListensRequest
+	194, // 251: mootslive.v1.UserService.GetSyncStatus:input_type -> mootslive.v1.GetSyncStatusRequest
+	198, // 252: mootslive.v1.UserService.ListMySecurityEvents:input_type -> mootslive.v1.ListMySecurityEventsRequest
+	16,  // 253: mootslive.v1.AdminService.GetStatus:output_type -> mootslive.v1.GetStatusResponse
+	18,  // 254: mootslive.v1.AdminService.GenerateRecap:output_type -> mootslive.v1.GenerateRecapResponse
+	21,  // 255: mootslive.v1.AdminService.ListUsers:output_type -> mootslive.v1.ListUsersResponse
+	25,  // 256: mootslive.v1.AdminService.GetUser:output_type -> mootslive.v1.GetUserResponse
+	27,  // 257: mootslive.v1.AdminService.DisableUser:output_type -> mootslive.v1.DisableUserResponse
+	29,  // 258: mootslive.v1.AdminService.EnableUser:output_type -> mootslive.v1.EnableUserResponse
+	31,  // 259: mootslive.v1.AdminService.ForceRescan:output_type -> mootslive.v1.ForceRescanResponse
+	33,  // 260: mootslive.v1.AdminService.RevokeUserSessions:output_type -> mootslive.v1.RevokeUserSessionsResponse
+	36,  // 261: mootslive.v1.AdminService.GetPollerStatus:output_type -> mootslive.v1.GetPollerStatusResponse
+	39,  // 262: mootslive.v1.AdminService.ListAuditEvents:output_type -> mootslive.v1.ListAuditEventsResponse
+	43,  // 263: mootslive.v1.UserService.GetMe:output_type -> mootslive.v1.GetMeResponse
+	46,  // 264: mootslive.v1.UserService.BeginTwitterAuth:output_type -> mootslive.v1.BeginTwitterAuthResponse
+	48,  // 265: mootslive.v1.UserService.FinishTwitterAuth:output_type -> mootslive.v1.FinishTwitterAuthResponse
+	52,  // 266: mootslive.v1.UserService.ListListens:output_type -> mootslive.v1.ListListensResponse
+	54,  // 267: mootslive.v1.UserService.ExportMyData:output_type -> mootslive.v1.ExportMyDataResponse
+	56,  // 268: mootslive.v1.UserService.DeleteMyAccount:output_type -> mootslive.v1.DeleteMyAccountResponse
+	58,  // 269: mootslive.v1.UserService.CancelAccountDeletion:output_type -> mootslive.v1.CancelAccountDeletionResponse
+	61,  // 270: mootslive.v1.UserService.GetTwitterSharingSettings:output_type -> mootslive.v1.GetTwitterSharingSettingsResponse
+	63,  // 271: mootslive.v1.UserService.UpdateTwitterSharingSettings:output_type -> mootslive.v1.UpdateTwitterSharingSettingsResponse
+	65,  // 272: mootslive.v1.UserService.ShareListen:output_type -> mootslive.v1.ShareListenResponse
+	68,  // 273: mootslive.v1.UserService.SyncMoots:output_type -> mootslive.v1.SyncMootsResponse
+	70,  // 274: mootslive.v1.UserService.ListMoots:output_type -> mootslive.v1.ListMootsResponse
+	73,  // 275: mootslive.v1.UserService.FollowUser:output_type -> mootslive.v1.FollowUserResponse
+	75,  // 276: mootslive.v1.UserService.UnfollowUser:output_type -> mootslive.v1.UnfollowUserResponse
+	77,  // 277: mootslive.v1.UserService.ListFollowers:output_type -> mootslive.v1.ListFollowersResponse
+	79,  // 278: mootslive.v1.UserService.ListFollowing:output_type -> mootslive.v1.ListFollowingResponse
+	81,  // 279: mootslive.v1.UserService.ListFollowRequests:output_type -> mootslive.v1.ListFollowRequestsResponse
+	83,  // 280: mootslive.v1.UserService.ApproveFollowRequest:output_type -> mootslive.v1.ApproveFollowRequestResponse
+	85,  // 281: mootslive.v1.UserService.DenyFollowRequest:output_type -> mootslive.v1.DenyFollowRequestResponse
+	88,  // 282: mootslive.v1.UserService.GetFollowSettings:output_type -> mootslive.v1.GetFollowSettingsResponse
+	90,  // 283: mootslive.v1.UserService.UpdateFollowSettings:output_type -> mootslive.v1.UpdateFollowSettingsResponse
+	97,  // 284: mootslive.v1.UserService.ListFeed:output_type -> mootslive.v1.ListFeedResponse
+	101, // 285: mootslive.v1.UserService.WatchListens:output_type -> mootslive.v1.WatchListensResponse
+	104, // 286: mootslive.v1.UserService.GetCompatibility:output_type -> mootslive.v1.GetCompatibilityResponse
+	107, // 287: mootslive.v1.UserService.GetPrivacySettings:output_type -> mootslive.v1.GetPrivacySettingsResponse
+	109, // 288: mootslive.v1.UserService.UpdatePrivacySettings:output_type -> mootslive.v1.UpdatePrivacySettingsResponse
+	111, // 289: mootslive.v1.UserService.SetListenHidden:output_type -> mootslive.v1.SetListenHiddenResponse
+	114, // 290: mootslive.v1.UserService.CreateIncognitoWindow:output_type -> mootslive.v1.CreateIncognitoWindowResponse
+	116, // 291: mootslive.v1.UserService.ListIncognitoWindows:output_type -> mootslive.v1.ListIncognitoWindowsResponse
+	118, // 292: mootslive.v1.UserService.DeleteIncognitoWindow:output_type -> mootslive.v1.DeleteIncognitoWindowResponse
+	120, // 293: mootslive.v1.UserService.AddReaction:output_type -> mootslive.v1.AddReactionResponse
+	122, // 294: mootslive.v1.UserService.RemoveReaction:output_type -> mootslive.v1.RemoveReactionResponse
+	125, // 295: mootslive.v1.UserService.AddComment:output_type -> mootslive.v1.AddCommentResponse
+	127, // 296: mootslive.v1.UserService.DeleteComment:output_type -> mootslive.v1.DeleteCommentResponse
+	129, // 297: mootslive.v1.UserService.ListComments:output_type -> mootslive.v1.ListCommentsResponse
+	131, // 298: mootslive.v1.UserService.FlagComment:output_type -> mootslive.v1.FlagCommentResponse
+	134, // 299: mootslive.v1.UserService.ListNotifications:output_type -> mootslive.v1.ListNotificationsResponse
+	136, // 300: mootslive.v1.UserService.MarkNotificationsRead:output_type -> mootslive.v1.MarkNotificationsReadResponse
+	139, // 301: mootslive.v1.UserService.GetNotificationPreferences:output_type -> mootslive.v1.GetNotificationPreferencesResponse
+	141, // 302: mootslive.v1.UserService.UpdateNotificationPreferences:output_type -> mootslive.v1.UpdateNotificationPreferencesResponse
+	143, // 303: mootslive.v1.UserService.UpdateProfile:output_type -> mootslive.v1.UpdateProfileResponse
+	147, // 304: mootslive.v1.UserService.GetProfile:output_type -> mootslive.v1.GetProfileResponse
+	149, // 305: mootslive.v1.UserService.BlockUser:output_type -> mootslive.v1.BlockUserResponse
+	151, // 306: mootslive.v1.UserService.UnblockUser:output_type -> mootslive.v1.UnblockUserResponse
+	153, // 307: mootslive.v1.UserService.ListBlockedUsers:output_type -> mootslive.v1.ListBlockedUsersResponse
+	155, // 308: mootslive.v1.UserService.MuteUser:output_type -> mootslive.v1.MuteUserResponse
+	157, // 309: mootslive.v1.UserService.UnmuteUser:output_type -> mootslive.v1.UnmuteUserResponse
+	159, // 310: mootslive.v1.UserService.ListMutedUsers:output_type -> mootslive.v1.ListMutedUsersResponse
+	163, // 311: mootslive.v1.UserService.GetTopTracks:output_type -> mootslive.v1.GetTopTracksResponse
+	165, // 312: mootslive.v1.UserService.GetTopArtists:output_type -> mootslive.v1.GetTopArtistsResponse
+	167, // 313: mootslive.v1.UserService.GetTopAlbums:output_type -> mootslive.v1.GetTopAlbumsResponse
+	171, // 314: mootslive.v1.UserService.GetListeningStats:output_type -> mootslive.v1.GetListeningStatsResponse
+	176, // 315: mootslive.v1.UserService.GetRecap:output_type -> mootslive.v1.GetRecapResponse
+	180, // 316: mootslive.v1.UserService.GetCharts:output_type -> mootslive.v1.GetChartsResponse
+	182, // 317: mootslive.v1.UserService.CreateListen:output_type -> mootslive.v1.CreateListenResponse
+	184, // 318: mootslive.v1.UserService.UpdateListen:output_type -> mootslive.v1.UpdateListenResponse
+	186, // 319: mootslive.v1.UserService.DeleteListen:output_type -> mootslive.v1.DeleteListenResponse
+	188, // 320: mootslive.v1.UserService.DeleteListens:output_type -> mootslive.v1.DeleteListensResponse
+	191, // 321: mootslive.v1.UserService.ListListenEdits:output_type -> mootslive.v1.ListListenEditsResponse
+	193, // 322: mootslive.v1.UserService.ExportListens:output_type -> mootslive.v1.ExportListensResponse
+	196, // 323: mootslive.v1.UserService.GetSyncStatus:output_type -> mootslive.v1.GetSyncStatusResponse
+	199, // 324: mootslive.v1.UserService.ListMySecurityEvents:output_type -> mootslive.v1.ListMySecurityEventsResponse
+	253, // [253:325] is the sub-list for method output_type
+	181, // [181:253] is the sub-list for method input_type
+	181, // [181:181] is the sub-list for extension type_name
+	181, // [181:181] is the sub-list for extension extendee
+	0,   // [0:181] is the sub-list for field type_name
+}
+
+func init() { file_mootslive_v1_mootslive_proto_init() }
+func file_mootslive_v1_mootslive_proto_init() {
+	if File_mootslive_v1_mootslive_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mootslive_v1_mootslive_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateRecapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateRecapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpotifyScan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpotifyAccountHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceRescanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceRescanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeUserSessionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeUserSessionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPollerStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollerReplicaThroughput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPollerStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkedAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Profile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OAuth2State); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeginTwitterAuthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeginTwitterAuthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinishTwitterAuthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinishTwitterAuthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Listen); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReactionCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListListensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListListensResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportMyDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportMyDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMyAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMyAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelAccountDeletionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelAccountDeletionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TwitterSharingSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTwitterSharingSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTwitterSharingSettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTwitterSharingSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTwitterSharingSettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareListenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareListenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Moot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncMootsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncMootsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMootsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMootsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Follow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfollowUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfollowUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFollowersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFollowersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFollowingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFollowingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFollowRequestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFollowRequestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveFollowRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveFollowRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenyFollowRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenyFollowRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFollowSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFollowSettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFollowSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFollowSettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Artist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Album); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Track); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeedEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFeedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Presence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchListensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationsUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchListensResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompatibilityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompatibilityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivacySettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPrivacySettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPrivacySettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePrivacySettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePrivacySettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetListenHiddenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetListenHiddenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncognitoWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateIncognitoWindowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateIncognitoWindowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIncognitoWindowsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIncognitoWindowsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteIncognitoWindowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteIncognitoWindowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddReactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddReactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveReactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveReactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCommentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCommentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlagCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlagCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Notification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNotificationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNotificationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkNotificationsReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkNotificationsReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationPreference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNotificationPreferencesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNotificationPreferencesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateNotificationPreferencesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateNotificationPreferencesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopArtist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecentListen); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProfileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProfileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBlockedUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBlockedUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuteUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuteUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnmuteUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnmuteUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMutedUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMutedUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopTrack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopAlbum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTopTracksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTopTracksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTopArtistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTopArtistsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTopAlbumsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTopAlbumsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeatmapCell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DailyPlays); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListeningStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListeningStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoopedTrack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MootsComparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*YearlyRecap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChartTrack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChartArtist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChartsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChartsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateListenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateListenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListListensRequest.ProtoReflect.Descriptor instead.
-func (*ListListensRequest) Descriptor() ([]byte, []int) {
-	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{10}
-}
-
-type ListListensResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Listens []*Listen `protobuf:"bytes,1,rep,name=listens,proto3" json:"listens,omitempty"`
-}
-
-func (x *ListListensResponse) Reset() {
-	*x = ListListensResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mootslive_v1_mootslive_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListListensResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListListensResponse) ProtoMessage() {}
-
-func (x *ListListensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mootslive_v1_mootslive_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_mootslive_v1_mootslive_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateListenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListListensResponse.ProtoReflect.Descriptor instead.
-func (*ListListensResponse) Descriptor() ([]byte, []int) {
-	return file_mootslive_v1_mootslive_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *ListListensResponse) GetListens() []*Listen {
-	if x != nil {
-		return x.Listens
-	}
-	return nil
-}
-
-var File_mootslive_v1_mootslive_proto protoreflect.FileDescriptor
-
-var file_mootslive_v1_mootslive_proto_rawDesc = []byte{
-	0x0a, 0x1c, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
-	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c,
-	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x12, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x3f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x78, 0x5f, 0x63, 0x6c, 0x61, 0x63,
-	0x6b, 0x73, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x68, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x78, 0x43, 0x6c, 0x61, 0x63, 0x6b, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x68, 0x65,
-	0x61, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x5a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x51,
-	0x0a, 0x0b, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x6b, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x70, 0x6b, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x22, 0x19, 0x0a, 0x17, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65,
-	0x72, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6e, 0x0a, 0x18,
-	0x42, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x2f, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x6f,
-	0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x97, 0x01, 0x0a,
-	0x18, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x41, 0x75,
-	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
-	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x36, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
-	0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbc,
-	0x01, 0x0a, 0x06, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x69, 0x73, 0x72, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x72, 0x63,
-	0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x22, 0x14, 0x0a,
-	0x12, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x45, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x6c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x6f,
-	0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65,
-	0x6e, 0x52, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x32, 0x5e, 0x0a, 0x0c, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c,
-	0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c,
-	0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xf4, 0x02, 0x0a, 0x0b, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x05, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x12, 0x1a, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x4d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63,
-	0x0a, 0x10, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x41, 0x75,
-	0x74, 0x68, 0x12, 0x25, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x41, 0x75,
-	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x6f, 0x6f, 0x74,
-	0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x77,
-	0x69, 0x74, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x77, 0x69,
-	0x74, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x12, 0x26, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73,
-	0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x77,
-	0x69, 0x74, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x27, 0x2e, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x77, 0x69, 0x74, 0x74, 0x65, 0x72, 0x41, 0x75, 0x74,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0b, 0x4c,
-	0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x6d, 0x6f, 0x6f,
-	0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d,
-	0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2f, 0x6d, 0x6f, 0x6e, 0x6f, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x2f, 0x76,
-	0x31, 0x3b, 0x6d, 0x6f, 0x6f, 0x74, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x70, 0x62, 0x76, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_mootslive_v1_mootslive_proto_rawDescOnce sync.Once
-	file_mootslive_v1_mootslive_proto_rawDescData = file_mootslive_v1_mootslive_proto_rawDesc
-)
-
-func file_mootslive_v1_mootslive_proto_rawDescGZIP() []byte {
-	file_mootslive_v1_mootslive_proto_rawDescOnce.Do(func() {
-		file_mootslive_v1_mootslive_proto_rawDescData = protoimpl.X.CompressGZIP(file_mootslive_v1_mootslive_proto_rawDescData)
-	})
-	return file_mootslive_v1_mootslive_proto_rawDescData
-}
-
-var file_mootslive_v1_mootslive_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
-var file_mootslive_v1_mootslive_proto_goTypes = []interface{}{
-	(*GetStatusRequest)(nil),          // 0: mootslive.v1.GetStatusRequest
-	(*GetStatusResponse)(nil),         // 1: mootslive.v1.GetStatusResponse
-	(*GetMeRequest)(nil),              // 2: mootslive.v1.GetMeRequest
-	(*GetMeResponse)(nil),             // 3: mootslive.v1.GetMeResponse
-	(*OAuth2State)(nil),               // 4: mootslive.v1.OAuth2State
-	(*BeginTwitterAuthRequest)(nil),   // 5: mootslive.v1.BeginTwitterAuthRequest
-	(*BeginTwitterAuthResponse)(nil),  // 6: mootslive.v1.BeginTwitterAuthResponse
-	(*FinishTwitterAuthRequest)(nil),  // 7: mootslive.v1.FinishTwitterAuthRequest
-	(*FinishTwitterAuthResponse)(nil), // 8: mootslive.v1.FinishTwitterAuthResponse
-	(*Listen)(nil),                    // 9: mootslive.v1.Listen
-	(*ListListensRequest)(nil),        // 10: mootslive.v1.ListListensRequest
-	(*ListListensResponse)(nil),       // 11: mootslive.v1.ListListensResponse
-	(*timestamppb.Timestamp)(nil),     // 12: google.protobuf.Timestamp
-}
-var file_mootslive_v1_mootslive_proto_depIdxs = []int32{
-	12, // 0: mootslive.v1.GetMeResponse.created_at:type_name -> google.protobuf.Timestamp
-	4,  // 1: mootslive.v1.BeginTwitterAuthResponse.state:type_name -> mootslive.v1.OAuth2State
-	4,  // 2: mootslive.v1.FinishTwitterAuthRequest.state:type_name -> mootslive.v1.OAuth2State
-	12, // 3: mootslive.v1.Listen.created_at:type_name -> google.protobuf.Timestamp
-	12, // 4: mootslive.v1.Listen.listened_at:type_name -> google.protobuf.Timestamp
-	9,  // 5: mootslive.v1.ListListensResponse.listens:type_name -> mootslive.v1.Listen
-	0,  // 6: mootslive.v1.AdminService.GetStatus:input_type -> mootslive.v1.GetStatusRequest
-	2,  // 7: mootslive.v1.UserService.GetMe:input_type -> mootslive.v1.GetMeRequest
-	5,  // 8: mootslive.v1.UserService.BeginTwitterAuth:input_type -> mootslive.v1.BeginTwitterAuthRequest
-	7,  // 9: mootslive.v1.UserService.FinishTwitterAuth:input_type -> mootslive.v1.FinishTwitterAuthRequest
-	10, // 10: mootslive.v1.UserService.ListListens:input_type -> mootslive.v1.ListListensRequest
-	1,  // 11: mootslive.v1.AdminService.GetStatus:output_type -> mootslive.v1.GetStatusResponse
-	3,  // 12: mootslive.v1.UserService.GetMe:output_type -> mootslive.v1.GetMeResponse
-	6,  // 13: mootslive.v1.UserService.BeginTwitterAuth:output_type -> mootslive.v1.BeginTwitterAuthResponse
-	8,  // 14: mootslive.v1.UserService.FinishTwitterAuth:output_type -> mootslive.v1.FinishTwitterAuthResponse
-	11, // 15: mootslive.v1.UserService.ListListens:output_type -> mootslive.v1.ListListensResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
-}
-
-func init() { file_mootslive_v1_mootslive_proto_init() }
-func file_mootslive_v1_mootslive_proto_init() {
-	if File_mootslive_v1_mootslive_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_mootslive_v1_mootslive_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatusRequest); i {
+		file_mootslive_v1_mootslive_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateListenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -797,8 +16055,8 @@ func file_mootslive_v1_mootslive_proto_init() {
 				return nil
 			}
 		}
-		file_mootslive_v1_mootslive_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatusResponse); i {
+		file_mootslive_v1_mootslive_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteListenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -809,8 +16067,8 @@ func file_mootslive_v1_mootslive_proto_init() {
 				return nil
 			}
 		}
-		file_mootslive_v1_mootslive_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMeRequest); i {
+		file_mootslive_v1_mootslive_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteListenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -821,8 +16079,8 @@ func file_mootslive_v1_mootslive_proto_init() {
 				return nil
 			}
 		}
-		file_mootslive_v1_mootslive_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMeResponse); i {
+		file_mootslive_v1_mootslive_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteListensRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -833,8 +16091,8 @@ func file_mootslive_v1_mootslive_proto_init() {
 				return nil
 			}
 		}
-		file_mootslive_v1_mootslive_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OAuth2State); i {
+		file_mootslive_v1_mootslive_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteListensResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -845,8 +16103,8 @@ func file_mootslive_v1_mootslive_proto_init() {
 				return nil
 			}
 		}
-		file_mootslive_v1_mootslive_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BeginTwitterAuthRequest); i {
+		file_mootslive_v1_mootslive_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListenEdit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -857,8 +16115,8 @@ func file_mootslive_v1_mootslive_proto_init() {
 				return nil
 			}
 		}
-		file_mootslive_v1_mootslive_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BeginTwitterAuthResponse); i {
+		file_mootslive_v1_mootslive_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListListenEditsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -869,8 +16127,8 @@ func file_mootslive_v1_mootslive_proto_init() {
 				return nil
 			}
 		}
-		file_mootslive_v1_mootslive_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FinishTwitterAuthRequest); i {
+		file_mootslive_v1_mootslive_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListListenEditsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -881,8 +16139,8 @@ func file_mootslive_v1_mootslive_proto_init() {
 				return nil
 			}
 		}
-		file_mootslive_v1_mootslive_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FinishTwitterAuthResponse); i {
+		file_mootslive_v1_mootslive_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportListensRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -893,8 +16151,8 @@ func file_mootslive_v1_mootslive_proto_init() {
 				return nil
 			}
 		}
-		file_mootslive_v1_mootslive_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Listen); i {
+		file_mootslive_v1_mootslive_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportListensResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -905,8 +16163,8 @@ func file_mootslive_v1_mootslive_proto_init() {
 				return nil
 			}
 		}
-		file_mootslive_v1_mootslive_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListListensRequest); i {
+		file_mootslive_v1_mootslive_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSyncStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -917,8 +16175,56 @@ func file_mootslive_v1_mootslive_proto_init() {
 				return nil
 			}
 		}
-		file_mootslive_v1_mootslive_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListListensResponse); i {
+		file_mootslive_v1_mootslive_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountSyncStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSyncStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecurityEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMySecurityEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mootslive_v1_mootslive_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMySecurityEventsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -930,18 +16236,24 @@ func file_mootslive_v1_mootslive_proto_init() {
 			}
 		}
 	}
+	file_mootslive_v1_mootslive_proto_msgTypes[87].OneofWrappers = []interface{}{
+		(*WatchListensResponse_Listen)(nil),
+		(*WatchListensResponse_Presence)(nil),
+		(*WatchListensResponse_Notifications)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mootslive_v1_mootslive_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      14,
+			NumMessages:   186,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
 		GoTypes:           file_mootslive_v1_mootslive_proto_goTypes,
 		DependencyIndexes: file_mootslive_v1_mootslive_proto_depIdxs,
+		EnumInfos:         file_mootslive_v1_mootslive_proto_enumTypes,
 		MessageInfos:      file_mootslive_v1_mootslive_proto_msgTypes,
 	}.Build()
 	File_mootslive_v1_mootslive_proto = out.File
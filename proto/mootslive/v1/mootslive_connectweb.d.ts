@@ -3,10 +3,12 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { BeginTwitterAuthRequest, BeginTwitterAuthResponse, FinishTwitterAuthRequest, FinishTwitterAuthResponse, GetMeRequest, GetMeResponse, GetStatusRequest, GetStatusResponse, ListListensRequest, ListListensResponse } from "./mootslive_pb.js";
+import { AddCommentRequest, AddCommentResponse, AddReactionRequest, AddReactionResponse, ApproveFollowRequestRequest, ApproveFollowRequestResponse, BeginTwitterAuthRequest, BeginTwitterAuthResponse, BlockUserRequest, BlockUserResponse, CancelAccountDeletionRequest, CancelAccountDeletionResponse, CreateIncognitoWindowRequest, CreateIncognitoWindowResponse, CreateListenRequest, CreateListenResponse, DeleteCommentRequest, DeleteCommentResponse, DeleteIncognitoWindowRequest, DeleteIncognitoWindowResponse, DeleteListenRequest, DeleteListenResponse, DeleteListensRequest, DeleteListensResponse, DeleteMyAccountRequest, DeleteMyAccountResponse, DenyFollowRequestRequest, DenyFollowRequestResponse, DisableUserRequest, DisableUserResponse, EnableUserRequest, EnableUserResponse, ExportListensRequest, ExportListensResponse, ExportMyDataRequest, ExportMyDataResponse, FinishTwitterAuthRequest, FinishTwitterAuthResponse, FlagCommentRequest, FlagCommentResponse, FollowUserRequest, FollowUserResponse, ForceRescanRequest, ForceRescanResponse, GenerateRecapRequest, GenerateRecapResponse, GetChartsRequest, GetChartsResponse, GetCompatibilityRequest, GetCompatibilityResponse, GetFollowSettingsRequest, GetFollowSettingsResponse, GetListeningStatsRequest, GetListeningStatsResponse, GetMeRequest, GetMeResponse, GetNotificationPreferencesRequest, GetNotificationPreferencesResponse, GetPollerStatusRequest, GetPollerStatusResponse, GetPrivacySettingsRequest, GetPrivacySettingsResponse, GetProfileRequest, GetProfileResponse, GetRecapRequest, GetRecapResponse, GetStatusRequest, GetStatusResponse, GetSyncStatusRequest, GetSyncStatusResponse, GetTopAlbumsRequest, GetTopAlbumsResponse, GetTopArtistsRequest, GetTopArtistsResponse, GetTopTracksRequest, GetTopTracksResponse, GetTwitterSharingSettingsRequest, GetTwitterSharingSettingsResponse, GetUserRequest, GetUserResponse, ListAuditEventsRequest, ListAuditEventsResponse, ListBlockedUsersRequest, ListBlockedUsersResponse, ListCommentsRequest, ListCommentsResponse, ListFeedRequest, ListFeedResponse, ListFollowRequestsRequest, ListFollowRequestsResponse, ListFollowersRequest, ListFollowersResponse, ListFollowingRequest, ListFollowingResponse, ListIncognitoWindowsRequest, ListIncognitoWindowsResponse, ListListenEditsRequest, ListListenEditsResponse, ListListensRequest, ListListensResponse, ListMootsRequest, ListMootsResponse, ListMutedUsersRequest, ListMutedUsersResponse, ListMySecurityEventsRequest, ListMySecurityEventsResponse, ListNotificationsRequest, ListNotificationsResponse, ListUsersRequest, ListUsersResponse, MarkNotificationsReadRequest, MarkNotificationsReadResponse, MuteUserRequest, MuteUserResponse, RemoveReactionRequest, RemoveReactionResponse, RevokeUserSessionsRequest, RevokeUserSessionsResponse, SetListenHiddenRequest, SetListenHiddenResponse, ShareListenRequest, ShareListenResponse, SyncMootsRequest, SyncMootsResponse, UnblockUserRequest, UnblockUserResponse, UnfollowUserRequest, UnfollowUserResponse, UnmuteUserRequest, UnmuteUserResponse, UpdateFollowSettingsRequest, UpdateFollowSettingsResponse, UpdateListenRequest, UpdateListenResponse, UpdateNotificationPreferencesRequest, UpdateNotificationPreferencesResponse, UpdatePrivacySettingsRequest, UpdatePrivacySettingsResponse, UpdateProfileRequest, UpdateProfileResponse, UpdateTwitterSharingSettingsRequest, UpdateTwitterSharingSettingsResponse, WatchListensRequest, WatchListensResponse } from "./mootslive_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
+ * AdminService can only be used by admins, except for GetStatus.
+ *
  * @generated from service mootslive.v1.AdminService
  */
 export declare const AdminService: {
@@ -21,6 +23,108 @@ export declare const AdminService: {
       readonly O: typeof GetStatusResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * GenerateRecap generates a new version of a user's recap of a year, which
+     * replaces any they already have.
+     *
+     * @generated from rpc mootslive.v1.AdminService.GenerateRecap
+     */
+    readonly generateRecap: {
+      readonly name: "GenerateRecap",
+      readonly I: typeof GenerateRecapRequest,
+      readonly O: typeof GenerateRecapResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListUsers lists users, most recently created first.
+     *
+     * @generated from rpc mootslive.v1.AdminService.ListUsers
+     */
+    readonly listUsers: {
+      readonly name: "ListUsers",
+      readonly I: typeof ListUsersRequest,
+      readonly O: typeof ListUsersResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * GetUser gets a user along with the accounts they've linked.
+     *
+     * @generated from rpc mootslive.v1.AdminService.GetUser
+     */
+    readonly getUser: {
+      readonly name: "GetUser",
+      readonly I: typeof GetUserRequest,
+      readonly O: typeof GetUserResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * DisableUser stops a user from using mootslive, and stops their listens
+     * being polled, until they're enabled again.
+     *
+     * @generated from rpc mootslive.v1.AdminService.DisableUser
+     */
+    readonly disableUser: {
+      readonly name: "DisableUser",
+      readonly I: typeof DisableUserRequest,
+      readonly O: typeof DisableUserResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.AdminService.EnableUser
+     */
+    readonly enableUser: {
+      readonly name: "EnableUser",
+      readonly I: typeof EnableUserRequest,
+      readonly O: typeof EnableUserResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ForceRescan scans a Spotify account for new listens immediately, rather
+     * than waiting for the poller to get to it.
+     *
+     * @generated from rpc mootslive.v1.AdminService.ForceRescan
+     */
+    readonly forceRescan: {
+      readonly name: "ForceRescan",
+      readonly I: typeof ForceRescanRequest,
+      readonly O: typeof ForceRescanResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * RevokeUserSessions signs a user out everywhere, by refusing every token
+     * issued to them before now.
+     *
+     * @generated from rpc mootslive.v1.AdminService.RevokeUserSessions
+     */
+    readonly revokeUserSessions: {
+      readonly name: "RevokeUserSessions",
+      readonly I: typeof RevokeUserSessionsRequest,
+      readonly O: typeof RevokeUserSessionsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * GetPollerStatus reports how far behind the poller is, which accounts
+     * it's struggling with and how much scanning each replica is doing.
+     *
+     * @generated from rpc mootslive.v1.AdminService.GetPollerStatus
+     */
+    readonly getPollerStatus: {
+      readonly name: "GetPollerStatus",
+      readonly I: typeof GetPollerStatusRequest,
+      readonly O: typeof GetPollerStatusResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListAuditEvents lists security relevant events, most recent first.
+     *
+     * @generated from rpc mootslive.v1.AdminService.ListAuditEvents
+     */
+    readonly listAuditEvents: {
+      readonly name: "ListAuditEvents",
+      readonly I: typeof ListAuditEventsRequest,
+      readonly O: typeof ListAuditEventsResponse,
+      readonly kind: MethodKind.Unary,
+    },
   }
 };
 
@@ -66,6 +170,604 @@ export declare const UserService: {
       readonly O: typeof ListListensResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ExportMyData
+     */
+    readonly exportMyData: {
+      readonly name: "ExportMyData",
+      readonly I: typeof ExportMyDataRequest,
+      readonly O: typeof ExportMyDataResponse,
+      readonly kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.DeleteMyAccount
+     */
+    readonly deleteMyAccount: {
+      readonly name: "DeleteMyAccount",
+      readonly I: typeof DeleteMyAccountRequest,
+      readonly O: typeof DeleteMyAccountResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.CancelAccountDeletion
+     */
+    readonly cancelAccountDeletion: {
+      readonly name: "CancelAccountDeletion",
+      readonly I: typeof CancelAccountDeletionRequest,
+      readonly O: typeof CancelAccountDeletionResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.GetTwitterSharingSettings
+     */
+    readonly getTwitterSharingSettings: {
+      readonly name: "GetTwitterSharingSettings",
+      readonly I: typeof GetTwitterSharingSettingsRequest,
+      readonly O: typeof GetTwitterSharingSettingsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.UpdateTwitterSharingSettings
+     */
+    readonly updateTwitterSharingSettings: {
+      readonly name: "UpdateTwitterSharingSettings",
+      readonly I: typeof UpdateTwitterSharingSettingsRequest,
+      readonly O: typeof UpdateTwitterSharingSettingsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ShareListen
+     */
+    readonly shareListen: {
+      readonly name: "ShareListen",
+      readonly I: typeof ShareListenRequest,
+      readonly O: typeof ShareListenResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * SyncMoots requests that the caller's follows are refreshed from Twitter.
+     * The refresh happens in the background, so ListMoots may not reflect it
+     * immediately.
+     *
+     * @generated from rpc mootslive.v1.UserService.SyncMoots
+     */
+    readonly syncMoots: {
+      readonly name: "SyncMoots",
+      readonly I: typeof SyncMootsRequest,
+      readonly O: typeof SyncMootsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ListMoots
+     */
+    readonly listMoots: {
+      readonly name: "ListMoots",
+      readonly I: typeof ListMootsRequest,
+      readonly O: typeof ListMootsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.FollowUser
+     */
+    readonly followUser: {
+      readonly name: "FollowUser",
+      readonly I: typeof FollowUserRequest,
+      readonly O: typeof FollowUserResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.UnfollowUser
+     */
+    readonly unfollowUser: {
+      readonly name: "UnfollowUser",
+      readonly I: typeof UnfollowUserRequest,
+      readonly O: typeof UnfollowUserResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ListFollowers
+     */
+    readonly listFollowers: {
+      readonly name: "ListFollowers",
+      readonly I: typeof ListFollowersRequest,
+      readonly O: typeof ListFollowersResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ListFollowing
+     */
+    readonly listFollowing: {
+      readonly name: "ListFollowing",
+      readonly I: typeof ListFollowingRequest,
+      readonly O: typeof ListFollowingResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ListFollowRequests
+     */
+    readonly listFollowRequests: {
+      readonly name: "ListFollowRequests",
+      readonly I: typeof ListFollowRequestsRequest,
+      readonly O: typeof ListFollowRequestsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ApproveFollowRequest
+     */
+    readonly approveFollowRequest: {
+      readonly name: "ApproveFollowRequest",
+      readonly I: typeof ApproveFollowRequestRequest,
+      readonly O: typeof ApproveFollowRequestResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.DenyFollowRequest
+     */
+    readonly denyFollowRequest: {
+      readonly name: "DenyFollowRequest",
+      readonly I: typeof DenyFollowRequestRequest,
+      readonly O: typeof DenyFollowRequestResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.GetFollowSettings
+     */
+    readonly getFollowSettings: {
+      readonly name: "GetFollowSettings",
+      readonly I: typeof GetFollowSettingsRequest,
+      readonly O: typeof GetFollowSettingsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.UpdateFollowSettings
+     */
+    readonly updateFollowSettings: {
+      readonly name: "UpdateFollowSettings",
+      readonly I: typeof UpdateFollowSettingsRequest,
+      readonly O: typeof UpdateFollowSettingsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListFeed returns the listens of the users the caller follows, newest
+     * first.
+     *
+     * @generated from rpc mootslive.v1.UserService.ListFeed
+     */
+    readonly listFeed: {
+      readonly name: "ListFeed",
+      readonly I: typeof ListFeedRequest,
+      readonly O: typeof ListFeedResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * WatchListens streams the listens of the caller and their moots as they
+     * are recorded, along with changes in their presence and to the caller's
+     * notifications. The current presence of each user, and the caller's
+     * unread notification count, are sent when the stream starts. Listens may
+     * be sent more than once across reconnects, so should be de-duplicated by
+     * ID.
+     *
+     * @generated from rpc mootslive.v1.UserService.WatchListens
+     */
+    readonly watchListens: {
+      readonly name: "WatchListens",
+      readonly I: typeof WatchListensRequest,
+      readonly O: typeof WatchListensResponse,
+      readonly kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * GetCompatibility scores how similar the listening of the caller and
+     * another user is. Scores are cached, so may lag behind the latest listens
+     * by a few minutes.
+     *
+     * @generated from rpc mootslive.v1.UserService.GetCompatibility
+     */
+    readonly getCompatibility: {
+      readonly name: "GetCompatibility",
+      readonly I: typeof GetCompatibilityRequest,
+      readonly O: typeof GetCompatibilityResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.GetPrivacySettings
+     */
+    readonly getPrivacySettings: {
+      readonly name: "GetPrivacySettings",
+      readonly I: typeof GetPrivacySettingsRequest,
+      readonly O: typeof GetPrivacySettingsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.UpdatePrivacySettings
+     */
+    readonly updatePrivacySettings: {
+      readonly name: "UpdatePrivacySettings",
+      readonly I: typeof UpdatePrivacySettingsRequest,
+      readonly O: typeof UpdatePrivacySettingsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * SetListenHidden hides one of the caller's listens from everyone else, or
+     * reveals it again.
+     *
+     * @generated from rpc mootslive.v1.UserService.SetListenHidden
+     */
+    readonly setListenHidden: {
+      readonly name: "SetListenHidden",
+      readonly I: typeof SetListenHiddenRequest,
+      readonly O: typeof SetListenHiddenResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * CreateIncognitoWindow hides the caller's listens within a period of time
+     * from everyone else. The period may extend into the future, hiding
+     * listens as they're recorded.
+     *
+     * @generated from rpc mootslive.v1.UserService.CreateIncognitoWindow
+     */
+    readonly createIncognitoWindow: {
+      readonly name: "CreateIncognitoWindow",
+      readonly I: typeof CreateIncognitoWindowRequest,
+      readonly O: typeof CreateIncognitoWindowResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ListIncognitoWindows
+     */
+    readonly listIncognitoWindows: {
+      readonly name: "ListIncognitoWindows",
+      readonly I: typeof ListIncognitoWindowsRequest,
+      readonly O: typeof ListIncognitoWindowsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.DeleteIncognitoWindow
+     */
+    readonly deleteIncognitoWindow: {
+      readonly name: "DeleteIncognitoWindow",
+      readonly I: typeof DeleteIncognitoWindowRequest,
+      readonly O: typeof DeleteIncognitoWindowResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * AddReaction reacts to a listen the caller can see with an emoji. Each
+     * user can react to a listen with up to five different emoji.
+     *
+     * @generated from rpc mootslive.v1.UserService.AddReaction
+     */
+    readonly addReaction: {
+      readonly name: "AddReaction",
+      readonly I: typeof AddReactionRequest,
+      readonly O: typeof AddReactionResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.RemoveReaction
+     */
+    readonly removeReaction: {
+      readonly name: "RemoveReaction",
+      readonly I: typeof RemoveReactionRequest,
+      readonly O: typeof RemoveReactionResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.AddComment
+     */
+    readonly addComment: {
+      readonly name: "AddComment",
+      readonly I: typeof AddCommentRequest,
+      readonly O: typeof AddCommentResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * DeleteComment deletes a comment, which can be done by its author or the
+     * user whose listen it was left on.
+     *
+     * @generated from rpc mootslive.v1.UserService.DeleteComment
+     */
+    readonly deleteComment: {
+      readonly name: "DeleteComment",
+      readonly I: typeof DeleteCommentRequest,
+      readonly O: typeof DeleteCommentResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ListComments
+     */
+    readonly listComments: {
+      readonly name: "ListComments",
+      readonly I: typeof ListCommentsRequest,
+      readonly O: typeof ListCommentsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * FlagComment reports a comment for moderation. Comments flagged by enough
+     * users are hidden.
+     *
+     * @generated from rpc mootslive.v1.UserService.FlagComment
+     */
+    readonly flagComment: {
+      readonly name: "FlagComment",
+      readonly I: typeof FlagCommentRequest,
+      readonly O: typeof FlagCommentResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListNotifications returns the caller's notifications, along with how
+     * many are unread. Changes are also sent as they happen to WatchListens.
+     *
+     * @generated from rpc mootslive.v1.UserService.ListNotifications
+     */
+    readonly listNotifications: {
+      readonly name: "ListNotifications",
+      readonly I: typeof ListNotificationsRequest,
+      readonly O: typeof ListNotificationsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.MarkNotificationsRead
+     */
+    readonly markNotificationsRead: {
+      readonly name: "MarkNotificationsRead",
+      readonly I: typeof MarkNotificationsReadRequest,
+      readonly O: typeof MarkNotificationsReadResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.GetNotificationPreferences
+     */
+    readonly getNotificationPreferences: {
+      readonly name: "GetNotificationPreferences",
+      readonly I: typeof GetNotificationPreferencesRequest,
+      readonly O: typeof GetNotificationPreferencesResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.UpdateNotificationPreferences
+     */
+    readonly updateNotificationPreferences: {
+      readonly name: "UpdateNotificationPreferences",
+      readonly I: typeof UpdateNotificationPreferencesRequest,
+      readonly O: typeof UpdateNotificationPreferencesResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.UpdateProfile
+     */
+    readonly updateProfile: {
+      readonly name: "UpdateProfile",
+      readonly I: typeof UpdateProfileRequest,
+      readonly O: typeof UpdateProfileResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * GetProfile can be called without signing in, in which case only the
+     * listens of users whose listens are public are included.
+     *
+     * @generated from rpc mootslive.v1.UserService.GetProfile
+     */
+    readonly getProfile: {
+      readonly name: "GetProfile",
+      readonly I: typeof GetProfileRequest,
+      readonly O: typeof GetProfileResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * BlockUser removes any follows between the caller and the user, and hides
+     * each of them from the other everywhere.
+     *
+     * @generated from rpc mootslive.v1.UserService.BlockUser
+     */
+    readonly blockUser: {
+      readonly name: "BlockUser",
+      readonly I: typeof BlockUserRequest,
+      readonly O: typeof BlockUserResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.UnblockUser
+     */
+    readonly unblockUser: {
+      readonly name: "UnblockUser",
+      readonly I: typeof UnblockUserRequest,
+      readonly O: typeof UnblockUserResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ListBlockedUsers
+     */
+    readonly listBlockedUsers: {
+      readonly name: "ListBlockedUsers",
+      readonly I: typeof ListBlockedUsersRequest,
+      readonly O: typeof ListBlockedUsersResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * MuteUser hides the user from the caller's feed and notifications, without
+     * them knowing.
+     *
+     * @generated from rpc mootslive.v1.UserService.MuteUser
+     */
+    readonly muteUser: {
+      readonly name: "MuteUser",
+      readonly I: typeof MuteUserRequest,
+      readonly O: typeof MuteUserResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.UnmuteUser
+     */
+    readonly unmuteUser: {
+      readonly name: "UnmuteUser",
+      readonly I: typeof UnmuteUserRequest,
+      readonly O: typeof UnmuteUserResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ListMutedUsers
+     */
+    readonly listMutedUsers: {
+      readonly name: "ListMutedUsers",
+      readonly I: typeof ListMutedUsersRequest,
+      readonly O: typeof ListMutedUsersResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * GetTopTracks, GetTopArtists and GetTopAlbums return what a user has
+     * played most over a window. Listens the user has hidden from others are
+     * only counted when the user is looking at their own.
+     *
+     * @generated from rpc mootslive.v1.UserService.GetTopTracks
+     */
+    readonly getTopTracks: {
+      readonly name: "GetTopTracks",
+      readonly I: typeof GetTopTracksRequest,
+      readonly O: typeof GetTopTracksResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.GetTopArtists
+     */
+    readonly getTopArtists: {
+      readonly name: "GetTopArtists",
+      readonly I: typeof GetTopArtistsRequest,
+      readonly O: typeof GetTopArtistsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.GetTopAlbums
+     */
+    readonly getTopAlbums: {
+      readonly name: "GetTopAlbums",
+      readonly I: typeof GetTopAlbumsRequest,
+      readonly O: typeof GetTopAlbumsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.GetListeningStats
+     */
+    readonly getListeningStats: {
+      readonly name: "GetListeningStats",
+      readonly I: typeof GetListeningStatsRequest,
+      readonly O: typeof GetListeningStatsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * GetRecap returns the caller's recap of a year, which is generated shortly
+     * after the year ends.
+     *
+     * @generated from rpc mootslive.v1.UserService.GetRecap
+     */
+    readonly getRecap: {
+      readonly name: "GetRecap",
+      readonly I: typeof GetRecapRequest,
+      readonly O: typeof GetRecapResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * GetCharts returns the most played tracks and artists over a period. Only
+     * the listening of users whose listens are public is counted.
+     *
+     * @generated from rpc mootslive.v1.UserService.GetCharts
+     */
+    readonly getCharts: {
+      readonly name: "GetCharts",
+      readonly I: typeof GetChartsRequest,
+      readonly O: typeof GetChartsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * CreateListen records a listen by hand, such as one that was played
+     * offline. Its source is "manual".
+     *
+     * @generated from rpc mootslive.v1.UserService.CreateListen
+     */
+    readonly createListen: {
+      readonly name: "CreateListen",
+      readonly I: typeof CreateListenRequest,
+      readonly O: typeof CreateListenResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.UpdateListen
+     */
+    readonly updateListen: {
+      readonly name: "UpdateListen",
+      readonly I: typeof UpdateListenRequest,
+      readonly O: typeof UpdateListenResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.DeleteListen
+     */
+    readonly deleteListen: {
+      readonly name: "DeleteListen",
+      readonly I: typeof DeleteListenRequest,
+      readonly O: typeof DeleteListenResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * DeleteListens deletes every listen in a range of time.
+     *
+     * @generated from rpc mootslive.v1.UserService.DeleteListens
+     */
+    readonly deleteListens: {
+      readonly name: "DeleteListens",
+      readonly I: typeof DeleteListensRequest,
+      readonly O: typeof DeleteListensResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListListenEdits lists the changes the caller has made to their listens,
+     * most recent first.
+     *
+     * @generated from rpc mootslive.v1.UserService.ListListenEdits
+     */
+    readonly listListenEdits: {
+      readonly name: "ListListenEdits",
+      readonly I: typeof ListListenEditsRequest,
+      readonly O: typeof ListListenEditsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ExportListens streams the caller's listens in a format other services
+     * can import. They can also be downloaded over plain HTTP from
+     * /export/listens, with the format and range given as the query parameters
+     * format ("csv", "jsonl", "listenbrainz" or "scrobbler_log"), start_time
+     * and end_time (RFC 3339).
+     *
+     * @generated from rpc mootslive.v1.UserService.ExportListens
+     */
+    readonly exportListens: {
+      readonly name: "ExportListens",
+      readonly I: typeof ExportListensRequest,
+      readonly O: typeof ExportListensResponse,
+      readonly kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * GetSyncStatus reports when the caller's linked accounts last had their
+     * listens synced.
+     *
+     * @generated from rpc mootslive.v1.UserService.GetSyncStatus
+     */
+    readonly getSyncStatus: {
+      readonly name: "GetSyncStatus",
+      readonly I: typeof GetSyncStatusRequest,
+      readonly O: typeof GetSyncStatusResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * ListMySecurityEvents lists security relevant events affecting the
+     * caller's account, such as logins, most recent first.
+     *
+     * @generated from rpc mootslive.v1.UserService.ListMySecurityEvents
+     */
+    readonly listMySecurityEvents: {
+      readonly name: "ListMySecurityEvents",
+      readonly I: typeof ListMySecurityEventsRequest,
+      readonly O: typeof ListMySecurityEventsResponse,
+      readonly kind: MethodKind.Unary,
+    },
   }
 };
 
@@ -3,10 +3,12 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { BeginTwitterAuthRequest, BeginTwitterAuthResponse, FinishTwitterAuthRequest, FinishTwitterAuthResponse, GetMeRequest, GetMeResponse, GetStatusRequest, GetStatusResponse, ListListensRequest, ListListensResponse } from "./mootslive_pb.js";
+import { AddCommentRequest, AddCommentResponse, AddReactionRequest, AddReactionResponse, ApproveFollowRequestRequest, ApproveFollowRequestResponse, BeginTwitterAuthRequest, BeginTwitterAuthResponse, BlockUserRequest, BlockUserResponse, CancelAccountDeletionRequest, CancelAccountDeletionResponse, CreateIncognitoWindowRequest, CreateIncognitoWindowResponse, CreateListenRequest, CreateListenResponse, DeleteCommentRequest, DeleteCommentResponse, DeleteIncognitoWindowRequest, DeleteIncognitoWindowResponse, DeleteListenRequest, DeleteListenResponse, DeleteListensRequest, DeleteListensResponse, DeleteMyAccountRequest, DeleteMyAccountResponse, DenyFollowRequestRequest, DenyFollowRequestResponse, DisableUserRequest, DisableUserResponse, EnableUserRequest, EnableUserResponse, ExportListensRequest, ExportListensResponse, ExportMyDataRequest, ExportMyDataResponse, FinishTwitterAuthRequest, FinishTwitterAuthResponse, FlagCommentRequest, FlagCommentResponse, FollowUserRequest, FollowUserResponse, ForceRescanRequest, ForceRescanResponse, GenerateRecapRequest, GenerateRecapResponse, GetChartsRequest, GetChartsResponse, GetCompatibilityRequest, GetCompatibilityResponse, GetFollowSettingsRequest, GetFollowSettingsResponse, GetListeningStatsRequest, GetListeningStatsResponse, GetMeRequest, GetMeResponse, GetNotificationPreferencesRequest, GetNotificationPreferencesResponse, GetPollerStatusRequest, GetPollerStatusResponse, GetPrivacySettingsRequest, GetPrivacySettingsResponse, GetProfileRequest, GetProfileResponse, GetRecapRequest, GetRecapResponse, GetStatusRequest, GetStatusResponse, GetSyncStatusRequest, GetSyncStatusResponse, GetTopAlbumsRequest, GetTopAlbumsResponse, GetTopArtistsRequest, GetTopArtistsResponse, GetTopTracksRequest, GetTopTracksResponse, GetTwitterSharingSettingsRequest, GetTwitterSharingSettingsResponse, GetUserRequest, GetUserResponse, ListAuditEventsRequest, ListAuditEventsResponse, ListBlockedUsersRequest, ListBlockedUsersResponse, ListCommentsRequest, ListCommentsResponse, ListFeedRequest, ListFeedResponse, ListFollowRequestsRequest, ListFollowRequestsResponse, ListFollowersRequest, ListFollowersResponse, ListFollowingRequest, ListFollowingResponse, ListIncognitoWindowsRequest, ListIncognitoWindowsResponse, ListListenEditsRequest, ListListenEditsResponse, ListListensRequest, ListListensResponse, ListMootsRequest, ListMootsResponse, ListMutedUsersRequest, ListMutedUsersResponse, ListMySecurityEventsRequest, ListMySecurityEventsResponse, ListNotificationsRequest, ListNotificationsResponse, ListUsersRequest, ListUsersResponse, MarkNotificationsReadRequest, MarkNotificationsReadResponse, MuteUserRequest, MuteUserResponse, RemoveReactionRequest, RemoveReactionResponse, RevokeUserSessionsRequest, RevokeUserSessionsResponse, SetListenHiddenRequest, SetListenHiddenResponse, ShareListenRequest, ShareListenResponse, SyncMootsRequest, SyncMootsResponse, UnblockUserRequest, UnblockUserResponse, UnfollowUserRequest, UnfollowUserResponse, UnmuteUserRequest, UnmuteUserResponse, UpdateFollowSettingsRequest, UpdateFollowSettingsResponse, UpdateListenRequest, UpdateListenResponse, UpdateNotificationPreferencesRequest, UpdateNotificationPreferencesResponse, UpdatePrivacySettingsRequest, UpdatePrivacySettingsResponse, UpdateProfileRequest, UpdateProfileResponse, UpdateTwitterSharingSettingsRequest, UpdateTwitterSharingSettingsResponse, WatchListensRequest, WatchListensResponse } from "./mootslive_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
+ * AdminService can only be used by admins, except for GetStatus.
+ *
  * @generated from service mootslive.v1.AdminService
  */
 export const AdminService = {
@@ -21,6 +23,108 @@ export const AdminService = {
       O: GetStatusResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * GenerateRecap generates a new version of a user's recap of a year, which
+     * replaces any they already have.
+     *
+     * @generated from rpc mootslive.v1.AdminService.GenerateRecap
+     */
+    generateRecap: {
+      name: "GenerateRecap",
+      I: GenerateRecapRequest,
+      O: GenerateRecapResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListUsers lists users, most recently created first.
+     *
+     * @generated from rpc mootslive.v1.AdminService.ListUsers
+     */
+    listUsers: {
+      name: "ListUsers",
+      I: ListUsersRequest,
+      O: ListUsersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetUser gets a user along with the accounts they've linked.
+     *
+     * @generated from rpc mootslive.v1.AdminService.GetUser
+     */
+    getUser: {
+      name: "GetUser",
+      I: GetUserRequest,
+      O: GetUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DisableUser stops a user from using mootslive, and stops their listens
+     * being polled, until they're enabled again.
+     *
+     * @generated from rpc mootslive.v1.AdminService.DisableUser
+     */
+    disableUser: {
+      name: "DisableUser",
+      I: DisableUserRequest,
+      O: DisableUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.AdminService.EnableUser
+     */
+    enableUser: {
+      name: "EnableUser",
+      I: EnableUserRequest,
+      O: EnableUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ForceRescan scans a Spotify account for new listens immediately, rather
+     * than waiting for the poller to get to it.
+     *
+     * @generated from rpc mootslive.v1.AdminService.ForceRescan
+     */
+    forceRescan: {
+      name: "ForceRescan",
+      I: ForceRescanRequest,
+      O: ForceRescanResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RevokeUserSessions signs a user out everywhere, by refusing every token
+     * issued to them before now.
+     *
+     * @generated from rpc mootslive.v1.AdminService.RevokeUserSessions
+     */
+    revokeUserSessions: {
+      name: "RevokeUserSessions",
+      I: RevokeUserSessionsRequest,
+      O: RevokeUserSessionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetPollerStatus reports how far behind the poller is, which accounts
+     * it's struggling with and how much scanning each replica is doing.
+     *
+     * @generated from rpc mootslive.v1.AdminService.GetPollerStatus
+     */
+    getPollerStatus: {
+      name: "GetPollerStatus",
+      I: GetPollerStatusRequest,
+      O: GetPollerStatusResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListAuditEvents lists security relevant events, most recent first.
+     *
+     * @generated from rpc mootslive.v1.AdminService.ListAuditEvents
+     */
+    listAuditEvents: {
+      name: "ListAuditEvents",
+      I: ListAuditEventsRequest,
+      O: ListAuditEventsResponse,
+      kind: MethodKind.Unary,
+    },
   }
 };
 
@@ -66,6 +170,604 @@ export const UserService = {
       O: ListListensResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ExportMyData
+     */
+    exportMyData: {
+      name: "ExportMyData",
+      I: ExportMyDataRequest,
+      O: ExportMyDataResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.DeleteMyAccount
+     */
+    deleteMyAccount: {
+      name: "DeleteMyAccount",
+      I: DeleteMyAccountRequest,
+      O: DeleteMyAccountResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.CancelAccountDeletion
+     */
+    cancelAccountDeletion: {
+      name: "CancelAccountDeletion",
+      I: CancelAccountDeletionRequest,
+      O: CancelAccountDeletionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.GetTwitterSharingSettings
+     */
+    getTwitterSharingSettings: {
+      name: "GetTwitterSharingSettings",
+      I: GetTwitterSharingSettingsRequest,
+      O: GetTwitterSharingSettingsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.UpdateTwitterSharingSettings
+     */
+    updateTwitterSharingSettings: {
+      name: "UpdateTwitterSharingSettings",
+      I: UpdateTwitterSharingSettingsRequest,
+      O: UpdateTwitterSharingSettingsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ShareListen
+     */
+    shareListen: {
+      name: "ShareListen",
+      I: ShareListenRequest,
+      O: ShareListenResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SyncMoots requests that the caller's follows are refreshed from Twitter.
+     * The refresh happens in the background, so ListMoots may not reflect it
+     * immediately.
+     *
+     * @generated from rpc mootslive.v1.UserService.SyncMoots
+     */
+    syncMoots: {
+      name: "SyncMoots",
+      I: SyncMootsRequest,
+      O: SyncMootsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ListMoots
+     */
+    listMoots: {
+      name: "ListMoots",
+      I: ListMootsRequest,
+      O: ListMootsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.FollowUser
+     */
+    followUser: {
+      name: "FollowUser",
+      I: FollowUserRequest,
+      O: FollowUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.UnfollowUser
+     */
+    unfollowUser: {
+      name: "UnfollowUser",
+      I: UnfollowUserRequest,
+      O: UnfollowUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ListFollowers
+     */
+    listFollowers: {
+      name: "ListFollowers",
+      I: ListFollowersRequest,
+      O: ListFollowersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ListFollowing
+     */
+    listFollowing: {
+      name: "ListFollowing",
+      I: ListFollowingRequest,
+      O: ListFollowingResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ListFollowRequests
+     */
+    listFollowRequests: {
+      name: "ListFollowRequests",
+      I: ListFollowRequestsRequest,
+      O: ListFollowRequestsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ApproveFollowRequest
+     */
+    approveFollowRequest: {
+      name: "ApproveFollowRequest",
+      I: ApproveFollowRequestRequest,
+      O: ApproveFollowRequestResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.DenyFollowRequest
+     */
+    denyFollowRequest: {
+      name: "DenyFollowRequest",
+      I: DenyFollowRequestRequest,
+      O: DenyFollowRequestResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.GetFollowSettings
+     */
+    getFollowSettings: {
+      name: "GetFollowSettings",
+      I: GetFollowSettingsRequest,
+      O: GetFollowSettingsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.UpdateFollowSettings
+     */
+    updateFollowSettings: {
+      name: "UpdateFollowSettings",
+      I: UpdateFollowSettingsRequest,
+      O: UpdateFollowSettingsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListFeed returns the listens of the users the caller follows, newest
+     * first.
+     *
+     * @generated from rpc mootslive.v1.UserService.ListFeed
+     */
+    listFeed: {
+      name: "ListFeed",
+      I: ListFeedRequest,
+      O: ListFeedResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * WatchListens streams the listens of the caller and their moots as they
+     * are recorded, along with changes in their presence and to the caller's
+     * notifications. The current presence of each user, and the caller's
+     * unread notification count, are sent when the stream starts. Listens may
+     * be sent more than once across reconnects, so should be de-duplicated by
+     * ID.
+     *
+     * @generated from rpc mootslive.v1.UserService.WatchListens
+     */
+    watchListens: {
+      name: "WatchListens",
+      I: WatchListensRequest,
+      O: WatchListensResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * GetCompatibility scores how similar the listening of the caller and
+     * another user is. Scores are cached, so may lag behind the latest listens
+     * by a few minutes.
+     *
+     * @generated from rpc mootslive.v1.UserService.GetCompatibility
+     */
+    getCompatibility: {
+      name: "GetCompatibility",
+      I: GetCompatibilityRequest,
+      O: GetCompatibilityResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.GetPrivacySettings
+     */
+    getPrivacySettings: {
+      name: "GetPrivacySettings",
+      I: GetPrivacySettingsRequest,
+      O: GetPrivacySettingsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.UpdatePrivacySettings
+     */
+    updatePrivacySettings: {
+      name: "UpdatePrivacySettings",
+      I: UpdatePrivacySettingsRequest,
+      O: UpdatePrivacySettingsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetListenHidden hides one of the caller's listens from everyone else, or
+     * reveals it again.
+     *
+     * @generated from rpc mootslive.v1.UserService.SetListenHidden
+     */
+    setListenHidden: {
+      name: "SetListenHidden",
+      I: SetListenHiddenRequest,
+      O: SetListenHiddenResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CreateIncognitoWindow hides the caller's listens within a period of time
+     * from everyone else. The period may extend into the future, hiding
+     * listens as they're recorded.
+     *
+     * @generated from rpc mootslive.v1.UserService.CreateIncognitoWindow
+     */
+    createIncognitoWindow: {
+      name: "CreateIncognitoWindow",
+      I: CreateIncognitoWindowRequest,
+      O: CreateIncognitoWindowResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ListIncognitoWindows
+     */
+    listIncognitoWindows: {
+      name: "ListIncognitoWindows",
+      I: ListIncognitoWindowsRequest,
+      O: ListIncognitoWindowsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.DeleteIncognitoWindow
+     */
+    deleteIncognitoWindow: {
+      name: "DeleteIncognitoWindow",
+      I: DeleteIncognitoWindowRequest,
+      O: DeleteIncognitoWindowResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * AddReaction reacts to a listen the caller can see with an emoji. Each
+     * user can react to a listen with up to five different emoji.
+     *
+     * @generated from rpc mootslive.v1.UserService.AddReaction
+     */
+    addReaction: {
+      name: "AddReaction",
+      I: AddReactionRequest,
+      O: AddReactionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.RemoveReaction
+     */
+    removeReaction: {
+      name: "RemoveReaction",
+      I: RemoveReactionRequest,
+      O: RemoveReactionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.AddComment
+     */
+    addComment: {
+      name: "AddComment",
+      I: AddCommentRequest,
+      O: AddCommentResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DeleteComment deletes a comment, which can be done by its author or the
+     * user whose listen it was left on.
+     *
+     * @generated from rpc mootslive.v1.UserService.DeleteComment
+     */
+    deleteComment: {
+      name: "DeleteComment",
+      I: DeleteCommentRequest,
+      O: DeleteCommentResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ListComments
+     */
+    listComments: {
+      name: "ListComments",
+      I: ListCommentsRequest,
+      O: ListCommentsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * FlagComment reports a comment for moderation. Comments flagged by enough
+     * users are hidden.
+     *
+     * @generated from rpc mootslive.v1.UserService.FlagComment
+     */
+    flagComment: {
+      name: "FlagComment",
+      I: FlagCommentRequest,
+      O: FlagCommentResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListNotifications returns the caller's notifications, along with how
+     * many are unread. Changes are also sent as they happen to WatchListens.
+     *
+     * @generated from rpc mootslive.v1.UserService.ListNotifications
+     */
+    listNotifications: {
+      name: "ListNotifications",
+      I: ListNotificationsRequest,
+      O: ListNotificationsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.MarkNotificationsRead
+     */
+    markNotificationsRead: {
+      name: "MarkNotificationsRead",
+      I: MarkNotificationsReadRequest,
+      O: MarkNotificationsReadResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.GetNotificationPreferences
+     */
+    getNotificationPreferences: {
+      name: "GetNotificationPreferences",
+      I: GetNotificationPreferencesRequest,
+      O: GetNotificationPreferencesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.UpdateNotificationPreferences
+     */
+    updateNotificationPreferences: {
+      name: "UpdateNotificationPreferences",
+      I: UpdateNotificationPreferencesRequest,
+      O: UpdateNotificationPreferencesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.UpdateProfile
+     */
+    updateProfile: {
+      name: "UpdateProfile",
+      I: UpdateProfileRequest,
+      O: UpdateProfileResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetProfile can be called without signing in, in which case only the
+     * listens of users whose listens are public are included.
+     *
+     * @generated from rpc mootslive.v1.UserService.GetProfile
+     */
+    getProfile: {
+      name: "GetProfile",
+      I: GetProfileRequest,
+      O: GetProfileResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * BlockUser removes any follows between the caller and the user, and hides
+     * each of them from the other everywhere.
+     *
+     * @generated from rpc mootslive.v1.UserService.BlockUser
+     */
+    blockUser: {
+      name: "BlockUser",
+      I: BlockUserRequest,
+      O: BlockUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.UnblockUser
+     */
+    unblockUser: {
+      name: "UnblockUser",
+      I: UnblockUserRequest,
+      O: UnblockUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ListBlockedUsers
+     */
+    listBlockedUsers: {
+      name: "ListBlockedUsers",
+      I: ListBlockedUsersRequest,
+      O: ListBlockedUsersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * MuteUser hides the user from the caller's feed and notifications, without
+     * them knowing.
+     *
+     * @generated from rpc mootslive.v1.UserService.MuteUser
+     */
+    muteUser: {
+      name: "MuteUser",
+      I: MuteUserRequest,
+      O: MuteUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.UnmuteUser
+     */
+    unmuteUser: {
+      name: "UnmuteUser",
+      I: UnmuteUserRequest,
+      O: UnmuteUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.ListMutedUsers
+     */
+    listMutedUsers: {
+      name: "ListMutedUsers",
+      I: ListMutedUsersRequest,
+      O: ListMutedUsersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetTopTracks, GetTopArtists and GetTopAlbums return what a user has
+     * played most over a window. Listens the user has hidden from others are
+     * only counted when the user is looking at their own.
+     *
+     * @generated from rpc mootslive.v1.UserService.GetTopTracks
+     */
+    getTopTracks: {
+      name: "GetTopTracks",
+      I: GetTopTracksRequest,
+      O: GetTopTracksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.GetTopArtists
+     */
+    getTopArtists: {
+      name: "GetTopArtists",
+      I: GetTopArtistsRequest,
+      O: GetTopArtistsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.GetTopAlbums
+     */
+    getTopAlbums: {
+      name: "GetTopAlbums",
+      I: GetTopAlbumsRequest,
+      O: GetTopAlbumsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.GetListeningStats
+     */
+    getListeningStats: {
+      name: "GetListeningStats",
+      I: GetListeningStatsRequest,
+      O: GetListeningStatsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetRecap returns the caller's recap of a year, which is generated shortly
+     * after the year ends.
+     *
+     * @generated from rpc mootslive.v1.UserService.GetRecap
+     */
+    getRecap: {
+      name: "GetRecap",
+      I: GetRecapRequest,
+      O: GetRecapResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetCharts returns the most played tracks and artists over a period. Only
+     * the listening of users whose listens are public is counted.
+     *
+     * @generated from rpc mootslive.v1.UserService.GetCharts
+     */
+    getCharts: {
+      name: "GetCharts",
+      I: GetChartsRequest,
+      O: GetChartsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CreateListen records a listen by hand, such as one that was played
+     * offline. Its source is "manual".
+     *
+     * @generated from rpc mootslive.v1.UserService.CreateListen
+     */
+    createListen: {
+      name: "CreateListen",
+      I: CreateListenRequest,
+      O: CreateListenResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.UpdateListen
+     */
+    updateListen: {
+      name: "UpdateListen",
+      I: UpdateListenRequest,
+      O: UpdateListenResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc mootslive.v1.UserService.DeleteListen
+     */
+    deleteListen: {
+      name: "DeleteListen",
+      I: DeleteListenRequest,
+      O: DeleteListenResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DeleteListens deletes every listen in a range of time.
+     *
+     * @generated from rpc mootslive.v1.UserService.DeleteListens
+     */
+    deleteListens: {
+      name: "DeleteListens",
+      I: DeleteListensRequest,
+      O: DeleteListensResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListListenEdits lists the changes the caller has made to their listens,
+     * most recent first.
+     *
+     * @generated from rpc mootslive.v1.UserService.ListListenEdits
+     */
+    listListenEdits: {
+      name: "ListListenEdits",
+      I: ListListenEditsRequest,
+      O: ListListenEditsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ExportListens streams the caller's listens in a format other services
+     * can import. They can also be downloaded over plain HTTP from
+     * /export/listens, with the format and range given as the query parameters
+     * format ("csv", "jsonl", "listenbrainz" or "scrobbler_log"), start_time
+     * and end_time (RFC 3339).
+     *
+     * @generated from rpc mootslive.v1.UserService.ExportListens
+     */
+    exportListens: {
+      name: "ExportListens",
+      I: ExportListensRequest,
+      O: ExportListensResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * GetSyncStatus reports when the caller's linked accounts last had their
+     * listens synced.
+     *
+     * @generated from rpc mootslive.v1.UserService.GetSyncStatus
+     */
+    getSyncStatus: {
+      name: "GetSyncStatus",
+      I: GetSyncStatusRequest,
+      O: GetSyncStatusResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListMySecurityEvents lists security relevant events affecting the
+     * caller's account, such as logins, most recent first.
+     *
+     * @generated from rpc mootslive.v1.UserService.ListMySecurityEvents
+     */
+    listMySecurityEvents: {
+      name: "ListMySecurityEvents",
+      I: ListMySecurityEventsRequest,
+      O: ListMySecurityEventsResponse,
+      kind: MethodKind.Unary,
+    },
   }
 };
 
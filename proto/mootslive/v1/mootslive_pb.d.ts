@@ -3,326 +3,6417 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
+import type { BinaryReadOptions, Duration, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage, Timestamp } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 
+/**
+ * @generated from enum mootslive.v1.SpotifyScanResult
+ */
+export declare enum SpotifyScanResult {
+  /**
+   * SPOTIFY_SCAN_RESULT_UNSPECIFIED scans are still running, or were
+   * interrupted.
+   *
+   * @generated from enum value: SPOTIFY_SCAN_RESULT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SPOTIFY_SCAN_RESULT_SUCCEEDED = 1;
+   */
+  SUCCEEDED = 1,
+
+  /**
+   * @generated from enum value: SPOTIFY_SCAN_RESULT_FAILED = 2;
+   */
+  FAILED = 2,
+}
+
+/**
+ * @generated from enum mootslive.v1.AuditEventKind
+ */
+export declare enum AuditEventKind {
+  /**
+   * @generated from enum value: AUDIT_EVENT_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: AUDIT_EVENT_KIND_SIGNUP = 1;
+   */
+  SIGNUP = 1,
+
+  /**
+   * @generated from enum value: AUDIT_EVENT_KIND_LOGIN = 2;
+   */
+  LOGIN = 2,
+
+  /**
+   * AUDIT_EVENT_KIND_TOKEN_ISSUED events are recorded when a user is issued an
+   * ID token, which happens as part of signing up or logging in.
+   *
+   * @generated from enum value: AUDIT_EVENT_KIND_TOKEN_ISSUED = 3;
+   */
+  TOKEN_ISSUED = 3,
+
+  /**
+   * AUDIT_EVENT_KIND_SESSIONS_REVOKED events are recorded when every token
+   * issued to a user is revoked.
+   *
+   * @generated from enum value: AUDIT_EVENT_KIND_SESSIONS_REVOKED = 4;
+   */
+  SESSIONS_REVOKED = 4,
+
+  /**
+   * @generated from enum value: AUDIT_EVENT_KIND_PROVIDER_LINKED = 5;
+   */
+  PROVIDER_LINKED = 5,
+
+  /**
+   * @generated from enum value: AUDIT_EVENT_KIND_PROVIDER_UNLINKED = 6;
+   */
+  PROVIDER_UNLINKED = 6,
+
+  /**
+   * @generated from enum value: AUDIT_EVENT_KIND_ACCOUNT_DELETION_REQUESTED = 7;
+   */
+  ACCOUNT_DELETION_REQUESTED = 7,
+
+  /**
+   * @generated from enum value: AUDIT_EVENT_KIND_ACCOUNT_DELETION_CANCELLED = 8;
+   */
+  ACCOUNT_DELETION_CANCELLED = 8,
+
+  /**
+   * @generated from enum value: AUDIT_EVENT_KIND_ACCOUNT_DELETED = 9;
+   */
+  ACCOUNT_DELETED = 9,
+
+  /**
+   * @generated from enum value: AUDIT_EVENT_KIND_USER_DISABLED = 10;
+   */
+  USER_DISABLED = 10,
+
+  /**
+   * @generated from enum value: AUDIT_EVENT_KIND_USER_ENABLED = 11;
+   */
+  USER_ENABLED = 11,
+
+  /**
+   * @generated from enum value: AUDIT_EVENT_KIND_RESCAN_FORCED = 12;
+   */
+  RESCAN_FORCED = 12,
+
+  /**
+   * @generated from enum value: AUDIT_EVENT_KIND_RECAP_GENERATED = 13;
+   */
+  RECAP_GENERATED = 13,
+}
+
+/**
+ * @generated from enum mootslive.v1.Provider
+ */
+export declare enum Provider {
+  /**
+   * @generated from enum value: PROVIDER_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PROVIDER_TWITTER = 1;
+   */
+  TWITTER = 1,
+
+  /**
+   * @generated from enum value: PROVIDER_SPOTIFY = 2;
+   */
+  SPOTIFY = 2,
+}
+
+/**
+ * @generated from enum mootslive.v1.ListenOrder
+ */
+export declare enum ListenOrder {
+  /**
+   * LISTEN_ORDER_UNSPECIFIED is treated as LISTEN_ORDER_NEWEST_FIRST.
+   *
+   * @generated from enum value: LISTEN_ORDER_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: LISTEN_ORDER_NEWEST_FIRST = 1;
+   */
+  NEWEST_FIRST = 1,
+
+  /**
+   * @generated from enum value: LISTEN_ORDER_OLDEST_FIRST = 2;
+   */
+  OLDEST_FIRST = 2,
+}
+
+/**
+ * @generated from enum mootslive.v1.FollowStatus
+ */
+export declare enum FollowStatus {
+  /**
+   * @generated from enum value: FOLLOW_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * FOLLOW_STATUS_PENDING follows are requests awaiting the approval of the
+   * followee.
+   *
+   * @generated from enum value: FOLLOW_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: FOLLOW_STATUS_ACCEPTED = 2;
+   */
+  ACCEPTED = 2,
+}
+
+/**
+ * @generated from enum mootslive.v1.PresenceStatus
+ */
+export declare enum PresenceStatus {
+  /**
+   * @generated from enum value: PRESENCE_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PRESENCE_STATUS_IDLE = 1;
+   */
+  IDLE = 1,
+
+  /**
+   * PRESENCE_STATUS_LISTENING users have listened to something in the last
+   * ten minutes.
+   *
+   * @generated from enum value: PRESENCE_STATUS_LISTENING = 2;
+   */
+  LISTENING = 2,
+}
+
+/**
+ * TimeWindow is a period of time ending today.
+ *
+ * @generated from enum mootslive.v1.TimeWindow
+ */
+export declare enum TimeWindow {
+  /**
+   * @generated from enum value: TIME_WINDOW_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TIME_WINDOW_LAST_7_DAYS = 1;
+   */
+  LAST_7_DAYS = 1,
+
+  /**
+   * @generated from enum value: TIME_WINDOW_LAST_30_DAYS = 2;
+   */
+  LAST_30_DAYS = 2,
+
+  /**
+   * @generated from enum value: TIME_WINDOW_LAST_90_DAYS = 3;
+   */
+  LAST_90_DAYS = 3,
+
+  /**
+   * @generated from enum value: TIME_WINDOW_LAST_365_DAYS = 4;
+   */
+  LAST_365_DAYS = 4,
+
+  /**
+   * @generated from enum value: TIME_WINDOW_ALL_TIME = 5;
+   */
+  ALL_TIME = 5,
+
+  /**
+   * TIME_WINDOW_CUSTOM is a range of days given alongside the window, where
+   * supported.
+   *
+   * @generated from enum value: TIME_WINDOW_CUSTOM = 6;
+   */
+  CUSTOM = 6,
+}
+
+/**
+ * @generated from enum mootslive.v1.Visibility
+ */
+export declare enum Visibility {
+  /**
+   * @generated from enum value: VISIBILITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * VISIBILITY_PUBLIC listens can be seen by anyone.
+   *
+   * @generated from enum value: VISIBILITY_PUBLIC = 1;
+   */
+  PUBLIC = 1,
+
+  /**
+   * VISIBILITY_MOOTS listens can only be seen by the user's moots.
+   *
+   * @generated from enum value: VISIBILITY_MOOTS = 2;
+   */
+  MOOTS = 2,
+
+  /**
+   * VISIBILITY_PRIVATE listens can only be seen by the user.
+   *
+   * @generated from enum value: VISIBILITY_PRIVATE = 3;
+   */
+  PRIVATE = 3,
+}
+
+/**
+ * @generated from enum mootslive.v1.NotificationKind
+ */
+export declare enum NotificationKind {
+  /**
+   * @generated from enum value: NOTIFICATION_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: NOTIFICATION_KIND_NEW_FOLLOWER = 1;
+   */
+  NEW_FOLLOWER = 1,
+
+  /**
+   * @generated from enum value: NOTIFICATION_KIND_FOLLOW_REQUEST = 2;
+   */
+  FOLLOW_REQUEST = 2,
+
+  /**
+   * @generated from enum value: NOTIFICATION_KIND_FOLLOW_ACCEPTED = 3;
+   */
+  FOLLOW_ACCEPTED = 3,
+
+  /**
+   * NOTIFICATION_KIND_REACTION targets the listen that was reacted to.
+   *
+   * @generated from enum value: NOTIFICATION_KIND_REACTION = 4;
+   */
+  REACTION = 4,
+
+  /**
+   * NOTIFICATION_KIND_COMMENT targets the listen that was commented on.
+   *
+   * @generated from enum value: NOTIFICATION_KIND_COMMENT = 5;
+   */
+  COMMENT = 5,
+
+  /**
+   * NOTIFICATION_KIND_SAME_SONG targets the ISRC of a song that the user and
+   * a moot are listening to at the same time.
+   *
+   * @generated from enum value: NOTIFICATION_KIND_SAME_SONG = 6;
+   */
+  SAME_SONG = 6,
+}
+
+/**
+ * @generated from enum mootslive.v1.ChartScope
+ */
+export declare enum ChartScope {
+  /**
+   * @generated from enum value: CHART_SCOPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CHART_SCOPE_GLOBAL = 1;
+   */
+  GLOBAL = 1,
+
+  /**
+   * CHART_SCOPE_MOOTS counts the listening of the caller's moots.
+   *
+   * @generated from enum value: CHART_SCOPE_MOOTS = 2;
+   */
+  MOOTS = 2,
+}
+
+/**
+ * ChartPeriod is the length of time a chart covers. Periods are in UTC, with
+ * weeks starting on Monday.
+ *
+ * @generated from enum mootslive.v1.ChartPeriod
+ */
+export declare enum ChartPeriod {
+  /**
+   * @generated from enum value: CHART_PERIOD_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CHART_PERIOD_DAILY = 1;
+   */
+  DAILY = 1,
+
+  /**
+   * @generated from enum value: CHART_PERIOD_WEEKLY = 2;
+   */
+  WEEKLY = 2,
+
+  /**
+   * @generated from enum value: CHART_PERIOD_MONTHLY = 3;
+   */
+  MONTHLY = 3,
+}
+
+/**
+ * ChartMovement is how an entry has moved since the chart of the previous
+ * period.
+ *
+ * @generated from enum mootslive.v1.ChartMovement
+ */
+export declare enum ChartMovement {
+  /**
+   * @generated from enum value: CHART_MOVEMENT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CHART_MOVEMENT_UP = 1;
+   */
+  UP = 1,
+
+  /**
+   * @generated from enum value: CHART_MOVEMENT_DOWN = 2;
+   */
+  DOWN = 2,
+
+  /**
+   * CHART_MOVEMENT_NONE is an entry at the same rank as before.
+   *
+   * @generated from enum value: CHART_MOVEMENT_NONE = 3;
+   */
+  NONE = 3,
+
+  /**
+   * CHART_MOVEMENT_NEW is an entry that has never been in the chart before.
+   *
+   * @generated from enum value: CHART_MOVEMENT_NEW = 4;
+   */
+  NEW = 4,
+
+  /**
+   * CHART_MOVEMENT_RE_ENTRY is an entry that wasn't in the previous chart,
+   * but has been in an earlier one.
+   *
+   * @generated from enum value: CHART_MOVEMENT_RE_ENTRY = 5;
+   */
+  RE_ENTRY = 5,
+}
+
+/**
+ * @generated from enum mootslive.v1.ListenEditAction
+ */
+export declare enum ListenEditAction {
+  /**
+   * @generated from enum value: LISTEN_EDIT_ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: LISTEN_EDIT_ACTION_CREATE = 1;
+   */
+  CREATE = 1,
+
+  /**
+   * @generated from enum value: LISTEN_EDIT_ACTION_UPDATE = 2;
+   */
+  UPDATE = 2,
+
+  /**
+   * @generated from enum value: LISTEN_EDIT_ACTION_DELETE = 3;
+   */
+  DELETE = 3,
+}
+
+/**
+ * @generated from enum mootslive.v1.ExportFormat
+ */
+export declare enum ExportFormat {
+  /**
+   * @generated from enum value: EXPORT_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: EXPORT_FORMAT_CSV = 1;
+   */
+  CSV = 1,
+
+  /**
+   * EXPORT_FORMAT_JSONL is a JSON object per line.
+   *
+   * @generated from enum value: EXPORT_FORMAT_JSONL = 2;
+   */
+  JSONL = 2,
+
+  /**
+   * EXPORT_FORMAT_LISTENBRAINZ is a JSON array of listens, as ListenBrainz
+   * imports. Listens of tracks we don't hold metadata for are left out, as
+   * ListenBrainz requires a track and artist name.
+   *
+   * @generated from enum value: EXPORT_FORMAT_LISTENBRAINZ = 3;
+   */
+  LISTENBRAINZ = 3,
+
+  /**
+   * EXPORT_FORMAT_SCROBBLER_LOG is an Audioscrobbler .scrobbler.log, which
+   * Last.fm scrobblers can submit. Listens of tracks we don't hold metadata
+   * for are left out.
+   *
+   * @generated from enum value: EXPORT_FORMAT_SCROBBLER_LOG = 4;
+   */
+  SCROBBLER_LOG = 4,
+}
+
 /**
  * @generated from message mootslive.v1.GetStatusRequest
  */
-export declare class GetStatusRequest extends Message<GetStatusRequest> {
-  constructor(data?: PartialMessage<GetStatusRequest>);
+export declare class GetStatusRequest extends Message<GetStatusRequest> {
+  constructor(data?: PartialMessage<GetStatusRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetStatusRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStatusRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStatusRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStatusRequest;
+
+  static equals(a: GetStatusRequest | PlainMessage<GetStatusRequest> | undefined, b: GetStatusRequest | PlainMessage<GetStatusRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.StatusCheck
+ */
+export declare class StatusCheck extends Message<StatusCheck> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: bool healthy = 2;
+   */
+  healthy: boolean;
+
+  constructor(data?: PartialMessage<StatusCheck>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.StatusCheck";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusCheck;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusCheck;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusCheck;
+
+  static equals(a: StatusCheck | PlainMessage<StatusCheck> | undefined, b: StatusCheck | PlainMessage<StatusCheck> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetStatusResponse
+ */
+export declare class GetStatusResponse extends Message<GetStatusResponse> {
+  /**
+   * healthy is whether the replica that served the request is ready to serve
+   * requests, as reported by the gRPC health service.
+   *
+   * @generated from field: bool healthy = 2;
+   */
+  healthy: boolean;
+
+  /**
+   * checks are the health checks that were run. Why any failed isn't given,
+   * as anyone can call GetStatus.
+   *
+   * @generated from field: repeated mootslive.v1.StatusCheck checks = 3;
+   */
+  checks: StatusCheck[];
+
+  constructor(data?: PartialMessage<GetStatusResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetStatusResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStatusResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStatusResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStatusResponse;
+
+  static equals(a: GetStatusResponse | PlainMessage<GetStatusResponse> | undefined, b: GetStatusResponse | PlainMessage<GetStatusResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GenerateRecapRequest
+ */
+export declare class GenerateRecapRequest extends Message<GenerateRecapRequest> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: int32 year = 2;
+   */
+  year: number;
+
+  constructor(data?: PartialMessage<GenerateRecapRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GenerateRecapRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateRecapRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateRecapRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateRecapRequest;
+
+  static equals(a: GenerateRecapRequest | PlainMessage<GenerateRecapRequest> | undefined, b: GenerateRecapRequest | PlainMessage<GenerateRecapRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GenerateRecapResponse
+ */
+export declare class GenerateRecapResponse extends Message<GenerateRecapResponse> {
+  /**
+   * @generated from field: mootslive.v1.YearlyRecap recap = 1;
+   */
+  recap?: YearlyRecap;
+
+  /**
+   * @generated from field: int32 version = 2;
+   */
+  version: number;
+
+  constructor(data?: PartialMessage<GenerateRecapResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GenerateRecapResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateRecapResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateRecapResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateRecapResponse;
+
+  static equals(a: GenerateRecapResponse | PlainMessage<GenerateRecapResponse> | undefined, b: GenerateRecapResponse | PlainMessage<GenerateRecapResponse> | undefined): boolean;
+}
+
+/**
+ * AdminUser is a user as seen by admins.
+ *
+ * @generated from message mootslive.v1.AdminUser
+ */
+export declare class AdminUser extends Message<AdminUser> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 2;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: string handle = 3;
+   */
+  handle: string;
+
+  /**
+   * @generated from field: string display_name = 4;
+   */
+  displayName: string;
+
+  /**
+   * @generated from field: bool is_admin = 5;
+   */
+  isAdmin: boolean;
+
+  /**
+   * disabled_at is set while the user is disabled.
+   *
+   * @generated from field: google.protobuf.Timestamp disabled_at = 6;
+   */
+  disabledAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp deletion_requested_at = 7;
+   */
+  deletionRequestedAt?: Timestamp;
+
+  /**
+   * sessions_revoked_at is when the user's sessions were last revoked.
+   *
+   * @generated from field: google.protobuf.Timestamp sessions_revoked_at = 8;
+   */
+  sessionsRevokedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<AdminUser>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.AdminUser";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AdminUser;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AdminUser;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AdminUser;
+
+  static equals(a: AdminUser | PlainMessage<AdminUser> | undefined, b: AdminUser | PlainMessage<AdminUser> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListUsersRequest
+ */
+export declare class ListUsersRequest extends Message<ListUsersRequest> {
+  /**
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  /**
+   * query matches users by their ID, the start of their handle, or the ID or
+   * username of an account they've linked. All users are listed when empty.
+   *
+   * @generated from field: string query = 3;
+   */
+  query: string;
+
+  constructor(data?: PartialMessage<ListUsersRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListUsersRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUsersRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUsersRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUsersRequest;
+
+  static equals(a: ListUsersRequest | PlainMessage<ListUsersRequest> | undefined, b: ListUsersRequest | PlainMessage<ListUsersRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListUsersResponse
+ */
+export declare class ListUsersResponse extends Message<ListUsersResponse> {
+  /**
+   * @generated from field: repeated mootslive.v1.AdminUser users = 1;
+   */
+  users: AdminUser[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  constructor(data?: PartialMessage<ListUsersResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListUsersResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUsersResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUsersResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUsersResponse;
+
+  static equals(a: ListUsersResponse | PlainMessage<ListUsersResponse> | undefined, b: ListUsersResponse | PlainMessage<ListUsersResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetUserRequest
+ */
+export declare class GetUserRequest extends Message<GetUserRequest> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  constructor(data?: PartialMessage<GetUserRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetUserRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserRequest;
+
+  static equals(a: GetUserRequest | PlainMessage<GetUserRequest> | undefined, b: GetUserRequest | PlainMessage<GetUserRequest> | undefined): boolean;
+}
+
+/**
+ * SpotifyScan is a scan of a Spotify account for new listens by the poller.
+ *
+ * @generated from message mootslive.v1.SpotifyScan
+ */
+export declare class SpotifyScan extends Message<SpotifyScan> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * replica is the host name of the replica that ran the scan.
+   *
+   * @generated from field: string replica = 2;
+   */
+  replica: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp started_at = 3;
+   */
+  startedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp finished_at = 4;
+   */
+  finishedAt?: Timestamp;
+
+  /**
+   * @generated from field: mootslive.v1.SpotifyScanResult result = 5;
+   */
+  result: SpotifyScanResult;
+
+  /**
+   * @generated from field: int32 listens_found = 6;
+   */
+  listensFound: number;
+
+  /**
+   * @generated from field: string error = 7;
+   */
+  error: string;
+
+  constructor(data?: PartialMessage<SpotifyScan>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.SpotifyScan";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SpotifyScan;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SpotifyScan;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SpotifyScan;
+
+  static equals(a: SpotifyScan | PlainMessage<SpotifyScan> | undefined, b: SpotifyScan | PlainMessage<SpotifyScan> | undefined): boolean;
+}
+
+/**
+ * SpotifyAccountHealth describes how the poller is getting on with a linked
+ * Spotify account.
+ *
+ * @generated from message mootslive.v1.SpotifyAccountHealth
+ */
+export declare class SpotifyAccountHealth extends Message<SpotifyAccountHealth> {
+  /**
+   * @generated from field: string spotify_user_id = 1;
+   */
+  spotifyUserId: string;
+
+  /**
+   * polled is false when the poller is skipping the account, because its
+   * user is disabled or being deleted.
+   *
+   * @generated from field: bool polled = 2;
+   */
+  polled: boolean;
+
+  /**
+   * last_listened_at is the time of the most recent listen the poller has
+   * found.
+   *
+   * @generated from field: google.protobuf.Timestamp last_listened_at = 3;
+   */
+  lastListenedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_scanned_at = 4;
+   */
+  lastScannedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_succeeded_at = 5;
+   */
+  lastSucceededAt?: Timestamp;
+
+  /**
+   * failure_streak is how many scans in a row have failed.
+   *
+   * @generated from field: int32 failure_streak = 6;
+   */
+  failureStreak: number;
+
+  /**
+   * last_error is the error of the latest scan, if it failed.
+   *
+   * @generated from field: string last_error = 7;
+   */
+  lastError: string;
+
+  /**
+   * lag is how long it's been since the account was last scanned
+   * successfully, or since it was linked if it never has been.
+   *
+   * @generated from field: google.protobuf.Duration lag = 8;
+   */
+  lag?: Duration;
+
+  /**
+   * overdue is set when the lag is longer than the poller should ever take
+   * to get round to the account.
+   *
+   * @generated from field: bool overdue = 9;
+   */
+  overdue: boolean;
+
+  /**
+   * @generated from field: string user_id = 10;
+   */
+  userId: string;
+
+  /**
+   * recent_scans are the latest scans of the account, newest first. They're
+   * only included by GetUser.
+   *
+   * @generated from field: repeated mootslive.v1.SpotifyScan recent_scans = 11;
+   */
+  recentScans: SpotifyScan[];
+
+  constructor(data?: PartialMessage<SpotifyAccountHealth>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.SpotifyAccountHealth";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SpotifyAccountHealth;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SpotifyAccountHealth;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SpotifyAccountHealth;
+
+  static equals(a: SpotifyAccountHealth | PlainMessage<SpotifyAccountHealth> | undefined, b: SpotifyAccountHealth | PlainMessage<SpotifyAccountHealth> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetUserResponse
+ */
+export declare class GetUserResponse extends Message<GetUserResponse> {
+  /**
+   * @generated from field: mootslive.v1.AdminUser user = 1;
+   */
+  user?: AdminUser;
+
+  /**
+   * @generated from field: repeated mootslive.v1.LinkedAccount linked_accounts = 2;
+   */
+  linkedAccounts: LinkedAccount[];
+
+  /**
+   * @generated from field: repeated mootslive.v1.SpotifyAccountHealth spotify_accounts = 3;
+   */
+  spotifyAccounts: SpotifyAccountHealth[];
+
+  constructor(data?: PartialMessage<GetUserResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetUserResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetUserResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetUserResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetUserResponse;
+
+  static equals(a: GetUserResponse | PlainMessage<GetUserResponse> | undefined, b: GetUserResponse | PlainMessage<GetUserResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.DisableUserRequest
+ */
+export declare class DisableUserRequest extends Message<DisableUserRequest> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  constructor(data?: PartialMessage<DisableUserRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.DisableUserRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DisableUserRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DisableUserRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DisableUserRequest;
+
+  static equals(a: DisableUserRequest | PlainMessage<DisableUserRequest> | undefined, b: DisableUserRequest | PlainMessage<DisableUserRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.DisableUserResponse
+ */
+export declare class DisableUserResponse extends Message<DisableUserResponse> {
+  /**
+   * @generated from field: mootslive.v1.AdminUser user = 1;
+   */
+  user?: AdminUser;
+
+  constructor(data?: PartialMessage<DisableUserResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.DisableUserResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DisableUserResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DisableUserResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DisableUserResponse;
+
+  static equals(a: DisableUserResponse | PlainMessage<DisableUserResponse> | undefined, b: DisableUserResponse | PlainMessage<DisableUserResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.EnableUserRequest
+ */
+export declare class EnableUserRequest extends Message<EnableUserRequest> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  constructor(data?: PartialMessage<EnableUserRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.EnableUserRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnableUserRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnableUserRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnableUserRequest;
+
+  static equals(a: EnableUserRequest | PlainMessage<EnableUserRequest> | undefined, b: EnableUserRequest | PlainMessage<EnableUserRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.EnableUserResponse
+ */
+export declare class EnableUserResponse extends Message<EnableUserResponse> {
+  /**
+   * @generated from field: mootslive.v1.AdminUser user = 1;
+   */
+  user?: AdminUser;
+
+  constructor(data?: PartialMessage<EnableUserResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.EnableUserResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnableUserResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnableUserResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnableUserResponse;
+
+  static equals(a: EnableUserResponse | PlainMessage<EnableUserResponse> | undefined, b: EnableUserResponse | PlainMessage<EnableUserResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ForceRescanRequest
+ */
+export declare class ForceRescanRequest extends Message<ForceRescanRequest> {
+  /**
+   * @generated from field: string spotify_user_id = 1;
+   */
+  spotifyUserId: string;
+
+  constructor(data?: PartialMessage<ForceRescanRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ForceRescanRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForceRescanRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ForceRescanRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ForceRescanRequest;
+
+  static equals(a: ForceRescanRequest | PlainMessage<ForceRescanRequest> | undefined, b: ForceRescanRequest | PlainMessage<ForceRescanRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ForceRescanResponse
+ */
+export declare class ForceRescanResponse extends Message<ForceRescanResponse> {
+  constructor(data?: PartialMessage<ForceRescanResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ForceRescanResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForceRescanResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ForceRescanResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ForceRescanResponse;
+
+  static equals(a: ForceRescanResponse | PlainMessage<ForceRescanResponse> | undefined, b: ForceRescanResponse | PlainMessage<ForceRescanResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.RevokeUserSessionsRequest
+ */
+export declare class RevokeUserSessionsRequest extends Message<RevokeUserSessionsRequest> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  constructor(data?: PartialMessage<RevokeUserSessionsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.RevokeUserSessionsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeUserSessionsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeUserSessionsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeUserSessionsRequest;
+
+  static equals(a: RevokeUserSessionsRequest | PlainMessage<RevokeUserSessionsRequest> | undefined, b: RevokeUserSessionsRequest | PlainMessage<RevokeUserSessionsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.RevokeUserSessionsResponse
+ */
+export declare class RevokeUserSessionsResponse extends Message<RevokeUserSessionsResponse> {
+  /**
+   * @generated from field: mootslive.v1.AdminUser user = 1;
+   */
+  user?: AdminUser;
+
+  constructor(data?: PartialMessage<RevokeUserSessionsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.RevokeUserSessionsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeUserSessionsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeUserSessionsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeUserSessionsResponse;
+
+  static equals(a: RevokeUserSessionsResponse | PlainMessage<RevokeUserSessionsResponse> | undefined, b: RevokeUserSessionsResponse | PlainMessage<RevokeUserSessionsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetPollerStatusRequest
+ */
+export declare class GetPollerStatusRequest extends Message<GetPollerStatusRequest> {
+  /**
+   * account_limit is how many of the most lagging accounts to include.
+   *
+   * @generated from field: int32 account_limit = 1;
+   */
+  accountLimit: number;
+
+  constructor(data?: PartialMessage<GetPollerStatusRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetPollerStatusRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPollerStatusRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPollerStatusRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPollerStatusRequest;
+
+  static equals(a: GetPollerStatusRequest | PlainMessage<GetPollerStatusRequest> | undefined, b: GetPollerStatusRequest | PlainMessage<GetPollerStatusRequest> | undefined): boolean;
+}
+
+/**
+ * PollerReplicaThroughput is how much scanning a replica has done over the
+ * throughput window.
+ *
+ * @generated from message mootslive.v1.PollerReplicaThroughput
+ */
+export declare class PollerReplicaThroughput extends Message<PollerReplicaThroughput> {
+  /**
+   * @generated from field: string replica = 1;
+   */
+  replica: string;
+
+  /**
+   * @generated from field: int64 scans = 2;
+   */
+  scans: bigint;
+
+  /**
+   * @generated from field: int64 failed_scans = 3;
+   */
+  failedScans: bigint;
+
+  /**
+   * @generated from field: int64 listens_found = 4;
+   */
+  listensFound: bigint;
+
+  /**
+   * @generated from field: double scans_per_minute = 5;
+   */
+  scansPerMinute: number;
+
+  constructor(data?: PartialMessage<PollerReplicaThroughput>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.PollerReplicaThroughput";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PollerReplicaThroughput;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PollerReplicaThroughput;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PollerReplicaThroughput;
+
+  static equals(a: PollerReplicaThroughput | PlainMessage<PollerReplicaThroughput> | undefined, b: PollerReplicaThroughput | PlainMessage<PollerReplicaThroughput> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetPollerStatusResponse
+ */
+export declare class GetPollerStatusResponse extends Message<GetPollerStatusResponse> {
+  /**
+   * accounts is how many Spotify accounts are being polled.
+   *
+   * @generated from field: int32 accounts = 1;
+   */
+  accounts: number;
+
+  /**
+   * @generated from field: int32 overdue_accounts = 2;
+   */
+  overdueAccounts: number;
+
+  /**
+   * failing_accounts is how many accounts' latest scan failed.
+   *
+   * @generated from field: int32 failing_accounts = 3;
+   */
+  failingAccounts: number;
+
+  /**
+   * @generated from field: google.protobuf.Duration max_lag = 4;
+   */
+  maxLag?: Duration;
+
+  /**
+   * lagging_accounts are the accounts that have gone longest without a
+   * successful scan, most lagging first.
+   *
+   * @generated from field: repeated mootslive.v1.SpotifyAccountHealth lagging_accounts = 5;
+   */
+  laggingAccounts: SpotifyAccountHealth[];
+
+  /**
+   * @generated from field: repeated mootslive.v1.PollerReplicaThroughput replicas = 6;
+   */
+  replicas: PollerReplicaThroughput[];
+
+  /**
+   * @generated from field: google.protobuf.Duration throughput_window = 7;
+   */
+  throughputWindow?: Duration;
+
+  constructor(data?: PartialMessage<GetPollerStatusResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetPollerStatusResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPollerStatusResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPollerStatusResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPollerStatusResponse;
+
+  static equals(a: GetPollerStatusResponse | PlainMessage<GetPollerStatusResponse> | undefined, b: GetPollerStatusResponse | PlainMessage<GetPollerStatusResponse> | undefined): boolean;
+}
+
+/**
+ * AuditEvent is a security relevant event, as seen by admins.
+ *
+ * @generated from message mootslive.v1.AuditEvent
+ */
+export declare class AuditEvent extends Message<AuditEvent> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: mootslive.v1.AuditEventKind kind = 2;
+   */
+  kind: AuditEventKind;
+
+  /**
+   * actor_user_id is the user who caused the event. It's empty for events
+   * caused by mootslive itself.
+   *
+   * @generated from field: string actor_user_id = 3;
+   */
+  actorUserId: string;
+
+  /**
+   * target_user_id is the user the event affected.
+   *
+   * @generated from field: string target_user_id = 4;
+   */
+  targetUserId: string;
+
+  /**
+   * provider and provider_user_id identify the linked account involved in
+   * the event, if there was one.
+   *
+   * @generated from field: mootslive.v1.Provider provider = 5;
+   */
+  provider: Provider;
+
+  /**
+   * @generated from field: string provider_user_id = 6;
+   */
+  providerUserId: string;
+
+  /**
+   * @generated from field: string ip_address = 7;
+   */
+  ipAddress: string;
+
+  /**
+   * @generated from field: string user_agent = 8;
+   */
+  userAgent: string;
+
+  /**
+   * @generated from field: string trace_id = 9;
+   */
+  traceId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<AuditEvent>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.AuditEvent";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditEvent;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditEvent;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditEvent;
+
+  static equals(a: AuditEvent | PlainMessage<AuditEvent> | undefined, b: AuditEvent | PlainMessage<AuditEvent> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListAuditEventsRequest
+ */
+export declare class ListAuditEventsRequest extends Message<ListAuditEventsRequest> {
+  /**
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  /**
+   * The remaining fields filter the events, and are ignored when unset.
+   *
+   * @generated from field: string actor_user_id = 3;
+   */
+  actorUserId: string;
+
+  /**
+   * @generated from field: string target_user_id = 4;
+   */
+  targetUserId: string;
+
+  /**
+   * @generated from field: repeated mootslive.v1.AuditEventKind kinds = 5;
+   */
+  kinds: AuditEventKind[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 6;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 7;
+   */
+  endTime?: Timestamp;
+
+  constructor(data?: PartialMessage<ListAuditEventsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListAuditEventsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsRequest;
+
+  static equals(a: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined, b: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListAuditEventsResponse
+ */
+export declare class ListAuditEventsResponse extends Message<ListAuditEventsResponse> {
+  /**
+   * @generated from field: repeated mootslive.v1.AuditEvent events = 1;
+   */
+  events: AuditEvent[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  constructor(data?: PartialMessage<ListAuditEventsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListAuditEventsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsResponse;
+
+  static equals(a: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined, b: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetMeRequest
+ */
+export declare class GetMeRequest extends Message<GetMeRequest> {
+  constructor(data?: PartialMessage<GetMeRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetMeRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMeRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMeRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMeRequest;
+
+  static equals(a: GetMeRequest | PlainMessage<GetMeRequest> | undefined, b: GetMeRequest | PlainMessage<GetMeRequest> | undefined): boolean;
+}
+
+/**
+ * LinkedAccount is an account with a third party that a user has linked to
+ * their mootslive account.
+ *
+ * @generated from message mootslive.v1.LinkedAccount
+ */
+export declare class LinkedAccount extends Message<LinkedAccount> {
+  /**
+   * @generated from field: mootslive.v1.Provider provider = 1;
+   */
+  provider: Provider;
+
+  /**
+   * provider_user_id is the ID of the user with the provider.
+   *
+   * @generated from field: string provider_user_id = 2;
+   */
+  providerUserId: string;
+
+  /**
+   * username is the user's username with the provider, where the provider has
+   * such a concept.
+   *
+   * @generated from field: string username = 3;
+   */
+  username: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp linked_at = 4;
+   */
+  linkedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<LinkedAccount>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.LinkedAccount";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LinkedAccount;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LinkedAccount;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LinkedAccount;
+
+  static equals(a: LinkedAccount | PlainMessage<LinkedAccount> | undefined, b: LinkedAccount | PlainMessage<LinkedAccount> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.Profile
+ */
+export declare class Profile extends Message<Profile> {
+  /**
+   * @generated from field: string display_name = 1;
+   */
+  displayName: string;
+
+  /**
+   * handle is unique to the user, ignoring case. It's empty if the user
+   * hasn't chosen one and one couldn't be chosen for them.
+   *
+   * @generated from field: string handle = 2;
+   */
+  handle: string;
+
+  /**
+   * @generated from field: string avatar_url = 3;
+   */
+  avatarUrl: string;
+
+  /**
+   * @generated from field: repeated mootslive.v1.LinkedAccount linked_accounts = 4;
+   */
+  linkedAccounts: LinkedAccount[];
+
+  /**
+   * @generated from field: string bio = 5;
+   */
+  bio: string;
+
+  /**
+   * time_zone is the IANA name of the time zone the user's listening stats
+   * are computed in, e.g "Europe/London".
+   *
+   * @generated from field: string time_zone = 6;
+   */
+  timeZone: string;
+
+  constructor(data?: PartialMessage<Profile>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.Profile";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Profile;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Profile;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Profile;
+
+  static equals(a: Profile | PlainMessage<Profile> | undefined, b: Profile | PlainMessage<Profile> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetMeResponse
+ */
+export declare class GetMeResponse extends Message<GetMeResponse> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 2;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: mootslive.v1.Profile profile = 3;
+   */
+  profile?: Profile;
+
+  constructor(data?: PartialMessage<GetMeResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetMeResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMeResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMeResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMeResponse;
+
+  static equals(a: GetMeResponse | PlainMessage<GetMeResponse> | undefined, b: GetMeResponse | PlainMessage<GetMeResponse> | undefined): boolean;
+}
+
+/**
+ * OAuth2State contains bits of state we need the client to hold during the
+ * OAuth2 3-legged flow. These values aren't something that need to be kept
+ * secret from the client.
+ *
+ * @generated from message mootslive.v1.OAuth2State
+ */
+export declare class OAuth2State extends Message<OAuth2State> {
+  /**
+   * @generated from field: string state = 1;
+   */
+  state: string;
+
+  /**
+   * @generated from field: string pkce_code_verifier = 2;
+   */
+  pkceCodeVerifier: string;
+
+  constructor(data?: PartialMessage<OAuth2State>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.OAuth2State";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OAuth2State;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OAuth2State;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OAuth2State;
+
+  static equals(a: OAuth2State | PlainMessage<OAuth2State> | undefined, b: OAuth2State | PlainMessage<OAuth2State> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.BeginTwitterAuthRequest
+ */
+export declare class BeginTwitterAuthRequest extends Message<BeginTwitterAuthRequest> {
+  constructor(data?: PartialMessage<BeginTwitterAuthRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.BeginTwitterAuthRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BeginTwitterAuthRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BeginTwitterAuthRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BeginTwitterAuthRequest;
+
+  static equals(a: BeginTwitterAuthRequest | PlainMessage<BeginTwitterAuthRequest> | undefined, b: BeginTwitterAuthRequest | PlainMessage<BeginTwitterAuthRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.BeginTwitterAuthResponse
+ */
+export declare class BeginTwitterAuthResponse extends Message<BeginTwitterAuthResponse> {
+  /**
+   * @generated from field: string redirect_url = 1;
+   */
+  redirectUrl: string;
+
+  /**
+   * @generated from field: mootslive.v1.OAuth2State state = 2;
+   */
+  state?: OAuth2State;
+
+  constructor(data?: PartialMessage<BeginTwitterAuthResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.BeginTwitterAuthResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BeginTwitterAuthResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BeginTwitterAuthResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BeginTwitterAuthResponse;
+
+  static equals(a: BeginTwitterAuthResponse | PlainMessage<BeginTwitterAuthResponse> | undefined, b: BeginTwitterAuthResponse | PlainMessage<BeginTwitterAuthResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.FinishTwitterAuthRequest
+ */
+export declare class FinishTwitterAuthRequest extends Message<FinishTwitterAuthRequest> {
+  /**
+   * @generated from field: mootslive.v1.OAuth2State state = 1;
+   */
+  state?: OAuth2State;
+
+  /**
+   * received_state is the state url parameter received during the callback to 
+   * the webapp from the relying party.
+   *
+   * @generated from field: string received_state = 2;
+   */
+  receivedState: string;
+
+  /**
+   * @generated from field: string received_code = 3;
+   */
+  receivedCode: string;
+
+  constructor(data?: PartialMessage<FinishTwitterAuthRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.FinishTwitterAuthRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FinishTwitterAuthRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FinishTwitterAuthRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FinishTwitterAuthRequest;
+
+  static equals(a: FinishTwitterAuthRequest | PlainMessage<FinishTwitterAuthRequest> | undefined, b: FinishTwitterAuthRequest | PlainMessage<FinishTwitterAuthRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.FinishTwitterAuthResponse
+ */
+export declare class FinishTwitterAuthResponse extends Message<FinishTwitterAuthResponse> {
+  /**
+   * @generated from field: string id_token = 1;
+   */
+  idToken: string;
+
+  constructor(data?: PartialMessage<FinishTwitterAuthResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.FinishTwitterAuthResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FinishTwitterAuthResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FinishTwitterAuthResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FinishTwitterAuthResponse;
+
+  static equals(a: FinishTwitterAuthResponse | PlainMessage<FinishTwitterAuthResponse> | undefined, b: FinishTwitterAuthResponse | PlainMessage<FinishTwitterAuthResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.Listen
+ */
+export declare class Listen extends Message<Listen> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 2;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: string source = 3;
+   */
+  source: string;
+
+  /**
+   * @generated from field: string isrc = 4;
+   */
+  isrc: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp listened_at = 5;
+   */
+  listenedAt?: Timestamp;
+
+  /**
+   * @generated from field: string user_id = 6;
+   */
+  userId: string;
+
+  /**
+   * hidden listens can only be seen by the user who listened to them.
+   *
+   * @generated from field: bool hidden = 7;
+   */
+  hidden: boolean;
+
+  /**
+   * reactions are the reactions to the listen, most popular first.
+   *
+   * @generated from field: repeated mootslive.v1.ReactionCount reactions = 8;
+   */
+  reactions: ReactionCount[];
+
+  constructor(data?: PartialMessage<Listen>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.Listen";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Listen;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Listen;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Listen;
+
+  static equals(a: Listen | PlainMessage<Listen> | undefined, b: Listen | PlainMessage<Listen> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ReactionCount
+ */
+export declare class ReactionCount extends Message<ReactionCount> {
+  /**
+   * @generated from field: string emoji = 1;
+   */
+  emoji: string;
+
+  /**
+   * @generated from field: int64 count = 2;
+   */
+  count: bigint;
+
+  /**
+   * reacted is whether the caller is one of the users who reacted.
+   *
+   * @generated from field: bool reacted = 3;
+   */
+  reacted: boolean;
+
+  constructor(data?: PartialMessage<ReactionCount>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ReactionCount";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReactionCount;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReactionCount;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReactionCount;
+
+  static equals(a: ReactionCount | PlainMessage<ReactionCount> | undefined, b: ReactionCount | PlainMessage<ReactionCount> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListListensRequest
+ */
+export declare class ListListensRequest extends Message<ListListensRequest> {
+  /**
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * page_token is the next_page_token of the previous page. The other fields
+   * of the request must be the same as for the previous page.
+   *
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  /**
+   * start_time and end_time limit the listens to those listened to within
+   * them. end_time is excluded. Either can be left unset.
+   *
+   * @generated from field: google.protobuf.Timestamp start_time = 3;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 4;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * source, isrc and artist_id limit the listens to those with the source,
+   * of the track or of a track by the artist, if set.
+   *
+   * @generated from field: string source = 5;
+   */
+  source: string;
+
+  /**
+   * @generated from field: string isrc = 6;
+   */
+  isrc: string;
+
+  /**
+   * @generated from field: string artist_id = 7;
+   */
+  artistId: string;
+
+  /**
+   * @generated from field: mootslive.v1.ListenOrder order = 8;
+   */
+  order: ListenOrder;
+
+  constructor(data?: PartialMessage<ListListensRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListListensRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListListensRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListListensRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListListensRequest;
+
+  static equals(a: ListListensRequest | PlainMessage<ListListensRequest> | undefined, b: ListListensRequest | PlainMessage<ListListensRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListListensResponse
+ */
+export declare class ListListensResponse extends Message<ListListensResponse> {
+  /**
+   * @generated from field: repeated mootslive.v1.Listen listens = 1;
+   */
+  listens: Listen[];
+
+  /**
+   * next_page_token is empty if there are no more listens.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  constructor(data?: PartialMessage<ListListensResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListListensResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListListensResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListListensResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListListensResponse;
+
+  static equals(a: ListListensResponse | PlainMessage<ListListensResponse> | undefined, b: ListListensResponse | PlainMessage<ListListensResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ExportMyDataRequest
+ */
+export declare class ExportMyDataRequest extends Message<ExportMyDataRequest> {
+  constructor(data?: PartialMessage<ExportMyDataRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ExportMyDataRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportMyDataRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportMyDataRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportMyDataRequest;
+
+  static equals(a: ExportMyDataRequest | PlainMessage<ExportMyDataRequest> | undefined, b: ExportMyDataRequest | PlainMessage<ExportMyDataRequest> | undefined): boolean;
+}
+
+/**
+ * ExportMyDataResponse carries a chunk of the caller's data export. Joining
+ * the data of every message in the stream produces a single JSON document.
+ *
+ * @generated from message mootslive.v1.ExportMyDataResponse
+ */
+export declare class ExportMyDataResponse extends Message<ExportMyDataResponse> {
+  /**
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  constructor(data?: PartialMessage<ExportMyDataResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ExportMyDataResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportMyDataResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportMyDataResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportMyDataResponse;
+
+  static equals(a: ExportMyDataResponse | PlainMessage<ExportMyDataResponse> | undefined, b: ExportMyDataResponse | PlainMessage<ExportMyDataResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.DeleteMyAccountRequest
+ */
+export declare class DeleteMyAccountRequest extends Message<DeleteMyAccountRequest> {
+  constructor(data?: PartialMessage<DeleteMyAccountRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.DeleteMyAccountRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteMyAccountRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteMyAccountRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteMyAccountRequest;
+
+  static equals(a: DeleteMyAccountRequest | PlainMessage<DeleteMyAccountRequest> | undefined, b: DeleteMyAccountRequest | PlainMessage<DeleteMyAccountRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.DeleteMyAccountResponse
+ */
+export declare class DeleteMyAccountResponse extends Message<DeleteMyAccountResponse> {
+  /**
+   * delete_time is when the account and all of its data will be permanently
+   * deleted, unless the deletion is cancelled before then.
+   *
+   * @generated from field: google.protobuf.Timestamp delete_time = 1;
+   */
+  deleteTime?: Timestamp;
+
+  constructor(data?: PartialMessage<DeleteMyAccountResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.DeleteMyAccountResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteMyAccountResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteMyAccountResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteMyAccountResponse;
+
+  static equals(a: DeleteMyAccountResponse | PlainMessage<DeleteMyAccountResponse> | undefined, b: DeleteMyAccountResponse | PlainMessage<DeleteMyAccountResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.CancelAccountDeletionRequest
+ */
+export declare class CancelAccountDeletionRequest extends Message<CancelAccountDeletionRequest> {
+  constructor(data?: PartialMessage<CancelAccountDeletionRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.CancelAccountDeletionRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelAccountDeletionRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelAccountDeletionRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelAccountDeletionRequest;
+
+  static equals(a: CancelAccountDeletionRequest | PlainMessage<CancelAccountDeletionRequest> | undefined, b: CancelAccountDeletionRequest | PlainMessage<CancelAccountDeletionRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.CancelAccountDeletionResponse
+ */
+export declare class CancelAccountDeletionResponse extends Message<CancelAccountDeletionResponse> {
+  constructor(data?: PartialMessage<CancelAccountDeletionResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.CancelAccountDeletionResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelAccountDeletionResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelAccountDeletionResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelAccountDeletionResponse;
+
+  static equals(a: CancelAccountDeletionResponse | PlainMessage<CancelAccountDeletionResponse> | undefined, b: CancelAccountDeletionResponse | PlainMessage<CancelAccountDeletionResponse> | undefined): boolean;
+}
+
+/**
+ * TwitterSharingSettings controls what a user allows us to tweet on their
+ * behalf.
+ *
+ * @generated from message mootslive.v1.TwitterSharingSettings
+ */
+export declare class TwitterSharingSettings extends Message<TwitterSharingSettings> {
+  /**
+   * listen_sharing_enabled allows listens to be tweeted with ShareListen.
+   *
+   * @generated from field: bool listen_sharing_enabled = 1;
+   */
+  listenSharingEnabled: boolean;
+
+  /**
+   * weekly_recap_enabled opts the user into a tweet of their top tracks at
+   * the end of each week.
+   *
+   * @generated from field: bool weekly_recap_enabled = 2;
+   */
+  weeklyRecapEnabled: boolean;
+
+  constructor(data?: PartialMessage<TwitterSharingSettings>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.TwitterSharingSettings";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TwitterSharingSettings;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TwitterSharingSettings;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TwitterSharingSettings;
+
+  static equals(a: TwitterSharingSettings | PlainMessage<TwitterSharingSettings> | undefined, b: TwitterSharingSettings | PlainMessage<TwitterSharingSettings> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetTwitterSharingSettingsRequest
+ */
+export declare class GetTwitterSharingSettingsRequest extends Message<GetTwitterSharingSettingsRequest> {
+  constructor(data?: PartialMessage<GetTwitterSharingSettingsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetTwitterSharingSettingsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTwitterSharingSettingsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTwitterSharingSettingsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTwitterSharingSettingsRequest;
+
+  static equals(a: GetTwitterSharingSettingsRequest | PlainMessage<GetTwitterSharingSettingsRequest> | undefined, b: GetTwitterSharingSettingsRequest | PlainMessage<GetTwitterSharingSettingsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetTwitterSharingSettingsResponse
+ */
+export declare class GetTwitterSharingSettingsResponse extends Message<GetTwitterSharingSettingsResponse> {
+  /**
+   * @generated from field: mootslive.v1.TwitterSharingSettings settings = 1;
+   */
+  settings?: TwitterSharingSettings;
+
+  constructor(data?: PartialMessage<GetTwitterSharingSettingsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetTwitterSharingSettingsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTwitterSharingSettingsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTwitterSharingSettingsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTwitterSharingSettingsResponse;
+
+  static equals(a: GetTwitterSharingSettingsResponse | PlainMessage<GetTwitterSharingSettingsResponse> | undefined, b: GetTwitterSharingSettingsResponse | PlainMessage<GetTwitterSharingSettingsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.UpdateTwitterSharingSettingsRequest
+ */
+export declare class UpdateTwitterSharingSettingsRequest extends Message<UpdateTwitterSharingSettingsRequest> {
+  /**
+   * @generated from field: mootslive.v1.TwitterSharingSettings settings = 1;
+   */
+  settings?: TwitterSharingSettings;
+
+  constructor(data?: PartialMessage<UpdateTwitterSharingSettingsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.UpdateTwitterSharingSettingsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTwitterSharingSettingsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateTwitterSharingSettingsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateTwitterSharingSettingsRequest;
+
+  static equals(a: UpdateTwitterSharingSettingsRequest | PlainMessage<UpdateTwitterSharingSettingsRequest> | undefined, b: UpdateTwitterSharingSettingsRequest | PlainMessage<UpdateTwitterSharingSettingsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.UpdateTwitterSharingSettingsResponse
+ */
+export declare class UpdateTwitterSharingSettingsResponse extends Message<UpdateTwitterSharingSettingsResponse> {
+  /**
+   * @generated from field: mootslive.v1.TwitterSharingSettings settings = 1;
+   */
+  settings?: TwitterSharingSettings;
+
+  constructor(data?: PartialMessage<UpdateTwitterSharingSettingsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.UpdateTwitterSharingSettingsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTwitterSharingSettingsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateTwitterSharingSettingsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateTwitterSharingSettingsResponse;
+
+  static equals(a: UpdateTwitterSharingSettingsResponse | PlainMessage<UpdateTwitterSharingSettingsResponse> | undefined, b: UpdateTwitterSharingSettingsResponse | PlainMessage<UpdateTwitterSharingSettingsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ShareListenRequest
+ */
+export declare class ShareListenRequest extends Message<ShareListenRequest> {
+  /**
+   * @generated from field: string listen_id = 1;
+   */
+  listenId: string;
+
+  constructor(data?: PartialMessage<ShareListenRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ShareListenRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ShareListenRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ShareListenRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ShareListenRequest;
+
+  static equals(a: ShareListenRequest | PlainMessage<ShareListenRequest> | undefined, b: ShareListenRequest | PlainMessage<ShareListenRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ShareListenResponse
+ */
+export declare class ShareListenResponse extends Message<ShareListenResponse> {
+  /**
+   * @generated from field: string tweet_id = 1;
+   */
+  tweetId: string;
+
+  constructor(data?: PartialMessage<ShareListenResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ShareListenResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ShareListenResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ShareListenResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ShareListenResponse;
+
+  static equals(a: ShareListenResponse | PlainMessage<ShareListenResponse> | undefined, b: ShareListenResponse | PlainMessage<ShareListenResponse> | undefined): boolean;
+}
+
+/**
+ * Moot is a mootslive user who the caller follows, and who follows the caller
+ * back, either on Twitter or on mootslive.
+ *
+ * @generated from message mootslive.v1.Moot
+ */
+export declare class Moot extends Message<Moot> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * twitter_user_id is set if the users are mutuals on Twitter.
+   *
+   * @generated from field: string twitter_user_id = 2;
+   */
+  twitterUserId: string;
+
+  constructor(data?: PartialMessage<Moot>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.Moot";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Moot;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Moot;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Moot;
+
+  static equals(a: Moot | PlainMessage<Moot> | undefined, b: Moot | PlainMessage<Moot> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.SyncMootsRequest
+ */
+export declare class SyncMootsRequest extends Message<SyncMootsRequest> {
+  constructor(data?: PartialMessage<SyncMootsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.SyncMootsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SyncMootsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SyncMootsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SyncMootsRequest;
+
+  static equals(a: SyncMootsRequest | PlainMessage<SyncMootsRequest> | undefined, b: SyncMootsRequest | PlainMessage<SyncMootsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.SyncMootsResponse
+ */
+export declare class SyncMootsResponse extends Message<SyncMootsResponse> {
+  constructor(data?: PartialMessage<SyncMootsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.SyncMootsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SyncMootsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SyncMootsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SyncMootsResponse;
+
+  static equals(a: SyncMootsResponse | PlainMessage<SyncMootsResponse> | undefined, b: SyncMootsResponse | PlainMessage<SyncMootsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListMootsRequest
+ */
+export declare class ListMootsRequest extends Message<ListMootsRequest> {
+  constructor(data?: PartialMessage<ListMootsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListMootsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMootsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMootsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMootsRequest;
+
+  static equals(a: ListMootsRequest | PlainMessage<ListMootsRequest> | undefined, b: ListMootsRequest | PlainMessage<ListMootsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListMootsResponse
+ */
+export declare class ListMootsResponse extends Message<ListMootsResponse> {
+  /**
+   * @generated from field: repeated mootslive.v1.Moot moots = 1;
+   */
+  moots: Moot[];
+
+  constructor(data?: PartialMessage<ListMootsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListMootsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMootsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMootsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMootsResponse;
+
+  static equals(a: ListMootsResponse | PlainMessage<ListMootsResponse> | undefined, b: ListMootsResponse | PlainMessage<ListMootsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.Follow
+ */
+export declare class Follow extends Message<Follow> {
+  /**
+   * @generated from field: string follower_id = 1;
+   */
+  followerId: string;
+
+  /**
+   * @generated from field: string followee_id = 2;
+   */
+  followeeId: string;
+
+  /**
+   * @generated from field: mootslive.v1.FollowStatus status = 3;
+   */
+  status: FollowStatus;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp accepted_at = 5;
+   */
+  acceptedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<Follow>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.Follow";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Follow;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Follow;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Follow;
+
+  static equals(a: Follow | PlainMessage<Follow> | undefined, b: Follow | PlainMessage<Follow> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.FollowUserRequest
+ */
+export declare class FollowUserRequest extends Message<FollowUserRequest> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  constructor(data?: PartialMessage<FollowUserRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.FollowUserRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FollowUserRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FollowUserRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FollowUserRequest;
+
+  static equals(a: FollowUserRequest | PlainMessage<FollowUserRequest> | undefined, b: FollowUserRequest | PlainMessage<FollowUserRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.FollowUserResponse
+ */
+export declare class FollowUserResponse extends Message<FollowUserResponse> {
+  /**
+   * @generated from field: mootslive.v1.Follow follow = 1;
+   */
+  follow?: Follow;
+
+  constructor(data?: PartialMessage<FollowUserResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.FollowUserResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FollowUserResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FollowUserResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FollowUserResponse;
+
+  static equals(a: FollowUserResponse | PlainMessage<FollowUserResponse> | undefined, b: FollowUserResponse | PlainMessage<FollowUserResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.UnfollowUserRequest
+ */
+export declare class UnfollowUserRequest extends Message<UnfollowUserRequest> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  constructor(data?: PartialMessage<UnfollowUserRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.UnfollowUserRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnfollowUserRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnfollowUserRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnfollowUserRequest;
+
+  static equals(a: UnfollowUserRequest | PlainMessage<UnfollowUserRequest> | undefined, b: UnfollowUserRequest | PlainMessage<UnfollowUserRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.UnfollowUserResponse
+ */
+export declare class UnfollowUserResponse extends Message<UnfollowUserResponse> {
+  constructor(data?: PartialMessage<UnfollowUserResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.UnfollowUserResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnfollowUserResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnfollowUserResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnfollowUserResponse;
+
+  static equals(a: UnfollowUserResponse | PlainMessage<UnfollowUserResponse> | undefined, b: UnfollowUserResponse | PlainMessage<UnfollowUserResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListFollowersRequest
+ */
+export declare class ListFollowersRequest extends Message<ListFollowersRequest> {
+  constructor(data?: PartialMessage<ListFollowersRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListFollowersRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFollowersRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFollowersRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFollowersRequest;
+
+  static equals(a: ListFollowersRequest | PlainMessage<ListFollowersRequest> | undefined, b: ListFollowersRequest | PlainMessage<ListFollowersRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListFollowersResponse
+ */
+export declare class ListFollowersResponse extends Message<ListFollowersResponse> {
+  /**
+   * @generated from field: repeated mootslive.v1.Follow follows = 1;
+   */
+  follows: Follow[];
+
+  constructor(data?: PartialMessage<ListFollowersResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListFollowersResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFollowersResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFollowersResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFollowersResponse;
+
+  static equals(a: ListFollowersResponse | PlainMessage<ListFollowersResponse> | undefined, b: ListFollowersResponse | PlainMessage<ListFollowersResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListFollowingRequest
+ */
+export declare class ListFollowingRequest extends Message<ListFollowingRequest> {
+  constructor(data?: PartialMessage<ListFollowingRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListFollowingRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFollowingRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFollowingRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFollowingRequest;
+
+  static equals(a: ListFollowingRequest | PlainMessage<ListFollowingRequest> | undefined, b: ListFollowingRequest | PlainMessage<ListFollowingRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListFollowingResponse
+ */
+export declare class ListFollowingResponse extends Message<ListFollowingResponse> {
+  /**
+   * @generated from field: repeated mootslive.v1.Follow follows = 1;
+   */
+  follows: Follow[];
+
+  constructor(data?: PartialMessage<ListFollowingResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListFollowingResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFollowingResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFollowingResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFollowingResponse;
+
+  static equals(a: ListFollowingResponse | PlainMessage<ListFollowingResponse> | undefined, b: ListFollowingResponse | PlainMessage<ListFollowingResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListFollowRequestsRequest
+ */
+export declare class ListFollowRequestsRequest extends Message<ListFollowRequestsRequest> {
+  constructor(data?: PartialMessage<ListFollowRequestsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListFollowRequestsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFollowRequestsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFollowRequestsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFollowRequestsRequest;
+
+  static equals(a: ListFollowRequestsRequest | PlainMessage<ListFollowRequestsRequest> | undefined, b: ListFollowRequestsRequest | PlainMessage<ListFollowRequestsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListFollowRequestsResponse
+ */
+export declare class ListFollowRequestsResponse extends Message<ListFollowRequestsResponse> {
+  /**
+   * @generated from field: repeated mootslive.v1.Follow follows = 1;
+   */
+  follows: Follow[];
+
+  constructor(data?: PartialMessage<ListFollowRequestsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListFollowRequestsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFollowRequestsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFollowRequestsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFollowRequestsResponse;
+
+  static equals(a: ListFollowRequestsResponse | PlainMessage<ListFollowRequestsResponse> | undefined, b: ListFollowRequestsResponse | PlainMessage<ListFollowRequestsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ApproveFollowRequestRequest
+ */
+export declare class ApproveFollowRequestRequest extends Message<ApproveFollowRequestRequest> {
+  /**
+   * user_id is the ID of the user who requested to follow the caller.
+   *
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  constructor(data?: PartialMessage<ApproveFollowRequestRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ApproveFollowRequestRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveFollowRequestRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApproveFollowRequestRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApproveFollowRequestRequest;
+
+  static equals(a: ApproveFollowRequestRequest | PlainMessage<ApproveFollowRequestRequest> | undefined, b: ApproveFollowRequestRequest | PlainMessage<ApproveFollowRequestRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ApproveFollowRequestResponse
+ */
+export declare class ApproveFollowRequestResponse extends Message<ApproveFollowRequestResponse> {
+  /**
+   * @generated from field: mootslive.v1.Follow follow = 1;
+   */
+  follow?: Follow;
+
+  constructor(data?: PartialMessage<ApproveFollowRequestResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ApproveFollowRequestResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveFollowRequestResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApproveFollowRequestResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApproveFollowRequestResponse;
+
+  static equals(a: ApproveFollowRequestResponse | PlainMessage<ApproveFollowRequestResponse> | undefined, b: ApproveFollowRequestResponse | PlainMessage<ApproveFollowRequestResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.DenyFollowRequestRequest
+ */
+export declare class DenyFollowRequestRequest extends Message<DenyFollowRequestRequest> {
+  /**
+   * user_id is the ID of the user who requested to follow the caller.
+   *
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  constructor(data?: PartialMessage<DenyFollowRequestRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.DenyFollowRequestRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DenyFollowRequestRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DenyFollowRequestRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DenyFollowRequestRequest;
+
+  static equals(a: DenyFollowRequestRequest | PlainMessage<DenyFollowRequestRequest> | undefined, b: DenyFollowRequestRequest | PlainMessage<DenyFollowRequestRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.DenyFollowRequestResponse
+ */
+export declare class DenyFollowRequestResponse extends Message<DenyFollowRequestResponse> {
+  constructor(data?: PartialMessage<DenyFollowRequestResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.DenyFollowRequestResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DenyFollowRequestResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DenyFollowRequestResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DenyFollowRequestResponse;
+
+  static equals(a: DenyFollowRequestResponse | PlainMessage<DenyFollowRequestResponse> | undefined, b: DenyFollowRequestResponse | PlainMessage<DenyFollowRequestResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.FollowSettings
+ */
+export declare class FollowSettings extends Message<FollowSettings> {
+  /**
+   * require_approval makes new follows of the user pending until they approve
+   * them.
+   *
+   * @generated from field: bool require_approval = 1;
+   */
+  requireApproval: boolean;
+
+  constructor(data?: PartialMessage<FollowSettings>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.FollowSettings";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FollowSettings;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FollowSettings;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FollowSettings;
+
+  static equals(a: FollowSettings | PlainMessage<FollowSettings> | undefined, b: FollowSettings | PlainMessage<FollowSettings> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetFollowSettingsRequest
+ */
+export declare class GetFollowSettingsRequest extends Message<GetFollowSettingsRequest> {
+  constructor(data?: PartialMessage<GetFollowSettingsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetFollowSettingsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetFollowSettingsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetFollowSettingsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetFollowSettingsRequest;
+
+  static equals(a: GetFollowSettingsRequest | PlainMessage<GetFollowSettingsRequest> | undefined, b: GetFollowSettingsRequest | PlainMessage<GetFollowSettingsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetFollowSettingsResponse
+ */
+export declare class GetFollowSettingsResponse extends Message<GetFollowSettingsResponse> {
+  /**
+   * @generated from field: mootslive.v1.FollowSettings settings = 1;
+   */
+  settings?: FollowSettings;
+
+  constructor(data?: PartialMessage<GetFollowSettingsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetFollowSettingsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetFollowSettingsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetFollowSettingsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetFollowSettingsResponse;
+
+  static equals(a: GetFollowSettingsResponse | PlainMessage<GetFollowSettingsResponse> | undefined, b: GetFollowSettingsResponse | PlainMessage<GetFollowSettingsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.UpdateFollowSettingsRequest
+ */
+export declare class UpdateFollowSettingsRequest extends Message<UpdateFollowSettingsRequest> {
+  /**
+   * @generated from field: mootslive.v1.FollowSettings settings = 1;
+   */
+  settings?: FollowSettings;
+
+  constructor(data?: PartialMessage<UpdateFollowSettingsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.UpdateFollowSettingsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateFollowSettingsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateFollowSettingsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateFollowSettingsRequest;
+
+  static equals(a: UpdateFollowSettingsRequest | PlainMessage<UpdateFollowSettingsRequest> | undefined, b: UpdateFollowSettingsRequest | PlainMessage<UpdateFollowSettingsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.UpdateFollowSettingsResponse
+ */
+export declare class UpdateFollowSettingsResponse extends Message<UpdateFollowSettingsResponse> {
+  /**
+   * @generated from field: mootslive.v1.FollowSettings settings = 1;
+   */
+  settings?: FollowSettings;
+
+  constructor(data?: PartialMessage<UpdateFollowSettingsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.UpdateFollowSettingsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateFollowSettingsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateFollowSettingsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateFollowSettingsResponse;
+
+  static equals(a: UpdateFollowSettingsResponse | PlainMessage<UpdateFollowSettingsResponse> | undefined, b: UpdateFollowSettingsResponse | PlainMessage<UpdateFollowSettingsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.Artist
+ */
+export declare class Artist extends Message<Artist> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  constructor(data?: PartialMessage<Artist>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.Artist";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Artist;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Artist;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Artist;
+
+  static equals(a: Artist | PlainMessage<Artist> | undefined, b: Artist | PlainMessage<Artist> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.Album
+ */
+export declare class Album extends Message<Album> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string image_url = 3;
+   */
+  imageUrl: string;
+
+  constructor(data?: PartialMessage<Album>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.Album";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Album;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Album;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Album;
+
+  static equals(a: Album | PlainMessage<Album> | undefined, b: Album | PlainMessage<Album> | undefined): boolean;
+}
+
+/**
+ * Track is the metadata of a track, as provided by Spotify.
+ *
+ * @generated from message mootslive.v1.Track
+ */
+export declare class Track extends Message<Track> {
+  /**
+   * @generated from field: string isrc = 1;
+   */
+  isrc: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: repeated mootslive.v1.Artist artists = 3;
+   */
+  artists: Artist[];
+
+  /**
+   * @generated from field: mootslive.v1.Album album = 4;
+   */
+  album?: Album;
+
+  /**
+   * @generated from field: int32 duration_ms = 5;
+   */
+  durationMs: number;
+
+  /**
+   * @generated from field: string spotify_track_id = 6;
+   */
+  spotifyTrackId: string;
+
+  constructor(data?: PartialMessage<Track>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.Track";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Track;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Track;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Track;
+
+  static equals(a: Track | PlainMessage<Track> | undefined, b: Track | PlainMessage<Track> | undefined): boolean;
+}
+
+/**
+ * UserSummary is the public profile of a user, as shown alongside their
+ * activity.
+ *
+ * @generated from message mootslive.v1.UserSummary
+ */
+export declare class UserSummary extends Message<UserSummary> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string display_name = 2;
+   */
+  displayName: string;
+
+  /**
+   * @generated from field: string handle = 3;
+   */
+  handle: string;
+
+  /**
+   * @generated from field: string avatar_url = 4;
+   */
+  avatarUrl: string;
+
+  constructor(data?: PartialMessage<UserSummary>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.UserSummary";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UserSummary;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UserSummary;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UserSummary;
+
+  static equals(a: UserSummary | PlainMessage<UserSummary> | undefined, b: UserSummary | PlainMessage<UserSummary> | undefined): boolean;
+}
+
+/**
+ * FeedEntry is a listen, or a run of consecutive listens to the same album,
+ * by a user the caller follows.
+ *
+ * @generated from message mootslive.v1.FeedEntry
+ */
+export declare class FeedEntry extends Message<FeedEntry> {
+  /**
+   * @generated from field: mootslive.v1.UserSummary user = 1;
+   */
+  user?: UserSummary;
+
+  /**
+   * track is the track of the most recent listen in the entry. It's unset if
+   * we don't hold metadata for the track.
+   *
+   * @generated from field: mootslive.v1.Track track = 2;
+   */
+  track?: Track;
+
+  /**
+   * listens are ordered newest first.
+   *
+   * @generated from field: repeated mootslive.v1.Listen listens = 3;
+   */
+  listens: Listen[];
+
+  constructor(data?: PartialMessage<FeedEntry>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.FeedEntry";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FeedEntry;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FeedEntry;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FeedEntry;
+
+  static equals(a: FeedEntry | PlainMessage<FeedEntry> | undefined, b: FeedEntry | PlainMessage<FeedEntry> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListFeedRequest
+ */
+export declare class ListFeedRequest extends Message<ListFeedRequest> {
+  /**
+   * page_size is the maximum number of listens to return, which may be
+   * collapsed into fewer entries. Defaults to 50, and is capped at 100.
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  constructor(data?: PartialMessage<ListFeedRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListFeedRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFeedRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFeedRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFeedRequest;
+
+  static equals(a: ListFeedRequest | PlainMessage<ListFeedRequest> | undefined, b: ListFeedRequest | PlainMessage<ListFeedRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListFeedResponse
+ */
+export declare class ListFeedResponse extends Message<ListFeedResponse> {
+  /**
+   * @generated from field: repeated mootslive.v1.FeedEntry entries = 1;
+   */
+  entries: FeedEntry[];
+
+  /**
+   * next_page_token is empty once the end of the feed has been reached.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  constructor(data?: PartialMessage<ListFeedResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListFeedResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFeedResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListFeedResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListFeedResponse;
+
+  static equals(a: ListFeedResponse | PlainMessage<ListFeedResponse> | undefined, b: ListFeedResponse | PlainMessage<ListFeedResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.Presence
+ */
+export declare class Presence extends Message<Presence> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: mootslive.v1.PresenceStatus status = 2;
+   */
+  status: PresenceStatus;
+
+  /**
+   * last_listened_at is unset if the user has never listened to anything.
+   *
+   * @generated from field: google.protobuf.Timestamp last_listened_at = 3;
+   */
+  lastListenedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<Presence>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.Presence";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Presence;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Presence;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Presence;
+
+  static equals(a: Presence | PlainMessage<Presence> | undefined, b: Presence | PlainMessage<Presence> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.WatchListensRequest
+ */
+export declare class WatchListensRequest extends Message<WatchListensRequest> {
+  /**
+   * resume_token is the resume_token of the last message received on a
+   * previous stream, used to receive any listens missed since it ended.
+   *
+   * @generated from field: string resume_token = 1;
+   */
+  resumeToken: string;
+
+  constructor(data?: PartialMessage<WatchListensRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.WatchListensRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchListensRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchListensRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchListensRequest;
+
+  static equals(a: WatchListensRequest | PlainMessage<WatchListensRequest> | undefined, b: WatchListensRequest | PlainMessage<WatchListensRequest> | undefined): boolean;
+}
+
+/**
+ * NotificationsUpdate is sent when the caller's notifications change.
+ *
+ * @generated from message mootslive.v1.NotificationsUpdate
+ */
+export declare class NotificationsUpdate extends Message<NotificationsUpdate> {
+  /**
+   * notifications are those that are new or have changed, such as by having
+   * another actor grouped into them.
+   *
+   * @generated from field: repeated mootslive.v1.Notification notifications = 1;
+   */
+  notifications: Notification[];
+
+  /**
+   * @generated from field: int64 unread_count = 2;
+   */
+  unreadCount: bigint;
+
+  constructor(data?: PartialMessage<NotificationsUpdate>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.NotificationsUpdate";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NotificationsUpdate;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NotificationsUpdate;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NotificationsUpdate;
+
+  static equals(a: NotificationsUpdate | PlainMessage<NotificationsUpdate> | undefined, b: NotificationsUpdate | PlainMessage<NotificationsUpdate> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.WatchListensResponse
+ */
+export declare class WatchListensResponse extends Message<WatchListensResponse> {
+  /**
+   * @generated from oneof mootslive.v1.WatchListensResponse.event
+   */
+  event: {
+    /**
+     * @generated from field: mootslive.v1.Listen listen = 1;
+     */
+    value: Listen;
+    case: "listen";
+  } | {
+    /**
+     * @generated from field: mootslive.v1.Presence presence = 2;
+     */
+    value: Presence;
+    case: "presence";
+  } | {
+    /**
+     * @generated from field: mootslive.v1.NotificationsUpdate notifications = 4;
+     */
+    value: NotificationsUpdate;
+    case: "notifications";
+  } | { case: undefined; value?: undefined };
+
+  /**
+   * @generated from field: string resume_token = 3;
+   */
+  resumeToken: string;
+
+  constructor(data?: PartialMessage<WatchListensResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.WatchListensResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WatchListensResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WatchListensResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WatchListensResponse;
+
+  static equals(a: WatchListensResponse | PlainMessage<WatchListensResponse> | undefined, b: WatchListensResponse | PlainMessage<WatchListensResponse> | undefined): boolean;
+}
+
+/**
+ * TimeRange is a range of whole UTC days. start_time and end_time are each
+ * rounded down to the start of their day, and the day of end_time is
+ * excluded.
+ *
+ * @generated from message mootslive.v1.TimeRange
+ */
+export declare class TimeRange extends Message<TimeRange> {
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 1;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 2;
+   */
+  endTime?: Timestamp;
+
+  constructor(data?: PartialMessage<TimeRange>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.TimeRange";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TimeRange;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TimeRange;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TimeRange;
+
+  static equals(a: TimeRange | PlainMessage<TimeRange> | undefined, b: TimeRange | PlainMessage<TimeRange> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetCompatibilityRequest
+ */
+export declare class GetCompatibilityRequest extends Message<GetCompatibilityRequest> {
+  /**
+   * @generated from field: string other_user_id = 1;
+   */
+  otherUserId: string;
+
+  /**
+   * window defaults to the last 90 days.
+   *
+   * @generated from field: mootslive.v1.TimeWindow window = 2;
+   */
+  window: TimeWindow;
+
+  constructor(data?: PartialMessage<GetCompatibilityRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetCompatibilityRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCompatibilityRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCompatibilityRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCompatibilityRequest;
+
+  static equals(a: GetCompatibilityRequest | PlainMessage<GetCompatibilityRequest> | undefined, b: GetCompatibilityRequest | PlainMessage<GetCompatibilityRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetCompatibilityResponse
+ */
+export declare class GetCompatibilityResponse extends Message<GetCompatibilityResponse> {
+  /**
+   * score is between 0 and 100, where 100 is identical listening.
+   *
+   * @generated from field: double score = 1;
+   */
+  score: number;
+
+  /**
+   * shared_tracks are the tracks both users listen to most, best first.
+   *
+   * @generated from field: repeated mootslive.v1.Track shared_tracks = 2;
+   */
+  sharedTracks: Track[];
+
+  /**
+   * shared_artists are the artists both users listen to most, best first.
+   *
+   * @generated from field: repeated mootslive.v1.Artist shared_artists = 3;
+   */
+  sharedArtists: Artist[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp computed_at = 4;
+   */
+  computedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<GetCompatibilityResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetCompatibilityResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetCompatibilityResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetCompatibilityResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetCompatibilityResponse;
+
+  static equals(a: GetCompatibilityResponse | PlainMessage<GetCompatibilityResponse> | undefined, b: GetCompatibilityResponse | PlainMessage<GetCompatibilityResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.PrivacySettings
+ */
+export declare class PrivacySettings extends Message<PrivacySettings> {
+  /**
+   * visibility is who can see the user's listens.
+   *
+   * @generated from field: mootslive.v1.Visibility visibility = 1;
+   */
+  visibility: Visibility;
+
+  /**
+   * hidden_sources are the sources of listens, e.g "spotify", that are
+   * hidden from everyone else.
+   *
+   * @generated from field: repeated string hidden_sources = 2;
+   */
+  hiddenSources: string[];
+
+  constructor(data?: PartialMessage<PrivacySettings>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.PrivacySettings";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PrivacySettings;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PrivacySettings;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PrivacySettings;
+
+  static equals(a: PrivacySettings | PlainMessage<PrivacySettings> | undefined, b: PrivacySettings | PlainMessage<PrivacySettings> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetPrivacySettingsRequest
+ */
+export declare class GetPrivacySettingsRequest extends Message<GetPrivacySettingsRequest> {
+  constructor(data?: PartialMessage<GetPrivacySettingsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetPrivacySettingsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPrivacySettingsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPrivacySettingsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPrivacySettingsRequest;
+
+  static equals(a: GetPrivacySettingsRequest | PlainMessage<GetPrivacySettingsRequest> | undefined, b: GetPrivacySettingsRequest | PlainMessage<GetPrivacySettingsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetPrivacySettingsResponse
+ */
+export declare class GetPrivacySettingsResponse extends Message<GetPrivacySettingsResponse> {
+  /**
+   * @generated from field: mootslive.v1.PrivacySettings settings = 1;
+   */
+  settings?: PrivacySettings;
+
+  constructor(data?: PartialMessage<GetPrivacySettingsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetPrivacySettingsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPrivacySettingsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPrivacySettingsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPrivacySettingsResponse;
+
+  static equals(a: GetPrivacySettingsResponse | PlainMessage<GetPrivacySettingsResponse> | undefined, b: GetPrivacySettingsResponse | PlainMessage<GetPrivacySettingsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.UpdatePrivacySettingsRequest
+ */
+export declare class UpdatePrivacySettingsRequest extends Message<UpdatePrivacySettingsRequest> {
+  /**
+   * @generated from field: mootslive.v1.PrivacySettings settings = 1;
+   */
+  settings?: PrivacySettings;
+
+  constructor(data?: PartialMessage<UpdatePrivacySettingsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.UpdatePrivacySettingsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdatePrivacySettingsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdatePrivacySettingsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdatePrivacySettingsRequest;
+
+  static equals(a: UpdatePrivacySettingsRequest | PlainMessage<UpdatePrivacySettingsRequest> | undefined, b: UpdatePrivacySettingsRequest | PlainMessage<UpdatePrivacySettingsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.UpdatePrivacySettingsResponse
+ */
+export declare class UpdatePrivacySettingsResponse extends Message<UpdatePrivacySettingsResponse> {
+  /**
+   * @generated from field: mootslive.v1.PrivacySettings settings = 1;
+   */
+  settings?: PrivacySettings;
+
+  constructor(data?: PartialMessage<UpdatePrivacySettingsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.UpdatePrivacySettingsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdatePrivacySettingsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdatePrivacySettingsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdatePrivacySettingsResponse;
+
+  static equals(a: UpdatePrivacySettingsResponse | PlainMessage<UpdatePrivacySettingsResponse> | undefined, b: UpdatePrivacySettingsResponse | PlainMessage<UpdatePrivacySettingsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.SetListenHiddenRequest
+ */
+export declare class SetListenHiddenRequest extends Message<SetListenHiddenRequest> {
+  /**
+   * @generated from field: string listen_id = 1;
+   */
+  listenId: string;
+
+  /**
+   * @generated from field: bool hidden = 2;
+   */
+  hidden: boolean;
+
+  constructor(data?: PartialMessage<SetListenHiddenRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.SetListenHiddenRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetListenHiddenRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetListenHiddenRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetListenHiddenRequest;
+
+  static equals(a: SetListenHiddenRequest | PlainMessage<SetListenHiddenRequest> | undefined, b: SetListenHiddenRequest | PlainMessage<SetListenHiddenRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.SetListenHiddenResponse
+ */
+export declare class SetListenHiddenResponse extends Message<SetListenHiddenResponse> {
+  /**
+   * @generated from field: mootslive.v1.Listen listen = 1;
+   */
+  listen?: Listen;
+
+  constructor(data?: PartialMessage<SetListenHiddenResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.SetListenHiddenResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetListenHiddenResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetListenHiddenResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetListenHiddenResponse;
+
+  static equals(a: SetListenHiddenResponse | PlainMessage<SetListenHiddenResponse> | undefined, b: SetListenHiddenResponse | PlainMessage<SetListenHiddenResponse> | undefined): boolean;
+}
+
+/**
+ * IncognitoWindow is a period of time during which a user's listens are
+ * hidden from everyone else.
+ *
+ * @generated from message mootslive.v1.IncognitoWindow
+ */
+export declare class IncognitoWindow extends Message<IncognitoWindow> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 2;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 3;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<IncognitoWindow>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.IncognitoWindow";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IncognitoWindow;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IncognitoWindow;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IncognitoWindow;
+
+  static equals(a: IncognitoWindow | PlainMessage<IncognitoWindow> | undefined, b: IncognitoWindow | PlainMessage<IncognitoWindow> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.CreateIncognitoWindowRequest
+ */
+export declare class CreateIncognitoWindowRequest extends Message<CreateIncognitoWindowRequest> {
+  /**
+   * @generated from field: google.protobuf.Timestamp start_time = 1;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 2;
+   */
+  endTime?: Timestamp;
+
+  constructor(data?: PartialMessage<CreateIncognitoWindowRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.CreateIncognitoWindowRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateIncognitoWindowRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateIncognitoWindowRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateIncognitoWindowRequest;
+
+  static equals(a: CreateIncognitoWindowRequest | PlainMessage<CreateIncognitoWindowRequest> | undefined, b: CreateIncognitoWindowRequest | PlainMessage<CreateIncognitoWindowRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.CreateIncognitoWindowResponse
+ */
+export declare class CreateIncognitoWindowResponse extends Message<CreateIncognitoWindowResponse> {
+  /**
+   * @generated from field: mootslive.v1.IncognitoWindow window = 1;
+   */
+  window?: IncognitoWindow;
+
+  constructor(data?: PartialMessage<CreateIncognitoWindowResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.CreateIncognitoWindowResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateIncognitoWindowResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateIncognitoWindowResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateIncognitoWindowResponse;
+
+  static equals(a: CreateIncognitoWindowResponse | PlainMessage<CreateIncognitoWindowResponse> | undefined, b: CreateIncognitoWindowResponse | PlainMessage<CreateIncognitoWindowResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListIncognitoWindowsRequest
+ */
+export declare class ListIncognitoWindowsRequest extends Message<ListIncognitoWindowsRequest> {
+  constructor(data?: PartialMessage<ListIncognitoWindowsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListIncognitoWindowsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListIncognitoWindowsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListIncognitoWindowsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListIncognitoWindowsRequest;
+
+  static equals(a: ListIncognitoWindowsRequest | PlainMessage<ListIncognitoWindowsRequest> | undefined, b: ListIncognitoWindowsRequest | PlainMessage<ListIncognitoWindowsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListIncognitoWindowsResponse
+ */
+export declare class ListIncognitoWindowsResponse extends Message<ListIncognitoWindowsResponse> {
+  /**
+   * @generated from field: repeated mootslive.v1.IncognitoWindow windows = 1;
+   */
+  windows: IncognitoWindow[];
+
+  constructor(data?: PartialMessage<ListIncognitoWindowsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListIncognitoWindowsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListIncognitoWindowsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListIncognitoWindowsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListIncognitoWindowsResponse;
+
+  static equals(a: ListIncognitoWindowsResponse | PlainMessage<ListIncognitoWindowsResponse> | undefined, b: ListIncognitoWindowsResponse | PlainMessage<ListIncognitoWindowsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.DeleteIncognitoWindowRequest
+ */
+export declare class DeleteIncognitoWindowRequest extends Message<DeleteIncognitoWindowRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  constructor(data?: PartialMessage<DeleteIncognitoWindowRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.DeleteIncognitoWindowRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteIncognitoWindowRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteIncognitoWindowRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteIncognitoWindowRequest;
+
+  static equals(a: DeleteIncognitoWindowRequest | PlainMessage<DeleteIncognitoWindowRequest> | undefined, b: DeleteIncognitoWindowRequest | PlainMessage<DeleteIncognitoWindowRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.DeleteIncognitoWindowResponse
+ */
+export declare class DeleteIncognitoWindowResponse extends Message<DeleteIncognitoWindowResponse> {
+  constructor(data?: PartialMessage<DeleteIncognitoWindowResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.DeleteIncognitoWindowResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteIncognitoWindowResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteIncognitoWindowResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteIncognitoWindowResponse;
+
+  static equals(a: DeleteIncognitoWindowResponse | PlainMessage<DeleteIncognitoWindowResponse> | undefined, b: DeleteIncognitoWindowResponse | PlainMessage<DeleteIncognitoWindowResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.AddReactionRequest
+ */
+export declare class AddReactionRequest extends Message<AddReactionRequest> {
+  /**
+   * @generated from field: string listen_id = 1;
+   */
+  listenId: string;
+
+  /**
+   * @generated from field: string emoji = 2;
+   */
+  emoji: string;
+
+  constructor(data?: PartialMessage<AddReactionRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.AddReactionRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddReactionRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddReactionRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddReactionRequest;
+
+  static equals(a: AddReactionRequest | PlainMessage<AddReactionRequest> | undefined, b: AddReactionRequest | PlainMessage<AddReactionRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.AddReactionResponse
+ */
+export declare class AddReactionResponse extends Message<AddReactionResponse> {
+  /**
+   * @generated from field: repeated mootslive.v1.ReactionCount reactions = 1;
+   */
+  reactions: ReactionCount[];
+
+  constructor(data?: PartialMessage<AddReactionResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.AddReactionResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddReactionResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddReactionResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddReactionResponse;
+
+  static equals(a: AddReactionResponse | PlainMessage<AddReactionResponse> | undefined, b: AddReactionResponse | PlainMessage<AddReactionResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.RemoveReactionRequest
+ */
+export declare class RemoveReactionRequest extends Message<RemoveReactionRequest> {
+  /**
+   * @generated from field: string listen_id = 1;
+   */
+  listenId: string;
+
+  /**
+   * @generated from field: string emoji = 2;
+   */
+  emoji: string;
+
+  constructor(data?: PartialMessage<RemoveReactionRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.RemoveReactionRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveReactionRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveReactionRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveReactionRequest;
+
+  static equals(a: RemoveReactionRequest | PlainMessage<RemoveReactionRequest> | undefined, b: RemoveReactionRequest | PlainMessage<RemoveReactionRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.RemoveReactionResponse
+ */
+export declare class RemoveReactionResponse extends Message<RemoveReactionResponse> {
+  /**
+   * @generated from field: repeated mootslive.v1.ReactionCount reactions = 1;
+   */
+  reactions: ReactionCount[];
+
+  constructor(data?: PartialMessage<RemoveReactionResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.RemoveReactionResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveReactionResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveReactionResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveReactionResponse;
+
+  static equals(a: RemoveReactionResponse | PlainMessage<RemoveReactionResponse> | undefined, b: RemoveReactionResponse | PlainMessage<RemoveReactionResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.Comment
+ */
+export declare class Comment extends Message<Comment> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string listen_id = 2;
+   */
+  listenId: string;
+
+  /**
+   * @generated from field: mootslive.v1.UserSummary author = 3;
+   */
+  author?: UserSummary;
+
+  /**
+   * @generated from field: string text = 4;
+   */
+  text: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * hidden comments have been flagged by enough users that they are only
+   * shown to their author.
+   *
+   * @generated from field: bool hidden = 6;
+   */
+  hidden: boolean;
+
+  constructor(data?: PartialMessage<Comment>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.Comment";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Comment;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Comment;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Comment;
+
+  static equals(a: Comment | PlainMessage<Comment> | undefined, b: Comment | PlainMessage<Comment> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.AddCommentRequest
+ */
+export declare class AddCommentRequest extends Message<AddCommentRequest> {
+  /**
+   * @generated from field: string listen_id = 1;
+   */
+  listenId: string;
+
+  /**
+   * text is at most 500 characters.
+   *
+   * @generated from field: string text = 2;
+   */
+  text: string;
+
+  constructor(data?: PartialMessage<AddCommentRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.AddCommentRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddCommentRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddCommentRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddCommentRequest;
+
+  static equals(a: AddCommentRequest | PlainMessage<AddCommentRequest> | undefined, b: AddCommentRequest | PlainMessage<AddCommentRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.AddCommentResponse
+ */
+export declare class AddCommentResponse extends Message<AddCommentResponse> {
+  /**
+   * @generated from field: mootslive.v1.Comment comment = 1;
+   */
+  comment?: Comment;
+
+  constructor(data?: PartialMessage<AddCommentResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.AddCommentResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddCommentResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddCommentResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddCommentResponse;
+
+  static equals(a: AddCommentResponse | PlainMessage<AddCommentResponse> | undefined, b: AddCommentResponse | PlainMessage<AddCommentResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.DeleteCommentRequest
+ */
+export declare class DeleteCommentRequest extends Message<DeleteCommentRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  constructor(data?: PartialMessage<DeleteCommentRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.DeleteCommentRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteCommentRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteCommentRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteCommentRequest;
+
+  static equals(a: DeleteCommentRequest | PlainMessage<DeleteCommentRequest> | undefined, b: DeleteCommentRequest | PlainMessage<DeleteCommentRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.DeleteCommentResponse
+ */
+export declare class DeleteCommentResponse extends Message<DeleteCommentResponse> {
+  constructor(data?: PartialMessage<DeleteCommentResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.DeleteCommentResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteCommentResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteCommentResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteCommentResponse;
+
+  static equals(a: DeleteCommentResponse | PlainMessage<DeleteCommentResponse> | undefined, b: DeleteCommentResponse | PlainMessage<DeleteCommentResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListCommentsRequest
+ */
+export declare class ListCommentsRequest extends Message<ListCommentsRequest> {
+  /**
+   * @generated from field: string listen_id = 1;
+   */
+  listenId: string;
+
+  /**
+   * page_size defaults to 50, and is at most 100.
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+
+  constructor(data?: PartialMessage<ListCommentsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListCommentsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCommentsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCommentsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCommentsRequest;
+
+  static equals(a: ListCommentsRequest | PlainMessage<ListCommentsRequest> | undefined, b: ListCommentsRequest | PlainMessage<ListCommentsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListCommentsResponse
+ */
+export declare class ListCommentsResponse extends Message<ListCommentsResponse> {
+  /**
+   * comments are ordered oldest first.
+   *
+   * @generated from field: repeated mootslive.v1.Comment comments = 1;
+   */
+  comments: Comment[];
+
+  /**
+   * next_page_token is empty when there are no more comments.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  constructor(data?: PartialMessage<ListCommentsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListCommentsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCommentsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListCommentsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListCommentsResponse;
+
+  static equals(a: ListCommentsResponse | PlainMessage<ListCommentsResponse> | undefined, b: ListCommentsResponse | PlainMessage<ListCommentsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.FlagCommentRequest
+ */
+export declare class FlagCommentRequest extends Message<FlagCommentRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  constructor(data?: PartialMessage<FlagCommentRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.FlagCommentRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FlagCommentRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FlagCommentRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FlagCommentRequest;
+
+  static equals(a: FlagCommentRequest | PlainMessage<FlagCommentRequest> | undefined, b: FlagCommentRequest | PlainMessage<FlagCommentRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.FlagCommentResponse
+ */
+export declare class FlagCommentResponse extends Message<FlagCommentResponse> {
+  constructor(data?: PartialMessage<FlagCommentResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.FlagCommentResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FlagCommentResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FlagCommentResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FlagCommentResponse;
+
+  static equals(a: FlagCommentResponse | PlainMessage<FlagCommentResponse> | undefined, b: FlagCommentResponse | PlainMessage<FlagCommentResponse> | undefined): boolean;
+}
+
+/**
+ * Notification tells a user that something concerning them has happened.
+ * Unread notifications of the same kind and target are grouped together.
+ *
+ * @generated from message mootslive.v1.Notification
+ */
+export declare class Notification extends Message<Notification> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: mootslive.v1.NotificationKind kind = 2;
+   */
+  kind: NotificationKind;
+
+  /**
+   * @generated from field: string target_id = 3;
+   */
+  targetId: string;
+
+  /**
+   * actors are the most recent of the users who caused the notification,
+   * most recent first.
+   *
+   * @generated from field: repeated mootslive.v1.UserSummary actors = 4;
+   */
+  actors: UserSummary[];
+
+  /**
+   * actor_count is the total number of users who caused the notification.
+   *
+   * @generated from field: int32 actor_count = 5;
+   */
+  actorCount: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 7;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: bool read = 8;
+   */
+  read: boolean;
+
+  constructor(data?: PartialMessage<Notification>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.Notification";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Notification;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Notification;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Notification;
+
+  static equals(a: Notification | PlainMessage<Notification> | undefined, b: Notification | PlainMessage<Notification> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListNotificationsRequest
+ */
+export declare class ListNotificationsRequest extends Message<ListNotificationsRequest> {
+  /**
+   * page_size defaults to 50, and is at most 100.
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  constructor(data?: PartialMessage<ListNotificationsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListNotificationsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListNotificationsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListNotificationsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListNotificationsRequest;
+
+  static equals(a: ListNotificationsRequest | PlainMessage<ListNotificationsRequest> | undefined, b: ListNotificationsRequest | PlainMessage<ListNotificationsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListNotificationsResponse
+ */
+export declare class ListNotificationsResponse extends Message<ListNotificationsResponse> {
+  /**
+   * notifications are ordered by when they were last updated, most recent
+   * first.
+   *
+   * @generated from field: repeated mootslive.v1.Notification notifications = 1;
+   */
+  notifications: Notification[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  /**
+   * @generated from field: int64 unread_count = 3;
+   */
+  unreadCount: bigint;
+
+  constructor(data?: PartialMessage<ListNotificationsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListNotificationsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListNotificationsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListNotificationsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListNotificationsResponse;
+
+  static equals(a: ListNotificationsResponse | PlainMessage<ListNotificationsResponse> | undefined, b: ListNotificationsResponse | PlainMessage<ListNotificationsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.MarkNotificationsReadRequest
+ */
+export declare class MarkNotificationsReadRequest extends Message<MarkNotificationsReadRequest> {
+  /**
+   * @generated from field: repeated string ids = 1;
+   */
+  ids: string[];
+
+  /**
+   * all marks every notification updated before the time given as read, in
+   * addition to any given by ID. This avoids marking notifications the user
+   * hasn't seen yet as read.
+   *
+   * @generated from field: google.protobuf.Timestamp all_updated_before = 2;
+   */
+  allUpdatedBefore?: Timestamp;
+
+  constructor(data?: PartialMessage<MarkNotificationsReadRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.MarkNotificationsReadRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MarkNotificationsReadRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MarkNotificationsReadRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MarkNotificationsReadRequest;
+
+  static equals(a: MarkNotificationsReadRequest | PlainMessage<MarkNotificationsReadRequest> | undefined, b: MarkNotificationsReadRequest | PlainMessage<MarkNotificationsReadRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.MarkNotificationsReadResponse
+ */
+export declare class MarkNotificationsReadResponse extends Message<MarkNotificationsReadResponse> {
+  /**
+   * @generated from field: int64 unread_count = 1;
+   */
+  unreadCount: bigint;
+
+  constructor(data?: PartialMessage<MarkNotificationsReadResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.MarkNotificationsReadResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MarkNotificationsReadResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MarkNotificationsReadResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MarkNotificationsReadResponse;
+
+  static equals(a: MarkNotificationsReadResponse | PlainMessage<MarkNotificationsReadResponse> | undefined, b: MarkNotificationsReadResponse | PlainMessage<MarkNotificationsReadResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.NotificationPreference
+ */
+export declare class NotificationPreference extends Message<NotificationPreference> {
+  /**
+   * @generated from field: mootslive.v1.NotificationKind kind = 1;
+   */
+  kind: NotificationKind;
+
+  /**
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+
+  constructor(data?: PartialMessage<NotificationPreference>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.NotificationPreference";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NotificationPreference;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NotificationPreference;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NotificationPreference;
+
+  static equals(a: NotificationPreference | PlainMessage<NotificationPreference> | undefined, b: NotificationPreference | PlainMessage<NotificationPreference> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetNotificationPreferencesRequest
+ */
+export declare class GetNotificationPreferencesRequest extends Message<GetNotificationPreferencesRequest> {
+  constructor(data?: PartialMessage<GetNotificationPreferencesRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetNotificationPreferencesRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetNotificationPreferencesRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetNotificationPreferencesRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetNotificationPreferencesRequest;
+
+  static equals(a: GetNotificationPreferencesRequest | PlainMessage<GetNotificationPreferencesRequest> | undefined, b: GetNotificationPreferencesRequest | PlainMessage<GetNotificationPreferencesRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetNotificationPreferencesResponse
+ */
+export declare class GetNotificationPreferencesResponse extends Message<GetNotificationPreferencesResponse> {
+  /**
+   * @generated from field: repeated mootslive.v1.NotificationPreference preferences = 1;
+   */
+  preferences: NotificationPreference[];
+
+  constructor(data?: PartialMessage<GetNotificationPreferencesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetNotificationPreferencesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetNotificationPreferencesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetNotificationPreferencesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetNotificationPreferencesResponse;
+
+  static equals(a: GetNotificationPreferencesResponse | PlainMessage<GetNotificationPreferencesResponse> | undefined, b: GetNotificationPreferencesResponse | PlainMessage<GetNotificationPreferencesResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.UpdateNotificationPreferencesRequest
+ */
+export declare class UpdateNotificationPreferencesRequest extends Message<UpdateNotificationPreferencesRequest> {
+  /**
+   * preferences are the preferences to change, others are left as they are.
+   *
+   * @generated from field: repeated mootslive.v1.NotificationPreference preferences = 1;
+   */
+  preferences: NotificationPreference[];
+
+  constructor(data?: PartialMessage<UpdateNotificationPreferencesRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.UpdateNotificationPreferencesRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateNotificationPreferencesRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateNotificationPreferencesRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateNotificationPreferencesRequest;
+
+  static equals(a: UpdateNotificationPreferencesRequest | PlainMessage<UpdateNotificationPreferencesRequest> | undefined, b: UpdateNotificationPreferencesRequest | PlainMessage<UpdateNotificationPreferencesRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.UpdateNotificationPreferencesResponse
+ */
+export declare class UpdateNotificationPreferencesResponse extends Message<UpdateNotificationPreferencesResponse> {
+  /**
+   * @generated from field: repeated mootslive.v1.NotificationPreference preferences = 1;
+   */
+  preferences: NotificationPreference[];
+
+  constructor(data?: PartialMessage<UpdateNotificationPreferencesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.UpdateNotificationPreferencesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateNotificationPreferencesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateNotificationPreferencesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateNotificationPreferencesResponse;
+
+  static equals(a: UpdateNotificationPreferencesResponse | PlainMessage<UpdateNotificationPreferencesResponse> | undefined, b: UpdateNotificationPreferencesResponse | PlainMessage<UpdateNotificationPreferencesResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.UpdateProfileRequest
+ */
+export declare class UpdateProfileRequest extends Message<UpdateProfileRequest> {
+  /**
+   * handle must be 3 to 30 letters, numbers or underscores.
+   *
+   * @generated from field: string handle = 1;
+   */
+  handle: string;
+
+  /**
+   * display_name is at most 64 characters. If empty, the name of the user's
+   * Twitter account is used.
+   *
+   * @generated from field: string display_name = 2;
+   */
+  displayName: string;
+
+  /**
+   * bio is at most 160 characters.
+   *
+   * @generated from field: string bio = 3;
+   */
+  bio: string;
+
+  /**
+   * time_zone is an IANA time zone name. It's left unchanged if empty.
+   *
+   * @generated from field: string time_zone = 4;
+   */
+  timeZone: string;
+
+  constructor(data?: PartialMessage<UpdateProfileRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.UpdateProfileRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateProfileRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateProfileRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateProfileRequest;
+
+  static equals(a: UpdateProfileRequest | PlainMessage<UpdateProfileRequest> | undefined, b: UpdateProfileRequest | PlainMessage<UpdateProfileRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.UpdateProfileResponse
+ */
+export declare class UpdateProfileResponse extends Message<UpdateProfileResponse> {
+  /**
+   * @generated from field: mootslive.v1.Profile profile = 1;
+   */
+  profile?: Profile;
+
+  constructor(data?: PartialMessage<UpdateProfileResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.UpdateProfileResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateProfileResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateProfileResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateProfileResponse;
+
+  static equals(a: UpdateProfileResponse | PlainMessage<UpdateProfileResponse> | undefined, b: UpdateProfileResponse | PlainMessage<UpdateProfileResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.TopArtist
+ */
+export declare class TopArtist extends Message<TopArtist> {
+  /**
+   * @generated from field: mootslive.v1.Artist artist = 1;
+   */
+  artist?: Artist;
+
+  /**
+   * @generated from field: int64 plays = 2;
+   */
+  plays: bigint;
+
+  constructor(data?: PartialMessage<TopArtist>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.TopArtist";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TopArtist;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TopArtist;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TopArtist;
+
+  static equals(a: TopArtist | PlainMessage<TopArtist> | undefined, b: TopArtist | PlainMessage<TopArtist> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.RecentListen
+ */
+export declare class RecentListen extends Message<RecentListen> {
+  /**
+   * @generated from field: mootslive.v1.Listen listen = 1;
+   */
+  listen?: Listen;
+
+  /**
+   * track is unset if we don't hold metadata for the track.
+   *
+   * @generated from field: mootslive.v1.Track track = 2;
+   */
+  track?: Track;
+
+  constructor(data?: PartialMessage<RecentListen>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.RecentListen";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RecentListen;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RecentListen;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RecentListen;
+
+  static equals(a: RecentListen | PlainMessage<RecentListen> | undefined, b: RecentListen | PlainMessage<RecentListen> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetProfileRequest
+ */
+export declare class GetProfileRequest extends Message<GetProfileRequest> {
+  /**
+   * @generated from field: string handle = 1;
+   */
+  handle: string;
+
+  constructor(data?: PartialMessage<GetProfileRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetProfileRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetProfileRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetProfileRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetProfileRequest;
+
+  static equals(a: GetProfileRequest | PlainMessage<GetProfileRequest> | undefined, b: GetProfileRequest | PlainMessage<GetProfileRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetProfileResponse
+ */
+export declare class GetProfileResponse extends Message<GetProfileResponse> {
+  /**
+   * @generated from field: mootslive.v1.UserSummary user = 1;
+   */
+  user?: UserSummary;
+
+  /**
+   * @generated from field: string bio = 2;
+   */
+  bio: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp joined_at = 3;
+   */
+  joinedAt?: Timestamp;
+
+  /**
+   * listens_visible is whether the caller can see the user's listens. If
+   * not, recent_listens and top_artists are empty.
+   *
+   * @generated from field: bool listens_visible = 4;
+   */
+  listensVisible: boolean;
+
+  /**
+   * recent_listens are newest first.
+   *
+   * @generated from field: repeated mootslive.v1.RecentListen recent_listens = 5;
+   */
+  recentListens: RecentListen[];
+
+  /**
+   * top_artists are the artists the user has listened to most over the last
+   * 90 days, most played first.
+   *
+   * @generated from field: repeated mootslive.v1.TopArtist top_artists = 6;
+   */
+  topArtists: TopArtist[];
+
+  constructor(data?: PartialMessage<GetProfileResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetProfileResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetProfileResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetProfileResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetProfileResponse;
+
+  static equals(a: GetProfileResponse | PlainMessage<GetProfileResponse> | undefined, b: GetProfileResponse | PlainMessage<GetProfileResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.BlockUserRequest
+ */
+export declare class BlockUserRequest extends Message<BlockUserRequest> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  constructor(data?: PartialMessage<BlockUserRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.BlockUserRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BlockUserRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BlockUserRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BlockUserRequest;
+
+  static equals(a: BlockUserRequest | PlainMessage<BlockUserRequest> | undefined, b: BlockUserRequest | PlainMessage<BlockUserRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.BlockUserResponse
+ */
+export declare class BlockUserResponse extends Message<BlockUserResponse> {
+  constructor(data?: PartialMessage<BlockUserResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.BlockUserResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BlockUserResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BlockUserResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BlockUserResponse;
+
+  static equals(a: BlockUserResponse | PlainMessage<BlockUserResponse> | undefined, b: BlockUserResponse | PlainMessage<BlockUserResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.UnblockUserRequest
+ */
+export declare class UnblockUserRequest extends Message<UnblockUserRequest> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  constructor(data?: PartialMessage<UnblockUserRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.UnblockUserRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnblockUserRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnblockUserRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnblockUserRequest;
+
+  static equals(a: UnblockUserRequest | PlainMessage<UnblockUserRequest> | undefined, b: UnblockUserRequest | PlainMessage<UnblockUserRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.UnblockUserResponse
+ */
+export declare class UnblockUserResponse extends Message<UnblockUserResponse> {
+  constructor(data?: PartialMessage<UnblockUserResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.UnblockUserResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnblockUserResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnblockUserResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnblockUserResponse;
+
+  static equals(a: UnblockUserResponse | PlainMessage<UnblockUserResponse> | undefined, b: UnblockUserResponse | PlainMessage<UnblockUserResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListBlockedUsersRequest
+ */
+export declare class ListBlockedUsersRequest extends Message<ListBlockedUsersRequest> {
+  constructor(data?: PartialMessage<ListBlockedUsersRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListBlockedUsersRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListBlockedUsersRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListBlockedUsersRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListBlockedUsersRequest;
+
+  static equals(a: ListBlockedUsersRequest | PlainMessage<ListBlockedUsersRequest> | undefined, b: ListBlockedUsersRequest | PlainMessage<ListBlockedUsersRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListBlockedUsersResponse
+ */
+export declare class ListBlockedUsersResponse extends Message<ListBlockedUsersResponse> {
+  /**
+   * users are ordered most recently blocked first.
+   *
+   * @generated from field: repeated mootslive.v1.UserSummary users = 1;
+   */
+  users: UserSummary[];
+
+  constructor(data?: PartialMessage<ListBlockedUsersResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListBlockedUsersResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListBlockedUsersResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListBlockedUsersResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListBlockedUsersResponse;
+
+  static equals(a: ListBlockedUsersResponse | PlainMessage<ListBlockedUsersResponse> | undefined, b: ListBlockedUsersResponse | PlainMessage<ListBlockedUsersResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.MuteUserRequest
+ */
+export declare class MuteUserRequest extends Message<MuteUserRequest> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  constructor(data?: PartialMessage<MuteUserRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.MuteUserRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MuteUserRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MuteUserRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MuteUserRequest;
+
+  static equals(a: MuteUserRequest | PlainMessage<MuteUserRequest> | undefined, b: MuteUserRequest | PlainMessage<MuteUserRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.MuteUserResponse
+ */
+export declare class MuteUserResponse extends Message<MuteUserResponse> {
+  constructor(data?: PartialMessage<MuteUserResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.MuteUserResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MuteUserResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MuteUserResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MuteUserResponse;
+
+  static equals(a: MuteUserResponse | PlainMessage<MuteUserResponse> | undefined, b: MuteUserResponse | PlainMessage<MuteUserResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.UnmuteUserRequest
+ */
+export declare class UnmuteUserRequest extends Message<UnmuteUserRequest> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  constructor(data?: PartialMessage<UnmuteUserRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.UnmuteUserRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnmuteUserRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnmuteUserRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnmuteUserRequest;
+
+  static equals(a: UnmuteUserRequest | PlainMessage<UnmuteUserRequest> | undefined, b: UnmuteUserRequest | PlainMessage<UnmuteUserRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.UnmuteUserResponse
+ */
+export declare class UnmuteUserResponse extends Message<UnmuteUserResponse> {
+  constructor(data?: PartialMessage<UnmuteUserResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.UnmuteUserResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnmuteUserResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnmuteUserResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnmuteUserResponse;
+
+  static equals(a: UnmuteUserResponse | PlainMessage<UnmuteUserResponse> | undefined, b: UnmuteUserResponse | PlainMessage<UnmuteUserResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListMutedUsersRequest
+ */
+export declare class ListMutedUsersRequest extends Message<ListMutedUsersRequest> {
+  constructor(data?: PartialMessage<ListMutedUsersRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListMutedUsersRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMutedUsersRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMutedUsersRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMutedUsersRequest;
+
+  static equals(a: ListMutedUsersRequest | PlainMessage<ListMutedUsersRequest> | undefined, b: ListMutedUsersRequest | PlainMessage<ListMutedUsersRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ListMutedUsersResponse
+ */
+export declare class ListMutedUsersResponse extends Message<ListMutedUsersResponse> {
+  /**
+   * users are ordered most recently muted first.
+   *
+   * @generated from field: repeated mootslive.v1.UserSummary users = 1;
+   */
+  users: UserSummary[];
+
+  constructor(data?: PartialMessage<ListMutedUsersResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ListMutedUsersResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMutedUsersResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMutedUsersResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMutedUsersResponse;
+
+  static equals(a: ListMutedUsersResponse | PlainMessage<ListMutedUsersResponse> | undefined, b: ListMutedUsersResponse | PlainMessage<ListMutedUsersResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.TopTrack
+ */
+export declare class TopTrack extends Message<TopTrack> {
+  /**
+   * track only has its ISRC set if we don't hold metadata for it.
+   *
+   * @generated from field: mootslive.v1.Track track = 1;
+   */
+  track?: Track;
+
+  /**
+   * @generated from field: int64 plays = 2;
+   */
+  plays: bigint;
+
+  constructor(data?: PartialMessage<TopTrack>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.TopTrack";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TopTrack;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TopTrack;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TopTrack;
+
+  static equals(a: TopTrack | PlainMessage<TopTrack> | undefined, b: TopTrack | PlainMessage<TopTrack> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.TopAlbum
+ */
+export declare class TopAlbum extends Message<TopAlbum> {
+  /**
+   * @generated from field: mootslive.v1.Album album = 1;
+   */
+  album?: Album;
+
+  /**
+   * @generated from field: int64 plays = 2;
+   */
+  plays: bigint;
+
+  constructor(data?: PartialMessage<TopAlbum>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.TopAlbum";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TopAlbum;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TopAlbum;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TopAlbum;
+
+  static equals(a: TopAlbum | PlainMessage<TopAlbum> | undefined, b: TopAlbum | PlainMessage<TopAlbum> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetTopTracksRequest
+ */
+export declare class GetTopTracksRequest extends Message<GetTopTracksRequest> {
+  /**
+   * user_id defaults to the caller.
+   *
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * window defaults to the last 30 days.
+   *
+   * @generated from field: mootslive.v1.TimeWindow window = 2;
+   */
+  window: TimeWindow;
+
+  /**
+   * custom_range must be set if window is TIME_WINDOW_CUSTOM.
+   *
+   * @generated from field: mootslive.v1.TimeRange custom_range = 3;
+   */
+  customRange?: TimeRange;
+
+  /**
+   * limit defaults to 10, and is capped at 100.
+   *
+   * @generated from field: int32 limit = 4;
+   */
+  limit: number;
+
+  constructor(data?: PartialMessage<GetTopTracksRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetTopTracksRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTopTracksRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTopTracksRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTopTracksRequest;
+
+  static equals(a: GetTopTracksRequest | PlainMessage<GetTopTracksRequest> | undefined, b: GetTopTracksRequest | PlainMessage<GetTopTracksRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetTopTracksResponse
+ */
+export declare class GetTopTracksResponse extends Message<GetTopTracksResponse> {
+  /**
+   * tracks are the most played first.
+   *
+   * @generated from field: repeated mootslive.v1.TopTrack tracks = 1;
+   */
+  tracks: TopTrack[];
+
+  /**
+   * range is the days plays were counted over. Its start_time is unset for
+   * TIME_WINDOW_ALL_TIME.
+   *
+   * @generated from field: mootslive.v1.TimeRange range = 2;
+   */
+  range?: TimeRange;
+
+  constructor(data?: PartialMessage<GetTopTracksResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetTopTracksResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTopTracksResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTopTracksResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTopTracksResponse;
+
+  static equals(a: GetTopTracksResponse | PlainMessage<GetTopTracksResponse> | undefined, b: GetTopTracksResponse | PlainMessage<GetTopTracksResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetTopArtistsRequest
+ */
+export declare class GetTopArtistsRequest extends Message<GetTopArtistsRequest> {
+  /**
+   * user_id defaults to the caller.
+   *
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * window defaults to the last 30 days.
+   *
+   * @generated from field: mootslive.v1.TimeWindow window = 2;
+   */
+  window: TimeWindow;
+
+  /**
+   * custom_range must be set if window is TIME_WINDOW_CUSTOM.
+   *
+   * @generated from field: mootslive.v1.TimeRange custom_range = 3;
+   */
+  customRange?: TimeRange;
+
+  /**
+   * limit defaults to 10, and is capped at 100.
+   *
+   * @generated from field: int32 limit = 4;
+   */
+  limit: number;
+
+  constructor(data?: PartialMessage<GetTopArtistsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetTopArtistsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTopArtistsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTopArtistsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTopArtistsRequest;
+
+  static equals(a: GetTopArtistsRequest | PlainMessage<GetTopArtistsRequest> | undefined, b: GetTopArtistsRequest | PlainMessage<GetTopArtistsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetTopArtistsResponse
+ */
+export declare class GetTopArtistsResponse extends Message<GetTopArtistsResponse> {
+  /**
+   * artists are the most played first. Plays of tracks we don't hold
+   * metadata for aren't counted.
+   *
+   * @generated from field: repeated mootslive.v1.TopArtist artists = 1;
+   */
+  artists: TopArtist[];
+
+  /**
+   * range is the days plays were counted over. Its start_time is unset for
+   * TIME_WINDOW_ALL_TIME.
+   *
+   * @generated from field: mootslive.v1.TimeRange range = 2;
+   */
+  range?: TimeRange;
+
+  constructor(data?: PartialMessage<GetTopArtistsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetTopArtistsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTopArtistsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTopArtistsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTopArtistsResponse;
+
+  static equals(a: GetTopArtistsResponse | PlainMessage<GetTopArtistsResponse> | undefined, b: GetTopArtistsResponse | PlainMessage<GetTopArtistsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetTopAlbumsRequest
+ */
+export declare class GetTopAlbumsRequest extends Message<GetTopAlbumsRequest> {
+  /**
+   * user_id defaults to the caller.
+   *
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * window defaults to the last 30 days.
+   *
+   * @generated from field: mootslive.v1.TimeWindow window = 2;
+   */
+  window: TimeWindow;
+
+  /**
+   * custom_range must be set if window is TIME_WINDOW_CUSTOM.
+   *
+   * @generated from field: mootslive.v1.TimeRange custom_range = 3;
+   */
+  customRange?: TimeRange;
+
+  /**
+   * limit defaults to 10, and is capped at 100.
+   *
+   * @generated from field: int32 limit = 4;
+   */
+  limit: number;
+
+  constructor(data?: PartialMessage<GetTopAlbumsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetTopAlbumsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTopAlbumsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTopAlbumsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTopAlbumsRequest;
+
+  static equals(a: GetTopAlbumsRequest | PlainMessage<GetTopAlbumsRequest> | undefined, b: GetTopAlbumsRequest | PlainMessage<GetTopAlbumsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetTopAlbumsResponse
+ */
+export declare class GetTopAlbumsResponse extends Message<GetTopAlbumsResponse> {
+  /**
+   * albums are the most played first. Plays of tracks we don't hold
+   * metadata for aren't counted.
+   *
+   * @generated from field: repeated mootslive.v1.TopAlbum albums = 1;
+   */
+  albums: TopAlbum[];
+
+  /**
+   * range is the days plays were counted over. Its start_time is unset for
+   * TIME_WINDOW_ALL_TIME.
+   *
+   * @generated from field: mootslive.v1.TimeRange range = 2;
+   */
+  range?: TimeRange;
+
+  constructor(data?: PartialMessage<GetTopAlbumsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetTopAlbumsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTopAlbumsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTopAlbumsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTopAlbumsResponse;
+
+  static equals(a: GetTopAlbumsResponse | PlainMessage<GetTopAlbumsResponse> | undefined, b: GetTopAlbumsResponse | PlainMessage<GetTopAlbumsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.HeatmapCell
+ */
+export declare class HeatmapCell extends Message<HeatmapCell> {
+  /**
+   * day_of_week is from 1 for Monday to 7 for Sunday.
+   *
+   * @generated from field: int32 day_of_week = 1;
+   */
+  dayOfWeek: number;
+
+  /**
+   * hour is from 0 to 23.
+   *
+   * @generated from field: int32 hour = 2;
+   */
+  hour: number;
+
+  /**
+   * @generated from field: int64 plays = 3;
+   */
+  plays: bigint;
+
+  constructor(data?: PartialMessage<HeatmapCell>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.HeatmapCell";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HeatmapCell;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HeatmapCell;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HeatmapCell;
+
+  static equals(a: HeatmapCell | PlainMessage<HeatmapCell> | undefined, b: HeatmapCell | PlainMessage<HeatmapCell> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.DailyPlays
+ */
+export declare class DailyPlays extends Message<DailyPlays> {
+  /**
+   * date is formatted as YYYY-MM-DD.
+   *
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: int64 plays = 2;
+   */
+  plays: bigint;
+
+  constructor(data?: PartialMessage<DailyPlays>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.DailyPlays";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DailyPlays;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DailyPlays;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DailyPlays;
+
+  static equals(a: DailyPlays | PlainMessage<DailyPlays> | undefined, b: DailyPlays | PlainMessage<DailyPlays> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetListeningStatsRequest
+ */
+export declare class GetListeningStatsRequest extends Message<GetListeningStatsRequest> {
+  /**
+   * window defaults to the last 30 days. Days are those of the caller's time
+   * zone, rather than UTC.
+   *
+   * @generated from field: mootslive.v1.TimeWindow window = 1;
+   */
+  window: TimeWindow;
+
+  /**
+   * custom_range must be set if window is TIME_WINDOW_CUSTOM.
+   *
+   * @generated from field: mootslive.v1.TimeRange custom_range = 2;
+   */
+  customRange?: TimeRange;
+
+  constructor(data?: PartialMessage<GetListeningStatsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetListeningStatsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetListeningStatsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetListeningStatsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetListeningStatsRequest;
+
+  static equals(a: GetListeningStatsRequest | PlainMessage<GetListeningStatsRequest> | undefined, b: GetListeningStatsRequest | PlainMessage<GetListeningStatsRequest> | undefined): boolean;
+}
+
+/**
+ * GetListeningStatsResponse describes the caller's listening over a window,
+ * in their time zone.
+ *
+ * @generated from message mootslive.v1.GetListeningStatsResponse
+ */
+export declare class GetListeningStatsResponse extends Message<GetListeningStatsResponse> {
+  /**
+   * @generated from field: string time_zone = 1;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: mootslive.v1.TimeRange range = 2;
+   */
+  range?: TimeRange;
+
+  /**
+   * heatmap counts plays by hour of the day and day of the week. Cells
+   * without plays are left out.
+   *
+   * @generated from field: repeated mootslive.v1.HeatmapCell heatmap = 3;
+   */
+  heatmap: HeatmapCell[];
+
+  /**
+   * daily_plays are oldest first. Days without plays are left out.
+   *
+   * @generated from field: repeated mootslive.v1.DailyPlays daily_plays = 4;
+   */
+  dailyPlays: DailyPlays[];
+
+  /**
+   * current_streak_days is how many days in a row, up to today or
+   * yesterday, the caller has listened to something on. Unlike everything
+   * else, streaks aren't limited to the window.
+   *
+   * @generated from field: int32 current_streak_days = 5;
+   */
+  currentStreakDays: number;
+
+  /**
+   * @generated from field: int32 longest_streak_days = 6;
+   */
+  longestStreakDays: number;
+
+  /**
+   * @generated from field: int64 plays = 7;
+   */
+  plays: bigint;
+
+  /**
+   * estimated_listening_ms adds up the durations of the tracks played. Plays
+   * of tracks we don't hold metadata for are assumed to be of average
+   * length.
+   *
+   * @generated from field: int64 estimated_listening_ms = 8;
+   */
+  estimatedListeningMs: bigint;
+
+  /**
+   * new_track_plays counts the plays of tracks first played within the
+   * range, and repeat_plays those of tracks played before it.
+   *
+   * @generated from field: int64 new_track_plays = 9;
+   */
+  newTrackPlays: bigint;
+
+  /**
+   * @generated from field: int64 repeat_plays = 10;
+   */
+  repeatPlays: bigint;
+
+  constructor(data?: PartialMessage<GetListeningStatsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetListeningStatsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetListeningStatsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetListeningStatsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetListeningStatsResponse;
+
+  static equals(a: GetListeningStatsResponse | PlainMessage<GetListeningStatsResponse> | undefined, b: GetListeningStatsResponse | PlainMessage<GetListeningStatsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.LoopedTrack
+ */
+export declare class LoopedTrack extends Message<LoopedTrack> {
+  /**
+   * @generated from field: mootslive.v1.Track track = 1;
+   */
+  track?: Track;
+
+  /**
+   * plays is how many times in a row the track was played.
+   *
+   * @generated from field: int32 plays = 2;
+   */
+  plays: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp started_at = 3;
+   */
+  startedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<LoopedTrack>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.LoopedTrack";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LoopedTrack;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LoopedTrack;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LoopedTrack;
+
+  static equals(a: LoopedTrack | PlainMessage<LoopedTrack> | undefined, b: LoopedTrack | PlainMessage<LoopedTrack> | undefined): boolean;
+}
+
+/**
+ * MootsComparison compares a user's year with those of the moots whose
+ * listens they can see.
+ *
+ * @generated from message mootslive.v1.MootsComparison
+ */
+export declare class MootsComparison extends Message<MootsComparison> {
+  /**
+   * @generated from field: int32 moots_compared = 1;
+   */
+  mootsCompared: number;
+
+  /**
+   * rank is 1 if the user played more than any of their moots.
+   *
+   * @generated from field: int32 rank = 2;
+   */
+  rank: number;
+
+  /**
+   * percentile is the percentage of moots the user played more than.
+   *
+   * @generated from field: double percentile = 3;
+   */
+  percentile: number;
+
+  /**
+   * top_artist_moots is how many moots also have the user's top artist among
+   * their own top artists of the year.
+   *
+   * @generated from field: int32 top_artist_moots = 4;
+   */
+  topArtistMoots: number;
+
+  constructor(data?: PartialMessage<MootsComparison>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.MootsComparison";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MootsComparison;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MootsComparison;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MootsComparison;
+
+  static equals(a: MootsComparison | PlainMessage<MootsComparison> | undefined, b: MootsComparison | PlainMessage<MootsComparison> | undefined): boolean;
+}
+
+/**
+ * YearlyRecap is a snapshot of a user's listening over a year in their time
+ * zone, taken once the year is over.
+ *
+ * @generated from message mootslive.v1.YearlyRecap
+ */
+export declare class YearlyRecap extends Message<YearlyRecap> {
+  /**
+   * @generated from field: int32 year = 1;
+   */
+  year: number;
+
+  /**
+   * @generated from field: string time_zone = 2;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: repeated mootslive.v1.TopTrack top_tracks = 3;
+   */
+  topTracks: TopTrack[];
+
+  /**
+   * @generated from field: repeated mootslive.v1.TopArtist top_artists = 4;
+   */
+  topArtists: TopArtist[];
+
+  /**
+   * @generated from field: int64 plays = 5;
+   */
+  plays: bigint;
+
+  /**
+   * estimated_listening_minutes assumes plays of tracks we don't hold
+   * metadata for are of average length.
+   *
+   * @generated from field: int64 estimated_listening_minutes = 6;
+   */
+  estimatedListeningMinutes: bigint;
+
+  /**
+   * top_month is from 1 for January to 12 for December.
+   *
+   * @generated from field: int32 top_month = 7;
+   */
+  topMonth: number;
+
+  /**
+   * @generated from field: int64 top_month_plays = 8;
+   */
+  topMonthPlays: bigint;
+
+  /**
+   * most_looped is the track played the most times in a row.
+   *
+   * @generated from field: mootslive.v1.LoopedTrack most_looped = 9;
+   */
+  mostLooped?: LoopedTrack;
+
+  /**
+   * @generated from field: mootslive.v1.RecentListen first_listen = 10;
+   */
+  firstListen?: RecentListen;
+
+  /**
+   * @generated from field: mootslive.v1.MootsComparison moots = 11;
+   */
+  moots?: MootsComparison;
+
+  constructor(data?: PartialMessage<YearlyRecap>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.YearlyRecap";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): YearlyRecap;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): YearlyRecap;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): YearlyRecap;
+
+  static equals(a: YearlyRecap | PlainMessage<YearlyRecap> | undefined, b: YearlyRecap | PlainMessage<YearlyRecap> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetRecapRequest
+ */
+export declare class GetRecapRequest extends Message<GetRecapRequest> {
+  /**
+   * @generated from field: int32 year = 1;
+   */
+  year: number;
+
+  constructor(data?: PartialMessage<GetRecapRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetRecapRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRecapRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRecapRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRecapRequest;
+
+  static equals(a: GetRecapRequest | PlainMessage<GetRecapRequest> | undefined, b: GetRecapRequest | PlainMessage<GetRecapRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetRecapResponse
+ */
+export declare class GetRecapResponse extends Message<GetRecapResponse> {
+  /**
+   * @generated from field: mootslive.v1.YearlyRecap recap = 1;
+   */
+  recap?: YearlyRecap;
+
+  /**
+   * version increases each time the recap is regenerated.
+   *
+   * @generated from field: int32 version = 2;
+   */
+  version: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp generated_at = 3;
+   */
+  generatedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<GetRecapResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetRecapResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRecapResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRecapResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRecapResponse;
+
+  static equals(a: GetRecapResponse | PlainMessage<GetRecapResponse> | undefined, b: GetRecapResponse | PlainMessage<GetRecapResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ChartTrack
+ */
+export declare class ChartTrack extends Message<ChartTrack> {
+  /**
+   * @generated from field: int32 rank = 1;
+   */
+  rank: number;
+
+  /**
+   * track only has its ISRC set if we don't hold metadata for it.
+   *
+   * @generated from field: mootslive.v1.Track track = 2;
+   */
+  track?: Track;
+
+  /**
+   * @generated from field: int64 plays = 3;
+   */
+  plays: bigint;
+
+  /**
+   * listeners is how many users played the track.
+   *
+   * @generated from field: int32 listeners = 4;
+   */
+  listeners: number;
+
+  /**
+   * previous_rank is zero if the track wasn't in the previous chart.
+   *
+   * @generated from field: int32 previous_rank = 5;
+   */
+  previousRank: number;
+
+  /**
+   * @generated from field: mootslive.v1.ChartMovement movement = 6;
+   */
+  movement: ChartMovement;
+
+  constructor(data?: PartialMessage<ChartTrack>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ChartTrack";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChartTrack;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChartTrack;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChartTrack;
+
+  static equals(a: ChartTrack | PlainMessage<ChartTrack> | undefined, b: ChartTrack | PlainMessage<ChartTrack> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.ChartArtist
+ */
+export declare class ChartArtist extends Message<ChartArtist> {
+  /**
+   * @generated from field: int32 rank = 1;
+   */
+  rank: number;
+
+  /**
+   * @generated from field: mootslive.v1.Artist artist = 2;
+   */
+  artist?: Artist;
+
+  /**
+   * @generated from field: int64 plays = 3;
+   */
+  plays: bigint;
+
+  /**
+   * @generated from field: int32 listeners = 4;
+   */
+  listeners: number;
+
+  /**
+   * @generated from field: int32 previous_rank = 5;
+   */
+  previousRank: number;
+
+  /**
+   * @generated from field: mootslive.v1.ChartMovement movement = 6;
+   */
+  movement: ChartMovement;
+
+  constructor(data?: PartialMessage<ChartArtist>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.ChartArtist";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChartArtist;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChartArtist;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChartArtist;
+
+  static equals(a: ChartArtist | PlainMessage<ChartArtist> | undefined, b: ChartArtist | PlainMessage<ChartArtist> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetChartsRequest
+ */
+export declare class GetChartsRequest extends Message<GetChartsRequest> {
+  /**
+   * @generated from field: mootslive.v1.ChartScope scope = 1;
+   */
+  scope: ChartScope;
+
+  /**
+   * @generated from field: mootslive.v1.ChartPeriod period = 2;
+   */
+  period: ChartPeriod;
+
+  /**
+   * time picks the chart of the period containing it, for browsing past
+   * charts. The latest chart is returned if it isn't set.
+   *
+   * @generated from field: google.protobuf.Timestamp time = 3;
+   */
+  time?: Timestamp;
+
+  constructor(data?: PartialMessage<GetChartsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetChartsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetChartsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetChartsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetChartsRequest;
+
+  static equals(a: GetChartsRequest | PlainMessage<GetChartsRequest> | undefined, b: GetChartsRequest | PlainMessage<GetChartsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.GetChartsResponse
+ */
+export declare class GetChartsResponse extends Message<GetChartsResponse> {
+  /**
+   * @generated from field: mootslive.v1.ChartScope scope = 1;
+   */
+  scope: ChartScope;
+
+  /**
+   * @generated from field: mootslive.v1.ChartPeriod period = 2;
+   */
+  period: ChartPeriod;
+
+  /**
+   * @generated from field: mootslive.v1.TimeRange range = 3;
+   */
+  range?: TimeRange;
+
+  /**
+   * @generated from field: repeated mootslive.v1.ChartTrack tracks = 4;
+   */
+  tracks: ChartTrack[];
+
+  /**
+   * @generated from field: repeated mootslive.v1.ChartArtist artists = 5;
+   */
+  artists: ChartArtist[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp computed_at = 6;
+   */
+  computedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<GetChartsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetChartsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetChartsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetChartsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetChartsResponse;
+
+  static equals(a: GetChartsResponse | PlainMessage<GetChartsResponse> | undefined, b: GetChartsResponse | PlainMessage<GetChartsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.CreateListenRequest
+ */
+export declare class CreateListenRequest extends Message<CreateListenRequest> {
+  /**
+   * @generated from field: string isrc = 1;
+   */
+  isrc: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp listened_at = 2;
+   */
+  listenedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<CreateListenRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.CreateListenRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateListenRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateListenRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateListenRequest;
+
+  static equals(a: CreateListenRequest | PlainMessage<CreateListenRequest> | undefined, b: CreateListenRequest | PlainMessage<CreateListenRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.CreateListenResponse
+ */
+export declare class CreateListenResponse extends Message<CreateListenResponse> {
+  /**
+   * @generated from field: mootslive.v1.Listen listen = 1;
+   */
+  listen?: Listen;
+
+  constructor(data?: PartialMessage<CreateListenResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.CreateListenResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateListenResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateListenResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateListenResponse;
+
+  static equals(a: CreateListenResponse | PlainMessage<CreateListenResponse> | undefined, b: CreateListenResponse | PlainMessage<CreateListenResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.UpdateListenRequest
+ */
+export declare class UpdateListenRequest extends Message<UpdateListenRequest> {
+  /**
+   * @generated from field: string listen_id = 1;
+   */
+  listenId: string;
+
+  /**
+   * isrc and listened_at are left unchanged if they aren't set.
+   *
+   * @generated from field: string isrc = 2;
+   */
+  isrc: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp listened_at = 3;
+   */
+  listenedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<UpdateListenRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.UpdateListenRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateListenRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateListenRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateListenRequest;
+
+  static equals(a: UpdateListenRequest | PlainMessage<UpdateListenRequest> | undefined, b: UpdateListenRequest | PlainMessage<UpdateListenRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.UpdateListenResponse
+ */
+export declare class UpdateListenResponse extends Message<UpdateListenResponse> {
+  /**
+   * @generated from field: mootslive.v1.Listen listen = 1;
+   */
+  listen?: Listen;
+
+  constructor(data?: PartialMessage<UpdateListenResponse>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "mootslive.v1.GetStatusRequest";
+  static readonly typeName = "mootslive.v1.UpdateListenResponse";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStatusRequest;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateListenResponse;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStatusRequest;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateListenResponse;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStatusRequest;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateListenResponse;
 
-  static equals(a: GetStatusRequest | PlainMessage<GetStatusRequest> | undefined, b: GetStatusRequest | PlainMessage<GetStatusRequest> | undefined): boolean;
+  static equals(a: UpdateListenResponse | PlainMessage<UpdateListenResponse> | undefined, b: UpdateListenResponse | PlainMessage<UpdateListenResponse> | undefined): boolean;
 }
 
 /**
- * @generated from message mootslive.v1.GetStatusResponse
+ * @generated from message mootslive.v1.DeleteListenRequest
  */
-export declare class GetStatusResponse extends Message<GetStatusResponse> {
+export declare class DeleteListenRequest extends Message<DeleteListenRequest> {
   /**
-   * @generated from field: string x_clacks_overhead = 1;
+   * @generated from field: string listen_id = 1;
    */
-  xClacksOverhead: string;
+  listenId: string;
 
-  constructor(data?: PartialMessage<GetStatusResponse>);
+  constructor(data?: PartialMessage<DeleteListenRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "mootslive.v1.GetStatusResponse";
+  static readonly typeName = "mootslive.v1.DeleteListenRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStatusResponse;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteListenRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStatusResponse;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteListenRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStatusResponse;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteListenRequest;
 
-  static equals(a: GetStatusResponse | PlainMessage<GetStatusResponse> | undefined, b: GetStatusResponse | PlainMessage<GetStatusResponse> | undefined): boolean;
+  static equals(a: DeleteListenRequest | PlainMessage<DeleteListenRequest> | undefined, b: DeleteListenRequest | PlainMessage<DeleteListenRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message mootslive.v1.GetMeRequest
+ * @generated from message mootslive.v1.DeleteListenResponse
  */
-export declare class GetMeRequest extends Message<GetMeRequest> {
-  constructor(data?: PartialMessage<GetMeRequest>);
+export declare class DeleteListenResponse extends Message<DeleteListenResponse> {
+  constructor(data?: PartialMessage<DeleteListenResponse>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "mootslive.v1.GetMeRequest";
+  static readonly typeName = "mootslive.v1.DeleteListenResponse";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMeRequest;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteListenResponse;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMeRequest;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteListenResponse;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMeRequest;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteListenResponse;
 
-  static equals(a: GetMeRequest | PlainMessage<GetMeRequest> | undefined, b: GetMeRequest | PlainMessage<GetMeRequest> | undefined): boolean;
+  static equals(a: DeleteListenResponse | PlainMessage<DeleteListenResponse> | undefined, b: DeleteListenResponse | PlainMessage<DeleteListenResponse> | undefined): boolean;
 }
 
 /**
- * @generated from message mootslive.v1.GetMeResponse
+ * @generated from message mootslive.v1.DeleteListensRequest
  */
-export declare class GetMeResponse extends Message<GetMeResponse> {
+export declare class DeleteListensRequest extends Message<DeleteListensRequest> {
+  /**
+   * start_time and end_time are the range of listens to delete. end_time is
+   * excluded.
+   *
+   * @generated from field: google.protobuf.Timestamp start_time = 1;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 2;
+   */
+  endTime?: Timestamp;
+
+  constructor(data?: PartialMessage<DeleteListensRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.DeleteListensRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteListensRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteListensRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteListensRequest;
+
+  static equals(a: DeleteListensRequest | PlainMessage<DeleteListensRequest> | undefined, b: DeleteListensRequest | PlainMessage<DeleteListensRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message mootslive.v1.DeleteListensResponse
+ */
+export declare class DeleteListensResponse extends Message<DeleteListensResponse> {
+  /**
+   * @generated from field: int64 deleted = 1;
+   */
+  deleted: bigint;
+
+  constructor(data?: PartialMessage<DeleteListensResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.DeleteListensResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteListensResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteListensResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteListensResponse;
+
+  static equals(a: DeleteListensResponse | PlainMessage<DeleteListensResponse> | undefined, b: DeleteListensResponse | PlainMessage<DeleteListensResponse> | undefined): boolean;
+}
+
+/**
+ * ListenEdit is a change a user made to their listens by hand. The old values
+ * are unset for a listen being created, and the new ones for a listen being
+ * deleted.
+ *
+ * @generated from message mootslive.v1.ListenEdit
+ */
+export declare class ListenEdit extends Message<ListenEdit> {
   /**
    * @generated from field: string id = 1;
    */
   id: string;
 
   /**
-   * @generated from field: google.protobuf.Timestamp created_at = 2;
+   * @generated from field: string listen_id = 2;
+   */
+  listenId: string;
+
+  /**
+   * @generated from field: mootslive.v1.ListenEditAction action = 3;
+   */
+  action: ListenEditAction;
+
+  /**
+   * @generated from field: string old_isrc = 4;
+   */
+  oldIsrc: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp old_listened_at = 5;
+   */
+  oldListenedAt?: Timestamp;
+
+  /**
+   * @generated from field: string new_isrc = 6;
+   */
+  newIsrc: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp new_listened_at = 7;
+   */
+  newListenedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
    */
   createdAt?: Timestamp;
 
-  constructor(data?: PartialMessage<GetMeResponse>);
+  constructor(data?: PartialMessage<ListenEdit>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "mootslive.v1.GetMeResponse";
+  static readonly typeName = "mootslive.v1.ListenEdit";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMeResponse;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListenEdit;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMeResponse;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListenEdit;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMeResponse;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListenEdit;
 
-  static equals(a: GetMeResponse | PlainMessage<GetMeResponse> | undefined, b: GetMeResponse | PlainMessage<GetMeResponse> | undefined): boolean;
+  static equals(a: ListenEdit | PlainMessage<ListenEdit> | undefined, b: ListenEdit | PlainMessage<ListenEdit> | undefined): boolean;
 }
 
 /**
- * OAuth2State contains bits of state we need the client to hold during the
- * OAuth2 3-legged flow. These values aren't something that need to be kept
- * secret from the client.
- *
- * @generated from message mootslive.v1.OAuth2State
+ * @generated from message mootslive.v1.ListListenEditsRequest
  */
-export declare class OAuth2State extends Message<OAuth2State> {
+export declare class ListListenEditsRequest extends Message<ListListenEditsRequest> {
   /**
-   * @generated from field: string state = 1;
+   * @generated from field: int32 page_size = 1;
    */
-  state: string;
+  pageSize: number;
 
   /**
-   * @generated from field: string pkce_code_verifier = 2;
+   * @generated from field: string page_token = 2;
    */
-  pkceCodeVerifier: string;
+  pageToken: string;
 
-  constructor(data?: PartialMessage<OAuth2State>);
+  constructor(data?: PartialMessage<ListListenEditsRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "mootslive.v1.OAuth2State";
+  static readonly typeName = "mootslive.v1.ListListenEditsRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OAuth2State;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListListenEditsRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OAuth2State;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListListenEditsRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OAuth2State;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListListenEditsRequest;
 
-  static equals(a: OAuth2State | PlainMessage<OAuth2State> | undefined, b: OAuth2State | PlainMessage<OAuth2State> | undefined): boolean;
+  static equals(a: ListListenEditsRequest | PlainMessage<ListListenEditsRequest> | undefined, b: ListListenEditsRequest | PlainMessage<ListListenEditsRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message mootslive.v1.BeginTwitterAuthRequest
+ * @generated from message mootslive.v1.ListListenEditsResponse
  */
-export declare class BeginTwitterAuthRequest extends Message<BeginTwitterAuthRequest> {
-  constructor(data?: PartialMessage<BeginTwitterAuthRequest>);
+export declare class ListListenEditsResponse extends Message<ListListenEditsResponse> {
+  /**
+   * @generated from field: repeated mootslive.v1.ListenEdit edits = 1;
+   */
+  edits: ListenEdit[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  constructor(data?: PartialMessage<ListListenEditsResponse>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "mootslive.v1.BeginTwitterAuthRequest";
+  static readonly typeName = "mootslive.v1.ListListenEditsResponse";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BeginTwitterAuthRequest;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListListenEditsResponse;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BeginTwitterAuthRequest;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListListenEditsResponse;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BeginTwitterAuthRequest;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListListenEditsResponse;
 
-  static equals(a: BeginTwitterAuthRequest | PlainMessage<BeginTwitterAuthRequest> | undefined, b: BeginTwitterAuthRequest | PlainMessage<BeginTwitterAuthRequest> | undefined): boolean;
+  static equals(a: ListListenEditsResponse | PlainMessage<ListListenEditsResponse> | undefined, b: ListListenEditsResponse | PlainMessage<ListListenEditsResponse> | undefined): boolean;
 }
 
 /**
- * @generated from message mootslive.v1.BeginTwitterAuthResponse
+ * @generated from message mootslive.v1.ExportListensRequest
  */
-export declare class BeginTwitterAuthResponse extends Message<BeginTwitterAuthResponse> {
+export declare class ExportListensRequest extends Message<ExportListensRequest> {
   /**
-   * @generated from field: string redirect_url = 1;
+   * @generated from field: mootslive.v1.ExportFormat format = 1;
    */
-  redirectUrl: string;
+  format: ExportFormat;
 
   /**
-   * @generated from field: mootslive.v1.OAuth2State state = 2;
+   * start_time and end_time limit the export to listens within them.
+   * end_time is excluded. Either can be left unset.
+   *
+   * @generated from field: google.protobuf.Timestamp start_time = 2;
    */
-  state?: OAuth2State;
+  startTime?: Timestamp;
 
-  constructor(data?: PartialMessage<BeginTwitterAuthResponse>);
+  /**
+   * @generated from field: google.protobuf.Timestamp end_time = 3;
+   */
+  endTime?: Timestamp;
+
+  constructor(data?: PartialMessage<ExportListensRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "mootslive.v1.BeginTwitterAuthResponse";
+  static readonly typeName = "mootslive.v1.ExportListensRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BeginTwitterAuthResponse;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportListensRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BeginTwitterAuthResponse;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportListensRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BeginTwitterAuthResponse;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportListensRequest;
 
-  static equals(a: BeginTwitterAuthResponse | PlainMessage<BeginTwitterAuthResponse> | undefined, b: BeginTwitterAuthResponse | PlainMessage<BeginTwitterAuthResponse> | undefined): boolean;
+  static equals(a: ExportListensRequest | PlainMessage<ExportListensRequest> | undefined, b: ExportListensRequest | PlainMessage<ExportListensRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message mootslive.v1.FinishTwitterAuthRequest
+ * ExportListensResponse carries a chunk of an export of the caller's listens.
+ * Joining the data of every message in the stream produces the file.
+ *
+ * @generated from message mootslive.v1.ExportListensResponse
  */
-export declare class FinishTwitterAuthRequest extends Message<FinishTwitterAuthRequest> {
+export declare class ExportListensResponse extends Message<ExportListensResponse> {
   /**
-   * @generated from field: mootslive.v1.OAuth2State state = 1;
+   * @generated from field: bytes data = 1;
    */
-  state?: OAuth2State;
+  data: Uint8Array;
 
   /**
-   * received_state is the state url parameter received during the callback to 
-   * the webapp from the relying party.
+   * content_type and filename are only set on the first message.
    *
-   * @generated from field: string received_state = 2;
+   * @generated from field: string content_type = 2;
    */
-  receivedState: string;
+  contentType: string;
 
   /**
-   * @generated from field: string received_code = 3;
+   * @generated from field: string filename = 3;
    */
-  receivedCode: string;
+  filename: string;
 
-  constructor(data?: PartialMessage<FinishTwitterAuthRequest>);
+  constructor(data?: PartialMessage<ExportListensResponse>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "mootslive.v1.FinishTwitterAuthRequest";
+  static readonly typeName = "mootslive.v1.ExportListensResponse";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FinishTwitterAuthRequest;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportListensResponse;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FinishTwitterAuthRequest;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportListensResponse;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FinishTwitterAuthRequest;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportListensResponse;
 
-  static equals(a: FinishTwitterAuthRequest | PlainMessage<FinishTwitterAuthRequest> | undefined, b: FinishTwitterAuthRequest | PlainMessage<FinishTwitterAuthRequest> | undefined): boolean;
+  static equals(a: ExportListensResponse | PlainMessage<ExportListensResponse> | undefined, b: ExportListensResponse | PlainMessage<ExportListensResponse> | undefined): boolean;
 }
 
 /**
- * @generated from message mootslive.v1.FinishTwitterAuthResponse
+ * @generated from message mootslive.v1.GetSyncStatusRequest
  */
-export declare class FinishTwitterAuthResponse extends Message<FinishTwitterAuthResponse> {
+export declare class GetSyncStatusRequest extends Message<GetSyncStatusRequest> {
+  constructor(data?: PartialMessage<GetSyncStatusRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetSyncStatusRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSyncStatusRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSyncStatusRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSyncStatusRequest;
+
+  static equals(a: GetSyncStatusRequest | PlainMessage<GetSyncStatusRequest> | undefined, b: GetSyncStatusRequest | PlainMessage<GetSyncStatusRequest> | undefined): boolean;
+}
+
+/**
+ * AccountSyncStatus is how up to date the listens synced from one of a user's
+ * linked accounts are.
+ *
+ * @generated from message mootslive.v1.AccountSyncStatus
+ */
+export declare class AccountSyncStatus extends Message<AccountSyncStatus> {
   /**
-   * @generated from field: string id_token = 1;
+   * @generated from field: mootslive.v1.Provider provider = 1;
    */
-  idToken: string;
+  provider: Provider;
 
-  constructor(data?: PartialMessage<FinishTwitterAuthResponse>);
+  /**
+   * @generated from field: string provider_user_id = 2;
+   */
+  providerUserId: string;
+
+  /**
+   * last_synced_at is when the account was last synced successfully. It's
+   * unset if it never has been.
+   *
+   * @generated from field: google.protobuf.Timestamp last_synced_at = 3;
+   */
+  lastSyncedAt?: Timestamp;
+
+  /**
+   * last_listened_at is the time of the most recent listen synced from the
+   * account.
+   *
+   * @generated from field: google.protobuf.Timestamp last_listened_at = 4;
+   */
+  lastListenedAt?: Timestamp;
+
+  /**
+   * failing is set when the latest attempt to sync the account failed.
+   *
+   * @generated from field: bool failing = 5;
+   */
+  failing: boolean;
+
+  constructor(data?: PartialMessage<AccountSyncStatus>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "mootslive.v1.FinishTwitterAuthResponse";
+  static readonly typeName = "mootslive.v1.AccountSyncStatus";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FinishTwitterAuthResponse;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountSyncStatus;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FinishTwitterAuthResponse;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AccountSyncStatus;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FinishTwitterAuthResponse;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AccountSyncStatus;
 
-  static equals(a: FinishTwitterAuthResponse | PlainMessage<FinishTwitterAuthResponse> | undefined, b: FinishTwitterAuthResponse | PlainMessage<FinishTwitterAuthResponse> | undefined): boolean;
+  static equals(a: AccountSyncStatus | PlainMessage<AccountSyncStatus> | undefined, b: AccountSyncStatus | PlainMessage<AccountSyncStatus> | undefined): boolean;
 }
 
 /**
- * @generated from message mootslive.v1.Listen
+ * @generated from message mootslive.v1.GetSyncStatusResponse
  */
-export declare class Listen extends Message<Listen> {
+export declare class GetSyncStatusResponse extends Message<GetSyncStatusResponse> {
+  /**
+   * @generated from field: repeated mootslive.v1.AccountSyncStatus accounts = 1;
+   */
+  accounts: AccountSyncStatus[];
+
+  constructor(data?: PartialMessage<GetSyncStatusResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "mootslive.v1.GetSyncStatusResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSyncStatusResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSyncStatusResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSyncStatusResponse;
+
+  static equals(a: GetSyncStatusResponse | PlainMessage<GetSyncStatusResponse> | undefined, b: GetSyncStatusResponse | PlainMessage<GetSyncStatusResponse> | undefined): boolean;
+}
+
+/**
+ * SecurityEvent is a security relevant event affecting the caller's account.
+ *
+ * @generated from message mootslive.v1.SecurityEvent
+ */
+export declare class SecurityEvent extends Message<SecurityEvent> {
   /**
    * @generated from field: string id = 1;
    */
   id: string;
 
   /**
-   * @generated from field: google.protobuf.Timestamp created_at = 2;
+   * @generated from field: mootslive.v1.AuditEventKind kind = 2;
    */
-  createdAt?: Timestamp;
+  kind: AuditEventKind;
 
   /**
-   * @generated from field: string source = 3;
+   * by_admin is set for events caused by an admin rather than the user, in
+   * which case the client the admin used isn't shown.
+   *
+   * @generated from field: bool by_admin = 3;
    */
-  source: string;
+  byAdmin: boolean;
 
   /**
-   * @generated from field: string isrc = 4;
+   * @generated from field: mootslive.v1.Provider provider = 4;
    */
-  isrc: string;
+  provider: Provider;
 
   /**
-   * @generated from field: google.protobuf.Timestamp listened_at = 5;
+   * @generated from field: string provider_user_id = 5;
    */
-  listenedAt?: Timestamp;
+  providerUserId: string;
 
-  constructor(data?: PartialMessage<Listen>);
+  /**
+   * @generated from field: string ip_address = 6;
+   */
+  ipAddress: string;
+
+  /**
+   * @generated from field: string user_agent = 7;
+   */
+  userAgent: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<SecurityEvent>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "mootslive.v1.Listen";
+  static readonly typeName = "mootslive.v1.SecurityEvent";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Listen;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SecurityEvent;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Listen;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SecurityEvent;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Listen;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SecurityEvent;
 
-  static equals(a: Listen | PlainMessage<Listen> | undefined, b: Listen | PlainMessage<Listen> | undefined): boolean;
+  static equals(a: SecurityEvent | PlainMessage<SecurityEvent> | undefined, b: SecurityEvent | PlainMessage<SecurityEvent> | undefined): boolean;
 }
 
 /**
- * @generated from message mootslive.v1.ListListensRequest
+ * @generated from message mootslive.v1.ListMySecurityEventsRequest
  */
-export declare class ListListensRequest extends Message<ListListensRequest> {
-  constructor(data?: PartialMessage<ListListensRequest>);
+export declare class ListMySecurityEventsRequest extends Message<ListMySecurityEventsRequest> {
+  /**
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  constructor(data?: PartialMessage<ListMySecurityEventsRequest>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "mootslive.v1.ListListensRequest";
+  static readonly typeName = "mootslive.v1.ListMySecurityEventsRequest";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListListensRequest;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMySecurityEventsRequest;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListListensRequest;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMySecurityEventsRequest;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListListensRequest;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMySecurityEventsRequest;
 
-  static equals(a: ListListensRequest | PlainMessage<ListListensRequest> | undefined, b: ListListensRequest | PlainMessage<ListListensRequest> | undefined): boolean;
+  static equals(a: ListMySecurityEventsRequest | PlainMessage<ListMySecurityEventsRequest> | undefined, b: ListMySecurityEventsRequest | PlainMessage<ListMySecurityEventsRequest> | undefined): boolean;
 }
 
 /**
- * @generated from message mootslive.v1.ListListensResponse
+ * @generated from message mootslive.v1.ListMySecurityEventsResponse
  */
-export declare class ListListensResponse extends Message<ListListensResponse> {
+export declare class ListMySecurityEventsResponse extends Message<ListMySecurityEventsResponse> {
   /**
-   * @generated from field: repeated mootslive.v1.Listen listens = 1;
+   * @generated from field: repeated mootslive.v1.SecurityEvent events = 1;
    */
-  listens: Listen[];
+  events: SecurityEvent[];
 
-  constructor(data?: PartialMessage<ListListensResponse>);
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  constructor(data?: PartialMessage<ListMySecurityEventsResponse>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "mootslive.v1.ListListensResponse";
+  static readonly typeName = "mootslive.v1.ListMySecurityEventsResponse";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListListensResponse;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMySecurityEventsResponse;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListListensResponse;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListMySecurityEventsResponse;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListListensResponse;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListMySecurityEventsResponse;
 
-  static equals(a: ListListensResponse | PlainMessage<ListListensResponse> | undefined, b: ListListensResponse | PlainMessage<ListListensResponse> | undefined): boolean;
+  static equals(a: ListMySecurityEventsResponse | PlainMessage<ListMySecurityEventsResponse> | undefined, b: ListMySecurityEventsResponse | PlainMessage<ListMySecurityEventsResponse> | undefined): boolean;
 }
 
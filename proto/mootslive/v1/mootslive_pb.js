@@ -3,7 +3,210 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { proto3, Timestamp } from "@bufbuild/protobuf";
+import { proto3, Duration, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum mootslive.v1.SpotifyScanResult
+ */
+export const SpotifyScanResult = proto3.makeEnum(
+  "mootslive.v1.SpotifyScanResult",
+  [
+    {no: 0, name: "SPOTIFY_SCAN_RESULT_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "SPOTIFY_SCAN_RESULT_SUCCEEDED", localName: "SUCCEEDED"},
+    {no: 2, name: "SPOTIFY_SCAN_RESULT_FAILED", localName: "FAILED"},
+  ],
+);
+
+/**
+ * @generated from enum mootslive.v1.AuditEventKind
+ */
+export const AuditEventKind = proto3.makeEnum(
+  "mootslive.v1.AuditEventKind",
+  [
+    {no: 0, name: "AUDIT_EVENT_KIND_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "AUDIT_EVENT_KIND_SIGNUP", localName: "SIGNUP"},
+    {no: 2, name: "AUDIT_EVENT_KIND_LOGIN", localName: "LOGIN"},
+    {no: 3, name: "AUDIT_EVENT_KIND_TOKEN_ISSUED", localName: "TOKEN_ISSUED"},
+    {no: 4, name: "AUDIT_EVENT_KIND_SESSIONS_REVOKED", localName: "SESSIONS_REVOKED"},
+    {no: 5, name: "AUDIT_EVENT_KIND_PROVIDER_LINKED", localName: "PROVIDER_LINKED"},
+    {no: 6, name: "AUDIT_EVENT_KIND_PROVIDER_UNLINKED", localName: "PROVIDER_UNLINKED"},
+    {no: 7, name: "AUDIT_EVENT_KIND_ACCOUNT_DELETION_REQUESTED", localName: "ACCOUNT_DELETION_REQUESTED"},
+    {no: 8, name: "AUDIT_EVENT_KIND_ACCOUNT_DELETION_CANCELLED", localName: "ACCOUNT_DELETION_CANCELLED"},
+    {no: 9, name: "AUDIT_EVENT_KIND_ACCOUNT_DELETED", localName: "ACCOUNT_DELETED"},
+    {no: 10, name: "AUDIT_EVENT_KIND_USER_DISABLED", localName: "USER_DISABLED"},
+    {no: 11, name: "AUDIT_EVENT_KIND_USER_ENABLED", localName: "USER_ENABLED"},
+    {no: 12, name: "AUDIT_EVENT_KIND_RESCAN_FORCED", localName: "RESCAN_FORCED"},
+    {no: 13, name: "AUDIT_EVENT_KIND_RECAP_GENERATED", localName: "RECAP_GENERATED"},
+  ],
+);
+
+/**
+ * @generated from enum mootslive.v1.Provider
+ */
+export const Provider = proto3.makeEnum(
+  "mootslive.v1.Provider",
+  [
+    {no: 0, name: "PROVIDER_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "PROVIDER_TWITTER", localName: "TWITTER"},
+    {no: 2, name: "PROVIDER_SPOTIFY", localName: "SPOTIFY"},
+  ],
+);
+
+/**
+ * @generated from enum mootslive.v1.ListenOrder
+ */
+export const ListenOrder = proto3.makeEnum(
+  "mootslive.v1.ListenOrder",
+  [
+    {no: 0, name: "LISTEN_ORDER_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "LISTEN_ORDER_NEWEST_FIRST", localName: "NEWEST_FIRST"},
+    {no: 2, name: "LISTEN_ORDER_OLDEST_FIRST", localName: "OLDEST_FIRST"},
+  ],
+);
+
+/**
+ * @generated from enum mootslive.v1.FollowStatus
+ */
+export const FollowStatus = proto3.makeEnum(
+  "mootslive.v1.FollowStatus",
+  [
+    {no: 0, name: "FOLLOW_STATUS_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "FOLLOW_STATUS_PENDING", localName: "PENDING"},
+    {no: 2, name: "FOLLOW_STATUS_ACCEPTED", localName: "ACCEPTED"},
+  ],
+);
+
+/**
+ * @generated from enum mootslive.v1.PresenceStatus
+ */
+export const PresenceStatus = proto3.makeEnum(
+  "mootslive.v1.PresenceStatus",
+  [
+    {no: 0, name: "PRESENCE_STATUS_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "PRESENCE_STATUS_IDLE", localName: "IDLE"},
+    {no: 2, name: "PRESENCE_STATUS_LISTENING", localName: "LISTENING"},
+  ],
+);
+
+/**
+ * TimeWindow is a period of time ending today.
+ *
+ * @generated from enum mootslive.v1.TimeWindow
+ */
+export const TimeWindow = proto3.makeEnum(
+  "mootslive.v1.TimeWindow",
+  [
+    {no: 0, name: "TIME_WINDOW_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "TIME_WINDOW_LAST_7_DAYS", localName: "LAST_7_DAYS"},
+    {no: 2, name: "TIME_WINDOW_LAST_30_DAYS", localName: "LAST_30_DAYS"},
+    {no: 3, name: "TIME_WINDOW_LAST_90_DAYS", localName: "LAST_90_DAYS"},
+    {no: 4, name: "TIME_WINDOW_LAST_365_DAYS", localName: "LAST_365_DAYS"},
+    {no: 5, name: "TIME_WINDOW_ALL_TIME", localName: "ALL_TIME"},
+    {no: 6, name: "TIME_WINDOW_CUSTOM", localName: "CUSTOM"},
+  ],
+);
+
+/**
+ * @generated from enum mootslive.v1.Visibility
+ */
+export const Visibility = proto3.makeEnum(
+  "mootslive.v1.Visibility",
+  [
+    {no: 0, name: "VISIBILITY_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "VISIBILITY_PUBLIC", localName: "PUBLIC"},
+    {no: 2, name: "VISIBILITY_MOOTS", localName: "MOOTS"},
+    {no: 3, name: "VISIBILITY_PRIVATE", localName: "PRIVATE"},
+  ],
+);
+
+/**
+ * @generated from enum mootslive.v1.NotificationKind
+ */
+export const NotificationKind = proto3.makeEnum(
+  "mootslive.v1.NotificationKind",
+  [
+    {no: 0, name: "NOTIFICATION_KIND_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "NOTIFICATION_KIND_NEW_FOLLOWER", localName: "NEW_FOLLOWER"},
+    {no: 2, name: "NOTIFICATION_KIND_FOLLOW_REQUEST", localName: "FOLLOW_REQUEST"},
+    {no: 3, name: "NOTIFICATION_KIND_FOLLOW_ACCEPTED", localName: "FOLLOW_ACCEPTED"},
+    {no: 4, name: "NOTIFICATION_KIND_REACTION", localName: "REACTION"},
+    {no: 5, name: "NOTIFICATION_KIND_COMMENT", localName: "COMMENT"},
+    {no: 6, name: "NOTIFICATION_KIND_SAME_SONG", localName: "SAME_SONG"},
+  ],
+);
+
+/**
+ * @generated from enum mootslive.v1.ChartScope
+ */
+export const ChartScope = proto3.makeEnum(
+  "mootslive.v1.ChartScope",
+  [
+    {no: 0, name: "CHART_SCOPE_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "CHART_SCOPE_GLOBAL", localName: "GLOBAL"},
+    {no: 2, name: "CHART_SCOPE_MOOTS", localName: "MOOTS"},
+  ],
+);
+
+/**
+ * ChartPeriod is the length of time a chart covers. Periods are in UTC, with
+ * weeks starting on Monday.
+ *
+ * @generated from enum mootslive.v1.ChartPeriod
+ */
+export const ChartPeriod = proto3.makeEnum(
+  "mootslive.v1.ChartPeriod",
+  [
+    {no: 0, name: "CHART_PERIOD_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "CHART_PERIOD_DAILY", localName: "DAILY"},
+    {no: 2, name: "CHART_PERIOD_WEEKLY", localName: "WEEKLY"},
+    {no: 3, name: "CHART_PERIOD_MONTHLY", localName: "MONTHLY"},
+  ],
+);
+
+/**
+ * ChartMovement is how an entry has moved since the chart of the previous
+ * period.
+ *
+ * @generated from enum mootslive.v1.ChartMovement
+ */
+export const ChartMovement = proto3.makeEnum(
+  "mootslive.v1.ChartMovement",
+  [
+    {no: 0, name: "CHART_MOVEMENT_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "CHART_MOVEMENT_UP", localName: "UP"},
+    {no: 2, name: "CHART_MOVEMENT_DOWN", localName: "DOWN"},
+    {no: 3, name: "CHART_MOVEMENT_NONE", localName: "NONE"},
+    {no: 4, name: "CHART_MOVEMENT_NEW", localName: "NEW"},
+    {no: 5, name: "CHART_MOVEMENT_RE_ENTRY", localName: "RE_ENTRY"},
+  ],
+);
+
+/**
+ * @generated from enum mootslive.v1.ListenEditAction
+ */
+export const ListenEditAction = proto3.makeEnum(
+  "mootslive.v1.ListenEditAction",
+  [
+    {no: 0, name: "LISTEN_EDIT_ACTION_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "LISTEN_EDIT_ACTION_CREATE", localName: "CREATE"},
+    {no: 2, name: "LISTEN_EDIT_ACTION_UPDATE", localName: "UPDATE"},
+    {no: 3, name: "LISTEN_EDIT_ACTION_DELETE", localName: "DELETE"},
+  ],
+);
+
+/**
+ * @generated from enum mootslive.v1.ExportFormat
+ */
+export const ExportFormat = proto3.makeEnum(
+  "mootslive.v1.ExportFormat",
+  [
+    {no: 0, name: "EXPORT_FORMAT_UNSPECIFIED", localName: "UNSPECIFIED"},
+    {no: 1, name: "EXPORT_FORMAT_CSV", localName: "CSV"},
+    {no: 2, name: "EXPORT_FORMAT_JSONL", localName: "JSONL"},
+    {no: 3, name: "EXPORT_FORMAT_LISTENBRAINZ", localName: "LISTENBRAINZ"},
+    {no: 4, name: "EXPORT_FORMAT_SCROBBLER_LOG", localName: "SCROBBLER_LOG"},
+  ],
+);
 
 /**
  * @generated from message mootslive.v1.GetStatusRequest
@@ -13,13 +216,321 @@ export const GetStatusRequest = proto3.makeMessageType(
   [],
 );
 
+/**
+ * @generated from message mootslive.v1.StatusCheck
+ */
+export const StatusCheck = proto3.makeMessageType(
+  "mootslive.v1.StatusCheck",
+  () => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "healthy", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
 /**
  * @generated from message mootslive.v1.GetStatusResponse
  */
 export const GetStatusResponse = proto3.makeMessageType(
   "mootslive.v1.GetStatusResponse",
   () => [
-    { no: 1, name: "x_clacks_overhead", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "healthy", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "checks", kind: "message", T: StatusCheck, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GenerateRecapRequest
+ */
+export const GenerateRecapRequest = proto3.makeMessageType(
+  "mootslive.v1.GenerateRecapRequest",
+  () => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "year", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GenerateRecapResponse
+ */
+export const GenerateRecapResponse = proto3.makeMessageType(
+  "mootslive.v1.GenerateRecapResponse",
+  () => [
+    { no: 1, name: "recap", kind: "message", T: YearlyRecap },
+    { no: 2, name: "version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * AdminUser is a user as seen by admins.
+ *
+ * @generated from message mootslive.v1.AdminUser
+ */
+export const AdminUser = proto3.makeMessageType(
+  "mootslive.v1.AdminUser",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "created_at", kind: "message", T: Timestamp },
+    { no: 3, name: "handle", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "is_admin", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "disabled_at", kind: "message", T: Timestamp },
+    { no: 7, name: "deletion_requested_at", kind: "message", T: Timestamp },
+    { no: 8, name: "sessions_revoked_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ListUsersRequest
+ */
+export const ListUsersRequest = proto3.makeMessageType(
+  "mootslive.v1.ListUsersRequest",
+  () => [
+    { no: 1, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ListUsersResponse
+ */
+export const ListUsersResponse = proto3.makeMessageType(
+  "mootslive.v1.ListUsersResponse",
+  () => [
+    { no: 1, name: "users", kind: "message", T: AdminUser, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetUserRequest
+ */
+export const GetUserRequest = proto3.makeMessageType(
+  "mootslive.v1.GetUserRequest",
+  () => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * SpotifyScan is a scan of a Spotify account for new listens by the poller.
+ *
+ * @generated from message mootslive.v1.SpotifyScan
+ */
+export const SpotifyScan = proto3.makeMessageType(
+  "mootslive.v1.SpotifyScan",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "replica", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "started_at", kind: "message", T: Timestamp },
+    { no: 4, name: "finished_at", kind: "message", T: Timestamp },
+    { no: 5, name: "result", kind: "enum", T: proto3.getEnumType(SpotifyScanResult) },
+    { no: 6, name: "listens_found", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * SpotifyAccountHealth describes how the poller is getting on with a linked
+ * Spotify account.
+ *
+ * @generated from message mootslive.v1.SpotifyAccountHealth
+ */
+export const SpotifyAccountHealth = proto3.makeMessageType(
+  "mootslive.v1.SpotifyAccountHealth",
+  () => [
+    { no: 1, name: "spotify_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "polled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "last_listened_at", kind: "message", T: Timestamp },
+    { no: 4, name: "last_scanned_at", kind: "message", T: Timestamp },
+    { no: 5, name: "last_succeeded_at", kind: "message", T: Timestamp },
+    { no: 6, name: "failure_streak", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "last_error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "lag", kind: "message", T: Duration },
+    { no: 9, name: "overdue", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "recent_scans", kind: "message", T: SpotifyScan, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetUserResponse
+ */
+export const GetUserResponse = proto3.makeMessageType(
+  "mootslive.v1.GetUserResponse",
+  () => [
+    { no: 1, name: "user", kind: "message", T: AdminUser },
+    { no: 2, name: "linked_accounts", kind: "message", T: LinkedAccount, repeated: true },
+    { no: 3, name: "spotify_accounts", kind: "message", T: SpotifyAccountHealth, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.DisableUserRequest
+ */
+export const DisableUserRequest = proto3.makeMessageType(
+  "mootslive.v1.DisableUserRequest",
+  () => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.DisableUserResponse
+ */
+export const DisableUserResponse = proto3.makeMessageType(
+  "mootslive.v1.DisableUserResponse",
+  () => [
+    { no: 1, name: "user", kind: "message", T: AdminUser },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.EnableUserRequest
+ */
+export const EnableUserRequest = proto3.makeMessageType(
+  "mootslive.v1.EnableUserRequest",
+  () => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.EnableUserResponse
+ */
+export const EnableUserResponse = proto3.makeMessageType(
+  "mootslive.v1.EnableUserResponse",
+  () => [
+    { no: 1, name: "user", kind: "message", T: AdminUser },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ForceRescanRequest
+ */
+export const ForceRescanRequest = proto3.makeMessageType(
+  "mootslive.v1.ForceRescanRequest",
+  () => [
+    { no: 1, name: "spotify_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ForceRescanResponse
+ */
+export const ForceRescanResponse = proto3.makeMessageType(
+  "mootslive.v1.ForceRescanResponse",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.RevokeUserSessionsRequest
+ */
+export const RevokeUserSessionsRequest = proto3.makeMessageType(
+  "mootslive.v1.RevokeUserSessionsRequest",
+  () => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.RevokeUserSessionsResponse
+ */
+export const RevokeUserSessionsResponse = proto3.makeMessageType(
+  "mootslive.v1.RevokeUserSessionsResponse",
+  () => [
+    { no: 1, name: "user", kind: "message", T: AdminUser },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetPollerStatusRequest
+ */
+export const GetPollerStatusRequest = proto3.makeMessageType(
+  "mootslive.v1.GetPollerStatusRequest",
+  () => [
+    { no: 1, name: "account_limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * PollerReplicaThroughput is how much scanning a replica has done over the
+ * throughput window.
+ *
+ * @generated from message mootslive.v1.PollerReplicaThroughput
+ */
+export const PollerReplicaThroughput = proto3.makeMessageType(
+  "mootslive.v1.PollerReplicaThroughput",
+  () => [
+    { no: 1, name: "replica", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "scans", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "failed_scans", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "listens_found", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "scans_per_minute", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetPollerStatusResponse
+ */
+export const GetPollerStatusResponse = proto3.makeMessageType(
+  "mootslive.v1.GetPollerStatusResponse",
+  () => [
+    { no: 1, name: "accounts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "overdue_accounts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "failing_accounts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "max_lag", kind: "message", T: Duration },
+    { no: 5, name: "lagging_accounts", kind: "message", T: SpotifyAccountHealth, repeated: true },
+    { no: 6, name: "replicas", kind: "message", T: PollerReplicaThroughput, repeated: true },
+    { no: 7, name: "throughput_window", kind: "message", T: Duration },
+  ],
+);
+
+/**
+ * AuditEvent is a security relevant event, as seen by admins.
+ *
+ * @generated from message mootslive.v1.AuditEvent
+ */
+export const AuditEvent = proto3.makeMessageType(
+  "mootslive.v1.AuditEvent",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "enum", T: proto3.getEnumType(AuditEventKind) },
+    { no: 3, name: "actor_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "target_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "provider", kind: "enum", T: proto3.getEnumType(Provider) },
+    { no: 6, name: "provider_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "ip_address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "user_agent", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "trace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "created_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ListAuditEventsRequest
+ */
+export const ListAuditEventsRequest = proto3.makeMessageType(
+  "mootslive.v1.ListAuditEventsRequest",
+  () => [
+    { no: 1, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "actor_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "target_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "kinds", kind: "enum", T: proto3.getEnumType(AuditEventKind), repeated: true },
+    { no: 6, name: "start_time", kind: "message", T: Timestamp },
+    { no: 7, name: "end_time", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ListAuditEventsResponse
+ */
+export const ListAuditEventsResponse = proto3.makeMessageType(
+  "mootslive.v1.ListAuditEventsResponse",
+  () => [
+    { no: 1, name: "events", kind: "message", T: AuditEvent, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -31,6 +542,37 @@ export const GetMeRequest = proto3.makeMessageType(
   [],
 );
 
+/**
+ * LinkedAccount is an account with a third party that a user has linked to
+ * their mootslive account.
+ *
+ * @generated from message mootslive.v1.LinkedAccount
+ */
+export const LinkedAccount = proto3.makeMessageType(
+  "mootslive.v1.LinkedAccount",
+  () => [
+    { no: 1, name: "provider", kind: "enum", T: proto3.getEnumType(Provider) },
+    { no: 2, name: "provider_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "linked_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.Profile
+ */
+export const Profile = proto3.makeMessageType(
+  "mootslive.v1.Profile",
+  () => [
+    { no: 1, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "handle", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "avatar_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "linked_accounts", kind: "message", T: LinkedAccount, repeated: true },
+    { no: 5, name: "bio", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
 /**
  * @generated from message mootslive.v1.GetMeResponse
  */
@@ -39,6 +581,7 @@ export const GetMeResponse = proto3.makeMessageType(
   () => [
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "created_at", kind: "message", T: Timestamp },
+    { no: 3, name: "profile", kind: "message", T: Profile },
   ],
 );
 
@@ -109,6 +652,21 @@ export const Listen = proto3.makeMessageType(
     { no: 3, name: "source", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "isrc", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "listened_at", kind: "message", T: Timestamp },
+    { no: 6, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "hidden", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "reactions", kind: "message", T: ReactionCount, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ReactionCount
+ */
+export const ReactionCount = proto3.makeMessageType(
+  "mootslive.v1.ReactionCount",
+  () => [
+    { no: 1, name: "emoji", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "reacted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ],
 );
 
@@ -117,7 +675,16 @@ export const Listen = proto3.makeMessageType(
  */
 export const ListListensRequest = proto3.makeMessageType(
   "mootslive.v1.ListListensRequest",
-  [],
+  () => [
+    { no: 1, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "start_time", kind: "message", T: Timestamp },
+    { no: 4, name: "end_time", kind: "message", T: Timestamp },
+    { no: 5, name: "source", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "isrc", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "artist_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "order", kind: "enum", T: proto3.getEnumType(ListenOrder) },
+  ],
 );
 
 /**
@@ -127,6 +694,1633 @@ export const ListListensResponse = proto3.makeMessageType(
   "mootslive.v1.ListListensResponse",
   () => [
     { no: 1, name: "listens", kind: "message", T: Listen, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ExportMyDataRequest
+ */
+export const ExportMyDataRequest = proto3.makeMessageType(
+  "mootslive.v1.ExportMyDataRequest",
+  [],
+);
+
+/**
+ * ExportMyDataResponse carries a chunk of the caller's data export. Joining
+ * the data of every message in the stream produces a single JSON document.
+ *
+ * @generated from message mootslive.v1.ExportMyDataResponse
+ */
+export const ExportMyDataResponse = proto3.makeMessageType(
+  "mootslive.v1.ExportMyDataResponse",
+  () => [
+    { no: 1, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.DeleteMyAccountRequest
+ */
+export const DeleteMyAccountRequest = proto3.makeMessageType(
+  "mootslive.v1.DeleteMyAccountRequest",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.DeleteMyAccountResponse
+ */
+export const DeleteMyAccountResponse = proto3.makeMessageType(
+  "mootslive.v1.DeleteMyAccountResponse",
+  () => [
+    { no: 1, name: "delete_time", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.CancelAccountDeletionRequest
+ */
+export const CancelAccountDeletionRequest = proto3.makeMessageType(
+  "mootslive.v1.CancelAccountDeletionRequest",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.CancelAccountDeletionResponse
+ */
+export const CancelAccountDeletionResponse = proto3.makeMessageType(
+  "mootslive.v1.CancelAccountDeletionResponse",
+  [],
+);
+
+/**
+ * TwitterSharingSettings controls what a user allows us to tweet on their
+ * behalf.
+ *
+ * @generated from message mootslive.v1.TwitterSharingSettings
+ */
+export const TwitterSharingSettings = proto3.makeMessageType(
+  "mootslive.v1.TwitterSharingSettings",
+  () => [
+    { no: 1, name: "listen_sharing_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "weekly_recap_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetTwitterSharingSettingsRequest
+ */
+export const GetTwitterSharingSettingsRequest = proto3.makeMessageType(
+  "mootslive.v1.GetTwitterSharingSettingsRequest",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.GetTwitterSharingSettingsResponse
+ */
+export const GetTwitterSharingSettingsResponse = proto3.makeMessageType(
+  "mootslive.v1.GetTwitterSharingSettingsResponse",
+  () => [
+    { no: 1, name: "settings", kind: "message", T: TwitterSharingSettings },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.UpdateTwitterSharingSettingsRequest
+ */
+export const UpdateTwitterSharingSettingsRequest = proto3.makeMessageType(
+  "mootslive.v1.UpdateTwitterSharingSettingsRequest",
+  () => [
+    { no: 1, name: "settings", kind: "message", T: TwitterSharingSettings },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.UpdateTwitterSharingSettingsResponse
+ */
+export const UpdateTwitterSharingSettingsResponse = proto3.makeMessageType(
+  "mootslive.v1.UpdateTwitterSharingSettingsResponse",
+  () => [
+    { no: 1, name: "settings", kind: "message", T: TwitterSharingSettings },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ShareListenRequest
+ */
+export const ShareListenRequest = proto3.makeMessageType(
+  "mootslive.v1.ShareListenRequest",
+  () => [
+    { no: 1, name: "listen_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ShareListenResponse
+ */
+export const ShareListenResponse = proto3.makeMessageType(
+  "mootslive.v1.ShareListenResponse",
+  () => [
+    { no: 1, name: "tweet_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * Moot is a mootslive user who the caller follows, and who follows the caller
+ * back, either on Twitter or on mootslive.
+ *
+ * @generated from message mootslive.v1.Moot
+ */
+export const Moot = proto3.makeMessageType(
+  "mootslive.v1.Moot",
+  () => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "twitter_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.SyncMootsRequest
+ */
+export const SyncMootsRequest = proto3.makeMessageType(
+  "mootslive.v1.SyncMootsRequest",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.SyncMootsResponse
+ */
+export const SyncMootsResponse = proto3.makeMessageType(
+  "mootslive.v1.SyncMootsResponse",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.ListMootsRequest
+ */
+export const ListMootsRequest = proto3.makeMessageType(
+  "mootslive.v1.ListMootsRequest",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.ListMootsResponse
+ */
+export const ListMootsResponse = proto3.makeMessageType(
+  "mootslive.v1.ListMootsResponse",
+  () => [
+    { no: 1, name: "moots", kind: "message", T: Moot, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.Follow
+ */
+export const Follow = proto3.makeMessageType(
+  "mootslive.v1.Follow",
+  () => [
+    { no: 1, name: "follower_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "followee_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "enum", T: proto3.getEnumType(FollowStatus) },
+    { no: 4, name: "created_at", kind: "message", T: Timestamp },
+    { no: 5, name: "accepted_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.FollowUserRequest
+ */
+export const FollowUserRequest = proto3.makeMessageType(
+  "mootslive.v1.FollowUserRequest",
+  () => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.FollowUserResponse
+ */
+export const FollowUserResponse = proto3.makeMessageType(
+  "mootslive.v1.FollowUserResponse",
+  () => [
+    { no: 1, name: "follow", kind: "message", T: Follow },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.UnfollowUserRequest
+ */
+export const UnfollowUserRequest = proto3.makeMessageType(
+  "mootslive.v1.UnfollowUserRequest",
+  () => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.UnfollowUserResponse
+ */
+export const UnfollowUserResponse = proto3.makeMessageType(
+  "mootslive.v1.UnfollowUserResponse",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.ListFollowersRequest
+ */
+export const ListFollowersRequest = proto3.makeMessageType(
+  "mootslive.v1.ListFollowersRequest",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.ListFollowersResponse
+ */
+export const ListFollowersResponse = proto3.makeMessageType(
+  "mootslive.v1.ListFollowersResponse",
+  () => [
+    { no: 1, name: "follows", kind: "message", T: Follow, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ListFollowingRequest
+ */
+export const ListFollowingRequest = proto3.makeMessageType(
+  "mootslive.v1.ListFollowingRequest",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.ListFollowingResponse
+ */
+export const ListFollowingResponse = proto3.makeMessageType(
+  "mootslive.v1.ListFollowingResponse",
+  () => [
+    { no: 1, name: "follows", kind: "message", T: Follow, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ListFollowRequestsRequest
+ */
+export const ListFollowRequestsRequest = proto3.makeMessageType(
+  "mootslive.v1.ListFollowRequestsRequest",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.ListFollowRequestsResponse
+ */
+export const ListFollowRequestsResponse = proto3.makeMessageType(
+  "mootslive.v1.ListFollowRequestsResponse",
+  () => [
+    { no: 1, name: "follows", kind: "message", T: Follow, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ApproveFollowRequestRequest
+ */
+export const ApproveFollowRequestRequest = proto3.makeMessageType(
+  "mootslive.v1.ApproveFollowRequestRequest",
+  () => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ApproveFollowRequestResponse
+ */
+export const ApproveFollowRequestResponse = proto3.makeMessageType(
+  "mootslive.v1.ApproveFollowRequestResponse",
+  () => [
+    { no: 1, name: "follow", kind: "message", T: Follow },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.DenyFollowRequestRequest
+ */
+export const DenyFollowRequestRequest = proto3.makeMessageType(
+  "mootslive.v1.DenyFollowRequestRequest",
+  () => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.DenyFollowRequestResponse
+ */
+export const DenyFollowRequestResponse = proto3.makeMessageType(
+  "mootslive.v1.DenyFollowRequestResponse",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.FollowSettings
+ */
+export const FollowSettings = proto3.makeMessageType(
+  "mootslive.v1.FollowSettings",
+  () => [
+    { no: 1, name: "require_approval", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetFollowSettingsRequest
+ */
+export const GetFollowSettingsRequest = proto3.makeMessageType(
+  "mootslive.v1.GetFollowSettingsRequest",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.GetFollowSettingsResponse
+ */
+export const GetFollowSettingsResponse = proto3.makeMessageType(
+  "mootslive.v1.GetFollowSettingsResponse",
+  () => [
+    { no: 1, name: "settings", kind: "message", T: FollowSettings },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.UpdateFollowSettingsRequest
+ */
+export const UpdateFollowSettingsRequest = proto3.makeMessageType(
+  "mootslive.v1.UpdateFollowSettingsRequest",
+  () => [
+    { no: 1, name: "settings", kind: "message", T: FollowSettings },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.UpdateFollowSettingsResponse
+ */
+export const UpdateFollowSettingsResponse = proto3.makeMessageType(
+  "mootslive.v1.UpdateFollowSettingsResponse",
+  () => [
+    { no: 1, name: "settings", kind: "message", T: FollowSettings },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.Artist
+ */
+export const Artist = proto3.makeMessageType(
+  "mootslive.v1.Artist",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.Album
+ */
+export const Album = proto3.makeMessageType(
+  "mootslive.v1.Album",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "image_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * Track is the metadata of a track, as provided by Spotify.
+ *
+ * @generated from message mootslive.v1.Track
+ */
+export const Track = proto3.makeMessageType(
+  "mootslive.v1.Track",
+  () => [
+    { no: 1, name: "isrc", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "artists", kind: "message", T: Artist, repeated: true },
+    { no: 4, name: "album", kind: "message", T: Album },
+    { no: 5, name: "duration_ms", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "spotify_track_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * UserSummary is the public profile of a user, as shown alongside their
+ * activity.
+ *
+ * @generated from message mootslive.v1.UserSummary
+ */
+export const UserSummary = proto3.makeMessageType(
+  "mootslive.v1.UserSummary",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "handle", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "avatar_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * FeedEntry is a listen, or a run of consecutive listens to the same album,
+ * by a user the caller follows.
+ *
+ * @generated from message mootslive.v1.FeedEntry
+ */
+export const FeedEntry = proto3.makeMessageType(
+  "mootslive.v1.FeedEntry",
+  () => [
+    { no: 1, name: "user", kind: "message", T: UserSummary },
+    { no: 2, name: "track", kind: "message", T: Track },
+    { no: 3, name: "listens", kind: "message", T: Listen, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ListFeedRequest
+ */
+export const ListFeedRequest = proto3.makeMessageType(
+  "mootslive.v1.ListFeedRequest",
+  () => [
+    { no: 1, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ListFeedResponse
+ */
+export const ListFeedResponse = proto3.makeMessageType(
+  "mootslive.v1.ListFeedResponse",
+  () => [
+    { no: 1, name: "entries", kind: "message", T: FeedEntry, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.Presence
+ */
+export const Presence = proto3.makeMessageType(
+  "mootslive.v1.Presence",
+  () => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "status", kind: "enum", T: proto3.getEnumType(PresenceStatus) },
+    { no: 3, name: "last_listened_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.WatchListensRequest
+ */
+export const WatchListensRequest = proto3.makeMessageType(
+  "mootslive.v1.WatchListensRequest",
+  () => [
+    { no: 1, name: "resume_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * NotificationsUpdate is sent when the caller's notifications change.
+ *
+ * @generated from message mootslive.v1.NotificationsUpdate
+ */
+export const NotificationsUpdate = proto3.makeMessageType(
+  "mootslive.v1.NotificationsUpdate",
+  () => [
+    { no: 1, name: "notifications", kind: "message", T: Notification, repeated: true },
+    { no: 2, name: "unread_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.WatchListensResponse
+ */
+export const WatchListensResponse = proto3.makeMessageType(
+  "mootslive.v1.WatchListensResponse",
+  () => [
+    { no: 1, name: "listen", kind: "message", T: Listen, oneof: "event" },
+    { no: 2, name: "presence", kind: "message", T: Presence, oneof: "event" },
+    { no: 4, name: "notifications", kind: "message", T: NotificationsUpdate, oneof: "event" },
+    { no: 3, name: "resume_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * TimeRange is a range of whole UTC days. start_time and end_time are each
+ * rounded down to the start of their day, and the day of end_time is
+ * excluded.
+ *
+ * @generated from message mootslive.v1.TimeRange
+ */
+export const TimeRange = proto3.makeMessageType(
+  "mootslive.v1.TimeRange",
+  () => [
+    { no: 1, name: "start_time", kind: "message", T: Timestamp },
+    { no: 2, name: "end_time", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetCompatibilityRequest
+ */
+export const GetCompatibilityRequest = proto3.makeMessageType(
+  "mootslive.v1.GetCompatibilityRequest",
+  () => [
+    { no: 1, name: "other_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "window", kind: "enum", T: proto3.getEnumType(TimeWindow) },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetCompatibilityResponse
+ */
+export const GetCompatibilityResponse = proto3.makeMessageType(
+  "mootslive.v1.GetCompatibilityResponse",
+  () => [
+    { no: 1, name: "score", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 2, name: "shared_tracks", kind: "message", T: Track, repeated: true },
+    { no: 3, name: "shared_artists", kind: "message", T: Artist, repeated: true },
+    { no: 4, name: "computed_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.PrivacySettings
+ */
+export const PrivacySettings = proto3.makeMessageType(
+  "mootslive.v1.PrivacySettings",
+  () => [
+    { no: 1, name: "visibility", kind: "enum", T: proto3.getEnumType(Visibility) },
+    { no: 2, name: "hidden_sources", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetPrivacySettingsRequest
+ */
+export const GetPrivacySettingsRequest = proto3.makeMessageType(
+  "mootslive.v1.GetPrivacySettingsRequest",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.GetPrivacySettingsResponse
+ */
+export const GetPrivacySettingsResponse = proto3.makeMessageType(
+  "mootslive.v1.GetPrivacySettingsResponse",
+  () => [
+    { no: 1, name: "settings", kind: "message", T: PrivacySettings },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.UpdatePrivacySettingsRequest
+ */
+export const UpdatePrivacySettingsRequest = proto3.makeMessageType(
+  "mootslive.v1.UpdatePrivacySettingsRequest",
+  () => [
+    { no: 1, name: "settings", kind: "message", T: PrivacySettings },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.UpdatePrivacySettingsResponse
+ */
+export const UpdatePrivacySettingsResponse = proto3.makeMessageType(
+  "mootslive.v1.UpdatePrivacySettingsResponse",
+  () => [
+    { no: 1, name: "settings", kind: "message", T: PrivacySettings },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.SetListenHiddenRequest
+ */
+export const SetListenHiddenRequest = proto3.makeMessageType(
+  "mootslive.v1.SetListenHiddenRequest",
+  () => [
+    { no: 1, name: "listen_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "hidden", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.SetListenHiddenResponse
+ */
+export const SetListenHiddenResponse = proto3.makeMessageType(
+  "mootslive.v1.SetListenHiddenResponse",
+  () => [
+    { no: 1, name: "listen", kind: "message", T: Listen },
+  ],
+);
+
+/**
+ * IncognitoWindow is a period of time during which a user's listens are
+ * hidden from everyone else.
+ *
+ * @generated from message mootslive.v1.IncognitoWindow
+ */
+export const IncognitoWindow = proto3.makeMessageType(
+  "mootslive.v1.IncognitoWindow",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "start_time", kind: "message", T: Timestamp },
+    { no: 3, name: "end_time", kind: "message", T: Timestamp },
+    { no: 4, name: "created_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.CreateIncognitoWindowRequest
+ */
+export const CreateIncognitoWindowRequest = proto3.makeMessageType(
+  "mootslive.v1.CreateIncognitoWindowRequest",
+  () => [
+    { no: 1, name: "start_time", kind: "message", T: Timestamp },
+    { no: 2, name: "end_time", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.CreateIncognitoWindowResponse
+ */
+export const CreateIncognitoWindowResponse = proto3.makeMessageType(
+  "mootslive.v1.CreateIncognitoWindowResponse",
+  () => [
+    { no: 1, name: "window", kind: "message", T: IncognitoWindow },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ListIncognitoWindowsRequest
+ */
+export const ListIncognitoWindowsRequest = proto3.makeMessageType(
+  "mootslive.v1.ListIncognitoWindowsRequest",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.ListIncognitoWindowsResponse
+ */
+export const ListIncognitoWindowsResponse = proto3.makeMessageType(
+  "mootslive.v1.ListIncognitoWindowsResponse",
+  () => [
+    { no: 1, name: "windows", kind: "message", T: IncognitoWindow, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.DeleteIncognitoWindowRequest
+ */
+export const DeleteIncognitoWindowRequest = proto3.makeMessageType(
+  "mootslive.v1.DeleteIncognitoWindowRequest",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.DeleteIncognitoWindowResponse
+ */
+export const DeleteIncognitoWindowResponse = proto3.makeMessageType(
+  "mootslive.v1.DeleteIncognitoWindowResponse",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.AddReactionRequest
+ */
+export const AddReactionRequest = proto3.makeMessageType(
+  "mootslive.v1.AddReactionRequest",
+  () => [
+    { no: 1, name: "listen_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "emoji", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.AddReactionResponse
+ */
+export const AddReactionResponse = proto3.makeMessageType(
+  "mootslive.v1.AddReactionResponse",
+  () => [
+    { no: 1, name: "reactions", kind: "message", T: ReactionCount, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.RemoveReactionRequest
+ */
+export const RemoveReactionRequest = proto3.makeMessageType(
+  "mootslive.v1.RemoveReactionRequest",
+  () => [
+    { no: 1, name: "listen_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "emoji", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.RemoveReactionResponse
+ */
+export const RemoveReactionResponse = proto3.makeMessageType(
+  "mootslive.v1.RemoveReactionResponse",
+  () => [
+    { no: 1, name: "reactions", kind: "message", T: ReactionCount, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.Comment
+ */
+export const Comment = proto3.makeMessageType(
+  "mootslive.v1.Comment",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "listen_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "author", kind: "message", T: UserSummary },
+    { no: 4, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "created_at", kind: "message", T: Timestamp },
+    { no: 6, name: "hidden", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.AddCommentRequest
+ */
+export const AddCommentRequest = proto3.makeMessageType(
+  "mootslive.v1.AddCommentRequest",
+  () => [
+    { no: 1, name: "listen_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.AddCommentResponse
+ */
+export const AddCommentResponse = proto3.makeMessageType(
+  "mootslive.v1.AddCommentResponse",
+  () => [
+    { no: 1, name: "comment", kind: "message", T: Comment },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.DeleteCommentRequest
+ */
+export const DeleteCommentRequest = proto3.makeMessageType(
+  "mootslive.v1.DeleteCommentRequest",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.DeleteCommentResponse
+ */
+export const DeleteCommentResponse = proto3.makeMessageType(
+  "mootslive.v1.DeleteCommentResponse",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.ListCommentsRequest
+ */
+export const ListCommentsRequest = proto3.makeMessageType(
+  "mootslive.v1.ListCommentsRequest",
+  () => [
+    { no: 1, name: "listen_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ListCommentsResponse
+ */
+export const ListCommentsResponse = proto3.makeMessageType(
+  "mootslive.v1.ListCommentsResponse",
+  () => [
+    { no: 1, name: "comments", kind: "message", T: Comment, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.FlagCommentRequest
+ */
+export const FlagCommentRequest = proto3.makeMessageType(
+  "mootslive.v1.FlagCommentRequest",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.FlagCommentResponse
+ */
+export const FlagCommentResponse = proto3.makeMessageType(
+  "mootslive.v1.FlagCommentResponse",
+  [],
+);
+
+/**
+ * Notification tells a user that something concerning them has happened.
+ * Unread notifications of the same kind and target are grouped together.
+ *
+ * @generated from message mootslive.v1.Notification
+ */
+export const Notification = proto3.makeMessageType(
+  "mootslive.v1.Notification",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "enum", T: proto3.getEnumType(NotificationKind) },
+    { no: 3, name: "target_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "actors", kind: "message", T: UserSummary, repeated: true },
+    { no: 5, name: "actor_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "created_at", kind: "message", T: Timestamp },
+    { no: 7, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 8, name: "read", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ListNotificationsRequest
+ */
+export const ListNotificationsRequest = proto3.makeMessageType(
+  "mootslive.v1.ListNotificationsRequest",
+  () => [
+    { no: 1, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ListNotificationsResponse
+ */
+export const ListNotificationsResponse = proto3.makeMessageType(
+  "mootslive.v1.ListNotificationsResponse",
+  () => [
+    { no: 1, name: "notifications", kind: "message", T: Notification, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "unread_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.MarkNotificationsReadRequest
+ */
+export const MarkNotificationsReadRequest = proto3.makeMessageType(
+  "mootslive.v1.MarkNotificationsReadRequest",
+  () => [
+    { no: 1, name: "ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "all_updated_before", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.MarkNotificationsReadResponse
+ */
+export const MarkNotificationsReadResponse = proto3.makeMessageType(
+  "mootslive.v1.MarkNotificationsReadResponse",
+  () => [
+    { no: 1, name: "unread_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.NotificationPreference
+ */
+export const NotificationPreference = proto3.makeMessageType(
+  "mootslive.v1.NotificationPreference",
+  () => [
+    { no: 1, name: "kind", kind: "enum", T: proto3.getEnumType(NotificationKind) },
+    { no: 2, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetNotificationPreferencesRequest
+ */
+export const GetNotificationPreferencesRequest = proto3.makeMessageType(
+  "mootslive.v1.GetNotificationPreferencesRequest",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.GetNotificationPreferencesResponse
+ */
+export const GetNotificationPreferencesResponse = proto3.makeMessageType(
+  "mootslive.v1.GetNotificationPreferencesResponse",
+  () => [
+    { no: 1, name: "preferences", kind: "message", T: NotificationPreference, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.UpdateNotificationPreferencesRequest
+ */
+export const UpdateNotificationPreferencesRequest = proto3.makeMessageType(
+  "mootslive.v1.UpdateNotificationPreferencesRequest",
+  () => [
+    { no: 1, name: "preferences", kind: "message", T: NotificationPreference, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.UpdateNotificationPreferencesResponse
+ */
+export const UpdateNotificationPreferencesResponse = proto3.makeMessageType(
+  "mootslive.v1.UpdateNotificationPreferencesResponse",
+  () => [
+    { no: 1, name: "preferences", kind: "message", T: NotificationPreference, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.UpdateProfileRequest
+ */
+export const UpdateProfileRequest = proto3.makeMessageType(
+  "mootslive.v1.UpdateProfileRequest",
+  () => [
+    { no: 1, name: "handle", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "bio", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.UpdateProfileResponse
+ */
+export const UpdateProfileResponse = proto3.makeMessageType(
+  "mootslive.v1.UpdateProfileResponse",
+  () => [
+    { no: 1, name: "profile", kind: "message", T: Profile },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.TopArtist
+ */
+export const TopArtist = proto3.makeMessageType(
+  "mootslive.v1.TopArtist",
+  () => [
+    { no: 1, name: "artist", kind: "message", T: Artist },
+    { no: 2, name: "plays", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.RecentListen
+ */
+export const RecentListen = proto3.makeMessageType(
+  "mootslive.v1.RecentListen",
+  () => [
+    { no: 1, name: "listen", kind: "message", T: Listen },
+    { no: 2, name: "track", kind: "message", T: Track },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetProfileRequest
+ */
+export const GetProfileRequest = proto3.makeMessageType(
+  "mootslive.v1.GetProfileRequest",
+  () => [
+    { no: 1, name: "handle", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetProfileResponse
+ */
+export const GetProfileResponse = proto3.makeMessageType(
+  "mootslive.v1.GetProfileResponse",
+  () => [
+    { no: 1, name: "user", kind: "message", T: UserSummary },
+    { no: 2, name: "bio", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "joined_at", kind: "message", T: Timestamp },
+    { no: 4, name: "listens_visible", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "recent_listens", kind: "message", T: RecentListen, repeated: true },
+    { no: 6, name: "top_artists", kind: "message", T: TopArtist, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.BlockUserRequest
+ */
+export const BlockUserRequest = proto3.makeMessageType(
+  "mootslive.v1.BlockUserRequest",
+  () => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.BlockUserResponse
+ */
+export const BlockUserResponse = proto3.makeMessageType(
+  "mootslive.v1.BlockUserResponse",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.UnblockUserRequest
+ */
+export const UnblockUserRequest = proto3.makeMessageType(
+  "mootslive.v1.UnblockUserRequest",
+  () => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.UnblockUserResponse
+ */
+export const UnblockUserResponse = proto3.makeMessageType(
+  "mootslive.v1.UnblockUserResponse",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.ListBlockedUsersRequest
+ */
+export const ListBlockedUsersRequest = proto3.makeMessageType(
+  "mootslive.v1.ListBlockedUsersRequest",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.ListBlockedUsersResponse
+ */
+export const ListBlockedUsersResponse = proto3.makeMessageType(
+  "mootslive.v1.ListBlockedUsersResponse",
+  () => [
+    { no: 1, name: "users", kind: "message", T: UserSummary, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.MuteUserRequest
+ */
+export const MuteUserRequest = proto3.makeMessageType(
+  "mootslive.v1.MuteUserRequest",
+  () => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.MuteUserResponse
+ */
+export const MuteUserResponse = proto3.makeMessageType(
+  "mootslive.v1.MuteUserResponse",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.UnmuteUserRequest
+ */
+export const UnmuteUserRequest = proto3.makeMessageType(
+  "mootslive.v1.UnmuteUserRequest",
+  () => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.UnmuteUserResponse
+ */
+export const UnmuteUserResponse = proto3.makeMessageType(
+  "mootslive.v1.UnmuteUserResponse",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.ListMutedUsersRequest
+ */
+export const ListMutedUsersRequest = proto3.makeMessageType(
+  "mootslive.v1.ListMutedUsersRequest",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.ListMutedUsersResponse
+ */
+export const ListMutedUsersResponse = proto3.makeMessageType(
+  "mootslive.v1.ListMutedUsersResponse",
+  () => [
+    { no: 1, name: "users", kind: "message", T: UserSummary, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.TopTrack
+ */
+export const TopTrack = proto3.makeMessageType(
+  "mootslive.v1.TopTrack",
+  () => [
+    { no: 1, name: "track", kind: "message", T: Track },
+    { no: 2, name: "plays", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.TopAlbum
+ */
+export const TopAlbum = proto3.makeMessageType(
+  "mootslive.v1.TopAlbum",
+  () => [
+    { no: 1, name: "album", kind: "message", T: Album },
+    { no: 2, name: "plays", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetTopTracksRequest
+ */
+export const GetTopTracksRequest = proto3.makeMessageType(
+  "mootslive.v1.GetTopTracksRequest",
+  () => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "window", kind: "enum", T: proto3.getEnumType(TimeWindow) },
+    { no: 3, name: "custom_range", kind: "message", T: TimeRange },
+    { no: 4, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetTopTracksResponse
+ */
+export const GetTopTracksResponse = proto3.makeMessageType(
+  "mootslive.v1.GetTopTracksResponse",
+  () => [
+    { no: 1, name: "tracks", kind: "message", T: TopTrack, repeated: true },
+    { no: 2, name: "range", kind: "message", T: TimeRange },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetTopArtistsRequest
+ */
+export const GetTopArtistsRequest = proto3.makeMessageType(
+  "mootslive.v1.GetTopArtistsRequest",
+  () => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "window", kind: "enum", T: proto3.getEnumType(TimeWindow) },
+    { no: 3, name: "custom_range", kind: "message", T: TimeRange },
+    { no: 4, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetTopArtistsResponse
+ */
+export const GetTopArtistsResponse = proto3.makeMessageType(
+  "mootslive.v1.GetTopArtistsResponse",
+  () => [
+    { no: 1, name: "artists", kind: "message", T: TopArtist, repeated: true },
+    { no: 2, name: "range", kind: "message", T: TimeRange },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetTopAlbumsRequest
+ */
+export const GetTopAlbumsRequest = proto3.makeMessageType(
+  "mootslive.v1.GetTopAlbumsRequest",
+  () => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "window", kind: "enum", T: proto3.getEnumType(TimeWindow) },
+    { no: 3, name: "custom_range", kind: "message", T: TimeRange },
+    { no: 4, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetTopAlbumsResponse
+ */
+export const GetTopAlbumsResponse = proto3.makeMessageType(
+  "mootslive.v1.GetTopAlbumsResponse",
+  () => [
+    { no: 1, name: "albums", kind: "message", T: TopAlbum, repeated: true },
+    { no: 2, name: "range", kind: "message", T: TimeRange },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.HeatmapCell
+ */
+export const HeatmapCell = proto3.makeMessageType(
+  "mootslive.v1.HeatmapCell",
+  () => [
+    { no: 1, name: "day_of_week", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "hour", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "plays", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.DailyPlays
+ */
+export const DailyPlays = proto3.makeMessageType(
+  "mootslive.v1.DailyPlays",
+  () => [
+    { no: 1, name: "date", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "plays", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetListeningStatsRequest
+ */
+export const GetListeningStatsRequest = proto3.makeMessageType(
+  "mootslive.v1.GetListeningStatsRequest",
+  () => [
+    { no: 1, name: "window", kind: "enum", T: proto3.getEnumType(TimeWindow) },
+    { no: 2, name: "custom_range", kind: "message", T: TimeRange },
+  ],
+);
+
+/**
+ * GetListeningStatsResponse describes the caller's listening over a window,
+ * in their time zone.
+ *
+ * @generated from message mootslive.v1.GetListeningStatsResponse
+ */
+export const GetListeningStatsResponse = proto3.makeMessageType(
+  "mootslive.v1.GetListeningStatsResponse",
+  () => [
+    { no: 1, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "range", kind: "message", T: TimeRange },
+    { no: 3, name: "heatmap", kind: "message", T: HeatmapCell, repeated: true },
+    { no: 4, name: "daily_plays", kind: "message", T: DailyPlays, repeated: true },
+    { no: 5, name: "current_streak_days", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "longest_streak_days", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "plays", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "estimated_listening_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "new_track_plays", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "repeat_plays", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.LoopedTrack
+ */
+export const LoopedTrack = proto3.makeMessageType(
+  "mootslive.v1.LoopedTrack",
+  () => [
+    { no: 1, name: "track", kind: "message", T: Track },
+    { no: 2, name: "plays", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "started_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * MootsComparison compares a user's year with those of the moots whose
+ * listens they can see.
+ *
+ * @generated from message mootslive.v1.MootsComparison
+ */
+export const MootsComparison = proto3.makeMessageType(
+  "mootslive.v1.MootsComparison",
+  () => [
+    { no: 1, name: "moots_compared", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "rank", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "percentile", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "top_artist_moots", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * YearlyRecap is a snapshot of a user's listening over a year in their time
+ * zone, taken once the year is over.
+ *
+ * @generated from message mootslive.v1.YearlyRecap
+ */
+export const YearlyRecap = proto3.makeMessageType(
+  "mootslive.v1.YearlyRecap",
+  () => [
+    { no: 1, name: "year", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "time_zone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "top_tracks", kind: "message", T: TopTrack, repeated: true },
+    { no: 4, name: "top_artists", kind: "message", T: TopArtist, repeated: true },
+    { no: 5, name: "plays", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "estimated_listening_minutes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "top_month", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "top_month_plays", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "most_looped", kind: "message", T: LoopedTrack },
+    { no: 10, name: "first_listen", kind: "message", T: RecentListen },
+    { no: 11, name: "moots", kind: "message", T: MootsComparison },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetRecapRequest
+ */
+export const GetRecapRequest = proto3.makeMessageType(
+  "mootslive.v1.GetRecapRequest",
+  () => [
+    { no: 1, name: "year", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetRecapResponse
+ */
+export const GetRecapResponse = proto3.makeMessageType(
+  "mootslive.v1.GetRecapResponse",
+  () => [
+    { no: 1, name: "recap", kind: "message", T: YearlyRecap },
+    { no: 2, name: "version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "generated_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ChartTrack
+ */
+export const ChartTrack = proto3.makeMessageType(
+  "mootslive.v1.ChartTrack",
+  () => [
+    { no: 1, name: "rank", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "track", kind: "message", T: Track },
+    { no: 3, name: "plays", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "listeners", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "previous_rank", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "movement", kind: "enum", T: proto3.getEnumType(ChartMovement) },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ChartArtist
+ */
+export const ChartArtist = proto3.makeMessageType(
+  "mootslive.v1.ChartArtist",
+  () => [
+    { no: 1, name: "rank", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "artist", kind: "message", T: Artist },
+    { no: 3, name: "plays", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "listeners", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "previous_rank", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "movement", kind: "enum", T: proto3.getEnumType(ChartMovement) },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetChartsRequest
+ */
+export const GetChartsRequest = proto3.makeMessageType(
+  "mootslive.v1.GetChartsRequest",
+  () => [
+    { no: 1, name: "scope", kind: "enum", T: proto3.getEnumType(ChartScope) },
+    { no: 2, name: "period", kind: "enum", T: proto3.getEnumType(ChartPeriod) },
+    { no: 3, name: "time", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetChartsResponse
+ */
+export const GetChartsResponse = proto3.makeMessageType(
+  "mootslive.v1.GetChartsResponse",
+  () => [
+    { no: 1, name: "scope", kind: "enum", T: proto3.getEnumType(ChartScope) },
+    { no: 2, name: "period", kind: "enum", T: proto3.getEnumType(ChartPeriod) },
+    { no: 3, name: "range", kind: "message", T: TimeRange },
+    { no: 4, name: "tracks", kind: "message", T: ChartTrack, repeated: true },
+    { no: 5, name: "artists", kind: "message", T: ChartArtist, repeated: true },
+    { no: 6, name: "computed_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.CreateListenRequest
+ */
+export const CreateListenRequest = proto3.makeMessageType(
+  "mootslive.v1.CreateListenRequest",
+  () => [
+    { no: 1, name: "isrc", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "listened_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.CreateListenResponse
+ */
+export const CreateListenResponse = proto3.makeMessageType(
+  "mootslive.v1.CreateListenResponse",
+  () => [
+    { no: 1, name: "listen", kind: "message", T: Listen },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.UpdateListenRequest
+ */
+export const UpdateListenRequest = proto3.makeMessageType(
+  "mootslive.v1.UpdateListenRequest",
+  () => [
+    { no: 1, name: "listen_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "isrc", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "listened_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.UpdateListenResponse
+ */
+export const UpdateListenResponse = proto3.makeMessageType(
+  "mootslive.v1.UpdateListenResponse",
+  () => [
+    { no: 1, name: "listen", kind: "message", T: Listen },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.DeleteListenRequest
+ */
+export const DeleteListenRequest = proto3.makeMessageType(
+  "mootslive.v1.DeleteListenRequest",
+  () => [
+    { no: 1, name: "listen_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.DeleteListenResponse
+ */
+export const DeleteListenResponse = proto3.makeMessageType(
+  "mootslive.v1.DeleteListenResponse",
+  [],
+);
+
+/**
+ * @generated from message mootslive.v1.DeleteListensRequest
+ */
+export const DeleteListensRequest = proto3.makeMessageType(
+  "mootslive.v1.DeleteListensRequest",
+  () => [
+    { no: 1, name: "start_time", kind: "message", T: Timestamp },
+    { no: 2, name: "end_time", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.DeleteListensResponse
+ */
+export const DeleteListensResponse = proto3.makeMessageType(
+  "mootslive.v1.DeleteListensResponse",
+  () => [
+    { no: 1, name: "deleted", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ],
+);
+
+/**
+ * ListenEdit is a change a user made to their listens by hand. The old values
+ * are unset for a listen being created, and the new ones for a listen being
+ * deleted.
+ *
+ * @generated from message mootslive.v1.ListenEdit
+ */
+export const ListenEdit = proto3.makeMessageType(
+  "mootslive.v1.ListenEdit",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "listen_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "action", kind: "enum", T: proto3.getEnumType(ListenEditAction) },
+    { no: 4, name: "old_isrc", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "old_listened_at", kind: "message", T: Timestamp },
+    { no: 6, name: "new_isrc", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "new_listened_at", kind: "message", T: Timestamp },
+    { no: 8, name: "created_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ListListenEditsRequest
+ */
+export const ListListenEditsRequest = proto3.makeMessageType(
+  "mootslive.v1.ListListenEditsRequest",
+  () => [
+    { no: 1, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ListListenEditsResponse
+ */
+export const ListListenEditsResponse = proto3.makeMessageType(
+  "mootslive.v1.ListListenEditsResponse",
+  () => [
+    { no: 1, name: "edits", kind: "message", T: ListenEdit, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ExportListensRequest
+ */
+export const ExportListensRequest = proto3.makeMessageType(
+  "mootslive.v1.ExportListensRequest",
+  () => [
+    { no: 1, name: "format", kind: "enum", T: proto3.getEnumType(ExportFormat) },
+    { no: 2, name: "start_time", kind: "message", T: Timestamp },
+    { no: 3, name: "end_time", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * ExportListensResponse carries a chunk of an export of the caller's listens.
+ * Joining the data of every message in the stream produces the file.
+ *
+ * @generated from message mootslive.v1.ExportListensResponse
+ */
+export const ExportListensResponse = proto3.makeMessageType(
+  "mootslive.v1.ExportListensResponse",
+  () => [
+    { no: 1, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "content_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "filename", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetSyncStatusRequest
+ */
+export const GetSyncStatusRequest = proto3.makeMessageType(
+  "mootslive.v1.GetSyncStatusRequest",
+  [],
+);
+
+/**
+ * AccountSyncStatus is how up to date the listens synced from one of a user's
+ * linked accounts are.
+ *
+ * @generated from message mootslive.v1.AccountSyncStatus
+ */
+export const AccountSyncStatus = proto3.makeMessageType(
+  "mootslive.v1.AccountSyncStatus",
+  () => [
+    { no: 1, name: "provider", kind: "enum", T: proto3.getEnumType(Provider) },
+    { no: 2, name: "provider_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "last_synced_at", kind: "message", T: Timestamp },
+    { no: 4, name: "last_listened_at", kind: "message", T: Timestamp },
+    { no: 5, name: "failing", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.GetSyncStatusResponse
+ */
+export const GetSyncStatusResponse = proto3.makeMessageType(
+  "mootslive.v1.GetSyncStatusResponse",
+  () => [
+    { no: 1, name: "accounts", kind: "message", T: AccountSyncStatus, repeated: true },
+  ],
+);
+
+/**
+ * SecurityEvent is a security relevant event affecting the caller's account.
+ *
+ * @generated from message mootslive.v1.SecurityEvent
+ */
+export const SecurityEvent = proto3.makeMessageType(
+  "mootslive.v1.SecurityEvent",
+  () => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "kind", kind: "enum", T: proto3.getEnumType(AuditEventKind) },
+    { no: 3, name: "by_admin", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "provider", kind: "enum", T: proto3.getEnumType(Provider) },
+    { no: 5, name: "provider_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "ip_address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "user_agent", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "created_at", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ListMySecurityEventsRequest
+ */
+export const ListMySecurityEventsRequest = proto3.makeMessageType(
+  "mootslive.v1.ListMySecurityEventsRequest",
+  () => [
+    { no: 1, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message mootslive.v1.ListMySecurityEventsResponse
+ */
+export const ListMySecurityEventsResponse = proto3.makeMessageType(
+  "mootslive.v1.ListMySecurityEventsResponse",
+  () => [
+    { no: 1, name: "events", kind: "message", T: SecurityEvent, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -30,6 +30,28 @@ const (
 // AdminServiceClient is a client for the mootslive.v1.AdminService service.
 type AdminServiceClient interface {
 	GetStatus(context.Context, *connect_go.Request[v1.GetStatusRequest]) (*connect_go.Response[v1.GetStatusResponse], error)
+	// GenerateRecap generates a new version of a user's recap of a year, which
+	// replaces any they already have.
+	GenerateRecap(context.Context, *connect_go.Request[v1.GenerateRecapRequest]) (*connect_go.Response[v1.GenerateRecapResponse], error)
+	// ListUsers lists users, most recently created first.
+	ListUsers(context.Context, *connect_go.Request[v1.ListUsersRequest]) (*connect_go.Response[v1.ListUsersResponse], error)
+	// GetUser gets a user along with the accounts they've linked.
+	GetUser(context.Context, *connect_go.Request[v1.GetUserRequest]) (*connect_go.Response[v1.GetUserResponse], error)
+	// DisableUser stops a user from using mootslive, and stops their listens
+	// being polled, until they're enabled again.
+	DisableUser(context.Context, *connect_go.Request[v1.DisableUserRequest]) (*connect_go.Response[v1.DisableUserResponse], error)
+	EnableUser(context.Context, *connect_go.Request[v1.EnableUserRequest]) (*connect_go.Response[v1.EnableUserResponse], error)
+	// ForceRescan scans a Spotify account for new listens immediately, rather
+	// than waiting for the poller to get to it.
+	ForceRescan(context.Context, *connect_go.Request[v1.ForceRescanRequest]) (*connect_go.Response[v1.ForceRescanResponse], error)
+	// RevokeUserSessions signs a user out everywhere, by refusing every token
+	// issued to them before now.
+	RevokeUserSessions(context.Context, *connect_go.Request[v1.RevokeUserSessionsRequest]) (*connect_go.Response[v1.RevokeUserSessionsResponse], error)
+	// GetPollerStatus reports how far behind the poller is, which accounts
+	// it's struggling with and how much scanning each replica is doing.
+	GetPollerStatus(context.Context, *connect_go.Request[v1.GetPollerStatusRequest]) (*connect_go.Response[v1.GetPollerStatusResponse], error)
+	// ListAuditEvents lists security relevant events, most recent first.
+	ListAuditEvents(context.Context, *connect_go.Request[v1.ListAuditEventsRequest]) (*connect_go.Response[v1.ListAuditEventsResponse], error)
 }
 
 // NewAdminServiceClient constructs a client for the mootslive.v1.AdminService service. By default,
@@ -47,12 +69,66 @@ func NewAdminServiceClient(httpClient connect_go.HTTPClient, baseURL string, opt
 			baseURL+"/mootslive.v1.AdminService/GetStatus",
 			opts...,
 		),
+		generateRecap: connect_go.NewClient[v1.GenerateRecapRequest, v1.GenerateRecapResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.AdminService/GenerateRecap",
+			opts...,
+		),
+		listUsers: connect_go.NewClient[v1.ListUsersRequest, v1.ListUsersResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.AdminService/ListUsers",
+			opts...,
+		),
+		getUser: connect_go.NewClient[v1.GetUserRequest, v1.GetUserResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.AdminService/GetUser",
+			opts...,
+		),
+		disableUser: connect_go.NewClient[v1.DisableUserRequest, v1.DisableUserResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.AdminService/DisableUser",
+			opts...,
+		),
+		enableUser: connect_go.NewClient[v1.EnableUserRequest, v1.EnableUserResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.AdminService/EnableUser",
+			opts...,
+		),
+		forceRescan: connect_go.NewClient[v1.ForceRescanRequest, v1.ForceRescanResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.AdminService/ForceRescan",
+			opts...,
+		),
+		revokeUserSessions: connect_go.NewClient[v1.RevokeUserSessionsRequest, v1.RevokeUserSessionsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.AdminService/RevokeUserSessions",
+			opts...,
+		),
+		getPollerStatus: connect_go.NewClient[v1.GetPollerStatusRequest, v1.GetPollerStatusResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.AdminService/GetPollerStatus",
+			opts...,
+		),
+		listAuditEvents: connect_go.NewClient[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.AdminService/ListAuditEvents",
+			opts...,
+		),
 	}
 }
 
 // adminServiceClient implements AdminServiceClient.
 type adminServiceClient struct {
-	getStatus *connect_go.Client[v1.GetStatusRequest, v1.GetStatusResponse]
+	getStatus          *connect_go.Client[v1.GetStatusRequest, v1.GetStatusResponse]
+	generateRecap      *connect_go.Client[v1.GenerateRecapRequest, v1.GenerateRecapResponse]
+	listUsers          *connect_go.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	getUser            *connect_go.Client[v1.GetUserRequest, v1.GetUserResponse]
+	disableUser        *connect_go.Client[v1.DisableUserRequest, v1.DisableUserResponse]
+	enableUser         *connect_go.Client[v1.EnableUserRequest, v1.EnableUserResponse]
+	forceRescan        *connect_go.Client[v1.ForceRescanRequest, v1.ForceRescanResponse]
+	revokeUserSessions *connect_go.Client[v1.RevokeUserSessionsRequest, v1.RevokeUserSessionsResponse]
+	getPollerStatus    *connect_go.Client[v1.GetPollerStatusRequest, v1.GetPollerStatusResponse]
+	listAuditEvents    *connect_go.Client[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse]
 }
 
 // GetStatus calls mootslive.v1.AdminService.GetStatus.
@@ -60,9 +136,76 @@ func (c *adminServiceClient) GetStatus(ctx context.Context, req *connect_go.Requ
 	return c.getStatus.CallUnary(ctx, req)
 }
 
+// GenerateRecap calls mootslive.v1.AdminService.GenerateRecap.
+func (c *adminServiceClient) GenerateRecap(ctx context.Context, req *connect_go.Request[v1.GenerateRecapRequest]) (*connect_go.Response[v1.GenerateRecapResponse], error) {
+	return c.generateRecap.CallUnary(ctx, req)
+}
+
+// ListUsers calls mootslive.v1.AdminService.ListUsers.
+func (c *adminServiceClient) ListUsers(ctx context.Context, req *connect_go.Request[v1.ListUsersRequest]) (*connect_go.Response[v1.ListUsersResponse], error) {
+	return c.listUsers.CallUnary(ctx, req)
+}
+
+// GetUser calls mootslive.v1.AdminService.GetUser.
+func (c *adminServiceClient) GetUser(ctx context.Context, req *connect_go.Request[v1.GetUserRequest]) (*connect_go.Response[v1.GetUserResponse], error) {
+	return c.getUser.CallUnary(ctx, req)
+}
+
+// DisableUser calls mootslive.v1.AdminService.DisableUser.
+func (c *adminServiceClient) DisableUser(ctx context.Context, req *connect_go.Request[v1.DisableUserRequest]) (*connect_go.Response[v1.DisableUserResponse], error) {
+	return c.disableUser.CallUnary(ctx, req)
+}
+
+// EnableUser calls mootslive.v1.AdminService.EnableUser.
+func (c *adminServiceClient) EnableUser(ctx context.Context, req *connect_go.Request[v1.EnableUserRequest]) (*connect_go.Response[v1.EnableUserResponse], error) {
+	return c.enableUser.CallUnary(ctx, req)
+}
+
+// ForceRescan calls mootslive.v1.AdminService.ForceRescan.
+func (c *adminServiceClient) ForceRescan(ctx context.Context, req *connect_go.Request[v1.ForceRescanRequest]) (*connect_go.Response[v1.ForceRescanResponse], error) {
+	return c.forceRescan.CallUnary(ctx, req)
+}
+
+// RevokeUserSessions calls mootslive.v1.AdminService.RevokeUserSessions.
+func (c *adminServiceClient) RevokeUserSessions(ctx context.Context, req *connect_go.Request[v1.RevokeUserSessionsRequest]) (*connect_go.Response[v1.RevokeUserSessionsResponse], error) {
+	return c.revokeUserSessions.CallUnary(ctx, req)
+}
+
+// GetPollerStatus calls mootslive.v1.AdminService.GetPollerStatus.
+func (c *adminServiceClient) GetPollerStatus(ctx context.Context, req *connect_go.Request[v1.GetPollerStatusRequest]) (*connect_go.Response[v1.GetPollerStatusResponse], error) {
+	return c.getPollerStatus.CallUnary(ctx, req)
+}
+
+// ListAuditEvents calls mootslive.v1.AdminService.ListAuditEvents.
+func (c *adminServiceClient) ListAuditEvents(ctx context.Context, req *connect_go.Request[v1.ListAuditEventsRequest]) (*connect_go.Response[v1.ListAuditEventsResponse], error) {
+	return c.listAuditEvents.CallUnary(ctx, req)
+}
+
 // AdminServiceHandler is an implementation of the mootslive.v1.AdminService service.
 type AdminServiceHandler interface {
 	GetStatus(context.Context, *connect_go.Request[v1.GetStatusRequest]) (*connect_go.Response[v1.GetStatusResponse], error)
+	// GenerateRecap generates a new version of a user's recap of a year, which
+	// replaces any they already have.
+	GenerateRecap(context.Context, *connect_go.Request[v1.GenerateRecapRequest]) (*connect_go.Response[v1.GenerateRecapResponse], error)
+	// ListUsers lists users, most recently created first.
+	ListUsers(context.Context, *connect_go.Request[v1.ListUsersRequest]) (*connect_go.Response[v1.ListUsersResponse], error)
+	// GetUser gets a user along with the accounts they've linked.
+	GetUser(context.Context, *connect_go.Request[v1.GetUserRequest]) (*connect_go.Response[v1.GetUserResponse], error)
+	// DisableUser stops a user from using mootslive, and stops their listens
+	// being polled, until they're enabled again.
+	DisableUser(context.Context, *connect_go.Request[v1.DisableUserRequest]) (*connect_go.Response[v1.DisableUserResponse], error)
+	EnableUser(context.Context, *connect_go.Request[v1.EnableUserRequest]) (*connect_go.Response[v1.EnableUserResponse], error)
+	// ForceRescan scans a Spotify account for new listens immediately, rather
+	// than waiting for the poller to get to it.
+	ForceRescan(context.Context, *connect_go.Request[v1.ForceRescanRequest]) (*connect_go.Response[v1.ForceRescanResponse], error)
+	// RevokeUserSessions signs a user out everywhere, by refusing every token
+	// issued to them before now.
+	RevokeUserSessions(context.Context, *connect_go.Request[v1.RevokeUserSessionsRequest]) (*connect_go.Response[v1.RevokeUserSessionsResponse], error)
+	// GetPollerStatus reports how far behind the poller is, which accounts
+	// it's struggling with and how much scanning each replica is doing.
+	GetPollerStatus(context.Context, *connect_go.Request[v1.GetPollerStatusRequest]) (*connect_go.Response[v1.GetPollerStatusResponse], error)
+	// ListAuditEvents lists security relevant events, most recent first.
+	ListAuditEvents(context.Context, *connect_go.Request[v1.ListAuditEventsRequest]) (*connect_go.Response[v1.ListAuditEventsResponse], error)
 }
 
 // NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -77,6 +220,51 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect_go.HandlerO
 		svc.GetStatus,
 		opts...,
 	))
+	mux.Handle("/mootslive.v1.AdminService/GenerateRecap", connect_go.NewUnaryHandler(
+		"/mootslive.v1.AdminService/GenerateRecap",
+		svc.GenerateRecap,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.AdminService/ListUsers", connect_go.NewUnaryHandler(
+		"/mootslive.v1.AdminService/ListUsers",
+		svc.ListUsers,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.AdminService/GetUser", connect_go.NewUnaryHandler(
+		"/mootslive.v1.AdminService/GetUser",
+		svc.GetUser,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.AdminService/DisableUser", connect_go.NewUnaryHandler(
+		"/mootslive.v1.AdminService/DisableUser",
+		svc.DisableUser,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.AdminService/EnableUser", connect_go.NewUnaryHandler(
+		"/mootslive.v1.AdminService/EnableUser",
+		svc.EnableUser,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.AdminService/ForceRescan", connect_go.NewUnaryHandler(
+		"/mootslive.v1.AdminService/ForceRescan",
+		svc.ForceRescan,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.AdminService/RevokeUserSessions", connect_go.NewUnaryHandler(
+		"/mootslive.v1.AdminService/RevokeUserSessions",
+		svc.RevokeUserSessions,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.AdminService/GetPollerStatus", connect_go.NewUnaryHandler(
+		"/mootslive.v1.AdminService/GetPollerStatus",
+		svc.GetPollerStatus,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.AdminService/ListAuditEvents", connect_go.NewUnaryHandler(
+		"/mootslive.v1.AdminService/ListAuditEvents",
+		svc.ListAuditEvents,
+		opts...,
+	))
 	return "/mootslive.v1.AdminService/", mux
 }
 
@@ -87,12 +275,160 @@ func (UnimplementedAdminServiceHandler) GetStatus(context.Context, *connect_go.R
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.AdminService.GetStatus is not implemented"))
 }
 
+func (UnimplementedAdminServiceHandler) GenerateRecap(context.Context, *connect_go.Request[v1.GenerateRecapRequest]) (*connect_go.Response[v1.GenerateRecapResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.AdminService.GenerateRecap is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListUsers(context.Context, *connect_go.Request[v1.ListUsersRequest]) (*connect_go.Response[v1.ListUsersResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.AdminService.ListUsers is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetUser(context.Context, *connect_go.Request[v1.GetUserRequest]) (*connect_go.Response[v1.GetUserResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.AdminService.GetUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DisableUser(context.Context, *connect_go.Request[v1.DisableUserRequest]) (*connect_go.Response[v1.DisableUserResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.AdminService.DisableUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) EnableUser(context.Context, *connect_go.Request[v1.EnableUserRequest]) (*connect_go.Response[v1.EnableUserResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.AdminService.EnableUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ForceRescan(context.Context, *connect_go.Request[v1.ForceRescanRequest]) (*connect_go.Response[v1.ForceRescanResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.AdminService.ForceRescan is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) RevokeUserSessions(context.Context, *connect_go.Request[v1.RevokeUserSessionsRequest]) (*connect_go.Response[v1.RevokeUserSessionsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.AdminService.RevokeUserSessions is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetPollerStatus(context.Context, *connect_go.Request[v1.GetPollerStatusRequest]) (*connect_go.Response[v1.GetPollerStatusResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.AdminService.GetPollerStatus is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListAuditEvents(context.Context, *connect_go.Request[v1.ListAuditEventsRequest]) (*connect_go.Response[v1.ListAuditEventsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.AdminService.ListAuditEvents is not implemented"))
+}
+
 // UserServiceClient is a client for the mootslive.v1.UserService service.
 type UserServiceClient interface {
 	GetMe(context.Context, *connect_go.Request[v1.GetMeRequest]) (*connect_go.Response[v1.GetMeResponse], error)
 	BeginTwitterAuth(context.Context, *connect_go.Request[v1.BeginTwitterAuthRequest]) (*connect_go.Response[v1.BeginTwitterAuthResponse], error)
 	FinishTwitterAuth(context.Context, *connect_go.Request[v1.FinishTwitterAuthRequest]) (*connect_go.Response[v1.FinishTwitterAuthResponse], error)
 	ListListens(context.Context, *connect_go.Request[v1.ListListensRequest]) (*connect_go.Response[v1.ListListensResponse], error)
+	ExportMyData(context.Context, *connect_go.Request[v1.ExportMyDataRequest]) (*connect_go.ServerStreamForClient[v1.ExportMyDataResponse], error)
+	DeleteMyAccount(context.Context, *connect_go.Request[v1.DeleteMyAccountRequest]) (*connect_go.Response[v1.DeleteMyAccountResponse], error)
+	CancelAccountDeletion(context.Context, *connect_go.Request[v1.CancelAccountDeletionRequest]) (*connect_go.Response[v1.CancelAccountDeletionResponse], error)
+	GetTwitterSharingSettings(context.Context, *connect_go.Request[v1.GetTwitterSharingSettingsRequest]) (*connect_go.Response[v1.GetTwitterSharingSettingsResponse], error)
+	UpdateTwitterSharingSettings(context.Context, *connect_go.Request[v1.UpdateTwitterSharingSettingsRequest]) (*connect_go.Response[v1.UpdateTwitterSharingSettingsResponse], error)
+	ShareListen(context.Context, *connect_go.Request[v1.ShareListenRequest]) (*connect_go.Response[v1.ShareListenResponse], error)
+	// SyncMoots requests that the caller's follows are refreshed from Twitter.
+	// The refresh happens in the background, so ListMoots may not reflect it
+	// immediately.
+	SyncMoots(context.Context, *connect_go.Request[v1.SyncMootsRequest]) (*connect_go.Response[v1.SyncMootsResponse], error)
+	ListMoots(context.Context, *connect_go.Request[v1.ListMootsRequest]) (*connect_go.Response[v1.ListMootsResponse], error)
+	FollowUser(context.Context, *connect_go.Request[v1.FollowUserRequest]) (*connect_go.Response[v1.FollowUserResponse], error)
+	UnfollowUser(context.Context, *connect_go.Request[v1.UnfollowUserRequest]) (*connect_go.Response[v1.UnfollowUserResponse], error)
+	ListFollowers(context.Context, *connect_go.Request[v1.ListFollowersRequest]) (*connect_go.Response[v1.ListFollowersResponse], error)
+	ListFollowing(context.Context, *connect_go.Request[v1.ListFollowingRequest]) (*connect_go.Response[v1.ListFollowingResponse], error)
+	ListFollowRequests(context.Context, *connect_go.Request[v1.ListFollowRequestsRequest]) (*connect_go.Response[v1.ListFollowRequestsResponse], error)
+	ApproveFollowRequest(context.Context, *connect_go.Request[v1.ApproveFollowRequestRequest]) (*connect_go.Response[v1.ApproveFollowRequestResponse], error)
+	DenyFollowRequest(context.Context, *connect_go.Request[v1.DenyFollowRequestRequest]) (*connect_go.Response[v1.DenyFollowRequestResponse], error)
+	GetFollowSettings(context.Context, *connect_go.Request[v1.GetFollowSettingsRequest]) (*connect_go.Response[v1.GetFollowSettingsResponse], error)
+	UpdateFollowSettings(context.Context, *connect_go.Request[v1.UpdateFollowSettingsRequest]) (*connect_go.Response[v1.UpdateFollowSettingsResponse], error)
+	// ListFeed returns the listens of the users the caller follows, newest
+	// first.
+	ListFeed(context.Context, *connect_go.Request[v1.ListFeedRequest]) (*connect_go.Response[v1.ListFeedResponse], error)
+	// WatchListens streams the listens of the caller and their moots as they
+	// are recorded, along with changes in their presence and to the caller's
+	// notifications. The current presence of each user, and the caller's
+	// unread notification count, are sent when the stream starts. Listens may
+	// be sent more than once across reconnects, so should be de-duplicated by
+	// ID.
+	WatchListens(context.Context, *connect_go.Request[v1.WatchListensRequest]) (*connect_go.ServerStreamForClient[v1.WatchListensResponse], error)
+	// GetCompatibility scores how similar the listening of the caller and
+	// another user is. Scores are cached, so may lag behind the latest listens
+	// by a few minutes.
+	GetCompatibility(context.Context, *connect_go.Request[v1.GetCompatibilityRequest]) (*connect_go.Response[v1.GetCompatibilityResponse], error)
+	GetPrivacySettings(context.Context, *connect_go.Request[v1.GetPrivacySettingsRequest]) (*connect_go.Response[v1.GetPrivacySettingsResponse], error)
+	UpdatePrivacySettings(context.Context, *connect_go.Request[v1.UpdatePrivacySettingsRequest]) (*connect_go.Response[v1.UpdatePrivacySettingsResponse], error)
+	// SetListenHidden hides one of the caller's listens from everyone else, or
+	// reveals it again.
+	SetListenHidden(context.Context, *connect_go.Request[v1.SetListenHiddenRequest]) (*connect_go.Response[v1.SetListenHiddenResponse], error)
+	// CreateIncognitoWindow hides the caller's listens within a period of time
+	// from everyone else. The period may extend into the future, hiding
+	// listens as they're recorded.
+	CreateIncognitoWindow(context.Context, *connect_go.Request[v1.CreateIncognitoWindowRequest]) (*connect_go.Response[v1.CreateIncognitoWindowResponse], error)
+	ListIncognitoWindows(context.Context, *connect_go.Request[v1.ListIncognitoWindowsRequest]) (*connect_go.Response[v1.ListIncognitoWindowsResponse], error)
+	DeleteIncognitoWindow(context.Context, *connect_go.Request[v1.DeleteIncognitoWindowRequest]) (*connect_go.Response[v1.DeleteIncognitoWindowResponse], error)
+	// AddReaction reacts to a listen the caller can see with an emoji. Each
+	// user can react to a listen with up to five different emoji.
+	AddReaction(context.Context, *connect_go.Request[v1.AddReactionRequest]) (*connect_go.Response[v1.AddReactionResponse], error)
+	RemoveReaction(context.Context, *connect_go.Request[v1.RemoveReactionRequest]) (*connect_go.Response[v1.RemoveReactionResponse], error)
+	AddComment(context.Context, *connect_go.Request[v1.AddCommentRequest]) (*connect_go.Response[v1.AddCommentResponse], error)
+	// DeleteComment deletes a comment, which can be done by its author or the
+	// user whose listen it was left on.
+	DeleteComment(context.Context, *connect_go.Request[v1.DeleteCommentRequest]) (*connect_go.Response[v1.DeleteCommentResponse], error)
+	ListComments(context.Context, *connect_go.Request[v1.ListCommentsRequest]) (*connect_go.Response[v1.ListCommentsResponse], error)
+	// FlagComment reports a comment for moderation. Comments flagged by enough
+	// users are hidden.
+	FlagComment(context.Context, *connect_go.Request[v1.FlagCommentRequest]) (*connect_go.Response[v1.FlagCommentResponse], error)
+	// ListNotifications returns the caller's notifications, along with how
+	// many are unread. Changes are also sent as they happen to WatchListens.
+	ListNotifications(context.Context, *connect_go.Request[v1.ListNotificationsRequest]) (*connect_go.Response[v1.ListNotificationsResponse], error)
+	MarkNotificationsRead(context.Context, *connect_go.Request[v1.MarkNotificationsReadRequest]) (*connect_go.Response[v1.MarkNotificationsReadResponse], error)
+	GetNotificationPreferences(context.Context, *connect_go.Request[v1.GetNotificationPreferencesRequest]) (*connect_go.Response[v1.GetNotificationPreferencesResponse], error)
+	UpdateNotificationPreferences(context.Context, *connect_go.Request[v1.UpdateNotificationPreferencesRequest]) (*connect_go.Response[v1.UpdateNotificationPreferencesResponse], error)
+	UpdateProfile(context.Context, *connect_go.Request[v1.UpdateProfileRequest]) (*connect_go.Response[v1.UpdateProfileResponse], error)
+	// GetProfile can be called without signing in, in which case only the
+	// listens of users whose listens are public are included.
+	GetProfile(context.Context, *connect_go.Request[v1.GetProfileRequest]) (*connect_go.Response[v1.GetProfileResponse], error)
+	// BlockUser removes any follows between the caller and the user, and hides
+	// each of them from the other everywhere.
+	BlockUser(context.Context, *connect_go.Request[v1.BlockUserRequest]) (*connect_go.Response[v1.BlockUserResponse], error)
+	UnblockUser(context.Context, *connect_go.Request[v1.UnblockUserRequest]) (*connect_go.Response[v1.UnblockUserResponse], error)
+	ListBlockedUsers(context.Context, *connect_go.Request[v1.ListBlockedUsersRequest]) (*connect_go.Response[v1.ListBlockedUsersResponse], error)
+	// MuteUser hides the user from the caller's feed and notifications, without
+	// them knowing.
+	MuteUser(context.Context, *connect_go.Request[v1.MuteUserRequest]) (*connect_go.Response[v1.MuteUserResponse], error)
+	UnmuteUser(context.Context, *connect_go.Request[v1.UnmuteUserRequest]) (*connect_go.Response[v1.UnmuteUserResponse], error)
+	ListMutedUsers(context.Context, *connect_go.Request[v1.ListMutedUsersRequest]) (*connect_go.Response[v1.ListMutedUsersResponse], error)
+	// GetTopTracks, GetTopArtists and GetTopAlbums return what a user has
+	// played most over a window. Listens the user has hidden from others are
+	// only counted when the user is looking at their own.
+	GetTopTracks(context.Context, *connect_go.Request[v1.GetTopTracksRequest]) (*connect_go.Response[v1.GetTopTracksResponse], error)
+	GetTopArtists(context.Context, *connect_go.Request[v1.GetTopArtistsRequest]) (*connect_go.Response[v1.GetTopArtistsResponse], error)
+	GetTopAlbums(context.Context, *connect_go.Request[v1.GetTopAlbumsRequest]) (*connect_go.Response[v1.GetTopAlbumsResponse], error)
+	GetListeningStats(context.Context, *connect_go.Request[v1.GetListeningStatsRequest]) (*connect_go.Response[v1.GetListeningStatsResponse], error)
+	// GetRecap returns the caller's recap of a year, which is generated shortly
+	// after the year ends.
+	GetRecap(context.Context, *connect_go.Request[v1.GetRecapRequest]) (*connect_go.Response[v1.GetRecapResponse], error)
+	// GetCharts returns the most played tracks and artists over a period. Only
+	// the listening of users whose listens are public is counted.
+	GetCharts(context.Context, *connect_go.Request[v1.GetChartsRequest]) (*connect_go.Response[v1.GetChartsResponse], error)
+	// CreateListen records a listen by hand, such as one that was played
+	// offline. Its source is "manual".
+	CreateListen(context.Context, *connect_go.Request[v1.CreateListenRequest]) (*connect_go.Response[v1.CreateListenResponse], error)
+	UpdateListen(context.Context, *connect_go.Request[v1.UpdateListenRequest]) (*connect_go.Response[v1.UpdateListenResponse], error)
+	DeleteListen(context.Context, *connect_go.Request[v1.DeleteListenRequest]) (*connect_go.Response[v1.DeleteListenResponse], error)
+	// DeleteListens deletes every listen in a range of time.
+	DeleteListens(context.Context, *connect_go.Request[v1.DeleteListensRequest]) (*connect_go.Response[v1.DeleteListensResponse], error)
+	// ListListenEdits lists the changes the caller has made to their listens,
+	// most recent first.
+	ListListenEdits(context.Context, *connect_go.Request[v1.ListListenEditsRequest]) (*connect_go.Response[v1.ListListenEditsResponse], error)
+	// ExportListens streams the caller's listens in a format other services
+	// can import. They can also be downloaded over plain HTTP from
+	// /export/listens, with the format and range given as the query parameters
+	// format ("csv", "jsonl", "listenbrainz" or "scrobbler_log"), start_time
+	// and end_time (RFC 3339).
+	ExportListens(context.Context, *connect_go.Request[v1.ExportListensRequest]) (*connect_go.ServerStreamForClient[v1.ExportListensResponse], error)
+	// GetSyncStatus reports when the caller's linked accounts last had their
+	// listens synced.
+	GetSyncStatus(context.Context, *connect_go.Request[v1.GetSyncStatusRequest]) (*connect_go.Response[v1.GetSyncStatusResponse], error)
+	// ListMySecurityEvents lists security relevant events affecting the
+	// caller's account, such as logins, most recent first.
+	ListMySecurityEvents(context.Context, *connect_go.Request[v1.ListMySecurityEventsRequest]) (*connect_go.Response[v1.ListMySecurityEventsResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the mootslive.v1.UserService service. By default, it
@@ -125,15 +461,363 @@ func NewUserServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts
 			baseURL+"/mootslive.v1.UserService/ListListens",
 			opts...,
 		),
+		exportMyData: connect_go.NewClient[v1.ExportMyDataRequest, v1.ExportMyDataResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/ExportMyData",
+			opts...,
+		),
+		deleteMyAccount: connect_go.NewClient[v1.DeleteMyAccountRequest, v1.DeleteMyAccountResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/DeleteMyAccount",
+			opts...,
+		),
+		cancelAccountDeletion: connect_go.NewClient[v1.CancelAccountDeletionRequest, v1.CancelAccountDeletionResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/CancelAccountDeletion",
+			opts...,
+		),
+		getTwitterSharingSettings: connect_go.NewClient[v1.GetTwitterSharingSettingsRequest, v1.GetTwitterSharingSettingsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/GetTwitterSharingSettings",
+			opts...,
+		),
+		updateTwitterSharingSettings: connect_go.NewClient[v1.UpdateTwitterSharingSettingsRequest, v1.UpdateTwitterSharingSettingsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/UpdateTwitterSharingSettings",
+			opts...,
+		),
+		shareListen: connect_go.NewClient[v1.ShareListenRequest, v1.ShareListenResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/ShareListen",
+			opts...,
+		),
+		syncMoots: connect_go.NewClient[v1.SyncMootsRequest, v1.SyncMootsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/SyncMoots",
+			opts...,
+		),
+		listMoots: connect_go.NewClient[v1.ListMootsRequest, v1.ListMootsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/ListMoots",
+			opts...,
+		),
+		followUser: connect_go.NewClient[v1.FollowUserRequest, v1.FollowUserResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/FollowUser",
+			opts...,
+		),
+		unfollowUser: connect_go.NewClient[v1.UnfollowUserRequest, v1.UnfollowUserResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/UnfollowUser",
+			opts...,
+		),
+		listFollowers: connect_go.NewClient[v1.ListFollowersRequest, v1.ListFollowersResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/ListFollowers",
+			opts...,
+		),
+		listFollowing: connect_go.NewClient[v1.ListFollowingRequest, v1.ListFollowingResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/ListFollowing",
+			opts...,
+		),
+		listFollowRequests: connect_go.NewClient[v1.ListFollowRequestsRequest, v1.ListFollowRequestsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/ListFollowRequests",
+			opts...,
+		),
+		approveFollowRequest: connect_go.NewClient[v1.ApproveFollowRequestRequest, v1.ApproveFollowRequestResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/ApproveFollowRequest",
+			opts...,
+		),
+		denyFollowRequest: connect_go.NewClient[v1.DenyFollowRequestRequest, v1.DenyFollowRequestResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/DenyFollowRequest",
+			opts...,
+		),
+		getFollowSettings: connect_go.NewClient[v1.GetFollowSettingsRequest, v1.GetFollowSettingsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/GetFollowSettings",
+			opts...,
+		),
+		updateFollowSettings: connect_go.NewClient[v1.UpdateFollowSettingsRequest, v1.UpdateFollowSettingsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/UpdateFollowSettings",
+			opts...,
+		),
+		listFeed: connect_go.NewClient[v1.ListFeedRequest, v1.ListFeedResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/ListFeed",
+			opts...,
+		),
+		watchListens: connect_go.NewClient[v1.WatchListensRequest, v1.WatchListensResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/WatchListens",
+			opts...,
+		),
+		getCompatibility: connect_go.NewClient[v1.GetCompatibilityRequest, v1.GetCompatibilityResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/GetCompatibility",
+			opts...,
+		),
+		getPrivacySettings: connect_go.NewClient[v1.GetPrivacySettingsRequest, v1.GetPrivacySettingsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/GetPrivacySettings",
+			opts...,
+		),
+		updatePrivacySettings: connect_go.NewClient[v1.UpdatePrivacySettingsRequest, v1.UpdatePrivacySettingsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/UpdatePrivacySettings",
+			opts...,
+		),
+		setListenHidden: connect_go.NewClient[v1.SetListenHiddenRequest, v1.SetListenHiddenResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/SetListenHidden",
+			opts...,
+		),
+		createIncognitoWindow: connect_go.NewClient[v1.CreateIncognitoWindowRequest, v1.CreateIncognitoWindowResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/CreateIncognitoWindow",
+			opts...,
+		),
+		listIncognitoWindows: connect_go.NewClient[v1.ListIncognitoWindowsRequest, v1.ListIncognitoWindowsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/ListIncognitoWindows",
+			opts...,
+		),
+		deleteIncognitoWindow: connect_go.NewClient[v1.DeleteIncognitoWindowRequest, v1.DeleteIncognitoWindowResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/DeleteIncognitoWindow",
+			opts...,
+		),
+		addReaction: connect_go.NewClient[v1.AddReactionRequest, v1.AddReactionResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/AddReaction",
+			opts...,
+		),
+		removeReaction: connect_go.NewClient[v1.RemoveReactionRequest, v1.RemoveReactionResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/RemoveReaction",
+			opts...,
+		),
+		addComment: connect_go.NewClient[v1.AddCommentRequest, v1.AddCommentResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/AddComment",
+			opts...,
+		),
+		deleteComment: connect_go.NewClient[v1.DeleteCommentRequest, v1.DeleteCommentResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/DeleteComment",
+			opts...,
+		),
+		listComments: connect_go.NewClient[v1.ListCommentsRequest, v1.ListCommentsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/ListComments",
+			opts...,
+		),
+		flagComment: connect_go.NewClient[v1.FlagCommentRequest, v1.FlagCommentResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/FlagComment",
+			opts...,
+		),
+		listNotifications: connect_go.NewClient[v1.ListNotificationsRequest, v1.ListNotificationsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/ListNotifications",
+			opts...,
+		),
+		markNotificationsRead: connect_go.NewClient[v1.MarkNotificationsReadRequest, v1.MarkNotificationsReadResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/MarkNotificationsRead",
+			opts...,
+		),
+		getNotificationPreferences: connect_go.NewClient[v1.GetNotificationPreferencesRequest, v1.GetNotificationPreferencesResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/GetNotificationPreferences",
+			opts...,
+		),
+		updateNotificationPreferences: connect_go.NewClient[v1.UpdateNotificationPreferencesRequest, v1.UpdateNotificationPreferencesResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/UpdateNotificationPreferences",
+			opts...,
+		),
+		updateProfile: connect_go.NewClient[v1.UpdateProfileRequest, v1.UpdateProfileResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/UpdateProfile",
+			opts...,
+		),
+		getProfile: connect_go.NewClient[v1.GetProfileRequest, v1.GetProfileResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/GetProfile",
+			opts...,
+		),
+		blockUser: connect_go.NewClient[v1.BlockUserRequest, v1.BlockUserResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/BlockUser",
+			opts...,
+		),
+		unblockUser: connect_go.NewClient[v1.UnblockUserRequest, v1.UnblockUserResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/UnblockUser",
+			opts...,
+		),
+		listBlockedUsers: connect_go.NewClient[v1.ListBlockedUsersRequest, v1.ListBlockedUsersResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/ListBlockedUsers",
+			opts...,
+		),
+		muteUser: connect_go.NewClient[v1.MuteUserRequest, v1.MuteUserResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/MuteUser",
+			opts...,
+		),
+		unmuteUser: connect_go.NewClient[v1.UnmuteUserRequest, v1.UnmuteUserResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/UnmuteUser",
+			opts...,
+		),
+		listMutedUsers: connect_go.NewClient[v1.ListMutedUsersRequest, v1.ListMutedUsersResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/ListMutedUsers",
+			opts...,
+		),
+		getTopTracks: connect_go.NewClient[v1.GetTopTracksRequest, v1.GetTopTracksResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/GetTopTracks",
+			opts...,
+		),
+		getTopArtists: connect_go.NewClient[v1.GetTopArtistsRequest, v1.GetTopArtistsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/GetTopArtists",
+			opts...,
+		),
+		getTopAlbums: connect_go.NewClient[v1.GetTopAlbumsRequest, v1.GetTopAlbumsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/GetTopAlbums",
+			opts...,
+		),
+		getListeningStats: connect_go.NewClient[v1.GetListeningStatsRequest, v1.GetListeningStatsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/GetListeningStats",
+			opts...,
+		),
+		getRecap: connect_go.NewClient[v1.GetRecapRequest, v1.GetRecapResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/GetRecap",
+			opts...,
+		),
+		getCharts: connect_go.NewClient[v1.GetChartsRequest, v1.GetChartsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/GetCharts",
+			opts...,
+		),
+		createListen: connect_go.NewClient[v1.CreateListenRequest, v1.CreateListenResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/CreateListen",
+			opts...,
+		),
+		updateListen: connect_go.NewClient[v1.UpdateListenRequest, v1.UpdateListenResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/UpdateListen",
+			opts...,
+		),
+		deleteListen: connect_go.NewClient[v1.DeleteListenRequest, v1.DeleteListenResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/DeleteListen",
+			opts...,
+		),
+		deleteListens: connect_go.NewClient[v1.DeleteListensRequest, v1.DeleteListensResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/DeleteListens",
+			opts...,
+		),
+		listListenEdits: connect_go.NewClient[v1.ListListenEditsRequest, v1.ListListenEditsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/ListListenEdits",
+			opts...,
+		),
+		exportListens: connect_go.NewClient[v1.ExportListensRequest, v1.ExportListensResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/ExportListens",
+			opts...,
+		),
+		getSyncStatus: connect_go.NewClient[v1.GetSyncStatusRequest, v1.GetSyncStatusResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/GetSyncStatus",
+			opts...,
+		),
+		listMySecurityEvents: connect_go.NewClient[v1.ListMySecurityEventsRequest, v1.ListMySecurityEventsResponse](
+			httpClient,
+			baseURL+"/mootslive.v1.UserService/ListMySecurityEvents",
+			opts...,
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	getMe             *connect_go.Client[v1.GetMeRequest, v1.GetMeResponse]
-	beginTwitterAuth  *connect_go.Client[v1.BeginTwitterAuthRequest, v1.BeginTwitterAuthResponse]
-	finishTwitterAuth *connect_go.Client[v1.FinishTwitterAuthRequest, v1.FinishTwitterAuthResponse]
-	listListens       *connect_go.Client[v1.ListListensRequest, v1.ListListensResponse]
+	getMe                         *connect_go.Client[v1.GetMeRequest, v1.GetMeResponse]
+	beginTwitterAuth              *connect_go.Client[v1.BeginTwitterAuthRequest, v1.BeginTwitterAuthResponse]
+	finishTwitterAuth             *connect_go.Client[v1.FinishTwitterAuthRequest, v1.FinishTwitterAuthResponse]
+	listListens                   *connect_go.Client[v1.ListListensRequest, v1.ListListensResponse]
+	exportMyData                  *connect_go.Client[v1.ExportMyDataRequest, v1.ExportMyDataResponse]
+	deleteMyAccount               *connect_go.Client[v1.DeleteMyAccountRequest, v1.DeleteMyAccountResponse]
+	cancelAccountDeletion         *connect_go.Client[v1.CancelAccountDeletionRequest, v1.CancelAccountDeletionResponse]
+	getTwitterSharingSettings     *connect_go.Client[v1.GetTwitterSharingSettingsRequest, v1.GetTwitterSharingSettingsResponse]
+	updateTwitterSharingSettings  *connect_go.Client[v1.UpdateTwitterSharingSettingsRequest, v1.UpdateTwitterSharingSettingsResponse]
+	shareListen                   *connect_go.Client[v1.ShareListenRequest, v1.ShareListenResponse]
+	syncMoots                     *connect_go.Client[v1.SyncMootsRequest, v1.SyncMootsResponse]
+	listMoots                     *connect_go.Client[v1.ListMootsRequest, v1.ListMootsResponse]
+	followUser                    *connect_go.Client[v1.FollowUserRequest, v1.FollowUserResponse]
+	unfollowUser                  *connect_go.Client[v1.UnfollowUserRequest, v1.UnfollowUserResponse]
+	listFollowers                 *connect_go.Client[v1.ListFollowersRequest, v1.ListFollowersResponse]
+	listFollowing                 *connect_go.Client[v1.ListFollowingRequest, v1.ListFollowingResponse]
+	listFollowRequests            *connect_go.Client[v1.ListFollowRequestsRequest, v1.ListFollowRequestsResponse]
+	approveFollowRequest          *connect_go.Client[v1.ApproveFollowRequestRequest, v1.ApproveFollowRequestResponse]
+	denyFollowRequest             *connect_go.Client[v1.DenyFollowRequestRequest, v1.DenyFollowRequestResponse]
+	getFollowSettings             *connect_go.Client[v1.GetFollowSettingsRequest, v1.GetFollowSettingsResponse]
+	updateFollowSettings          *connect_go.Client[v1.UpdateFollowSettingsRequest, v1.UpdateFollowSettingsResponse]
+	listFeed                      *connect_go.Client[v1.ListFeedRequest, v1.ListFeedResponse]
+	watchListens                  *connect_go.Client[v1.WatchListensRequest, v1.WatchListensResponse]
+	getCompatibility              *connect_go.Client[v1.GetCompatibilityRequest, v1.GetCompatibilityResponse]
+	getPrivacySettings            *connect_go.Client[v1.GetPrivacySettingsRequest, v1.GetPrivacySettingsResponse]
+	updatePrivacySettings         *connect_go.Client[v1.UpdatePrivacySettingsRequest, v1.UpdatePrivacySettingsResponse]
+	setListenHidden               *connect_go.Client[v1.SetListenHiddenRequest, v1.SetListenHiddenResponse]
+	createIncognitoWindow         *connect_go.Client[v1.CreateIncognitoWindowRequest, v1.CreateIncognitoWindowResponse]
+	listIncognitoWindows          *connect_go.Client[v1.ListIncognitoWindowsRequest, v1.ListIncognitoWindowsResponse]
+	deleteIncognitoWindow         *connect_go.Client[v1.DeleteIncognitoWindowRequest, v1.DeleteIncognitoWindowResponse]
+	addReaction                   *connect_go.Client[v1.AddReactionRequest, v1.AddReactionResponse]
+	removeReaction                *connect_go.Client[v1.RemoveReactionRequest, v1.RemoveReactionResponse]
+	addComment                    *connect_go.Client[v1.AddCommentRequest, v1.AddCommentResponse]
+	deleteComment                 *connect_go.Client[v1.DeleteCommentRequest, v1.DeleteCommentResponse]
+	listComments                  *connect_go.Client[v1.ListCommentsRequest, v1.ListCommentsResponse]
+	flagComment                   *connect_go.Client[v1.FlagCommentRequest, v1.FlagCommentResponse]
+	listNotifications             *connect_go.Client[v1.ListNotificationsRequest, v1.ListNotificationsResponse]
+	markNotificationsRead         *connect_go.Client[v1.MarkNotificationsReadRequest, v1.MarkNotificationsReadResponse]
+	getNotificationPreferences    *connect_go.Client[v1.GetNotificationPreferencesRequest, v1.GetNotificationPreferencesResponse]
+	updateNotificationPreferences *connect_go.Client[v1.UpdateNotificationPreferencesRequest, v1.UpdateNotificationPreferencesResponse]
+	updateProfile                 *connect_go.Client[v1.UpdateProfileRequest, v1.UpdateProfileResponse]
+	getProfile                    *connect_go.Client[v1.GetProfileRequest, v1.GetProfileResponse]
+	blockUser                     *connect_go.Client[v1.BlockUserRequest, v1.BlockUserResponse]
+	unblockUser                   *connect_go.Client[v1.UnblockUserRequest, v1.UnblockUserResponse]
+	listBlockedUsers              *connect_go.Client[v1.ListBlockedUsersRequest, v1.ListBlockedUsersResponse]
+	muteUser                      *connect_go.Client[v1.MuteUserRequest, v1.MuteUserResponse]
+	unmuteUser                    *connect_go.Client[v1.UnmuteUserRequest, v1.UnmuteUserResponse]
+	listMutedUsers                *connect_go.Client[v1.ListMutedUsersRequest, v1.ListMutedUsersResponse]
+	getTopTracks                  *connect_go.Client[v1.GetTopTracksRequest, v1.GetTopTracksResponse]
+	getTopArtists                 *connect_go.Client[v1.GetTopArtistsRequest, v1.GetTopArtistsResponse]
+	getTopAlbums                  *connect_go.Client[v1.GetTopAlbumsRequest, v1.GetTopAlbumsResponse]
+	getListeningStats             *connect_go.Client[v1.GetListeningStatsRequest, v1.GetListeningStatsResponse]
+	getRecap                      *connect_go.Client[v1.GetRecapRequest, v1.GetRecapResponse]
+	getCharts                     *connect_go.Client[v1.GetChartsRequest, v1.GetChartsResponse]
+	createListen                  *connect_go.Client[v1.CreateListenRequest, v1.CreateListenResponse]
+	updateListen                  *connect_go.Client[v1.UpdateListenRequest, v1.UpdateListenResponse]
+	deleteListen                  *connect_go.Client[v1.DeleteListenRequest, v1.DeleteListenResponse]
+	deleteListens                 *connect_go.Client[v1.DeleteListensRequest, v1.DeleteListensResponse]
+	listListenEdits               *connect_go.Client[v1.ListListenEditsRequest, v1.ListListenEditsResponse]
+	exportListens                 *connect_go.Client[v1.ExportListensRequest, v1.ExportListensResponse]
+	getSyncStatus                 *connect_go.Client[v1.GetSyncStatusRequest, v1.GetSyncStatusResponse]
+	listMySecurityEvents          *connect_go.Client[v1.ListMySecurityEventsRequest, v1.ListMySecurityEventsResponse]
 }
 
 // GetMe calls mootslive.v1.UserService.GetMe.
@@ -156,12 +840,414 @@ func (c *userServiceClient) ListListens(ctx context.Context, req *connect_go.Req
 	return c.listListens.CallUnary(ctx, req)
 }
 
-// UserServiceHandler is an implementation of the mootslive.v1.UserService service.
+// ExportMyData calls mootslive.v1.UserService.ExportMyData.
+func (c *userServiceClient) ExportMyData(ctx context.Context, req *connect_go.Request[v1.ExportMyDataRequest]) (*connect_go.ServerStreamForClient[v1.ExportMyDataResponse], error) {
+	return c.exportMyData.CallServerStream(ctx, req)
+}
+
+// DeleteMyAccount calls mootslive.v1.UserService.DeleteMyAccount.
+func (c *userServiceClient) DeleteMyAccount(ctx context.Context, req *connect_go.Request[v1.DeleteMyAccountRequest]) (*connect_go.Response[v1.DeleteMyAccountResponse], error) {
+	return c.deleteMyAccount.CallUnary(ctx, req)
+}
+
+// CancelAccountDeletion calls mootslive.v1.UserService.CancelAccountDeletion.
+func (c *userServiceClient) CancelAccountDeletion(ctx context.Context, req *connect_go.Request[v1.CancelAccountDeletionRequest]) (*connect_go.Response[v1.CancelAccountDeletionResponse], error) {
+	return c.cancelAccountDeletion.CallUnary(ctx, req)
+}
+
+// GetTwitterSharingSettings calls mootslive.v1.UserService.GetTwitterSharingSettings.
+func (c *userServiceClient) GetTwitterSharingSettings(ctx context.Context, req *connect_go.Request[v1.GetTwitterSharingSettingsRequest]) (*connect_go.Response[v1.GetTwitterSharingSettingsResponse], error) {
+	return c.getTwitterSharingSettings.CallUnary(ctx, req)
+}
+
+// UpdateTwitterSharingSettings calls mootslive.v1.UserService.UpdateTwitterSharingSettings.
+func (c *userServiceClient) UpdateTwitterSharingSettings(ctx context.Context, req *connect_go.Request[v1.UpdateTwitterSharingSettingsRequest]) (*connect_go.Response[v1.UpdateTwitterSharingSettingsResponse], error) {
+	return c.updateTwitterSharingSettings.CallUnary(ctx, req)
+}
+
+// ShareListen calls mootslive.v1.UserService.ShareListen.
+func (c *userServiceClient) ShareListen(ctx context.Context, req *connect_go.Request[v1.ShareListenRequest]) (*connect_go.Response[v1.ShareListenResponse], error) {
+	return c.shareListen.CallUnary(ctx, req)
+}
+
+// SyncMoots calls mootslive.v1.UserService.SyncMoots.
+func (c *userServiceClient) SyncMoots(ctx context.Context, req *connect_go.Request[v1.SyncMootsRequest]) (*connect_go.Response[v1.SyncMootsResponse], error) {
+	return c.syncMoots.CallUnary(ctx, req)
+}
+
+// ListMoots calls mootslive.v1.UserService.ListMoots.
+func (c *userServiceClient) ListMoots(ctx context.Context, req *connect_go.Request[v1.ListMootsRequest]) (*connect_go.Response[v1.ListMootsResponse], error) {
+	return c.listMoots.CallUnary(ctx, req)
+}
+
+// FollowUser calls mootslive.v1.UserService.FollowUser.
+func (c *userServiceClient) FollowUser(ctx context.Context, req *connect_go.Request[v1.FollowUserRequest]) (*connect_go.Response[v1.FollowUserResponse], error) {
+	return c.followUser.CallUnary(ctx, req)
+}
+
+// UnfollowUser calls mootslive.v1.UserService.UnfollowUser.
+func (c *userServiceClient) UnfollowUser(ctx context.Context, req *connect_go.Request[v1.UnfollowUserRequest]) (*connect_go.Response[v1.UnfollowUserResponse], error) {
+	return c.unfollowUser.CallUnary(ctx, req)
+}
+
+// ListFollowers calls mootslive.v1.UserService.ListFollowers.
+func (c *userServiceClient) ListFollowers(ctx context.Context, req *connect_go.Request[v1.ListFollowersRequest]) (*connect_go.Response[v1.ListFollowersResponse], error) {
+	return c.listFollowers.CallUnary(ctx, req)
+}
+
+// ListFollowing calls mootslive.v1.UserService.ListFollowing.
+func (c *userServiceClient) ListFollowing(ctx context.Context, req *connect_go.Request[v1.ListFollowingRequest]) (*connect_go.Response[v1.ListFollowingResponse], error) {
+	return c.listFollowing.CallUnary(ctx, req)
+}
+
+// ListFollowRequests calls mootslive.v1.UserService.ListFollowRequests.
+func (c *userServiceClient) ListFollowRequests(ctx context.Context, req *connect_go.Request[v1.ListFollowRequestsRequest]) (*connect_go.Response[v1.ListFollowRequestsResponse], error) {
+	return c.listFollowRequests.CallUnary(ctx, req)
+}
+
+// ApproveFollowRequest calls mootslive.v1.UserService.ApproveFollowRequest.
+func (c *userServiceClient) ApproveFollowRequest(ctx context.Context, req *connect_go.Request[v1.ApproveFollowRequestRequest]) (*connect_go.Response[v1.ApproveFollowRequestResponse], error) {
+	return c.approveFollowRequest.CallUnary(ctx, req)
+}
+
+// DenyFollowRequest calls mootslive.v1.UserService.DenyFollowRequest.
+func (c *userServiceClient) DenyFollowRequest(ctx context.Context, req *connect_go.Request[v1.DenyFollowRequestRequest]) (*connect_go.Response[v1.DenyFollowRequestResponse], error) {
+	return c.denyFollowRequest.CallUnary(ctx, req)
+}
+
+// GetFollowSettings calls mootslive.v1.UserService.GetFollowSettings.
+func (c *userServiceClient) GetFollowSettings(ctx context.Context, req *connect_go.Request[v1.GetFollowSettingsRequest]) (*connect_go.Response[v1.GetFollowSettingsResponse], error) {
+	return c.getFollowSettings.CallUnary(ctx, req)
+}
+
+// UpdateFollowSettings calls mootslive.v1.UserService.UpdateFollowSettings.
+func (c *userServiceClient) UpdateFollowSettings(ctx context.Context, req *connect_go.Request[v1.UpdateFollowSettingsRequest]) (*connect_go.Response[v1.UpdateFollowSettingsResponse], error) {
+	return c.updateFollowSettings.CallUnary(ctx, req)
+}
+
+// ListFeed calls mootslive.v1.UserService.ListFeed.
+func (c *userServiceClient) ListFeed(ctx context.Context, req *connect_go.Request[v1.ListFeedRequest]) (*connect_go.Response[v1.ListFeedResponse], error) {
+	return c.listFeed.CallUnary(ctx, req)
+}
+
+// WatchListens calls mootslive.v1.UserService.WatchListens.
+func (c *userServiceClient) WatchListens(ctx context.Context, req *connect_go.Request[v1.WatchListensRequest]) (*connect_go.ServerStreamForClient[v1.WatchListensResponse], error) {
+	return c.watchListens.CallServerStream(ctx, req)
+}
+
+// GetCompatibility calls mootslive.v1.UserService.GetCompatibility.
+func (c *userServiceClient) GetCompatibility(ctx context.Context, req *connect_go.Request[v1.GetCompatibilityRequest]) (*connect_go.Response[v1.GetCompatibilityResponse], error) {
+	return c.getCompatibility.CallUnary(ctx, req)
+}
+
+// GetPrivacySettings calls mootslive.v1.UserService.GetPrivacySettings.
+func (c *userServiceClient) GetPrivacySettings(ctx context.Context, req *connect_go.Request[v1.GetPrivacySettingsRequest]) (*connect_go.Response[v1.GetPrivacySettingsResponse], error) {
+	return c.getPrivacySettings.CallUnary(ctx, req)
+}
+
+// UpdatePrivacySettings calls mootslive.v1.UserService.UpdatePrivacySettings.
+func (c *userServiceClient) UpdatePrivacySettings(ctx context.Context, req *connect_go.Request[v1.UpdatePrivacySettingsRequest]) (*connect_go.Response[v1.UpdatePrivacySettingsResponse], error) {
+	return c.updatePrivacySettings.CallUnary(ctx, req)
+}
+
+// SetListenHidden calls mootslive.v1.UserService.SetListenHidden.
+func (c *userServiceClient) SetListenHidden(ctx context.Context, req *connect_go.Request[v1.SetListenHiddenRequest]) (*connect_go.Response[v1.SetListenHiddenResponse], error) {
+	return c.setListenHidden.CallUnary(ctx, req)
+}
+
+// CreateIncognitoWindow calls mootslive.v1.UserService.CreateIncognitoWindow.
+func (c *userServiceClient) CreateIncognitoWindow(ctx context.Context, req *connect_go.Request[v1.CreateIncognitoWindowRequest]) (*connect_go.Response[v1.CreateIncognitoWindowResponse], error) {
+	return c.createIncognitoWindow.CallUnary(ctx, req)
+}
+
+// ListIncognitoWindows calls mootslive.v1.UserService.ListIncognitoWindows.
+func (c *userServiceClient) ListIncognitoWindows(ctx context.Context, req *connect_go.Request[v1.ListIncognitoWindowsRequest]) (*connect_go.Response[v1.ListIncognitoWindowsResponse], error) {
+	return c.listIncognitoWindows.CallUnary(ctx, req)
+}
+
+// DeleteIncognitoWindow calls mootslive.v1.UserService.DeleteIncognitoWindow.
+func (c *userServiceClient) DeleteIncognitoWindow(ctx context.Context, req *connect_go.Request[v1.DeleteIncognitoWindowRequest]) (*connect_go.Response[v1.DeleteIncognitoWindowResponse], error) {
+	return c.deleteIncognitoWindow.CallUnary(ctx, req)
+}
+
+// AddReaction calls mootslive.v1.UserService.AddReaction.
+func (c *userServiceClient) AddReaction(ctx context.Context, req *connect_go.Request[v1.AddReactionRequest]) (*connect_go.Response[v1.AddReactionResponse], error) {
+	return c.addReaction.CallUnary(ctx, req)
+}
+
+// RemoveReaction calls mootslive.v1.UserService.RemoveReaction.
+func (c *userServiceClient) RemoveReaction(ctx context.Context, req *connect_go.Request[v1.RemoveReactionRequest]) (*connect_go.Response[v1.RemoveReactionResponse], error) {
+	return c.removeReaction.CallUnary(ctx, req)
+}
+
+// AddComment calls mootslive.v1.UserService.AddComment.
+func (c *userServiceClient) AddComment(ctx context.Context, req *connect_go.Request[v1.AddCommentRequest]) (*connect_go.Response[v1.AddCommentResponse], error) {
+	return c.addComment.CallUnary(ctx, req)
+}
+
+// DeleteComment calls mootslive.v1.UserService.DeleteComment.
+func (c *userServiceClient) DeleteComment(ctx context.Context, req *connect_go.Request[v1.DeleteCommentRequest]) (*connect_go.Response[v1.DeleteCommentResponse], error) {
+	return c.deleteComment.CallUnary(ctx, req)
+}
+
+// ListComments calls mootslive.v1.UserService.ListComments.
+func (c *userServiceClient) ListComments(ctx context.Context, req *connect_go.Request[v1.ListCommentsRequest]) (*connect_go.Response[v1.ListCommentsResponse], error) {
+	return c.listComments.CallUnary(ctx, req)
+}
+
+// FlagComment calls mootslive.v1.UserService.FlagComment.
+func (c *userServiceClient) FlagComment(ctx context.Context, req *connect_go.Request[v1.FlagCommentRequest]) (*connect_go.Response[v1.FlagCommentResponse], error) {
+	return c.flagComment.CallUnary(ctx, req)
+}
+
+// ListNotifications calls mootslive.v1.UserService.ListNotifications.
+func (c *userServiceClient) ListNotifications(ctx context.Context, req *connect_go.Request[v1.ListNotificationsRequest]) (*connect_go.Response[v1.ListNotificationsResponse], error) {
+	return c.listNotifications.CallUnary(ctx, req)
+}
+
+// MarkNotificationsRead calls mootslive.v1.UserService.MarkNotificationsRead.
+func (c *userServiceClient) MarkNotificationsRead(ctx context.Context, req *connect_go.Request[v1.MarkNotificationsReadRequest]) (*connect_go.Response[v1.MarkNotificationsReadResponse], error) {
+	return c.markNotificationsRead.CallUnary(ctx, req)
+}
+
+// GetNotificationPreferences calls mootslive.v1.UserService.GetNotificationPreferences.
+func (c *userServiceClient) GetNotificationPreferences(ctx context.Context, req *connect_go.Request[v1.GetNotificationPreferencesRequest]) (*connect_go.Response[v1.GetNotificationPreferencesResponse], error) {
+	return c.getNotificationPreferences.CallUnary(ctx, req)
+}
+
+// UpdateNotificationPreferences calls mootslive.v1.UserService.UpdateNotificationPreferences.
+func (c *userServiceClient) UpdateNotificationPreferences(ctx context.Context, req *connect_go.Request[v1.UpdateNotificationPreferencesRequest]) (*connect_go.Response[v1.UpdateNotificationPreferencesResponse], error) {
+	return c.updateNotificationPreferences.CallUnary(ctx, req)
+}
+
+// UpdateProfile calls mootslive.v1.UserService.UpdateProfile.
+func (c *userServiceClient) UpdateProfile(ctx context.Context, req *connect_go.Request[v1.UpdateProfileRequest]) (*connect_go.Response[v1.UpdateProfileResponse], error) {
+	return c.updateProfile.CallUnary(ctx, req)
+}
+
+// GetProfile calls mootslive.v1.UserService.GetProfile.
+func (c *userServiceClient) GetProfile(ctx context.Context, req *connect_go.Request[v1.GetProfileRequest]) (*connect_go.Response[v1.GetProfileResponse], error) {
+	return c.getProfile.CallUnary(ctx, req)
+}
+
+// BlockUser calls mootslive.v1.UserService.BlockUser.
+func (c *userServiceClient) BlockUser(ctx context.Context, req *connect_go.Request[v1.BlockUserRequest]) (*connect_go.Response[v1.BlockUserResponse], error) {
+	return c.blockUser.CallUnary(ctx, req)
+}
+
+// UnblockUser calls mootslive.v1.UserService.UnblockUser.
+func (c *userServiceClient) UnblockUser(ctx context.Context, req *connect_go.Request[v1.UnblockUserRequest]) (*connect_go.Response[v1.UnblockUserResponse], error) {
+	return c.unblockUser.CallUnary(ctx, req)
+}
+
+// ListBlockedUsers calls mootslive.v1.UserService.ListBlockedUsers.
+func (c *userServiceClient) ListBlockedUsers(ctx context.Context, req *connect_go.Request[v1.ListBlockedUsersRequest]) (*connect_go.Response[v1.ListBlockedUsersResponse], error) {
+	return c.listBlockedUsers.CallUnary(ctx, req)
+}
+
+// MuteUser calls mootslive.v1.UserService.MuteUser.
+func (c *userServiceClient) MuteUser(ctx context.Context, req *connect_go.Request[v1.MuteUserRequest]) (*connect_go.Response[v1.MuteUserResponse], error) {
+	return c.muteUser.CallUnary(ctx, req)
+}
+
+// UnmuteUser calls mootslive.v1.UserService.UnmuteUser.
+func (c *userServiceClient) UnmuteUser(ctx context.Context, req *connect_go.Request[v1.UnmuteUserRequest]) (*connect_go.Response[v1.UnmuteUserResponse], error) {
+	return c.unmuteUser.CallUnary(ctx, req)
+}
+
+// ListMutedUsers calls mootslive.v1.UserService.ListMutedUsers.
+func (c *userServiceClient) ListMutedUsers(ctx context.Context, req *connect_go.Request[v1.ListMutedUsersRequest]) (*connect_go.Response[v1.ListMutedUsersResponse], error) {
+	return c.listMutedUsers.CallUnary(ctx, req)
+}
+
+// GetTopTracks calls mootslive.v1.UserService.GetTopTracks.
+func (c *userServiceClient) GetTopTracks(ctx context.Context, req *connect_go.Request[v1.GetTopTracksRequest]) (*connect_go.Response[v1.GetTopTracksResponse], error) {
+	return c.getTopTracks.CallUnary(ctx, req)
+}
+
+// GetTopArtists calls mootslive.v1.UserService.GetTopArtists.
+func (c *userServiceClient) GetTopArtists(ctx context.Context, req *connect_go.Request[v1.GetTopArtistsRequest]) (*connect_go.Response[v1.GetTopArtistsResponse], error) {
+	return c.getTopArtists.CallUnary(ctx, req)
+}
+
+// GetTopAlbums calls mootslive.v1.UserService.GetTopAlbums.
+func (c *userServiceClient) GetTopAlbums(ctx context.Context, req *connect_go.Request[v1.GetTopAlbumsRequest]) (*connect_go.Response[v1.GetTopAlbumsResponse], error) {
+	return c.getTopAlbums.CallUnary(ctx, req)
+}
+
+// GetListeningStats calls mootslive.v1.UserService.GetListeningStats.
+func (c *userServiceClient) GetListeningStats(ctx context.Context, req *connect_go.Request[v1.GetListeningStatsRequest]) (*connect_go.Response[v1.GetListeningStatsResponse], error) {
+	return c.getListeningStats.CallUnary(ctx, req)
+}
+
+// GetRecap calls mootslive.v1.UserService.GetRecap.
+func (c *userServiceClient) GetRecap(ctx context.Context, req *connect_go.Request[v1.GetRecapRequest]) (*connect_go.Response[v1.GetRecapResponse], error) {
+	return c.getRecap.CallUnary(ctx, req)
+}
+
+// GetCharts calls mootslive.v1.UserService.GetCharts.
+func (c *userServiceClient) GetCharts(ctx context.Context, req *connect_go.Request[v1.GetChartsRequest]) (*connect_go.Response[v1.GetChartsResponse], error) {
+	return c.getCharts.CallUnary(ctx, req)
+}
+
+// CreateListen calls mootslive.v1.UserService.CreateListen.
+func (c *userServiceClient) CreateListen(ctx context.Context, req *connect_go.Request[v1.CreateListenRequest]) (*connect_go.Response[v1.CreateListenResponse], error) {
+	return c.createListen.CallUnary(ctx, req)
+}
+
+// UpdateListen calls mootslive.v1.UserService.UpdateListen.
+func (c *userServiceClient) UpdateListen(ctx context.Context, req *connect_go.Request[v1.UpdateListenRequest]) (*connect_go.Response[v1.UpdateListenResponse], error) {
+	return c.updateListen.CallUnary(ctx, req)
+}
+
+// DeleteListen calls mootslive.v1.UserService.DeleteListen.
+func (c *userServiceClient) DeleteListen(ctx context.Context, req *connect_go.Request[v1.DeleteListenRequest]) (*connect_go.Response[v1.DeleteListenResponse], error) {
+	return c.deleteListen.CallUnary(ctx, req)
+}
+
+// DeleteListens calls mootslive.v1.UserService.DeleteListens.
+func (c *userServiceClient) DeleteListens(ctx context.Context, req *connect_go.Request[v1.DeleteListensRequest]) (*connect_go.Response[v1.DeleteListensResponse], error) {
+	return c.deleteListens.CallUnary(ctx, req)
+}
+
+// ListListenEdits calls mootslive.v1.UserService.ListListenEdits.
+func (c *userServiceClient) ListListenEdits(ctx context.Context, req *connect_go.Request[v1.ListListenEditsRequest]) (*connect_go.Response[v1.ListListenEditsResponse], error) {
+	return c.listListenEdits.CallUnary(ctx, req)
+}
+
+// ExportListens calls mootslive.v1.UserService.ExportListens.
+func (c *userServiceClient) ExportListens(ctx context.Context, req *connect_go.Request[v1.ExportListensRequest]) (*connect_go.ServerStreamForClient[v1.ExportListensResponse], error) {
+	return c.exportListens.CallServerStream(ctx, req)
+}
+
+// GetSyncStatus calls mootslive.v1.UserService.GetSyncStatus.
+func (c *userServiceClient) GetSyncStatus(ctx context.Context, req *connect_go.Request[v1.GetSyncStatusRequest]) (*connect_go.Response[v1.GetSyncStatusResponse], error) {
+	return c.getSyncStatus.CallUnary(ctx, req)
+}
+
+// ListMySecurityEvents calls mootslive.v1.UserService.ListMySecurityEvents.
+func (c *userServiceClient) ListMySecurityEvents(ctx context.Context, req *connect_go.Request[v1.ListMySecurityEventsRequest]) (*connect_go.Response[v1.ListMySecurityEventsResponse], error) {
+	return c.listMySecurityEvents.CallUnary(ctx, req)
+}
+
+// UserServiceHandler is an implementation of the mootslive.v1.UserService service.
 type UserServiceHandler interface {
 	GetMe(context.Context, *connect_go.Request[v1.GetMeRequest]) (*connect_go.Response[v1.GetMeResponse], error)
 	BeginTwitterAuth(context.Context, *connect_go.Request[v1.BeginTwitterAuthRequest]) (*connect_go.Response[v1.BeginTwitterAuthResponse], error)
 	FinishTwitterAuth(context.Context, *connect_go.Request[v1.FinishTwitterAuthRequest]) (*connect_go.Response[v1.FinishTwitterAuthResponse], error)
 	ListListens(context.Context, *connect_go.Request[v1.ListListensRequest]) (*connect_go.Response[v1.ListListensResponse], error)
+	ExportMyData(context.Context, *connect_go.Request[v1.ExportMyDataRequest], *connect_go.ServerStream[v1.ExportMyDataResponse]) error
+	DeleteMyAccount(context.Context, *connect_go.Request[v1.DeleteMyAccountRequest]) (*connect_go.Response[v1.DeleteMyAccountResponse], error)
+	CancelAccountDeletion(context.Context, *connect_go.Request[v1.CancelAccountDeletionRequest]) (*connect_go.Response[v1.CancelAccountDeletionResponse], error)
+	GetTwitterSharingSettings(context.Context, *connect_go.Request[v1.GetTwitterSharingSettingsRequest]) (*connect_go.Response[v1.GetTwitterSharingSettingsResponse], error)
+	UpdateTwitterSharingSettings(context.Context, *connect_go.Request[v1.UpdateTwitterSharingSettingsRequest]) (*connect_go.Response[v1.UpdateTwitterSharingSettingsResponse], error)
+	ShareListen(context.Context, *connect_go.Request[v1.ShareListenRequest]) (*connect_go.Response[v1.ShareListenResponse], error)
+	// SyncMoots requests that the caller's follows are refreshed from Twitter.
+	// The refresh happens in the background, so ListMoots may not reflect it
+	// immediately.
+	SyncMoots(context.Context, *connect_go.Request[v1.SyncMootsRequest]) (*connect_go.Response[v1.SyncMootsResponse], error)
+	ListMoots(context.Context, *connect_go.Request[v1.ListMootsRequest]) (*connect_go.Response[v1.ListMootsResponse], error)
+	FollowUser(context.Context, *connect_go.Request[v1.FollowUserRequest]) (*connect_go.Response[v1.FollowUserResponse], error)
+	UnfollowUser(context.Context, *connect_go.Request[v1.UnfollowUserRequest]) (*connect_go.Response[v1.UnfollowUserResponse], error)
+	ListFollowers(context.Context, *connect_go.Request[v1.ListFollowersRequest]) (*connect_go.Response[v1.ListFollowersResponse], error)
+	ListFollowing(context.Context, *connect_go.Request[v1.ListFollowingRequest]) (*connect_go.Response[v1.ListFollowingResponse], error)
+	ListFollowRequests(context.Context, *connect_go.Request[v1.ListFollowRequestsRequest]) (*connect_go.Response[v1.ListFollowRequestsResponse], error)
+	ApproveFollowRequest(context.Context, *connect_go.Request[v1.ApproveFollowRequestRequest]) (*connect_go.Response[v1.ApproveFollowRequestResponse], error)
+	DenyFollowRequest(context.Context, *connect_go.Request[v1.DenyFollowRequestRequest]) (*connect_go.Response[v1.DenyFollowRequestResponse], error)
+	GetFollowSettings(context.Context, *connect_go.Request[v1.GetFollowSettingsRequest]) (*connect_go.Response[v1.GetFollowSettingsResponse], error)
+	UpdateFollowSettings(context.Context, *connect_go.Request[v1.UpdateFollowSettingsRequest]) (*connect_go.Response[v1.UpdateFollowSettingsResponse], error)
+	// ListFeed returns the listens of the users the caller follows, newest
+	// first.
+	ListFeed(context.Context, *connect_go.Request[v1.ListFeedRequest]) (*connect_go.Response[v1.ListFeedResponse], error)
+	// WatchListens streams the listens of the caller and their moots as they
+	// are recorded, along with changes in their presence and to the caller's
+	// notifications. The current presence of each user, and the caller's
+	// unread notification count, are sent when the stream starts. Listens may
+	// be sent more than once across reconnects, so should be de-duplicated by
+	// ID.
+	WatchListens(context.Context, *connect_go.Request[v1.WatchListensRequest], *connect_go.ServerStream[v1.WatchListensResponse]) error
+	// GetCompatibility scores how similar the listening of the caller and
+	// another user is. Scores are cached, so may lag behind the latest listens
+	// by a few minutes.
+	GetCompatibility(context.Context, *connect_go.Request[v1.GetCompatibilityRequest]) (*connect_go.Response[v1.GetCompatibilityResponse], error)
+	GetPrivacySettings(context.Context, *connect_go.Request[v1.GetPrivacySettingsRequest]) (*connect_go.Response[v1.GetPrivacySettingsResponse], error)
+	UpdatePrivacySettings(context.Context, *connect_go.Request[v1.UpdatePrivacySettingsRequest]) (*connect_go.Response[v1.UpdatePrivacySettingsResponse], error)
+	// SetListenHidden hides one of the caller's listens from everyone else, or
+	// reveals it again.
+	SetListenHidden(context.Context, *connect_go.Request[v1.SetListenHiddenRequest]) (*connect_go.Response[v1.SetListenHiddenResponse], error)
+	// CreateIncognitoWindow hides the caller's listens within a period of time
+	// from everyone else. The period may extend into the future, hiding
+	// listens as they're recorded.
+	CreateIncognitoWindow(context.Context, *connect_go.Request[v1.CreateIncognitoWindowRequest]) (*connect_go.Response[v1.CreateIncognitoWindowResponse], error)
+	ListIncognitoWindows(context.Context, *connect_go.Request[v1.ListIncognitoWindowsRequest]) (*connect_go.Response[v1.ListIncognitoWindowsResponse], error)
+	DeleteIncognitoWindow(context.Context, *connect_go.Request[v1.DeleteIncognitoWindowRequest]) (*connect_go.Response[v1.DeleteIncognitoWindowResponse], error)
+	// AddReaction reacts to a listen the caller can see with an emoji. Each
+	// user can react to a listen with up to five different emoji.
+	AddReaction(context.Context, *connect_go.Request[v1.AddReactionRequest]) (*connect_go.Response[v1.AddReactionResponse], error)
+	RemoveReaction(context.Context, *connect_go.Request[v1.RemoveReactionRequest]) (*connect_go.Response[v1.RemoveReactionResponse], error)
+	AddComment(context.Context, *connect_go.Request[v1.AddCommentRequest]) (*connect_go.Response[v1.AddCommentResponse], error)
+	// DeleteComment deletes a comment, which can be done by its author or the
+	// user whose listen it was left on.
+	DeleteComment(context.Context, *connect_go.Request[v1.DeleteCommentRequest]) (*connect_go.Response[v1.DeleteCommentResponse], error)
+	ListComments(context.Context, *connect_go.Request[v1.ListCommentsRequest]) (*connect_go.Response[v1.ListCommentsResponse], error)
+	// FlagComment reports a comment for moderation. Comments flagged by enough
+	// users are hidden.
+	FlagComment(context.Context, *connect_go.Request[v1.FlagCommentRequest]) (*connect_go.Response[v1.FlagCommentResponse], error)
+	// ListNotifications returns the caller's notifications, along with how
+	// many are unread. Changes are also sent as they happen to WatchListens.
+	ListNotifications(context.Context, *connect_go.Request[v1.ListNotificationsRequest]) (*connect_go.Response[v1.ListNotificationsResponse], error)
+	MarkNotificationsRead(context.Context, *connect_go.Request[v1.MarkNotificationsReadRequest]) (*connect_go.Response[v1.MarkNotificationsReadResponse], error)
+	GetNotificationPreferences(context.Context, *connect_go.Request[v1.GetNotificationPreferencesRequest]) (*connect_go.Response[v1.GetNotificationPreferencesResponse], error)
+	UpdateNotificationPreferences(context.Context, *connect_go.Request[v1.UpdateNotificationPreferencesRequest]) (*connect_go.Response[v1.UpdateNotificationPreferencesResponse], error)
+	UpdateProfile(context.Context, *connect_go.Request[v1.UpdateProfileRequest]) (*connect_go.Response[v1.UpdateProfileResponse], error)
+	// GetProfile can be called without signing in, in which case only the
+	// listens of users whose listens are public are included.
+	GetProfile(context.Context, *connect_go.Request[v1.GetProfileRequest]) (*connect_go.Response[v1.GetProfileResponse], error)
+	// BlockUser removes any follows between the caller and the user, and hides
+	// each of them from the other everywhere.
+	BlockUser(context.Context, *connect_go.Request[v1.BlockUserRequest]) (*connect_go.Response[v1.BlockUserResponse], error)
+	UnblockUser(context.Context, *connect_go.Request[v1.UnblockUserRequest]) (*connect_go.Response[v1.UnblockUserResponse], error)
+	ListBlockedUsers(context.Context, *connect_go.Request[v1.ListBlockedUsersRequest]) (*connect_go.Response[v1.ListBlockedUsersResponse], error)
+	// MuteUser hides the user from the caller's feed and notifications, without
+	// them knowing.
+	MuteUser(context.Context, *connect_go.Request[v1.MuteUserRequest]) (*connect_go.Response[v1.MuteUserResponse], error)
+	UnmuteUser(context.Context, *connect_go.Request[v1.UnmuteUserRequest]) (*connect_go.Response[v1.UnmuteUserResponse], error)
+	ListMutedUsers(context.Context, *connect_go.Request[v1.ListMutedUsersRequest]) (*connect_go.Response[v1.ListMutedUsersResponse], error)
+	// GetTopTracks, GetTopArtists and GetTopAlbums return what a user has
+	// played most over a window. Listens the user has hidden from others are
+	// only counted when the user is looking at their own.
+	GetTopTracks(context.Context, *connect_go.Request[v1.GetTopTracksRequest]) (*connect_go.Response[v1.GetTopTracksResponse], error)
+	GetTopArtists(context.Context, *connect_go.Request[v1.GetTopArtistsRequest]) (*connect_go.Response[v1.GetTopArtistsResponse], error)
+	GetTopAlbums(context.Context, *connect_go.Request[v1.GetTopAlbumsRequest]) (*connect_go.Response[v1.GetTopAlbumsResponse], error)
+	GetListeningStats(context.Context, *connect_go.Request[v1.GetListeningStatsRequest]) (*connect_go.Response[v1.GetListeningStatsResponse], error)
+	// GetRecap returns the caller's recap of a year, which is generated shortly
+	// after the year ends.
+	GetRecap(context.Context, *connect_go.Request[v1.GetRecapRequest]) (*connect_go.Response[v1.GetRecapResponse], error)
+	// GetCharts returns the most played tracks and artists over a period. Only
+	// the listening of users whose listens are public is counted.
+	GetCharts(context.Context, *connect_go.Request[v1.GetChartsRequest]) (*connect_go.Response[v1.GetChartsResponse], error)
+	// CreateListen records a listen by hand, such as one that was played
+	// offline. Its source is "manual".
+	CreateListen(context.Context, *connect_go.Request[v1.CreateListenRequest]) (*connect_go.Response[v1.CreateListenResponse], error)
+	UpdateListen(context.Context, *connect_go.Request[v1.UpdateListenRequest]) (*connect_go.Response[v1.UpdateListenResponse], error)
+	DeleteListen(context.Context, *connect_go.Request[v1.DeleteListenRequest]) (*connect_go.Response[v1.DeleteListenResponse], error)
+	// DeleteListens deletes every listen in a range of time.
+	DeleteListens(context.Context, *connect_go.Request[v1.DeleteListensRequest]) (*connect_go.Response[v1.DeleteListensResponse], error)
+	// ListListenEdits lists the changes the caller has made to their listens,
+	// most recent first.
+	ListListenEdits(context.Context, *connect_go.Request[v1.ListListenEditsRequest]) (*connect_go.Response[v1.ListListenEditsResponse], error)
+	// ExportListens streams the caller's listens in a format other services
+	// can import. They can also be downloaded over plain HTTP from
+	// /export/listens, with the format and range given as the query parameters
+	// format ("csv", "jsonl", "listenbrainz" or "scrobbler_log"), start_time
+	// and end_time (RFC 3339).
+	ExportListens(context.Context, *connect_go.Request[v1.ExportListensRequest], *connect_go.ServerStream[v1.ExportListensResponse]) error
+	// GetSyncStatus reports when the caller's linked accounts last had their
+	// listens synced.
+	GetSyncStatus(context.Context, *connect_go.Request[v1.GetSyncStatusRequest]) (*connect_go.Response[v1.GetSyncStatusResponse], error)
+	// ListMySecurityEvents lists security relevant events affecting the
+	// caller's account, such as logins, most recent first.
+	ListMySecurityEvents(context.Context, *connect_go.Request[v1.ListMySecurityEventsRequest]) (*connect_go.Response[v1.ListMySecurityEventsResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -191,6 +1277,296 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect_go.HandlerOpt
 		svc.ListListens,
 		opts...,
 	))
+	mux.Handle("/mootslive.v1.UserService/ExportMyData", connect_go.NewServerStreamHandler(
+		"/mootslive.v1.UserService/ExportMyData",
+		svc.ExportMyData,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/DeleteMyAccount", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/DeleteMyAccount",
+		svc.DeleteMyAccount,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/CancelAccountDeletion", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/CancelAccountDeletion",
+		svc.CancelAccountDeletion,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/GetTwitterSharingSettings", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/GetTwitterSharingSettings",
+		svc.GetTwitterSharingSettings,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/UpdateTwitterSharingSettings", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/UpdateTwitterSharingSettings",
+		svc.UpdateTwitterSharingSettings,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/ShareListen", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/ShareListen",
+		svc.ShareListen,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/SyncMoots", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/SyncMoots",
+		svc.SyncMoots,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/ListMoots", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/ListMoots",
+		svc.ListMoots,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/FollowUser", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/FollowUser",
+		svc.FollowUser,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/UnfollowUser", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/UnfollowUser",
+		svc.UnfollowUser,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/ListFollowers", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/ListFollowers",
+		svc.ListFollowers,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/ListFollowing", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/ListFollowing",
+		svc.ListFollowing,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/ListFollowRequests", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/ListFollowRequests",
+		svc.ListFollowRequests,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/ApproveFollowRequest", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/ApproveFollowRequest",
+		svc.ApproveFollowRequest,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/DenyFollowRequest", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/DenyFollowRequest",
+		svc.DenyFollowRequest,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/GetFollowSettings", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/GetFollowSettings",
+		svc.GetFollowSettings,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/UpdateFollowSettings", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/UpdateFollowSettings",
+		svc.UpdateFollowSettings,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/ListFeed", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/ListFeed",
+		svc.ListFeed,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/WatchListens", connect_go.NewServerStreamHandler(
+		"/mootslive.v1.UserService/WatchListens",
+		svc.WatchListens,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/GetCompatibility", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/GetCompatibility",
+		svc.GetCompatibility,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/GetPrivacySettings", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/GetPrivacySettings",
+		svc.GetPrivacySettings,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/UpdatePrivacySettings", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/UpdatePrivacySettings",
+		svc.UpdatePrivacySettings,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/SetListenHidden", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/SetListenHidden",
+		svc.SetListenHidden,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/CreateIncognitoWindow", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/CreateIncognitoWindow",
+		svc.CreateIncognitoWindow,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/ListIncognitoWindows", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/ListIncognitoWindows",
+		svc.ListIncognitoWindows,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/DeleteIncognitoWindow", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/DeleteIncognitoWindow",
+		svc.DeleteIncognitoWindow,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/AddReaction", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/AddReaction",
+		svc.AddReaction,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/RemoveReaction", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/RemoveReaction",
+		svc.RemoveReaction,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/AddComment", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/AddComment",
+		svc.AddComment,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/DeleteComment", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/DeleteComment",
+		svc.DeleteComment,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/ListComments", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/ListComments",
+		svc.ListComments,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/FlagComment", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/FlagComment",
+		svc.FlagComment,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/ListNotifications", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/ListNotifications",
+		svc.ListNotifications,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/MarkNotificationsRead", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/MarkNotificationsRead",
+		svc.MarkNotificationsRead,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/GetNotificationPreferences", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/GetNotificationPreferences",
+		svc.GetNotificationPreferences,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/UpdateNotificationPreferences", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/UpdateNotificationPreferences",
+		svc.UpdateNotificationPreferences,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/UpdateProfile", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/UpdateProfile",
+		svc.UpdateProfile,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/GetProfile", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/GetProfile",
+		svc.GetProfile,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/BlockUser", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/BlockUser",
+		svc.BlockUser,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/UnblockUser", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/UnblockUser",
+		svc.UnblockUser,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/ListBlockedUsers", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/ListBlockedUsers",
+		svc.ListBlockedUsers,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/MuteUser", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/MuteUser",
+		svc.MuteUser,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/UnmuteUser", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/UnmuteUser",
+		svc.UnmuteUser,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/ListMutedUsers", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/ListMutedUsers",
+		svc.ListMutedUsers,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/GetTopTracks", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/GetTopTracks",
+		svc.GetTopTracks,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/GetTopArtists", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/GetTopArtists",
+		svc.GetTopArtists,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/GetTopAlbums", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/GetTopAlbums",
+		svc.GetTopAlbums,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/GetListeningStats", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/GetListeningStats",
+		svc.GetListeningStats,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/GetRecap", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/GetRecap",
+		svc.GetRecap,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/GetCharts", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/GetCharts",
+		svc.GetCharts,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/CreateListen", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/CreateListen",
+		svc.CreateListen,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/UpdateListen", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/UpdateListen",
+		svc.UpdateListen,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/DeleteListen", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/DeleteListen",
+		svc.DeleteListen,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/DeleteListens", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/DeleteListens",
+		svc.DeleteListens,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/ListListenEdits", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/ListListenEdits",
+		svc.ListListenEdits,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/ExportListens", connect_go.NewServerStreamHandler(
+		"/mootslive.v1.UserService/ExportListens",
+		svc.ExportListens,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/GetSyncStatus", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/GetSyncStatus",
+		svc.GetSyncStatus,
+		opts...,
+	))
+	mux.Handle("/mootslive.v1.UserService/ListMySecurityEvents", connect_go.NewUnaryHandler(
+		"/mootslive.v1.UserService/ListMySecurityEvents",
+		svc.ListMySecurityEvents,
+		opts...,
+	))
 	return "/mootslive.v1.UserService/", mux
 }
 
@@ -212,3 +1588,235 @@ func (UnimplementedUserServiceHandler) FinishTwitterAuth(context.Context, *conne
 func (UnimplementedUserServiceHandler) ListListens(context.Context, *connect_go.Request[v1.ListListensRequest]) (*connect_go.Response[v1.ListListensResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.ListListens is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) ExportMyData(context.Context, *connect_go.Request[v1.ExportMyDataRequest], *connect_go.ServerStream[v1.ExportMyDataResponse]) error {
+	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.ExportMyData is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteMyAccount(context.Context, *connect_go.Request[v1.DeleteMyAccountRequest]) (*connect_go.Response[v1.DeleteMyAccountResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.DeleteMyAccount is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CancelAccountDeletion(context.Context, *connect_go.Request[v1.CancelAccountDeletionRequest]) (*connect_go.Response[v1.CancelAccountDeletionResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.CancelAccountDeletion is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetTwitterSharingSettings(context.Context, *connect_go.Request[v1.GetTwitterSharingSettingsRequest]) (*connect_go.Response[v1.GetTwitterSharingSettingsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.GetTwitterSharingSettings is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UpdateTwitterSharingSettings(context.Context, *connect_go.Request[v1.UpdateTwitterSharingSettingsRequest]) (*connect_go.Response[v1.UpdateTwitterSharingSettingsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.UpdateTwitterSharingSettings is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ShareListen(context.Context, *connect_go.Request[v1.ShareListenRequest]) (*connect_go.Response[v1.ShareListenResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.ShareListen is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) SyncMoots(context.Context, *connect_go.Request[v1.SyncMootsRequest]) (*connect_go.Response[v1.SyncMootsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.SyncMoots is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListMoots(context.Context, *connect_go.Request[v1.ListMootsRequest]) (*connect_go.Response[v1.ListMootsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.ListMoots is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) FollowUser(context.Context, *connect_go.Request[v1.FollowUserRequest]) (*connect_go.Response[v1.FollowUserResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.FollowUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UnfollowUser(context.Context, *connect_go.Request[v1.UnfollowUserRequest]) (*connect_go.Response[v1.UnfollowUserResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.UnfollowUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListFollowers(context.Context, *connect_go.Request[v1.ListFollowersRequest]) (*connect_go.Response[v1.ListFollowersResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.ListFollowers is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListFollowing(context.Context, *connect_go.Request[v1.ListFollowingRequest]) (*connect_go.Response[v1.ListFollowingResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.ListFollowing is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListFollowRequests(context.Context, *connect_go.Request[v1.ListFollowRequestsRequest]) (*connect_go.Response[v1.ListFollowRequestsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.ListFollowRequests is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ApproveFollowRequest(context.Context, *connect_go.Request[v1.ApproveFollowRequestRequest]) (*connect_go.Response[v1.ApproveFollowRequestResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.ApproveFollowRequest is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DenyFollowRequest(context.Context, *connect_go.Request[v1.DenyFollowRequestRequest]) (*connect_go.Response[v1.DenyFollowRequestResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.DenyFollowRequest is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetFollowSettings(context.Context, *connect_go.Request[v1.GetFollowSettingsRequest]) (*connect_go.Response[v1.GetFollowSettingsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.GetFollowSettings is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UpdateFollowSettings(context.Context, *connect_go.Request[v1.UpdateFollowSettingsRequest]) (*connect_go.Response[v1.UpdateFollowSettingsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.UpdateFollowSettings is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListFeed(context.Context, *connect_go.Request[v1.ListFeedRequest]) (*connect_go.Response[v1.ListFeedResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.ListFeed is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) WatchListens(context.Context, *connect_go.Request[v1.WatchListensRequest], *connect_go.ServerStream[v1.WatchListensResponse]) error {
+	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.WatchListens is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetCompatibility(context.Context, *connect_go.Request[v1.GetCompatibilityRequest]) (*connect_go.Response[v1.GetCompatibilityResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.GetCompatibility is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetPrivacySettings(context.Context, *connect_go.Request[v1.GetPrivacySettingsRequest]) (*connect_go.Response[v1.GetPrivacySettingsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.GetPrivacySettings is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UpdatePrivacySettings(context.Context, *connect_go.Request[v1.UpdatePrivacySettingsRequest]) (*connect_go.Response[v1.UpdatePrivacySettingsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.UpdatePrivacySettings is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) SetListenHidden(context.Context, *connect_go.Request[v1.SetListenHiddenRequest]) (*connect_go.Response[v1.SetListenHiddenResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.SetListenHidden is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CreateIncognitoWindow(context.Context, *connect_go.Request[v1.CreateIncognitoWindowRequest]) (*connect_go.Response[v1.CreateIncognitoWindowResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.CreateIncognitoWindow is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListIncognitoWindows(context.Context, *connect_go.Request[v1.ListIncognitoWindowsRequest]) (*connect_go.Response[v1.ListIncognitoWindowsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.ListIncognitoWindows is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteIncognitoWindow(context.Context, *connect_go.Request[v1.DeleteIncognitoWindowRequest]) (*connect_go.Response[v1.DeleteIncognitoWindowResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.DeleteIncognitoWindow is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) AddReaction(context.Context, *connect_go.Request[v1.AddReactionRequest]) (*connect_go.Response[v1.AddReactionResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.AddReaction is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RemoveReaction(context.Context, *connect_go.Request[v1.RemoveReactionRequest]) (*connect_go.Response[v1.RemoveReactionResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.RemoveReaction is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) AddComment(context.Context, *connect_go.Request[v1.AddCommentRequest]) (*connect_go.Response[v1.AddCommentResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.AddComment is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteComment(context.Context, *connect_go.Request[v1.DeleteCommentRequest]) (*connect_go.Response[v1.DeleteCommentResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.DeleteComment is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListComments(context.Context, *connect_go.Request[v1.ListCommentsRequest]) (*connect_go.Response[v1.ListCommentsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.ListComments is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) FlagComment(context.Context, *connect_go.Request[v1.FlagCommentRequest]) (*connect_go.Response[v1.FlagCommentResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.FlagComment is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListNotifications(context.Context, *connect_go.Request[v1.ListNotificationsRequest]) (*connect_go.Response[v1.ListNotificationsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.ListNotifications is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) MarkNotificationsRead(context.Context, *connect_go.Request[v1.MarkNotificationsReadRequest]) (*connect_go.Response[v1.MarkNotificationsReadResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.MarkNotificationsRead is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetNotificationPreferences(context.Context, *connect_go.Request[v1.GetNotificationPreferencesRequest]) (*connect_go.Response[v1.GetNotificationPreferencesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.GetNotificationPreferences is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UpdateNotificationPreferences(context.Context, *connect_go.Request[v1.UpdateNotificationPreferencesRequest]) (*connect_go.Response[v1.UpdateNotificationPreferencesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.UpdateNotificationPreferences is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UpdateProfile(context.Context, *connect_go.Request[v1.UpdateProfileRequest]) (*connect_go.Response[v1.UpdateProfileResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.UpdateProfile is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetProfile(context.Context, *connect_go.Request[v1.GetProfileRequest]) (*connect_go.Response[v1.GetProfileResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.GetProfile is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) BlockUser(context.Context, *connect_go.Request[v1.BlockUserRequest]) (*connect_go.Response[v1.BlockUserResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.BlockUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UnblockUser(context.Context, *connect_go.Request[v1.UnblockUserRequest]) (*connect_go.Response[v1.UnblockUserResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.UnblockUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListBlockedUsers(context.Context, *connect_go.Request[v1.ListBlockedUsersRequest]) (*connect_go.Response[v1.ListBlockedUsersResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.ListBlockedUsers is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) MuteUser(context.Context, *connect_go.Request[v1.MuteUserRequest]) (*connect_go.Response[v1.MuteUserResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.MuteUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UnmuteUser(context.Context, *connect_go.Request[v1.UnmuteUserRequest]) (*connect_go.Response[v1.UnmuteUserResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.UnmuteUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListMutedUsers(context.Context, *connect_go.Request[v1.ListMutedUsersRequest]) (*connect_go.Response[v1.ListMutedUsersResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.ListMutedUsers is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetTopTracks(context.Context, *connect_go.Request[v1.GetTopTracksRequest]) (*connect_go.Response[v1.GetTopTracksResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.GetTopTracks is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetTopArtists(context.Context, *connect_go.Request[v1.GetTopArtistsRequest]) (*connect_go.Response[v1.GetTopArtistsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.GetTopArtists is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetTopAlbums(context.Context, *connect_go.Request[v1.GetTopAlbumsRequest]) (*connect_go.Response[v1.GetTopAlbumsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.GetTopAlbums is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetListeningStats(context.Context, *connect_go.Request[v1.GetListeningStatsRequest]) (*connect_go.Response[v1.GetListeningStatsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.GetListeningStats is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetRecap(context.Context, *connect_go.Request[v1.GetRecapRequest]) (*connect_go.Response[v1.GetRecapResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.GetRecap is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetCharts(context.Context, *connect_go.Request[v1.GetChartsRequest]) (*connect_go.Response[v1.GetChartsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.GetCharts is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CreateListen(context.Context, *connect_go.Request[v1.CreateListenRequest]) (*connect_go.Response[v1.CreateListenResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.CreateListen is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UpdateListen(context.Context, *connect_go.Request[v1.UpdateListenRequest]) (*connect_go.Response[v1.UpdateListenResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.UpdateListen is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteListen(context.Context, *connect_go.Request[v1.DeleteListenRequest]) (*connect_go.Response[v1.DeleteListenResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.DeleteListen is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteListens(context.Context, *connect_go.Request[v1.DeleteListensRequest]) (*connect_go.Response[v1.DeleteListensResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.DeleteListens is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListListenEdits(context.Context, *connect_go.Request[v1.ListListenEditsRequest]) (*connect_go.Response[v1.ListListenEditsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.ListListenEdits is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ExportListens(context.Context, *connect_go.Request[v1.ExportListensRequest], *connect_go.ServerStream[v1.ExportListensResponse]) error {
+	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.ExportListens is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetSyncStatus(context.Context, *connect_go.Request[v1.GetSyncStatusRequest]) (*connect_go.Response[v1.GetSyncStatusResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.GetSyncStatus is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListMySecurityEvents(context.Context, *connect_go.Request[v1.ListMySecurityEventsRequest]) (*connect_go.Response[v1.ListMySecurityEventsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("mootslive.v1.UserService.ListMySecurityEvents is not implemented"))
+}